@@ -35,6 +35,7 @@ import (
 	"github.com/nuts-foundation/nuts-node/auth/services"
 	nutsCrypto "github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/vcr"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
 	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
 	"github.com/nuts-foundation/nuts-node/vdr/doc"
@@ -349,10 +350,19 @@ func (s *service) validateAuthorizationCredentials(context *validationContext) e
 	iat := context.jwtBearerToken.IssuedAt()
 	iss := context.jwtBearerToken.Issuer()
 	sub := context.jwtBearerToken.Subject()
+	// the verifications are recorded in the audit trail with the parties of the access token request
+	caller := audit.Caller{
+		Name: "auth.CreateAccessToken",
+		Details: map[string]string{
+			"requester":    iss,
+			"authorizer":   sub,
+			"purposeOfUse": context.purposeOfUse,
+		},
+	}
 
 	for _, authCred := range vcs {
 		// first check if the VC is valid and if the signature is correct
-		if err := s.vcValidator.Validate(authCred, true, true, &iat); err != nil {
+		if err := s.vcValidator.Validate(authCred, true, true, &iat, caller); err != nil {
 			return fmt.Errorf(errInvalidVCClaim, err)
 		}
 
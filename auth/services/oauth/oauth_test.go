@@ -44,6 +44,7 @@ import (
 	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/didman"
 	"github.com/nuts-foundation/nuts-node/vcr"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
 	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
 	vcrTypes "github.com/nuts-foundation/nuts-node/vcr/types"
@@ -185,7 +186,12 @@ func TestAuth_CreateAccessToken(t *testing.T) {
 		ctx.serviceResolver.EXPECT().GetCompoundServiceEndpoint(authorizerDID, expectedService, services.OAuthEndpointType, true).Return(expectedAudience, nil)
 		ctx.privateKeyStore.EXPECT().Exists(authorizerSigningKeyID.String()).Return(true)
 		ctx.privateKeyStore.EXPECT().SignJWT(gomock.Any(), authorizerSigningKeyID.String()).Return("expectedAccessToken", nil)
-		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any()).Return(nil)
+		expectedCaller := audit.Caller{Name: "auth.CreateAccessToken", Details: map[string]string{
+			"requester":    requesterDID.String(),
+			"authorizer":   authorizerDID.String(),
+			"purposeOfUse": expectedService,
+		}}
+		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any(), expectedCaller).Return(nil)
 
 		tokenCtx := validContext()
 		tokenCtx.jwtBearerToken.Remove(userIdentityClaim)
@@ -214,7 +220,7 @@ func TestAuth_CreateAccessToken(t *testing.T) {
 			DAttributes: map[string]string{"name": "Henk de Vries"},
 			CAttributes: map[string]string{"legal_entity": "Carebears", "legal_entity_city": "Caretown"},
 		}, nil)
-		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any()).Return(nil)
+		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any(), gomock.Any()).Return(nil)
 
 		tokenCtx := validContext()
 		signToken(tokenCtx)
@@ -412,7 +418,7 @@ func TestService_validateAuthorizationCredentials(t *testing.T) {
 		tokenCtx := validContext()
 		signToken(tokenCtx)
 
-		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any()).Return(nil)
+		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any(), gomock.Any()).Return(nil)
 		err := ctx.oauthService.validateAuthorizationCredentials(tokenCtx)
 
 		if !assert.NoError(t, err) {
@@ -473,7 +479,7 @@ func TestService_validateAuthorizationCredentials(t *testing.T) {
 		tokenCtx := validContext()
 		tokenCtx.jwtBearerToken.Set(jwt.IssuerKey, "unknown")
 		signToken(tokenCtx)
-		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any()).Return(nil)
+		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any(), gomock.Any()).Return(nil)
 
 		err := ctx.oauthService.validateAuthorizationCredentials(tokenCtx)
 
@@ -487,7 +493,7 @@ func TestService_validateAuthorizationCredentials(t *testing.T) {
 		tokenCtx := validContext()
 		tokenCtx.jwtBearerToken.Set(jwt.SubjectKey, "unknown")
 		signToken(tokenCtx)
-		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any()).Return(nil)
+		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any(), gomock.Any()).Return(nil)
 
 		err := ctx.oauthService.validateAuthorizationCredentials(tokenCtx)
 
@@ -501,7 +507,7 @@ func TestService_validateAuthorizationCredentials(t *testing.T) {
 		tokenCtx := validContext()
 		tokenCtx.jwtBearerToken.Set(jwt.SubjectKey, "unknown")
 		signToken(tokenCtx)
-		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any()).Return(vcrTypes.ErrRevoked)
+		ctx.vcValidator.EXPECT().Validate(gomock.Any(), true, true, gomock.Any(), gomock.Any()).Return(vcrTypes.ErrRevoked)
 
 		err := ctx.oauthService.validateAuthorizationCredentials(tokenCtx)
 
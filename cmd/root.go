@@ -189,7 +189,7 @@ func CreateSystem() *core.System {
 
 	networkInstance := network.NewNetworkInstance(network.DefaultConfig(), keyResolver, cryptoInstance, cryptoInstance, docResolver, docFinder)
 	vdrInstance := vdr.NewVDR(vdr.DefaultConfig(), cryptoInstance, networkInstance, memoryStore)
-	credentialInstance := vcr.NewVCRInstance(cryptoInstance, docResolver, keyResolver, networkInstance)
+	credentialInstance := vcr.NewVCRInstance(cryptoInstance, docResolver, keyResolver, networkInstance, eventManager)
 	didmanInstance := didman.NewDidmanInstance(docResolver, memoryStore, vdrInstance, credentialInstance)
 	authInstance := auth.NewAuthInstance(auth.DefaultConfig(), memoryStore, credentialInstance, cryptoInstance, didmanInstance)
 
@@ -198,10 +198,11 @@ func CreateSystem() *core.System {
 
 	// Register HTTP routes
 	system.RegisterRoutes(&core.LandingPage{})
-	system.RegisterRoutes(&cryptoAPI.Wrapper{C: cryptoInstance})
+	system.RegisterRoutes(&cryptoAPI.Wrapper{C: cryptoInstance, DocResolver: docResolver})
 	system.RegisterRoutes(&networkAPI.Wrapper{Service: networkInstance})
-	system.RegisterRoutes(&vdrAPI.Wrapper{VDR: vdrInstance, DocResolver: docResolver, DocManipulator: &doc.Manipulator{
+	system.RegisterRoutes(&vdrAPI.Wrapper{VDR: vdrInstance, DocResolver: docResolver, DocFinder: docFinder, DocManipulator: &doc.Manipulator{
 		KeyCreator: cryptoInstance,
+		KeyRetirer: cryptoInstance,
 		Updater:    vdrInstance,
 		Resolver:   docResolver,
 	}})
@@ -21,10 +21,13 @@ package v1
 import (
 	"errors"
 	"net/http"
+	"sort"
 
 	"github.com/labstack/echo/v4"
+	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/nuts-node/core"
 	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/nuts-foundation/nuts-node/vdr/types"
 )
 
 var _ ServerInterface = (*Wrapper)(nil)
@@ -32,13 +35,16 @@ var _ ErrorStatusCodeResolver = (*Wrapper)(nil)
 
 // Wrapper implements the generated interface from oapi-codegen
 type Wrapper struct {
-	C crypto.JWTSigner
+	C crypto.KeyStore
+	// DocResolver is used to find out whether keys still appear in their DID document.
+	DocResolver types.DocResolver
 }
 
 // ResolveStatusCode maps errors returned by this API to specific HTTP status codes.
 func (w *Wrapper) ResolveStatusCode(err error) int {
 	return core.ResolveStatusCode(err, map[error]int{
 		crypto.ErrKeyNotFound: http.StatusBadRequest,
+		crypto.ErrKeyRetired:  http.StatusBadRequest,
 	})
 }
 
@@ -88,3 +94,63 @@ func (w *Wrapper) SignJwt(ctx echo.Context) error {
 
 	return ctx.String(http.StatusOK, sig)
 }
+
+// ListRetiredKeys handles api calls for listing the keys that have been retired
+func (w *Wrapper) ListRetiredKeys(ctx echo.Context) error {
+	retiredKeys, err := w.C.ListRetired()
+	if err != nil {
+		return err
+	}
+	results := make([]RetiredKey, len(retiredKeys))
+	for i, retiredKey := range retiredKeys {
+		results[i] = RetiredKey{
+			Kid:        retiredKey.KID,
+			RetiredAt:  retiredKey.RetiredAt,
+			PurgeAfter: retiredKey.PurgeAfter,
+		}
+	}
+	return ctx.JSON(http.StatusOK, results)
+}
+
+// ListOrphanedKeys handles api calls for listing the keys that no longer appear in their DID document.
+// Keys which KID isn't a DID URL, keys of DIDs that can't be found and retired keys are not considered orphaned.
+func (w *Wrapper) ListOrphanedKeys(ctx echo.Context) error {
+	retiredKeys, err := w.C.ListRetired()
+	if err != nil {
+		return err
+	}
+	retired := make(map[string]bool, len(retiredKeys))
+	for _, retiredKey := range retiredKeys {
+		retired[retiredKey.KID] = true
+	}
+
+	results := make([]string, 0)
+	documents := make(map[string]*did.Document)
+	for _, kid := range w.C.List() {
+		if retired[kid] {
+			continue
+		}
+		keyID, err := did.ParseDIDURL(kid)
+		if err != nil || keyID.Fragment == "" {
+			continue
+		}
+		id := *keyID
+		id.Fragment = ""
+		document, ok := documents[id.String()]
+		if !ok {
+			document, _, err = w.DocResolver.Resolve(id, &types.ResolveMetadata{AllowDeactivated: true})
+			if errors.Is(err, types.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			documents[id.String()] = document
+		}
+		if document.VerificationMethod.FindByID(*keyID) == nil {
+			results = append(results, kid)
+		}
+	}
+	sort.Strings(results)
+	return ctx.JSON(http.StatusOK, results)
+}
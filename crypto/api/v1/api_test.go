@@ -24,12 +24,15 @@ import (
 	"github.com/nuts-foundation/nuts-node/core"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/nuts-foundation/go-did/did"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/mock"
+	"github.com/nuts-foundation/nuts-node/vdr/types"
 )
 
 func TestWrapper_Preprocess(t *testing.T) {
@@ -140,22 +143,82 @@ func TestWrapper_SignJwt(t *testing.T) {
 	})
 }
 
+func TestWrapper_ListRetiredKeys(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		defer ctx.ctrl.Finish()
+		retiredAt := time.Now()
+		ctx.keyStore.EXPECT().ListRetired().Return([]crypto.RetiredKey{{KID: "kid", RetiredAt: retiredAt, PurgeAfter: retiredAt.Add(time.Hour)}}, nil)
+		ctx.echo.EXPECT().JSON(http.StatusOK, []RetiredKey{{Kid: "kid", RetiredAt: retiredAt, PurgeAfter: retiredAt.Add(time.Hour)}})
+
+		err := ctx.client.ListRetiredKeys(ctx.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("error", func(t *testing.T) {
+		ctx := newMockContext(t)
+		defer ctx.ctrl.Finish()
+		ctx.keyStore.EXPECT().ListRetired().Return(nil, errors.New("b00m!"))
+
+		err := ctx.client.ListRetiredKeys(ctx.echo)
+
+		assert.EqualError(t, err, "b00m!")
+	})
+}
+
+func TestWrapper_ListOrphanedKeys(t *testing.T) {
+	id := did.MustParseDID("did:nuts:123")
+	inUse := did.MustParseDIDURL("did:nuts:123#in-use")
+	removed := "did:nuts:123#removed"
+	retired := "did:nuts:123#retired"
+	unknown := "did:nuts:456#key"
+	document := &did.Document{ID: id, VerificationMethod: did.VerificationMethods{{ID: inUse, Controller: id}}}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		defer ctx.ctrl.Finish()
+		ctx.keyStore.EXPECT().ListRetired().Return([]crypto.RetiredKey{{KID: retired}}, nil)
+		ctx.keyStore.EXPECT().List().Return([]string{inUse.String(), removed, retired, unknown, "not-a-did"})
+		ctx.docResolver.EXPECT().Resolve(id, &types.ResolveMetadata{AllowDeactivated: true}).Return(document, nil, nil)
+		ctx.docResolver.EXPECT().Resolve(did.MustParseDID("did:nuts:456"), gomock.Any()).Return(nil, nil, types.ErrNotFound)
+		ctx.echo.EXPECT().JSON(http.StatusOK, []string{removed})
+
+		err := ctx.client.ListOrphanedKeys(ctx.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - resolve fails", func(t *testing.T) {
+		ctx := newMockContext(t)
+		defer ctx.ctrl.Finish()
+		ctx.keyStore.EXPECT().ListRetired().Return(nil, nil)
+		ctx.keyStore.EXPECT().List().Return([]string{removed})
+		ctx.docResolver.EXPECT().Resolve(id, gomock.Any()).Return(nil, nil, errors.New("b00m!"))
+
+		err := ctx.client.ListOrphanedKeys(ctx.echo)
+
+		assert.EqualError(t, err, "b00m!")
+	})
+}
+
 type mockContext struct {
-	ctrl     *gomock.Controller
-	echo     *mock.MockContext
-	keyStore *crypto.MockKeyStore
-	client   *Wrapper
+	ctrl        *gomock.Controller
+	echo        *mock.MockContext
+	keyStore    *crypto.MockKeyStore
+	docResolver *types.MockDocResolver
+	client      *Wrapper
 }
 
 func newMockContext(t *testing.T) mockContext {
 	ctrl := gomock.NewController(t)
 	keyStore := crypto.NewMockKeyStore(ctrl)
-	client := &Wrapper{C: keyStore}
+	docResolver := types.NewMockDocResolver(ctrl)
+	client := &Wrapper{C: keyStore, DocResolver: docResolver}
 
 	return mockContext{
-		ctrl:     ctrl,
-		echo:     mock.NewMockContext(ctrl),
-		keyStore: keyStore,
-		client:   client,
+		ctrl:        ctrl,
+		echo:        mock.NewMockContext(ctrl),
+		keyStore:    keyStore,
+		docResolver: docResolver,
+		client:      client,
 	}
 }
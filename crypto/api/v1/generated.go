@@ -13,10 +13,23 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
 
+// RetiredKey defines model for RetiredKey.
+type RetiredKey struct {
+	// The ID of the retired key.
+	Kid string `json:"kid"`
+
+	// The moment after which the key is purged from the key storage.
+	PurgeAfter time.Time `json:"purgeAfter"`
+
+	// The moment the key was retired.
+	RetiredAt time.Time `json:"retiredAt"`
+}
+
 // SignJwtRequest defines model for SignJwtRequest.
 type SignJwtRequest struct {
 	Claims map[string]interface{} `json:"claims"`
@@ -102,12 +115,42 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListOrphanedKeys request
+	ListOrphanedKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRetiredKeys request
+	ListRetiredKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SignJwt request with any body
 	SignJwtWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	SignJwt(ctx context.Context, body SignJwtJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) ListOrphanedKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrphanedKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRetiredKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRetiredKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) SignJwtWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSignJwtRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -132,6 +175,60 @@ func (c *Client) SignJwt(ctx context.Context, body SignJwtJSONRequestBody, reqEd
 	return c.Client.Do(req)
 }
 
+// NewListOrphanedKeysRequest generates requests for ListOrphanedKeys
+func NewListOrphanedKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/crypto/v1/keys/orphaned")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRetiredKeysRequest generates requests for ListRetiredKeys
+func NewListRetiredKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/crypto/v1/keys/retired")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewSignJwtRequest calls the generic SignJwt builder with application/json body
 func NewSignJwtRequest(server string, body SignJwtJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -215,12 +312,62 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// ListOrphanedKeys request
+	ListOrphanedKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrphanedKeysResponse, error)
+
+	// ListRetiredKeys request
+	ListRetiredKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRetiredKeysResponse, error)
+
 	// SignJwt request with any body
 	SignJwtWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignJwtResponse, error)
 
 	SignJwtWithResponse(ctx context.Context, body SignJwtJSONRequestBody, reqEditors ...RequestEditorFn) (*SignJwtResponse, error)
 }
 
+type ListOrphanedKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrphanedKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrphanedKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRetiredKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RetiredKey
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRetiredKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRetiredKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type SignJwtResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -242,6 +389,24 @@ func (r SignJwtResponse) StatusCode() int {
 	return 0
 }
 
+// ListOrphanedKeysWithResponse request returning *ListOrphanedKeysResponse
+func (c *ClientWithResponses) ListOrphanedKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrphanedKeysResponse, error) {
+	rsp, err := c.ListOrphanedKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrphanedKeysResponse(rsp)
+}
+
+// ListRetiredKeysWithResponse request returning *ListRetiredKeysResponse
+func (c *ClientWithResponses) ListRetiredKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRetiredKeysResponse, error) {
+	rsp, err := c.ListRetiredKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRetiredKeysResponse(rsp)
+}
+
 // SignJwtWithBodyWithResponse request with arbitrary body returning *SignJwtResponse
 func (c *ClientWithResponses) SignJwtWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignJwtResponse, error) {
 	rsp, err := c.SignJwtWithBody(ctx, contentType, body, reqEditors...)
@@ -259,6 +424,58 @@ func (c *ClientWithResponses) SignJwtWithResponse(ctx context.Context, body Sign
 	return ParseSignJwtResponse(rsp)
 }
 
+// ParseListOrphanedKeysResponse parses an HTTP response from a ListOrphanedKeysWithResponse call
+func ParseListOrphanedKeysResponse(rsp *http.Response) (*ListOrphanedKeysResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrphanedKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRetiredKeysResponse parses an HTTP response from a ListRetiredKeysWithResponse call
+func ParseListRetiredKeysResponse(rsp *http.Response) (*ListRetiredKeysResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRetiredKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RetiredKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseSignJwtResponse parses an HTTP response from a SignJwtWithResponse call
 func ParseSignJwtResponse(rsp *http.Response) (*SignJwtResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -277,6 +494,12 @@ func ParseSignJwtResponse(rsp *http.Response) (*SignJwtResponse, error) {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Lists the private keys that no longer appear in a DID document
+	// (GET /internal/crypto/v1/keys/orphaned)
+	ListOrphanedKeys(ctx echo.Context) error
+	// Lists the private keys that have been retired
+	// (GET /internal/crypto/v1/keys/retired)
+	ListRetiredKeys(ctx echo.Context) error
 	// sign a JWT payload with the private key of the given kid
 	// (POST /internal/crypto/v1/sign_jwt)
 	SignJwt(ctx echo.Context) error
@@ -287,6 +510,24 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// ListOrphanedKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOrphanedKeys(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListOrphanedKeys(ctx)
+	return err
+}
+
+// ListRetiredKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) ListRetiredKeys(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListRetiredKeys(ctx)
+	return err
+}
+
 // SignJwt converts echo context to params.
 func (w *ServerInterfaceWrapper) SignJwt(ctx echo.Context) error {
 	var err error
@@ -328,6 +569,14 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	// PATCH: This alteration wraps the call to the implementation in a function that sets the "OperationId" context parameter,
 	// so it can be used in error reporting middleware.
+	router.Add(http.MethodGet, baseURL+"/internal/crypto/v1/keys/orphaned", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ListOrphanedKeys", context)
+		return wrapper.ListOrphanedKeys(context)
+	})
+	router.Add(http.MethodGet, baseURL+"/internal/crypto/v1/keys/retired", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ListRetiredKeys", context)
+		return wrapper.ListRetiredKeys(context)
+	})
 	router.Add(http.MethodPost, baseURL+"/internal/crypto/v1/sign_jwt", func(context echo.Context) error {
 		si.(Preprocessor).Preprocess("SignJwt", context)
 		return wrapper.SignJwt(context)
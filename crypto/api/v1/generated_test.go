@@ -43,6 +43,14 @@ func (t *testServerInterface) SignJwt(_ echo.Context) error {
 	return t.err
 }
 
+func (t *testServerInterface) ListRetiredKeys(_ echo.Context) error {
+	return t.err
+}
+
+func (t *testServerInterface) ListOrphanedKeys(_ echo.Context) error {
+	return t.err
+}
+
 var siws = []*ServerInterfaceWrapper{
 	serverInterfaceWrapper(nil), serverInterfaceWrapper(errors.New("Server error")),
 }
@@ -68,6 +76,8 @@ func TestRegisterHandlers(t *testing.T) {
 		echo := core.NewMockEchoRouter(ctrl)
 
 		echo.EXPECT().Add(http.MethodPost, "/internal/crypto/v1/sign_jwt", gomock.Any())
+		echo.EXPECT().Add(http.MethodGet, "/internal/crypto/v1/keys/retired", gomock.Any())
+		echo.EXPECT().Add(http.MethodGet, "/internal/crypto/v1/keys/orphaned", gomock.Any())
 
 		RegisterHandlers(echo, &testServerInterface{})
 	})
@@ -37,6 +37,9 @@ const ConfigVaultAddr string = "crypto.vault.address"
 // ConfigVaultPathPrefix is used as --crypto.vault.pathprefix config flag
 const ConfigVaultPathPrefix string = "crypto.vault.pathprefix"
 
+// ConfigRetiredKeyRetention is used as --crypto.retiredkeyretention config flag
+const ConfigRetiredKeyRetention string = "crypto.retiredkeyretention"
+
 // FlagSet returns the configuration flags for crypto
 func FlagSet() *pflag.FlagSet {
 	flags := pflag.NewFlagSet("crypto", pflag.ContinueOnError)
@@ -46,6 +49,7 @@ func FlagSet() *pflag.FlagSet {
 	flags.String(ConfigVaultToken, defs.Vault.Token, "The Vault token. If set it overwrites the VAULT_TOKEN env var.")
 	flags.String(ConfigVaultAddr, defs.Vault.Address, "The Vault address. If set it overwrites the VAULT_ADDR env var.")
 	flags.String(ConfigVaultPathPrefix, defs.Vault.PathPrefix, fmt.Sprintf("The Vault path prefix. default: %s.", defs.Vault.PathPrefix))
+	flags.Duration(ConfigRetiredKeyRetention, defs.RetiredKeyRetention, "Period retired private keys are kept before they're purged from the key storage, such as '720h'. Refer to Golang's 'time.Duration' syntax for a more elaborate description of the syntax.")
 
 	return flags
 }
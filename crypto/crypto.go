@@ -19,41 +19,56 @@
 package crypto
 
 import (
+	"context"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
 	"errors"
 	"fmt"
 	"github.com/nuts-foundation/nuts-node/core"
 	"github.com/nuts-foundation/nuts-node/crypto/log"
 	"github.com/nuts-foundation/nuts-node/crypto/storage"
 	"path"
+	"sync"
+	"time"
 )
 
 const (
 	// ModuleName contains the name of this module
 	ModuleName = "Crypto"
+	// rsaKeySize contains the size in bits of generated RSA keys
+	rsaKeySize = 2048
+	// defaultRetiredKeyRetention contains the default period retired keys are kept before they're purged
+	defaultRetiredKeyRetention = 30 * 24 * time.Hour
 )
 
 // Config holds the values for the crypto engine
 type Config struct {
-	Storage string              `koanf:"crypto.storage"`
-	Vault   storage.VaultConfig `koanf:"crypto.vault"`
+	Storage             string              `koanf:"crypto.storage"`
+	Vault               storage.VaultConfig `koanf:"crypto.vault"`
+	RetiredKeyRetention time.Duration       `koanf:"crypto.retiredkeyretention"`
 }
 
 // DefaultCryptoConfig returns a Config with sane defaults
 func DefaultCryptoConfig() Config {
 	return Config{
-		Storage: "fs",
-		Vault:   storage.DefaultVaultConfig(),
+		Storage:             "fs",
+		Vault:               storage.DefaultVaultConfig(),
+		RetiredKeyRetention: defaultRetiredKeyRetention,
 	}
 }
 
 // Crypto holds references to storage and needed config
 type Crypto struct {
-	Storage storage.Storage
-	config  Config
+	Storage     storage.Storage
+	config      Config
+	retired     *retiredKeyStore
+	stopPurging context.CancelFunc
+	observers   []KeyObserverFunc
+	observerMux sync.RWMutex
 }
 
 // NewCryptoInstance creates a new instance of the crypto engine.
@@ -91,42 +106,83 @@ func (client *Crypto) List() []string {
 
 // Configure loads the given configurations in the engine. Any wrong combination will return an error
 func (client *Crypto) Configure(config core.ServerConfig) error {
+	var err error
 	switch client.config.Storage {
 	case "fs":
-		return client.setupFSBackend(config)
+		err = client.setupFSBackend(config)
 	case "vaultkv":
-		return client.setupVaultBackend(config)
+		err = client.setupVaultBackend(config)
 	case "":
 		if config.Strictmode {
 			return errors.New("backend must be explicitly set in strict mode")
 		}
 		// default to file system and run this setup again
-		return client.setupFSBackend(config)
+		err = client.setupFSBackend(config)
 	default:
 		return errors.New("invalid config for crypto.storage. Available options are: vaultkv, fs")
 	}
+	if err != nil {
+		return err
+	}
+	client.retired, err = newRetiredKeyStore(config.Datadir)
+	return err
+}
+
+// Start starts purging retired keys of which the retention period has passed.
+func (client *Crypto) Start() error {
+	var ctx context.Context
+	ctx, client.stopPurging = context.WithCancel(context.Background())
+	go client.purgeRetiredKeysPeriodically(ctx)
+	return nil
+}
+
+// Shutdown stops purging retired keys and closes the underlying database.
+func (client *Crypto) Shutdown() error {
+	if client.stopPurging != nil {
+		client.stopPurging()
+	}
+	if client.retired != nil {
+		return client.retired.close()
+	}
+	return nil
 }
 
 // New generates a new key pair.
 // Stores the private key, returns the public key
 // If a key is overwritten is handled by the storage implementation.
 // (it's considered bad practise to reuse a kid for different keys)
-func (client *Crypto) New(namingFunc KIDNamingFunc) (Key, error) {
-	keyPair, kid, err := generateKeyPairAndKID(namingFunc)
+func (client *Crypto) New(keyType KeyType, namingFunc KIDNamingFunc) (Key, error) {
+	keyPair, kid, err := generateKeyPairAndKID(keyType, namingFunc)
 	if err != nil {
 		return nil, err
 	}
 	if err = client.Storage.SavePrivateKey(kid, keyPair); err != nil {
 		return nil, fmt.Errorf("could not create new keypair: could not save private key: %w", err)
 	}
+	client.notifyObservers(kid)
 	return keySelector{
 		privateKey: keyPair,
 		kid:        kid,
 	}, nil
 }
 
-func generateKeyPairAndKID(namingFunc KIDNamingFunc) (*ecdsa.PrivateKey, string, error) {
-	keyPair, err := generateECKeyPair()
+// RegisterObserver registers a callback function that is called when a private key is added to or removed from the key store.
+func (client *Crypto) RegisterObserver(observer KeyObserverFunc) {
+	client.observerMux.Lock()
+	defer client.observerMux.Unlock()
+	client.observers = append(client.observers, observer)
+}
+
+func (client *Crypto) notifyObservers(kid string) {
+	client.observerMux.RLock()
+	defer client.observerMux.RUnlock()
+	for _, observer := range client.observers {
+		observer(kid)
+	}
+}
+
+func generateKeyPairAndKID(keyType KeyType, namingFunc KIDNamingFunc) (crypto.Signer, string, error) {
+	keyPair, err := generateKeyPair(keyType)
 	if err != nil {
 		return nil, "", err
 	}
@@ -135,10 +191,24 @@ func generateKeyPairAndKID(namingFunc KIDNamingFunc) (*ecdsa.PrivateKey, string,
 	if err != nil {
 		return nil, "", err
 	}
-	log.Logger().Infof("Generated new key pair (id=%s)", kid)
+	log.Logger().Infof("Generated new key pair (id=%s, type=%s)", kid, keyType)
 	return keyPair, kid, nil
 }
 
+func generateKeyPair(keyType KeyType) (crypto.Signer, error) {
+	switch keyType {
+	case ECP256Key:
+		return generateECKeyPair()
+	case Ed25519Key:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	case RSAKey:
+		return rsa.GenerateKey(rand.Reader, rsaKeySize)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, keyType)
+	}
+}
+
 func generateECKeyPair() (*ecdsa.PrivateKey, error) {
 	return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 }
@@ -149,6 +219,9 @@ func (client *Crypto) Exists(kid string) bool {
 }
 
 func (client *Crypto) Resolve(kid string) (Key, error) {
+	if err := client.checkNotRetired(kid); err != nil {
+		return nil, err
+	}
 	keypair, err := client.Storage.GetPrivateKey(kid)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
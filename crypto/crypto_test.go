@@ -20,6 +20,8 @@ package crypto
 
 import (
 	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"errors"
 	"reflect"
 	"testing"
@@ -37,7 +39,7 @@ func TestCrypto_PrivateKeyExists(t *testing.T) {
 	client := createCrypto(t)
 
 	kid := "kid"
-	client.New(StringNamingFunc(kid))
+	client.New(DefaultKeyType, StringNamingFunc(kid))
 
 	t.Run("returns true for existing key", func(t *testing.T) {
 		assert.True(t, client.Exists(kid))
@@ -53,18 +55,62 @@ func TestCrypto_New(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		kid := "kid"
-		key, err := client.New(StringNamingFunc(kid))
+		key, err := client.New(DefaultKeyType, StringNamingFunc(kid))
 		assert.NoError(t, err)
 		assert.NotNil(t, key.Signer())
 		assert.NotNil(t, key.Public())
 		assert.Equal(t, kid, key.KID())
 	})
 
+	t.Run("ok - observers are notified", func(t *testing.T) {
+		client := createCrypto(t)
+		var added []string
+		client.RegisterObserver(func(kid string) {
+			added = append(added, kid)
+		})
+
+		_, err := client.New(DefaultKeyType, StringNamingFunc("kid"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"kid"}, added)
+	})
+
+	t.Run("ok - Ed25519", func(t *testing.T) {
+		key, err := client.New(Ed25519Key, StringNamingFunc("ed25519"))
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.IsType(t, ed25519.PrivateKey{}, key.Signer())
+		resolved, err := client.Resolve("ed25519")
+		assert.NoError(t, err)
+		assert.Equal(t, key.Public(), resolved.Public())
+	})
+
+	t.Run("ok - RSA", func(t *testing.T) {
+		key, err := client.New(RSAKey, StringNamingFunc("rsa"))
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.IsType(t, &rsa.PrivateKey{}, key.Signer())
+		resolved, err := client.Resolve("rsa")
+		assert.NoError(t, err)
+		assert.Equal(t, key.Public(), resolved.Public())
+	})
+
+	t.Run("error - unsupported key type", func(t *testing.T) {
+		_, err := client.New("DSA", StringNamingFunc("dsa"))
+
+		assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+		assert.False(t, client.Exists("dsa"))
+	})
+
 	t.Run("error - NamingFunction returns err", func(t *testing.T) {
 		errorNamingFunc := func(key crypto.PublicKey) (string, error) {
 			return "", errors.New("b00m!")
 		}
-		_, err := client.New(errorNamingFunc)
+		_, err := client.New(DefaultKeyType, errorNamingFunc)
 		assert.Error(t, err)
 	})
 
@@ -75,17 +121,43 @@ func TestCrypto_New(t *testing.T) {
 		storageMock.EXPECT().SavePrivateKey(gomock.Any(), gomock.Any()).Return(errors.New("foo"))
 
 		client := &Crypto{Storage: storageMock}
-		key, err := client.New(StringNamingFunc("123"))
+		key, err := client.New(DefaultKeyType, StringNamingFunc("123"))
 		assert.Nil(t, key)
 		assert.Error(t, err)
 		assert.Equal(t, "could not create new keypair: could not save private key: foo", err.Error())
 	})
 }
 
+func TestParseKeyType(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		for _, expected := range SupportedKeyTypes() {
+			actual, err := ParseKeyType(string(expected))
+			assert.NoError(t, err)
+			assert.Equal(t, expected, actual)
+		}
+	})
+	t.Run("ok - empty yields default", func(t *testing.T) {
+		actual, err := ParseKeyType("")
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultKeyType, actual)
+	})
+	t.Run("error - unsupported", func(t *testing.T) {
+		_, err := ParseKeyType("DSA")
+		assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+		assert.EqualError(t, err, "unsupported key type: DSA")
+	})
+}
+
+func TestKeyType_SupportsKeyAgreement(t *testing.T) {
+	assert.True(t, ECP256Key.SupportsKeyAgreement())
+	assert.False(t, Ed25519Key.SupportsKeyAgreement())
+	assert.False(t, RSAKey.SupportsKeyAgreement())
+}
+
 func TestCrypto_Resolve(t *testing.T) {
 	client := createCrypto(t)
 	kid := "kid"
-	key, _ := client.New(StringNamingFunc(kid))
+	key, _ := client.New(DefaultKeyType, StringNamingFunc(kid))
 
 	t.Run("ok", func(t *testing.T) {
 		resolvedKey, err := client.Resolve("kid")
@@ -111,6 +183,8 @@ func TestCrypto_Configure(t *testing.T) {
 		e := createCrypto(t)
 		err := e.Configure(cfg)
 		assert.NoError(t, err)
+		assert.NotNil(t, e.retired)
+		assert.NoError(t, e.Shutdown())
 	})
 	t.Run("ok - default = fs backend", func(t *testing.T) {
 		client := createCrypto(t)
@@ -118,6 +192,7 @@ func TestCrypto_Configure(t *testing.T) {
 		if !assert.NoError(t, err) {
 			return
 		}
+		defer client.Shutdown()
 		storageType := reflect.TypeOf(client.Storage).String()
 		assert.Equal(t, "*storage.fileSystemBackend", storageType)
 	})
@@ -27,7 +27,7 @@ import (
 func TestCrypto_Decrypt(t *testing.T) {
 	client := createCrypto(t)
 	kid := "kid"
-	key, _ := client.New(StringNamingFunc(kid))
+	key, _ := client.New(DefaultKeyType, StringNamingFunc(kid))
 	pubKey := key.Public().(*ecdsa.PublicKey)
 
 	cipherText, err := EciesEncrypt(pubKey, []byte("hello!"))
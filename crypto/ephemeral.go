@@ -19,9 +19,9 @@
 
 package crypto
 
-// NewEphemeralKey returns a Key for single use.
-func NewEphemeralKey(namingFunc KIDNamingFunc) (Key, error) {
-	keyPair, kid, err := generateKeyPairAndKID(namingFunc)
+// NewEphemeralKey returns a Key of the given type for single use.
+func NewEphemeralKey(keyType KeyType, namingFunc KIDNamingFunc) (Key, error) {
+	keyPair, kid, err := generateKeyPairAndKID(keyType, namingFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +28,7 @@ import (
 
 func TestNewEphemeralKey(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
-		key, err := NewEphemeralKey(StringNamingFunc("kid"))
+		key, err := NewEphemeralKey(DefaultKeyType, StringNamingFunc("kid"))
 
 		if !assert.NoError(t, err) {
 			return
@@ -41,7 +41,7 @@ func TestNewEphemeralKey(t *testing.T) {
 	})
 
 	t.Run("error", func(t *testing.T) {
-		_, err := NewEphemeralKey(ErrorNamingFunc(errors.New("b00m!")))
+		_, err := NewEphemeralKey(DefaultKeyType, ErrorNamingFunc(errors.New("b00m!")))
 
 		if !assert.Error(t, err) {
 			return
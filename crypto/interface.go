@@ -21,19 +21,67 @@ package crypto
 import (
 	"crypto"
 	"errors"
+	"fmt"
+	"time"
 )
 
 // ErrKeyNotFound is returned when the key should not exists but does
 var ErrKeyNotFound = errors.New("key not found")
 
+// ErrKeyRetired is returned when a retired key is used for signing.
+var ErrKeyRetired = errors.New("key has been retired")
+
 // KIDNamingFunc is a function passed to New() which generates the kid for the pub/priv key
 type KIDNamingFunc func(key crypto.PublicKey) (string, error)
 
+// ErrUnsupportedKeyType is returned when a key pair of an unknown or unsupported type is requested.
+var ErrUnsupportedKeyType = errors.New("unsupported key type")
+
+// KeyType identifies the algorithm (and size or curve) of a key pair.
+type KeyType string
+
+const (
+	// ECP256Key identifies an ECDSA key pair on the NIST P-256 curve. It's the default key type.
+	ECP256Key KeyType = "ECP256"
+	// Ed25519Key identifies an EdDSA key pair on the Ed25519 curve.
+	Ed25519Key KeyType = "Ed25519"
+	// RSAKey identifies a 2048 bits RSA key pair.
+	RSAKey KeyType = "RSA"
+)
+
+// DefaultKeyType is the KeyType that's used when no key type is specified.
+const DefaultKeyType = ECP256Key
+
+// SupportedKeyTypes returns all key types that can be generated.
+func SupportedKeyTypes() []KeyType {
+	return []KeyType{ECP256Key, Ed25519Key, RSAKey}
+}
+
+// ParseKeyType parses the given string into a KeyType. An empty string yields the DefaultKeyType.
+// ErrUnsupportedKeyType is returned if the key type is unknown.
+func ParseKeyType(input string) (KeyType, error) {
+	if input == "" {
+		return DefaultKeyType, nil
+	}
+	for _, curr := range SupportedKeyTypes() {
+		if string(curr) == input {
+			return curr, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedKeyType, input)
+}
+
+// SupportsKeyAgreement returns whether keys of this type can be used for key agreement (ECDH).
+func (k KeyType) SupportsKeyAgreement() bool {
+	return k == ECP256Key
+}
+
 // KeyCreator is the interface for creating key pairs.
 type KeyCreator interface {
-	// New generates a keypair and returns a Key.
+	// New generates a keypair of the given type and returns a Key.
 	// the KIDNamingFunc will provide the kid.
-	New(namingFunc KIDNamingFunc) (Key, error)
+	// ErrUnsupportedKeyType is returned if the key type is unknown.
+	New(keyType KeyType, namingFunc KIDNamingFunc) (Key, error)
 }
 
 // KeyResolver is the interface for resolving keys.
@@ -41,20 +89,53 @@ type KeyResolver interface {
 	// Exists returns if the specified private key exists.
 	// If an error occurs, false is also returned
 	Exists(kid string) bool
-	// Resolve returns a Key for the given KID. ErrKeyNotFound is returned for an unknown KID.
+	// Resolve returns a Key for the given KID. ErrKeyNotFound is returned for an unknown KID,
+	// ErrKeyRetired is returned when the key has been retired.
 	Resolve(kid string) (Key, error)
 	// List returns the KIDs of the private keys that are present in the KeyStore.
 	List() []string
 }
 
+// KeyRetirer is the interface for retiring keys that are no longer in use.
+type KeyRetirer interface {
+	// Retire marks the specified private key as retired. A retired key can't be used for signing anymore,
+	// and is purged from the KeyStore after the configured retention period.
+	// Retiring a key that has already been retired is a no-op. ErrKeyNotFound is returned for an unknown KID.
+	Retire(kid string) error
+	// ListRetired returns the keys that have been retired, but have not been purged yet.
+	ListRetired() ([]RetiredKey, error)
+}
+
+// RetiredKey describes a private key that has been retired.
+type RetiredKey struct {
+	// KID is the ID of the retired key.
+	KID string
+	// RetiredAt is the moment the key was retired.
+	RetiredAt time.Time
+	// PurgeAfter is the moment after which the key will be removed from the KeyStore.
+	PurgeAfter time.Time
+}
+
 // KeyStore defines the functions for working with private keys.
 type KeyStore interface {
 	Decrypter
 	KeyCreator
 	KeyResolver
+	KeyRetirer
+	KeyObserver
 	JWTSigner
 }
 
+// KeyObserverFunc is a function that can be registered on the KeyStore.
+// It's called with the KID of a private key that has been added to or removed from the KeyStore.
+type KeyObserverFunc func(kid string)
+
+// KeyObserver is the interface for observing changes to the private keys in the KeyStore.
+type KeyObserver interface {
+	// RegisterObserver registers a callback function that is called when a private key is added to or removed from the KeyStore.
+	RegisterObserver(observer KeyObserverFunc)
+}
+
 // Decrypter is the interface to support decryption
 type Decrypter interface {
 	// Decrypt decrypts the `cipherText` with key `kid`
@@ -65,6 +146,7 @@ type Decrypter interface {
 type JWTSigner interface {
 	// SignJWT creates a signed JWT using the indicated key and map of claims.
 	// Returns ErrKeyNotFound when indicated private key is not present.
+	// Returns ErrKeyRetired when indicated private key has been retired.
 	SignJWT(claims map[string]interface{}, kid string) (string, error)
 }
 
@@ -34,10 +34,10 @@ import (
 	"github.com/shengdoushi/base58"
 )
 
-// ErrUnsupportedSigningKey is returned when an unsupported private key is used to sign. Currently only ecdsa and rsa keys are supported
+// ErrUnsupportedSigningKey is returned when an unsupported private key is used to sign. Currently only ecdsa, ed25519 and rsa keys are supported
 var ErrUnsupportedSigningKey = errors.New("signing key algorithm not supported")
 
-var supportedAlgorithms = []jwa.SignatureAlgorithm{jwa.PS256, jwa.PS384, jwa.PS512, jwa.ES256, jwa.ES384, jwa.ES512}
+var supportedAlgorithms = []jwa.SignatureAlgorithm{jwa.PS256, jwa.PS384, jwa.PS512, jwa.ES256, jwa.ES384, jwa.ES512, jwa.EdDSA}
 
 func isAlgorithmSupported(alg jwa.SignatureAlgorithm) bool {
 	for _, curr := range supportedAlgorithms {
@@ -50,6 +50,9 @@ func isAlgorithmSupported(alg jwa.SignatureAlgorithm) bool {
 
 // SignJWT creates a signed JWT given a legalEntity and map of claims
 func (client *Crypto) SignJWT(claims map[string]interface{}, kid string) (token string, err error) {
+	if err = client.checkNotRetired(kid); err != nil {
+		return "", err
+	}
 	privateKey, err := client.Storage.GetPrivateKey(kid)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
@@ -84,6 +87,8 @@ func jwkKey(signer crypto.Signer) (key jwk.Key, err error) {
 		var alg jwa.SignatureAlgorithm
 		alg, err = ecAlg(ecKey)
 		key.Set(jwk.AlgorithmKey, alg)
+	case ed25519.PrivateKey:
+		key.Set(jwk.AlgorithmKey, jwa.EdDSA)
 	default:
 		err = errors.New("unsupported signing private key")
 	}
@@ -148,7 +148,7 @@ func TestCrypto_SignJWT(t *testing.T) {
 	client := createCrypto(t)
 
 	kid := "kid"
-	key, _ := client.New(StringNamingFunc(kid))
+	key, _ := client.New(DefaultKeyType, StringNamingFunc(kid))
 
 	t.Run("creates valid JWT", func(t *testing.T) {
 		tokenString, err := client.SignJWT(map[string]interface{}{"iss": "nuts"}, kid)
@@ -168,6 +168,44 @@ func TestCrypto_SignJWT(t *testing.T) {
 		assert.Equal(t, "nuts", token.Issuer())
 	})
 
+	t.Run("creates valid JWT using Ed25519 key", func(t *testing.T) {
+		edKey, _ := client.New(Ed25519Key, StringNamingFunc("ed25519"))
+		tokenString, err := client.SignJWT(map[string]interface{}{"iss": "nuts"}, "ed25519")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		_, alg, _ := JWTKidAlg(tokenString)
+		assert.Equal(t, jwa.EdDSA, alg)
+		token, err := ParseJWT(tokenString, func(kid string) (crypto.PublicKey, error) {
+			return edKey.Public(), nil
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, "nuts", token.Issuer())
+	})
+
+	t.Run("creates valid JWT using RSA key", func(t *testing.T) {
+		rsaKey, _ := client.New(RSAKey, StringNamingFunc("rsa"))
+		tokenString, err := client.SignJWT(map[string]interface{}{"iss": "nuts"}, "rsa")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		_, alg, _ := JWTKidAlg(tokenString)
+		assert.Equal(t, jwa.PS256, alg)
+		_, err = ParseJWT(tokenString, func(kid string) (crypto.PublicKey, error) {
+			return rsaKey.Public(), nil
+		})
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("returns error for not found", func(t *testing.T) {
 		_, err := client.SignJWT(map[string]interface{}{"iss": "nuts"}, "unknown")
 
@@ -178,7 +216,7 @@ func TestCrypto_SignJWT(t *testing.T) {
 func TestSignJWS(t *testing.T) {
 	client := createCrypto(t)
 	kid := "kid"
-	key, _ := client.New(StringNamingFunc(kid))
+	key, _ := client.New(DefaultKeyType, StringNamingFunc(kid))
 
 	t.Run("ok", func(t *testing.T) {
 		payload := []byte{1, 2, 3}
@@ -284,6 +322,7 @@ func TestCrypto_convertHeaders(t *testing.T) {
 
 func Test_isAlgorithmSupported(t *testing.T) {
 	assert.True(t, isAlgorithmSupported(jwa.PS256))
+	assert.True(t, isAlgorithmSupported(jwa.EdDSA))
 	assert.False(t, isAlgorithmSupported(jwa.RS256))
 	assert.False(t, isAlgorithmSupported(""))
 }
@@ -35,18 +35,18 @@ func (m *MockKeyCreator) EXPECT() *MockKeyCreatorMockRecorder {
 }
 
 // New mocks base method.
-func (m *MockKeyCreator) New(namingFunc KIDNamingFunc) (Key, error) {
+func (m *MockKeyCreator) New(keyType KeyType, namingFunc KIDNamingFunc) (Key, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "New", namingFunc)
+	ret := m.ctrl.Call(m, "New", keyType, namingFunc)
 	ret0, _ := ret[0].(Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // New indicates an expected call of New.
-func (mr *MockKeyCreatorMockRecorder) New(namingFunc interface{}) *gomock.Call {
+func (mr *MockKeyCreatorMockRecorder) New(keyType, namingFunc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockKeyCreator)(nil).New), namingFunc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockKeyCreator)(nil).New), keyType, namingFunc)
 }
 
 // MockKeyResolver is a mock of KeyResolver interface.
@@ -115,6 +115,58 @@ func (mr *MockKeyResolverMockRecorder) Resolve(kid interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockKeyResolver)(nil).Resolve), kid)
 }
 
+// MockKeyRetirer is a mock of KeyRetirer interface.
+type MockKeyRetirer struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyRetirerMockRecorder
+}
+
+// MockKeyRetirerMockRecorder is the mock recorder for MockKeyRetirer.
+type MockKeyRetirerMockRecorder struct {
+	mock *MockKeyRetirer
+}
+
+// NewMockKeyRetirer creates a new mock instance.
+func NewMockKeyRetirer(ctrl *gomock.Controller) *MockKeyRetirer {
+	mock := &MockKeyRetirer{ctrl: ctrl}
+	mock.recorder = &MockKeyRetirerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyRetirer) EXPECT() *MockKeyRetirerMockRecorder {
+	return m.recorder
+}
+
+// ListRetired mocks base method.
+func (m *MockKeyRetirer) ListRetired() ([]RetiredKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRetired")
+	ret0, _ := ret[0].([]RetiredKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRetired indicates an expected call of ListRetired.
+func (mr *MockKeyRetirerMockRecorder) ListRetired() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRetired", reflect.TypeOf((*MockKeyRetirer)(nil).ListRetired))
+}
+
+// Retire mocks base method.
+func (m *MockKeyRetirer) Retire(kid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retire", kid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retire indicates an expected call of Retire.
+func (mr *MockKeyRetirerMockRecorder) Retire(kid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retire", reflect.TypeOf((*MockKeyRetirer)(nil).Retire), kid)
+}
+
 // MockKeyStore is a mock of KeyStore interface.
 type MockKeyStore struct {
 	ctrl     *gomock.Controller
@@ -181,19 +233,46 @@ func (mr *MockKeyStoreMockRecorder) List() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeyStore)(nil).List))
 }
 
+// ListRetired mocks base method.
+func (m *MockKeyStore) ListRetired() ([]RetiredKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRetired")
+	ret0, _ := ret[0].([]RetiredKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRetired indicates an expected call of ListRetired.
+func (mr *MockKeyStoreMockRecorder) ListRetired() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRetired", reflect.TypeOf((*MockKeyStore)(nil).ListRetired))
+}
+
 // New mocks base method.
-func (m *MockKeyStore) New(namingFunc KIDNamingFunc) (Key, error) {
+func (m *MockKeyStore) New(keyType KeyType, namingFunc KIDNamingFunc) (Key, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "New", namingFunc)
+	ret := m.ctrl.Call(m, "New", keyType, namingFunc)
 	ret0, _ := ret[0].(Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // New indicates an expected call of New.
-func (mr *MockKeyStoreMockRecorder) New(namingFunc interface{}) *gomock.Call {
+func (mr *MockKeyStoreMockRecorder) New(keyType, namingFunc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockKeyStore)(nil).New), namingFunc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockKeyStore)(nil).New), keyType, namingFunc)
+}
+
+// RegisterObserver mocks base method.
+func (m *MockKeyStore) RegisterObserver(observer KeyObserverFunc) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterObserver", observer)
+}
+
+// RegisterObserver indicates an expected call of RegisterObserver.
+func (mr *MockKeyStoreMockRecorder) RegisterObserver(observer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObserver", reflect.TypeOf((*MockKeyStore)(nil).RegisterObserver), observer)
 }
 
 // Resolve mocks base method.
@@ -211,6 +290,20 @@ func (mr *MockKeyStoreMockRecorder) Resolve(kid interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockKeyStore)(nil).Resolve), kid)
 }
 
+// Retire mocks base method.
+func (m *MockKeyStore) Retire(kid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retire", kid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retire indicates an expected call of Retire.
+func (mr *MockKeyStoreMockRecorder) Retire(kid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retire", reflect.TypeOf((*MockKeyStore)(nil).Retire), kid)
+}
+
 // SignJWT mocks base method.
 func (m *MockKeyStore) SignJWT(claims map[string]interface{}, kid string) (string, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +319,41 @@ func (mr *MockKeyStoreMockRecorder) SignJWT(claims, kid interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignJWT", reflect.TypeOf((*MockKeyStore)(nil).SignJWT), claims, kid)
 }
 
+// MockKeyObserver is a mock of KeyObserver interface.
+type MockKeyObserver struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyObserverMockRecorder
+}
+
+// MockKeyObserverMockRecorder is the mock recorder for MockKeyObserver.
+type MockKeyObserverMockRecorder struct {
+	mock *MockKeyObserver
+}
+
+// NewMockKeyObserver creates a new mock instance.
+func NewMockKeyObserver(ctrl *gomock.Controller) *MockKeyObserver {
+	mock := &MockKeyObserver{ctrl: ctrl}
+	mock.recorder = &MockKeyObserverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyObserver) EXPECT() *MockKeyObserverMockRecorder {
+	return m.recorder
+}
+
+// RegisterObserver mocks base method.
+func (m *MockKeyObserver) RegisterObserver(observer KeyObserverFunc) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterObserver", observer)
+}
+
+// RegisterObserver indicates an expected call of RegisterObserver.
+func (mr *MockKeyObserverMockRecorder) RegisterObserver(observer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObserver", reflect.TypeOf((*MockKeyObserver)(nil).RegisterObserver), observer)
+}
+
 // MockDecrypter is a mock of Decrypter interface.
 type MockDecrypter struct {
 	ctrl     *gomock.Controller
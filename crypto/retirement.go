@@ -0,0 +1,221 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package crypto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/nuts-foundation/nuts-node/crypto/log"
+	"github.com/nuts-foundation/nuts-node/crypto/storage"
+	"go.etcd.io/bbolt"
+)
+
+// retiredKeysBucket is the name of the bucket that maps the KIDs of retired keys to the moment they were retired.
+const retiredKeysBucket = "retiredKeys"
+
+// purgeInterval specifies how often retired keys are checked for having passed their retention period.
+const purgeInterval = time.Hour
+
+// errRetirementNotConfigured is returned when keys are retired before the crypto engine is configured.
+var errRetirementNotConfigured = errors.New("key retirement is not configured")
+
+// retiredKeyStore keeps track of retired keys. It's kept separate from the key storage backend,
+// so it works the same for every backend.
+type retiredKeyStore struct {
+	db *bbolt.DB
+}
+
+func newRetiredKeyStore(dataDir string) (*retiredKeyStore, error) {
+	dbFile := path.Join(dataDir, "crypto", "retired.db")
+	if err := os.MkdirAll(filepath.Dir(dbFile), os.ModePerm); err != nil {
+		return nil, fmt.Errorf("unable to create BBolt database: %w", err)
+	}
+	db, err := bbolt.Open(dbFile, 0600, bbolt.DefaultOptions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create BBolt database: %w", err)
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(retiredKeysBucket))
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &retiredKeyStore{db: db}, nil
+}
+
+// retiredAt returns the moment the given key was retired, or nil if it hasn't been retired.
+func (s *retiredKeyStore) retiredAt(kid string) (*time.Time, error) {
+	var result *time.Time
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		value := tx.Bucket([]byte(retiredKeysBucket)).Get([]byte(kid))
+		if value == nil {
+			return nil
+		}
+		moment := time.Time{}
+		if err := moment.UnmarshalText(value); err != nil {
+			return fmt.Errorf("invalid retirement time stored for key (kid=%s): %w", kid, err)
+		}
+		result = &moment
+		return nil
+	})
+	return result, err
+}
+
+// put records the given key as retired at the given moment, unless it was already retired.
+func (s *retiredKeyStore) put(kid string, moment time.Time) error {
+	value, err := moment.MarshalText()
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(retiredKeysBucket))
+		if bucket.Get([]byte(kid)) != nil {
+			return nil
+		}
+		return bucket.Put([]byte(kid), value)
+	})
+}
+
+// list returns the moment of retirement of all retired keys.
+func (s *retiredKeyStore) list() (map[string]time.Time, error) {
+	result := make(map[string]time.Time)
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(retiredKeysBucket)).ForEach(func(k, v []byte) error {
+			moment := time.Time{}
+			if err := moment.UnmarshalText(v); err != nil {
+				return fmt.Errorf("invalid retirement time stored for key (kid=%s): %w", string(k), err)
+			}
+			result[string(k)] = moment
+			return nil
+		})
+	})
+	return result, err
+}
+
+// remove forgets about the given retired key.
+func (s *retiredKeyStore) remove(kid string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(retiredKeysBucket)).Delete([]byte(kid))
+	})
+}
+
+func (s *retiredKeyStore) close() error {
+	return s.db.Close()
+}
+
+// Retire marks the specified private key as retired. See KeyRetirer for the details.
+func (client *Crypto) Retire(kid string) error {
+	if client.retired == nil {
+		return errRetirementNotConfigured
+	}
+	if !client.Storage.PrivateKeyExists(kid) {
+		return ErrKeyNotFound
+	}
+	if err := client.retired.put(kid, time.Now()); err != nil {
+		return fmt.Errorf("could not retire key (kid=%s): %w", kid, err)
+	}
+	log.Logger().Infof("Retired key (kid=%s)", kid)
+	return nil
+}
+
+// ListRetired returns the keys that have been retired, but have not been purged yet, ordered by KID.
+func (client *Crypto) ListRetired() ([]RetiredKey, error) {
+	if client.retired == nil {
+		return nil, errRetirementNotConfigured
+	}
+	retired, err := client.retired.list()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]RetiredKey, 0, len(retired))
+	for kid, retiredAt := range retired {
+		result = append(result, RetiredKey{
+			KID:        kid,
+			RetiredAt:  retiredAt,
+			PurgeAfter: retiredAt.Add(client.config.RetiredKeyRetention),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].KID < result[j].KID
+	})
+	return result, nil
+}
+
+// checkNotRetired returns ErrKeyRetired if the given key has been retired.
+func (client *Crypto) checkNotRetired(kid string) error {
+	if client.retired == nil {
+		return nil
+	}
+	retiredAt, err := client.retired.retiredAt(kid)
+	if err != nil {
+		return err
+	}
+	if retiredAt != nil {
+		return ErrKeyRetired
+	}
+	return nil
+}
+
+// purgeRetiredKeys removes the retired keys of which the retention period has passed at the given moment.
+func (client *Crypto) purgeRetiredKeys(now time.Time) error {
+	retired, err := client.retired.list()
+	if err != nil {
+		return err
+	}
+	for kid, retiredAt := range retired {
+		if now.Before(retiredAt.Add(client.config.RetiredKeyRetention)) {
+			continue
+		}
+		if err = client.Storage.DeletePrivateKey(kid); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Logger().WithError(err).Errorf("Could not purge retired key (kid=%s)", kid)
+			continue
+		}
+		client.notifyObservers(kid)
+		if err = client.retired.remove(kid); err != nil {
+			return err
+		}
+		log.Logger().Infof("Purged retired key (kid=%s)", kid)
+	}
+	return nil
+}
+
+func (client *Crypto) purgeRetiredKeysPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		if err := client.purgeRetiredKeys(time.Now()); err != nil {
+			log.Logger().WithError(err).Error("Could not purge retired keys")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,134 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package crypto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrypto_Retire(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		client := createRetiringCrypto(t)
+		_, _ = client.New(DefaultKeyType, StringNamingFunc("kid"))
+
+		err := client.Retire("kid")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		retired, _ := client.ListRetired()
+		if !assert.Len(t, retired, 1) {
+			return
+		}
+		assert.Equal(t, "kid", retired[0].KID)
+		assert.Equal(t, retired[0].RetiredAt.Add(time.Hour), retired[0].PurgeAfter)
+	})
+	t.Run("retired key can't be used for signing", func(t *testing.T) {
+		client := createRetiringCrypto(t)
+		_, _ = client.New(DefaultKeyType, StringNamingFunc("kid"))
+		_ = client.Retire("kid")
+
+		_, err := client.Resolve("kid")
+		assert.ErrorIs(t, err, ErrKeyRetired)
+		_, err = client.SignJWT(map[string]interface{}{"iss": "nuts"}, "kid")
+		assert.ErrorIs(t, err, ErrKeyRetired)
+	})
+	t.Run("retiring twice keeps the original moment of retirement", func(t *testing.T) {
+		client := createRetiringCrypto(t)
+		_, _ = client.New(DefaultKeyType, StringNamingFunc("kid"))
+		_ = client.Retire("kid")
+		first, _ := client.ListRetired()
+
+		err := client.Retire("kid")
+
+		assert.NoError(t, err)
+		second, _ := client.ListRetired()
+		assert.Equal(t, first, second)
+	})
+	t.Run("unknown key", func(t *testing.T) {
+		client := createRetiringCrypto(t)
+
+		err := client.Retire("kid")
+
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+	t.Run("not configured", func(t *testing.T) {
+		client := createCrypto(t)
+
+		err := client.Retire("kid")
+		assert.ErrorIs(t, err, errRetirementNotConfigured)
+		_, err = client.ListRetired()
+		assert.ErrorIs(t, err, errRetirementNotConfigured)
+	})
+}
+
+func TestCrypto_purgeRetiredKeys(t *testing.T) {
+	client := createRetiringCrypto(t)
+	_, _ = client.New(DefaultKeyType, StringNamingFunc("kid"))
+	_ = client.Retire("kid")
+	var removed []string
+	client.RegisterObserver(func(kid string) {
+		removed = append(removed, kid)
+	})
+
+	t.Run("retention period not passed", func(t *testing.T) {
+		err := client.purgeRetiredKeys(time.Now())
+
+		assert.NoError(t, err)
+		assert.True(t, client.Exists("kid"))
+		retired, _ := client.ListRetired()
+		assert.Len(t, retired, 1)
+		assert.Empty(t, removed)
+	})
+	t.Run("retention period passed", func(t *testing.T) {
+		err := client.purgeRetiredKeys(time.Now().Add(2 * time.Hour))
+
+		assert.NoError(t, err)
+		assert.False(t, client.Exists("kid"))
+		retired, _ := client.ListRetired()
+		assert.Empty(t, retired)
+		assert.Equal(t, []string{"kid"}, removed)
+	})
+}
+
+func TestCrypto_StartAndShutdown(t *testing.T) {
+	client := createRetiringCrypto(t)
+
+	assert.NoError(t, client.Start())
+	assert.NoError(t, client.Shutdown())
+}
+
+func createRetiringCrypto(t *testing.T) *Crypto {
+	client := createCrypto(t)
+	client.config.RetiredKeyRetention = time.Hour
+	var err error
+	client.retired, err = newRetiredKeyStore(io.TestDirectory(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = client.retired.close()
+	})
+	return client
+}
@@ -113,6 +113,19 @@ func (fsc *fileSystemBackend) SavePrivateKey(kid string, key crypto.PrivateKey)
 	return err
 }
 
+// DeletePrivateKey removes the private key file for the given key from disk.
+func (fsc *fileSystemBackend) DeletePrivateKey(kid string) error {
+	filePath := fsc.getEntryPath(kid, privateKeyEntry)
+	err := os.Remove(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &fileOpenError{kid: kid, filePath: filePath, err: ErrNotFound}
+		}
+		return &fileOpenError{kid: kid, filePath: filePath, err: err}
+	}
+	return nil
+}
+
 func (fsc *fileSystemBackend) ListPrivateKeys() []string {
 	var result []string
 	_ = filepath.Walk(fsc.fspath, func(path string, info fs.FileInfo, err error) error {
@@ -96,6 +96,26 @@ func Test_fs_KeyExistsFor(t *testing.T) {
 	})
 }
 
+func Test_fs_DeletePrivateKey(t *testing.T) {
+	t.Run("existing entry", func(t *testing.T) {
+		storage, _ := NewFileSystemBackend(io.TestDirectory(t))
+		kid := "kid"
+		_ = storage.SavePrivateKey(kid, test.GenerateECKey())
+
+		err := storage.DeletePrivateKey(kid)
+
+		assert.NoError(t, err)
+		assert.False(t, storage.PrivateKeyExists(kid))
+	})
+	t.Run("non-existing entry", func(t *testing.T) {
+		storage, _ := NewFileSystemBackend(io.TestDirectory(t))
+
+		err := storage.DeletePrivateKey("unknown")
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
 func Test_fs_ListPrivateKeys(t *testing.T) {
 	storage, _ := NewFileSystemBackend(io.TestDirectory(t))
 	backend := storage.(*fileSystemBackend)
@@ -34,6 +34,20 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// DeletePrivateKey mocks base method.
+func (m *MockStorage) DeletePrivateKey(kid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateKey", kid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivateKey indicates an expected call of DeletePrivateKey.
+func (mr *MockStorageMockRecorder) DeletePrivateKey(kid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateKey", reflect.TypeOf((*MockStorage)(nil).DeletePrivateKey), kid)
+}
+
 // GetPrivateKey mocks base method.
 func (m *MockStorage) GetPrivateKey(kid string) (crypto.Signer, error) {
 	m.ctrl.T.Helper()
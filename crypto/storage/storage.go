@@ -42,6 +42,9 @@ type Storage interface {
 	SavePrivateKey(kid string, key crypto.PrivateKey) error
 	// ListPrivateKeys returns the KIDs of the private keys that are present.
 	ListPrivateKeys() []string
+	// DeletePrivateKey removes the private key indicated with the kid from the storage backend.
+	// It returns ErrNotFound if the key isn't present.
+	DeletePrivateKey(kid string) error
 }
 
 // PublicKeyEntry is a public key entry also containing the period it's valid for.
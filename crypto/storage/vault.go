@@ -52,6 +52,7 @@ func DefaultVaultConfig() VaultConfig {
 type logicaler interface {
 	Read(path string) (*vault.Secret, error)
 	Write(path string, data map[string]interface{}) (*vault.Secret, error)
+	Delete(path string) (*vault.Secret, error)
 }
 
 type vaultKVStorage struct {
@@ -188,3 +189,14 @@ func (v vaultKVStorage) SavePrivateKey(kid string, key crypto.PrivateKey) error
 
 	return v.storeValue(path, keyName, pem)
 }
+
+func (v vaultKVStorage) DeletePrivateKey(kid string) error {
+	if !v.PrivateKeyExists(kid) {
+		return ErrNotFound
+	}
+	path := privateKeyPath(v.config.PathPrefix, kid)
+	if _, err := v.client.Delete(path); err != nil {
+		return fmt.Errorf("unable to delete private key from vault: %w", err)
+	}
+	return nil
+}
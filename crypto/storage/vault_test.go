@@ -56,6 +56,14 @@ func (m mockVaultClient) Write(path string, data map[string]interface{}) (*vault
 	}, nil
 }
 
+func (m mockVaultClient) Delete(path string) (*vault.Secret, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	delete(m.store, path)
+	return &vault.Secret{}, nil
+}
+
 func TestVaultKVStorage(t *testing.T) {
 	var privateKey, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	const kid = "did:nuts:123#abc"
@@ -71,6 +79,19 @@ func TestVaultKVStorage(t *testing.T) {
 		assert.Equal(t, privateKey, result, "expected retrieved key to equal original")
 	})
 
+	t.Run("ok - delete private key", func(t *testing.T) {
+		vaultStorage := vaultKVStorage{config: DefaultVaultConfig(), client: mockVaultClient{store: map[string]map[string]interface{}{}}}
+		_ = vaultStorage.SavePrivateKey(kid, privateKey)
+		assert.NoError(t, vaultStorage.DeletePrivateKey(kid), "deleting should work")
+		assert.False(t, vaultStorage.PrivateKeyExists(kid), "key should not be in vault")
+	})
+
+	t.Run("error - delete unknown private key", func(t *testing.T) {
+		vaultStorage := vaultKVStorage{config: DefaultVaultConfig(), client: mockVaultClient{store: map[string]map[string]interface{}{}}}
+		err := vaultStorage.DeletePrivateKey(kid)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
 	t.Run("error - while writing", func(t *testing.T) {
 		vaultStorage := vaultKVStorage{client: mockVaultClient{err: vaultError}}
 		err := vaultStorage.SavePrivateKey(kid, privateKey)
@@ -108,3 +108,11 @@ func (t TestKey) Public() crypto.PublicKey {
 func (t TestKey) Private() crypto.PrivateKey {
 	return t.PrivateKey
 }
+
+func (m memoryStorage) DeletePrivateKey(kid string) error {
+	if _, ok := m[kid]; !ok {
+		return storage.ErrNotFound
+	}
+	delete(m, kid)
+	return nil
+}
@@ -21,8 +21,6 @@ package didman
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -34,7 +32,6 @@ import (
 	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vdr/doc"
 	"github.com/nuts-foundation/nuts-node/vdr/types"
-	"github.com/shengdoushi/base58"
 )
 
 // ModuleName contains the name of this module: Didman
@@ -406,17 +403,17 @@ func filterServices(doc *did.Document, serviceType string) []did.Service {
 }
 
 func (d *didman) addService(id did.DID, serviceType string, serviceEndpoint interface{}, preprocessor func(*did.Document)) (*did.Service, error) {
-	doc, meta, err := d.docResolver.Resolve(id, nil)
+	document, meta, err := d.docResolver.Resolve(id, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	if preprocessor != nil {
-		preprocessor(doc)
+		preprocessor(document)
 	}
 
 	// check for duplicate service type
-	for _, s := range doc.Service {
+	for _, s := range document.Service {
 		if s.Type == serviceType {
 			return nil, types.ErrDuplicateService
 		}
@@ -428,24 +425,16 @@ func (d *didman) addService(id did.DID, serviceType string, serviceEndpoint inte
 		ServiceEndpoint: serviceEndpoint,
 	}
 	service.ID = ssi.URI{}
-	service.ID = generateIDForService(id, *service)
+	service.ID = doc.GenerateIDForService(id, *service)
 
 	// Add on DID Document and update
-	doc.Service = append(doc.Service, *service)
-	if err = d.vdr.Update(id, meta.Hash, *doc, nil); err != nil {
+	document.Service = append(document.Service, *service)
+	if err = d.vdr.Update(id, meta.Hash, *document, nil); err != nil {
 		return nil, err
 	}
 	return service, nil
 }
 
-func generateIDForService(id did.DID, service did.Service) ssi.URI {
-	bytes, _ := json.Marshal(service)
-	shaBytes := sha256.Sum256(bytes)
-	d := id.URI()
-	d.Fragment = base58.Encode(shaBytes[:], base58.BitcoinAlphabet)
-	return d
-}
-
 func referencesService(doc did.Document, serviceID ssi.URI) bool {
 	id := serviceID.String()
 	for _, s := range doc.Service {
@@ -751,17 +751,6 @@ func TestDidman_SearchOrganizations(t *testing.T) {
 	})
 }
 
-func TestGenerateIDForService(t *testing.T) {
-	u, _ := url.Parse("https://api.example.com/v1")
-	expectedID, _ := ssi.ParseURI(fmt.Sprintf("%s#D4eNCVjdtGaeHYMdjsdYHpTQmiwXtQKJmE9QSwwsKKzy", vdr.TestDIDA.String()))
-
-	id := generateIDForService(*vdr.TestDIDA, did.Service{
-		Type:            "type",
-		ServiceEndpoint: u.String(),
-	})
-	assert.Equal(t, *expectedID, id)
-}
-
 func TestReferencesService(t *testing.T) {
 	t.Run("false", func(t *testing.T) {
 		didDocStr := `{"service":[{"id":"did:nuts:1234#1", "serviceEndpoint": {"ref":"did:nuts:123#2"}}]}`
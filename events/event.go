@@ -88,6 +88,16 @@ func (m *manager) Configure(config core.ServerConfig) error {
 		Storage:   nats.FileStorage,
 	}, []nats.SubOpt{})
 
+	// register VCR stream
+	m.streams[VCRStream] = newStream(&nats.StreamConfig{
+		Name:      VCRStream,
+		Subjects:  []string{"VCR.*"},
+		Retention: nats.LimitsPolicy,
+		MaxAge:    168 * time.Hour, // week
+		Discard:   nats.DiscardOld,
+		Storage:   nats.FileStorage,
+	}, []nats.SubOpt{})
+
 	return nil
 }
 
@@ -76,8 +76,8 @@ func TestManager_Configure(t *testing.T) {
 	t.Run("streams are not created at startup", func(t *testing.T) {
 		eventManager := createManager(t)
 		_, js, _ := eventManager.Pool().Acquire(context.Background())
-		// 2 streams registered in own administration
-		assert.Len(t, eventManager.streams, 2)
+		// 3 streams registered in own administration
+		assert.Len(t, eventManager.streams, 3)
 
 		_, err := js.StreamInfo(eventManager.streams[TransactionsStream].Config().Name)
 
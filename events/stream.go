@@ -31,6 +31,8 @@ const (
 	TransactionsStream = "TRANSACTIONS"
 	// DataStream is the stream name on which the dat/payload is stored (VCs/DIDDocuments)
 	DataStream = "DATA"
+	// VCRStream is the stream name on which events of the VCR are published (e.g. issued credentials that are about to expire)
+	VCRStream = "VCR"
 )
 
 // Stream contains configuration for a NATS stream both on the server and client side
@@ -43,6 +45,9 @@ type Stream interface {
 	// The consumerName is used as the durable config name.
 	// The subjectFilter can be used to filter messages on the stream (eg: TRANSACTIONS.* or DATA.VerificableCredential)
 	Subscribe(conn Conn, consumerName string, subjectFilter string, handler nats.MsgHandler) error
+	// Publish publishes a message on the given subject of the stream.
+	// The stream is created on the NATS server if it doesn't exist yet.
+	Publish(conn Conn, subject string, data []byte) error
 }
 
 type stream struct {
@@ -103,6 +108,20 @@ func (stream *stream) Subscribe(conn Conn, consumerName string, subjectFilter st
 	return nil
 }
 
+func (stream *stream) Publish(conn Conn, subject string, data []byte) error {
+	ctx, err := conn.JetStream()
+	if err != nil {
+		return err
+	}
+
+	if err := stream.create(ctx); err != nil {
+		return err
+	}
+
+	_, err = ctx.Publish(subject, data)
+	return err
+}
+
 // NewDisposableStream configures a stream with memory storage, discard old policy and a message limit retention policy
 func NewDisposableStream(name string, subjects []string, maxMessages int64) Stream {
 	return newStream(&nats.StreamConfig{
@@ -139,3 +139,47 @@ func TestStream_ClientOpts(t *testing.T) {
 
 	assert.Len(t, disposableStream.ClientOpts(), 3)
 }
+
+func TestStream_Publish(t *testing.T) {
+	t.Run("publish works and stream is created", func(t *testing.T) {
+		disposableStream := NewDisposableStream("example", []string{"example.*"}, 100)
+		ctrl := gomock.NewController(t)
+
+		js := NewMockJetStreamContext(ctrl)
+		js.EXPECT().StreamInfo("example").Return(nil, nats.ErrStreamNotFound)
+		js.EXPECT().AddStream(disposableStream.Config()).Return(&nats.StreamInfo{}, nil)
+		js.EXPECT().Publish("example.subject", []byte("data")).Return(&nats.PubAck{}, nil)
+
+		conn := NewMockConn(ctrl)
+		conn.EXPECT().JetStream().Return(js, nil)
+
+		err := disposableStream.Publish(conn, "example.subject", []byte("data"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("error is returned when publish fails", func(t *testing.T) {
+		disposableStream := NewDisposableStream("example", []string{"example.*"}, 100)
+		ctrl := gomock.NewController(t)
+
+		js := NewMockJetStreamContext(ctrl)
+		js.EXPECT().StreamInfo("example").Return(&nats.StreamInfo{}, nil)
+		js.EXPECT().Publish("example.subject", []byte("data")).Return(nil, errors.New("random error"))
+
+		conn := NewMockConn(ctrl)
+		conn.EXPECT().JetStream().Return(js, nil)
+
+		err := disposableStream.Publish(conn, "example.subject", []byte("data"))
+		assert.Error(t, err)
+	})
+
+	t.Run("error is returned when JetStream fails", func(t *testing.T) {
+		disposableStream := NewDisposableStream("example", []string{"example.*"}, 100)
+		ctrl := gomock.NewController(t)
+
+		conn := NewMockConn(ctrl)
+		conn.EXPECT().JetStream().Return(nil, errors.New("random error"))
+
+		err := disposableStream.Publish(conn, "example.subject", []byte("data"))
+		assert.Error(t, err)
+	})
+}
@@ -25,3 +25,16 @@ const PrivateTransactionsSubject = "nuts.v2.private-transactions"
 
 // PrivateTransactionsStream defines the NATS stream name used for private transactions in the v2 protocol
 const PrivateTransactionsStream = "nuts-v2-private-transactions"
+
+// CredentialExpirySubject defines the NATS subject on the VCR stream used for events about issued credentials that are
+// about to expire, have been reissued or have been revoked because they expired.
+// Events are delivered at-least-once: the event for a credential that is about to expire is published again after a restart of the node.
+//
+// Payload: vcr.CredentialExpiryEvent
+const CredentialExpirySubject = "VCR.credential-expiry"
+
+// CredentialReceivedSubject defines the NATS subject on the VCR stream used for events about credentials issued to DIDs
+// managed by this node, that have been received and added to the wallet.
+//
+// Payload: vcr.CredentialReceivedEvent
+const CredentialReceivedSubject = "VCR.credential-received"
@@ -86,7 +86,7 @@ func Test_LoadExistingDAG(t *testing.T) {
 	if !assert.NoError(t, err) {
 		return
 	}
-	_, err = vdrClient.AddNewVerificationMethod(didDocument.ID.String())
+	_, err = vdrClient.AddNewVerificationMethod(didDocument.ID.String(), "")
 	if !assert.NoError(t, err) {
 		return
 	}
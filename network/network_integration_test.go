@@ -404,7 +404,7 @@ func resetIntegrationTest() {
 		document := did.Document{ID: *nodeDID}
 		kid := *nodeDID
 		kid.Fragment = "key-1"
-		key, _ := keyStore.New(func(_ crypto.PublicKey) (string, error) {
+		key, _ := keyStore.New(nutsCrypto.DefaultKeyType, func(_ crypto.PublicKey) (string, error) {
 			return kid.String(), nil
 		})
 		verificationMethod, _ := did.NewVerificationMethod(kid, ssi.JsonWebKey2020, *nodeDID, key.Public())
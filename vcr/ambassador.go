@@ -22,6 +22,9 @@ package vcr
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+
+	"github.com/nuts-foundation/nuts-node/vcr/holder"
 	"github.com/nuts-foundation/nuts-node/vcr/verifier"
 
 	"github.com/nuts-foundation/go-did/vc"
@@ -43,26 +46,34 @@ type ambassador struct {
 	writer        Writer
 	// verifier is used to store incoming revocations from the network
 	verifier verifier.Verifier
+	// holder is used to store incoming credentials of DIDs managed by this node in the wallet
+	holder holder.Holder
+	// notifier notifies the application of credentials added to the wallet
+	notifier *credentialNotifier
 }
 
 // NewAmbassador creates a new listener for the network that listens to Verifiable Credential transactions.
-func NewAmbassador(networkClient network.Transactions, writer Writer, verifier verifier.Verifier) Ambassador {
+func NewAmbassador(networkClient network.Transactions, writer Writer, verifier verifier.Verifier, holder holder.Holder, notifier *credentialNotifier) Ambassador {
 	return ambassador{
 		networkClient: networkClient,
 		writer:        writer,
 		verifier:      verifier,
+		holder:        holder,
+		notifier:      notifier,
 	}
 }
 
 // Configure instructs the ambassador to start receiving DID Documents from the network.
 func (n ambassador) Configure() {
 	n.networkClient.Subscribe(dag.TransactionPayloadAddedEvent, types.VcDocumentType, n.vcCallback)
+	n.networkClient.Subscribe(dag.TransactionPayloadAddedEvent, types.VcBatchDocumentType, n.vcBatchCallback)
 	n.networkClient.Subscribe(dag.TransactionPayloadAddedEvent, types.RevocationDocumentType, n.rCallback)
 	n.networkClient.Subscribe(dag.TransactionPayloadAddedEvent, types.RevocationLDDocumentType, n.jsonLDRevocationCallback)
 }
 
 // vcCallback gets called when new Verifiable Credentials are received by the network. All checks on the signature are already performed.
-// The VCR is used to verify the contents of the credential.
+// The VCR is used to verify the contents of the credential. Credentials issued to DIDs managed by this node are also added to the wallet,
+// and the application is notified of them.
 // payload should be a json encoded vc.VerifiableCredential
 func (n ambassador) vcCallback(tx dag.Transaction, payload []byte) error {
 	log.Logger().Debugf("Processing VC received from Nuts Network (ref=%s)", tx.Ref())
@@ -74,7 +85,52 @@ func (n ambassador) vcCallback(tx dag.Transaction, payload []byte) error {
 
 	// Verify and store
 	validAt := tx.SigningTime()
-	return n.writer.StoreCredential(target, &validAt)
+	if err := n.writer.StoreCredential(target, &validAt); err != nil {
+		return err
+	}
+	n.receiveCredential(target)
+	return nil
+}
+
+// vcBatchCallback gets called when a batch of Verifiable Credentials is received by the network.
+// Every credential is processed like it was received by itself, a credential that fails doesn't prevent the others from being stored.
+// payload should be a json encoded array of vc.VerifiableCredential
+func (n ambassador) vcBatchCallback(tx dag.Transaction, payload []byte) error {
+	log.Logger().Debugf("Processing VC batch received from Nuts Network (ref=%s)", tx.Ref())
+
+	var targets []vc.VerifiableCredential
+	if err := json.Unmarshal(payload, &targets); err != nil {
+		return fmt.Errorf("credential batch processing failed: %w", err)
+	}
+
+	validAt := tx.SigningTime()
+	var errs []string
+	for _, target := range targets {
+		if err := n.writer.StoreCredential(target, &validAt); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", target.ID, err))
+			continue
+		}
+		n.receiveCredential(target)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("unable to process %d of %d credentials of batch: %s", len(errs), len(targets), strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// receiveCredential adds the credential to the wallet if it's issued to a DID managed by this node, and notifies the application if so.
+// The application is only notified the first time the credential is added, which is determined by the wallet:
+// replaying the network on startup doesn't notify credentials that are, or have been, in the wallet again.
+// The credential has already been stored, so failing to add it to the wallet is logged instead of failing the transaction.
+func (n ambassador) receiveCredential(credential vc.VerifiableCredential) {
+	subject, err := n.holder.ReceiveCredential(credential)
+	if err != nil {
+		log.Logger().WithError(err).Errorf("Unable to add received credential to the wallet (id=%s)", credential.ID)
+		return
+	}
+	if subject != nil {
+		n.notifier.notify(newCredentialReceivedEvent(credential, *subject))
+	}
 }
 
 // rCallback gets called when new credential revocations are received by the network. All checks on the signature are already performed.
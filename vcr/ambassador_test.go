@@ -24,21 +24,27 @@ import (
 	"errors"
 	"github.com/nuts-foundation/nuts-node/vcr/verifier"
 	"os"
+	"path"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/crypto/hash"
 	"github.com/nuts-foundation/nuts-node/network"
 	"github.com/nuts-foundation/nuts-node/network/dag"
+	"github.com/nuts-foundation/nuts-node/test/io"
 	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/holder"
 	"github.com/nuts-foundation/nuts-node/vcr/types"
+	"github.com/nuts-foundation/nuts-node/vdr"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewAmbassador(t *testing.T) {
-	a := NewAmbassador(nil, nil, nil)
+	a := NewAmbassador(nil, nil, nil, nil, nil)
 
 	assert.NotNil(t, a)
 }
@@ -49,7 +55,8 @@ func TestAmbassador_Configure(t *testing.T) {
 		nMock := network.NewMockTransactions(ctrl)
 		defer ctrl.Finish()
 
-		a := NewAmbassador(nMock, nil, nil)
+		a := NewAmbassador(nMock, nil, nil, nil, nil)
+		nMock.EXPECT().Subscribe(dag.TransactionPayloadAddedEvent, types.VcBatchDocumentType, gomock.Any())
 		nMock.EXPECT().Subscribe(dag.TransactionPayloadAddedEvent, gomock.Any(), gomock.Any()).MinTimes(2)
 
 		a.Configure()
@@ -68,11 +75,13 @@ func TestAmbassador_vcCallback(t *testing.T) {
 		defer ctrl.Finish()
 
 		target := vc.VerifiableCredential{}
-		a := NewAmbassador(nil, wMock, nil).(ambassador)
+		hMock := holder.NewMockHolder(ctrl)
+		a := NewAmbassador(nil, wMock, nil, hMock, nil).(ambassador)
 		wMock.EXPECT().StoreCredential(gomock.Any(), &validAt).DoAndReturn(func(f interface{}, g interface{}) error {
 			target = f.(vc.VerifiableCredential)
 			return nil
 		})
+		hMock.EXPECT().ReceiveCredential(gomock.Any())
 
 		err := a.vcCallback(stx, payload)
 
@@ -83,12 +92,67 @@ func TestAmbassador_vcCallback(t *testing.T) {
 		assert.Equal(t, "did:nuts:B8PUHs2AUHbFF1xLLK4eZjgErEcMXHxs68FteY7NDtCY#123", target.ID.String())
 	})
 
+	t.Run("ok - credential for DID managed by this node", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		wMock := NewMockWriter(ctrl)
+		hMock := holder.NewMockHolder(ctrl)
+		var published []CredentialReceivedEvent
+		notifier := newCredentialNotifier(func(event CredentialReceivedEvent) error {
+			published = append(published, event)
+			return nil
+		}, nil)
+		a := NewAmbassador(nil, wMock, nil, hMock, notifier).(ambassador)
+		wMock.EXPECT().StoreCredential(gomock.Any(), &validAt)
+		subject := did.MustParseDID("did:nuts:GvkzxsezHvEc8nGhgz6Xo3jbqkHwswLmWw3CYtCm7hAW")
+		hMock.EXPECT().ReceiveCredential(gomock.Any()).Return(&subject, nil)
+
+		err := a.vcCallback(stx, payload)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []CredentialReceivedEvent{{
+			CredentialID:   "did:nuts:B8PUHs2AUHbFF1xLLK4eZjgErEcMXHxs68FteY7NDtCY#123",
+			CredentialType: "HumanCredential",
+			Issuer:         "did:nuts:B8PUHs2AUHbFF1xLLK4eZjgErEcMXHxs68FteY7NDtCY",
+			Subject:        subject.String(),
+		}}, published)
+	})
+
+	t.Run("ok - credential received again is not notified again", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		wMock := NewMockWriter(ctrl)
+		keyStore := crypto.NewTestCryptoInstance()
+		_, _ = keyStore.New(crypto.DefaultKeyType, crypto.StringNamingFunc(vdr.TestMethodDIDA.String()))
+		holderStore, _ := holder.NewLeiaHolderStore(path.Join(io.TestDirectory(t), "holder.db"))
+		defer holderStore.Close()
+		var published []CredentialReceivedEvent
+		notifier := newCredentialNotifier(func(event CredentialReceivedEvent) error {
+			published = append(published, event)
+			return nil
+		}, nil)
+		a := NewAmbassador(nil, wMock, nil, holder.New(nil, keyStore, nil, nil, holderStore), notifier).(ambassador)
+		wMock.EXPECT().StoreCredential(gomock.Any(), &validAt).Times(3)
+
+		// received for the first time
+		_ = a.vcCallback(stx, payload)
+		// received again when the network is replayed
+		_ = a.vcCallback(stx, payload)
+		// received again after being deleted from the wallet
+		var credential vc.VerifiableCredential
+		_ = json.Unmarshal(payload, &credential)
+		_ = holderStore.DeleteCredential(*credential.ID)
+		_ = a.vcCallback(stx, payload)
+
+		assert.Len(t, published, 1)
+	})
+
 	t.Run("error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		wMock := NewMockWriter(ctrl)
 		defer ctrl.Finish()
 
-		a := NewAmbassador(nil, wMock, nil).(ambassador)
+		a := NewAmbassador(nil, wMock, nil, nil, nil).(ambassador)
 		wMock.EXPECT().StoreCredential(gomock.Any(), &validAt).Return(errors.New("b00m!"))
 
 		err := a.vcCallback(stx, payload)
@@ -96,12 +160,26 @@ func TestAmbassador_vcCallback(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("ok - adding to wallet fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		wMock := NewMockWriter(ctrl)
+		hMock := holder.NewMockHolder(ctrl)
+
+		a := NewAmbassador(nil, wMock, nil, hMock, nil).(ambassador)
+		wMock.EXPECT().StoreCredential(gomock.Any(), &validAt)
+		hMock.EXPECT().ReceiveCredential(gomock.Any()).Return(nil, errors.New("b00m!"))
+
+		err := a.vcCallback(stx, payload)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("error - invalid payload", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		wMock := NewMockWriter(ctrl)
 		defer ctrl.Finish()
 
-		a := NewAmbassador(nil, wMock, nil).(ambassador)
+		a := NewAmbassador(nil, wMock, nil, nil, nil).(ambassador)
 
 		err := a.vcCallback(stx, []byte("{"))
 
@@ -109,6 +187,50 @@ func TestAmbassador_vcCallback(t *testing.T) {
 	})
 }
 
+func TestAmbassador_vcBatchCallback(t *testing.T) {
+	payload := []byte("[" + concept.TestCredential + "," + concept.TestCredential + "]")
+	tx, _ := dag.NewTransaction(hash.EmptyHash(), types.VcBatchDocumentType, nil, nil, 0)
+	stx := tx.(dag.Transaction)
+	validAt := stx.SigningTime()
+
+	t.Run("ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		wMock := NewMockWriter(ctrl)
+		hMock := holder.NewMockHolder(ctrl)
+		a := NewAmbassador(nil, wMock, nil, hMock, nil).(ambassador)
+		wMock.EXPECT().StoreCredential(gomock.Any(), &validAt).Times(2)
+		hMock.EXPECT().ReceiveCredential(gomock.Any()).Times(2)
+
+		err := a.vcBatchCallback(stx, payload)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - continues after failed credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		wMock := NewMockWriter(ctrl)
+		hMock := holder.NewMockHolder(ctrl)
+		a := NewAmbassador(nil, wMock, nil, hMock, nil).(ambassador)
+		gomock.InOrder(
+			wMock.EXPECT().StoreCredential(gomock.Any(), &validAt).Return(errors.New("b00m!")),
+			wMock.EXPECT().StoreCredential(gomock.Any(), &validAt),
+		)
+		hMock.EXPECT().ReceiveCredential(gomock.Any())
+
+		err := a.vcBatchCallback(stx, payload)
+
+		assert.EqualError(t, err, "unable to process 1 of 2 credentials of batch: did:nuts:B8PUHs2AUHbFF1xLLK4eZjgErEcMXHxs68FteY7NDtCY#123: b00m!")
+	})
+
+	t.Run("error - invalid payload", func(t *testing.T) {
+		a := NewAmbassador(nil, nil, nil, nil, nil).(ambassador)
+
+		err := a.vcBatchCallback(stx, []byte(concept.TestCredential))
+
+		assert.Contains(t, err.Error(), "credential batch processing failed")
+	})
+}
+
 func TestAmbassador_rCallback(t *testing.T) {
 	payload := []byte("{\"subject\":\"did:nuts:1#123\"}")
 	tx, _ := dag.NewTransaction(hash.EmptyHash(), types.RevocationDocumentType, nil, nil, 0)
@@ -120,7 +242,7 @@ func TestAmbassador_rCallback(t *testing.T) {
 		defer ctrl.Finish()
 
 		r := credential.Revocation{}
-		a := NewAmbassador(nil, wMock, nil).(ambassador)
+		a := NewAmbassador(nil, wMock, nil, nil, nil).(ambassador)
 		wMock.EXPECT().StoreRevocation(gomock.Any()).DoAndReturn(func(f interface{}) error {
 			r = f.(credential.Revocation)
 			return nil
@@ -140,7 +262,7 @@ func TestAmbassador_rCallback(t *testing.T) {
 		wMock := NewMockWriter(ctrl)
 		defer ctrl.Finish()
 
-		a := NewAmbassador(nil, wMock, nil).(ambassador)
+		a := NewAmbassador(nil, wMock, nil, nil, nil).(ambassador)
 		wMock.EXPECT().StoreRevocation(gomock.Any()).Return(errors.New("b00m!"))
 
 		err := a.rCallback(stx, payload)
@@ -153,7 +275,7 @@ func TestAmbassador_rCallback(t *testing.T) {
 		wMock := NewMockWriter(ctrl)
 		defer ctrl.Finish()
 
-		a := NewAmbassador(nil, wMock, nil).(ambassador)
+		a := NewAmbassador(nil, wMock, nil, nil, nil).(ambassador)
 
 		err := a.rCallback(stx, []byte("{"))
 
@@ -175,14 +297,14 @@ func Test_ambassador_jsonLDRevocationCallback(t *testing.T) {
 
 		mockVerifier := verifier.NewMockVerifier(ctrl)
 		mockVerifier.EXPECT().RegisterRevocation(revocation)
-		a := NewAmbassador(nil, nil, mockVerifier).(ambassador)
+		a := NewAmbassador(nil, nil, mockVerifier, nil, nil).(ambassador)
 
 		err := a.jsonLDRevocationCallback(stx, payload)
 		assert.NoError(t, err)
 	})
 
 	t.Run("error - invalid payload", func(t *testing.T) {
-		a := NewAmbassador(nil, nil, nil).(ambassador)
+		a := NewAmbassador(nil, nil, nil, nil, nil).(ambassador)
 
 		err := a.jsonLDRevocationCallback(stx, []byte("b00m"))
 		assert.EqualError(t, err, "revocation processing failed: invalid character 'b' looking for beginning of value")
@@ -194,7 +316,7 @@ func Test_ambassador_jsonLDRevocationCallback(t *testing.T) {
 
 		mockVerifier := verifier.NewMockVerifier(ctrl)
 		mockVerifier.EXPECT().RegisterRevocation(gomock.Any()).Return(errors.New("foo"))
-		a := NewAmbassador(nil, nil, mockVerifier).(ambassador)
+		a := NewAmbassador(nil, nil, mockVerifier, nil, nil).(ambassador)
 
 		err := a.jsonLDRevocationCallback(stx, payload)
 		assert.EqualError(t, err, "foo")
@@ -20,6 +20,9 @@
 package v1
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/labstack/echo/v4"
 	ssi "github.com/nuts-foundation/go-did"
 	"net/http"
@@ -46,6 +49,7 @@ type Wrapper struct {
 func (w *Wrapper) ResolveStatusCode(err error) int {
 	return core.ResolveStatusCode(err, map[error]int{
 		concept.ErrUnknownConcept:     http.StatusNotFound,
+		vcr.ErrQueryTooComplex:        http.StatusBadRequest,
 		vcrTypes.ErrNotFound:          http.StatusNotFound,
 		vcrTypes.ErrRevoked:           http.StatusConflict,
 		credential.ErrValidation:      http.StatusBadRequest,
@@ -74,17 +78,34 @@ func (w *Wrapper) Search(ctx echo.Context, conceptName string, requestParams Sea
 		return err
 	}
 
-	params := make(map[string]string, len(sr.Params))
-	for _, pair := range sr.Params {
-		params[pair.Key] = pair.Value
-	}
-
 	untrusted := false
 	if requestParams.Untrusted != nil {
 		untrusted = *requestParams.Untrusted
 	}
 
-	results, err := w.VCR.SearchConcept(ctx.Request().Context(), conceptName, untrusted, params)
+	var results []concept.Concept
+	var err error
+	if sr.Clauses == nil {
+		params := make(map[string]string, len(sr.Params))
+		for _, pair := range sr.Params {
+			params[pair.Key] = pair.Value
+		}
+		results, err = w.VCR.SearchConcept(ctx.Request().Context(), conceptName, untrusted, params)
+	} else {
+		// key/value pairs are prefix clauses, like SearchConcept does
+		clauses := make([]concept.Clause, 0, len(sr.Params)+len(*sr.Clauses))
+		for _, pair := range sr.Params {
+			clauses = append(clauses, concept.Prefix(pair.Key, pair.Value))
+		}
+		for _, searchClause := range *sr.Clauses {
+			clause, err := toClause(searchClause)
+			if err != nil {
+				return core.InvalidInputError("invalid search clause: %w", err)
+			}
+			clauses = append(clauses, clause)
+		}
+		results, err = w.VCR.SearchConceptClauses(ctx.Request().Context(), conceptName, untrusted, clauses)
+	}
 	if err != nil {
 		return err
 	}
@@ -246,3 +267,65 @@ func changeTrust(ctx echo.Context, f trustChangeFunc) error {
 
 	return ctx.NoContent(http.StatusNoContent)
 }
+
+// toClause converts a search clause of the API to a concept.Clause.
+func toClause(searchClause SearchClause) (concept.Clause, error) {
+	key := ""
+	if searchClause.Key != nil {
+		key = *searchClause.Key
+	}
+	if key == "" && searchClause.Type != SearchClauseTypeNot && searchClause.Type != SearchClauseTypeOr {
+		return nil, fmt.Errorf("%s clause requires a key", searchClause.Type)
+	}
+
+	switch searchClause.Type {
+	case SearchClauseTypeEq:
+		if searchClause.Value == nil {
+			return nil, errors.New("eq clause requires a value")
+		}
+		return concept.Eq(key, *searchClause.Value), nil
+	case SearchClauseTypePrefix:
+		if searchClause.Value == nil {
+			return nil, errors.New("prefix clause requires a value")
+		}
+		return concept.Prefix(key, *searchClause.Value), nil
+	case SearchClauseTypeRange:
+		from, to := "", ""
+		if searchClause.From != nil {
+			from = *searchClause.From
+		}
+		if searchClause.To != nil {
+			to = *searchClause.To
+		}
+		if from == "" && to == "" {
+			return nil, errors.New("range clause requires from or to")
+		}
+		return concept.Range(key, from, to), nil
+	case SearchClauseTypeExists:
+		return concept.Exists(key), nil
+	case SearchClauseTypeNot:
+		if searchClause.Clauses == nil || len(*searchClause.Clauses) != 1 {
+			return nil, errors.New("not clause requires exactly 1 clause")
+		}
+		clause, err := toClause((*searchClause.Clauses)[0])
+		if err != nil {
+			return nil, err
+		}
+		return concept.Not(clause), nil
+	case SearchClauseTypeOr:
+		if searchClause.Clauses == nil || len(*searchClause.Clauses) == 0 {
+			return nil, errors.New("or clause requires at least 1 clause")
+		}
+		clauses := make([]concept.Clause, len(*searchClause.Clauses))
+		for i, member := range *searchClause.Clauses {
+			clause, err := toClause(member)
+			if err != nil {
+				return nil, err
+			}
+			clauses[i] = clause
+		}
+		return concept.Or(clauses...), nil
+	default:
+		return nil, fmt.Errorf("unknown clause type: %s", searchClause.Type)
+	}
+}
@@ -298,6 +298,111 @@ func TestWrapper_SearchConcept(t *testing.T) {
 	})
 }
 
+func TestWrapper_SearchConceptClauses(t *testing.T) {
+	key := "human.eyeColour"
+	blue := "blue"
+	searchRequest := SearchRequest{
+		Params: []KeyValuePair{{Key: "subject", Value: "did:nuts:123"}},
+		Clauses: &[]SearchClause{
+			{Type: SearchClauseTypeOr, Clauses: &[]SearchClause{
+				{Type: SearchClauseTypeEq, Key: &key, Value: &blue},
+				{Type: SearchClauseTypeNot, Clauses: &[]SearchClause{{Type: SearchClauseTypeExists, Key: &key}}},
+			}},
+		},
+	}
+	bindRequest := func(ctx mockContext, request SearchRequest) {
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*SearchRequest) = request
+			return nil
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		bindRequest(ctx, searchRequest)
+		cpt := concept.Concept(map[string]interface{}{"foo": "bar"})
+		ctx.echo.EXPECT().Request().Return(&http.Request{})
+		ctx.vcr.EXPECT().SearchConceptClauses(gomock.Any(), "human", false, []concept.Clause{
+			concept.Prefix("subject", "did:nuts:123"),
+			concept.Or(concept.Eq(key, blue), concept.Not(concept.Exists(key))),
+		}).Return([]concept.Concept{cpt}, nil)
+		ctx.echo.EXPECT().JSON(http.StatusOK, []concept.Concept{cpt})
+
+		err := ctx.client.Search(ctx.echo, "human", SearchParams{})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - invalid clause", func(t *testing.T) {
+		ctx := newMockContext(t)
+		bindRequest(ctx, SearchRequest{Clauses: &[]SearchClause{{Type: SearchClauseTypeRange, Key: &key}}})
+
+		err := ctx.client.Search(ctx.echo, "human", SearchParams{})
+
+		if !assert.ErrorIs(t, err, core.InvalidInputError("")) {
+			return
+		}
+		assert.Contains(t, err.Error(), "range clause requires from or to")
+	})
+}
+
+func TestToClause(t *testing.T) {
+	key := "key"
+	value := "value"
+	from := "a"
+	to := "b"
+
+	t.Run("ok", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			clause   SearchClause
+			expected concept.Clause
+		}{
+			{"eq", SearchClause{Type: SearchClauseTypeEq, Key: &key, Value: &value}, concept.Eq(key, value)},
+			{"prefix", SearchClause{Type: SearchClauseTypePrefix, Key: &key, Value: &value}, concept.Prefix(key, value)},
+			{"range", SearchClause{Type: SearchClauseTypeRange, Key: &key, From: &from, To: &to}, concept.Range(key, from, to)},
+			{"range without upper bound", SearchClause{Type: SearchClauseTypeRange, Key: &key, From: &from}, concept.Range(key, from, "")},
+			{"exists", SearchClause{Type: SearchClauseTypeExists, Key: &key}, concept.Exists(key)},
+			{"not", SearchClause{Type: SearchClauseTypeNot, Clauses: &[]SearchClause{{Type: SearchClauseTypeExists, Key: &key}}}, concept.Not(concept.Exists(key))},
+			{"or", SearchClause{Type: SearchClauseTypeOr, Clauses: &[]SearchClause{{Type: SearchClauseTypeExists, Key: &key}}}, concept.Or(concept.Exists(key))},
+		}
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				clause, err := toClause(testCase.clause)
+
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, testCase.expected, clause)
+			})
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			clause SearchClause
+			err    string
+		}{
+			{"missing key", SearchClause{Type: SearchClauseTypeExists}, "exists clause requires a key"},
+			{"eq without value", SearchClause{Type: SearchClauseTypeEq, Key: &key}, "eq clause requires a value"},
+			{"prefix without value", SearchClause{Type: SearchClauseTypePrefix, Key: &key}, "prefix clause requires a value"},
+			{"range without bounds", SearchClause{Type: SearchClauseTypeRange, Key: &key}, "range clause requires from or to"},
+			{"not without clause", SearchClause{Type: SearchClauseTypeNot}, "not clause requires exactly 1 clause"},
+			{"or without clauses", SearchClause{Type: SearchClauseTypeOr, Clauses: &[]SearchClause{}}, "or clause requires at least 1 clause"},
+			{"invalid nested clause", SearchClause{Type: SearchClauseTypeOr, Clauses: &[]SearchClause{{Type: SearchClauseTypeEq, Key: &key}}}, "eq clause requires a value"},
+			{"unknown type", SearchClause{Type: "between", Key: &key}, "unknown clause type: between"},
+		}
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				_, err := toClause(testCase.clause)
+
+				assert.EqualError(t, err, testCase.err)
+			})
+		}
+	})
+}
+
 func TestWrapper_Revoke(t *testing.T) {
 	revocation := &credential.Revocation{}
 
@@ -27,6 +27,21 @@ const (
 	ResolutionResultCurrentStatusUntrusted ResolutionResultCurrentStatus = "untrusted"
 )
 
+// Defines values for SearchClauseType.
+const (
+	SearchClauseTypeEq SearchClauseType = "eq"
+
+	SearchClauseTypeExists SearchClauseType = "exists"
+
+	SearchClauseTypeNot SearchClauseType = "not"
+
+	SearchClauseTypeOr SearchClauseType = "or"
+
+	SearchClauseTypePrefix SearchClauseType = "prefix"
+
+	SearchClauseTypeRange SearchClauseType = "range"
+)
+
 // CredentialIssuer defines model for CredentialIssuer.
 type CredentialIssuer struct {
 	// a credential type
@@ -58,8 +73,33 @@ type ResolutionResult struct {
 // Only credentials with with "trusted" state are valid. If a revoked credential is also untrusted, revoked will be returned.
 type ResolutionResultCurrentStatus string
 
+// A search clause on a field of the concept. Keys follow the same rules as the keys of the key/value pairs. eq and prefix clauses require a key and value, a range clause requires a key and at least one of from and to. An exists clause only requires a key. A not clause contains exactly one clause, an or clause at least one.
+type SearchClause struct {
+	// Clauses of a not or an or clause.
+	Clauses *[]SearchClause `json:"clauses,omitempty"`
+
+	// Inclusive lower bound of a range clause. The range is open at the bottom when omitted.
+	From *string `json:"from,omitempty"`
+
+	// Field to search on, required for all clauses but not and or.
+	Key *string `json:"key,omitempty"`
+
+	// Inclusive upper bound of a range clause. The range is open at the top when omitted.
+	To   *string          `json:"to,omitempty"`
+	Type SearchClauseType `json:"type"`
+
+	// Value to match, required for eq and prefix clauses.
+	Value *string `json:"value,omitempty"`
+}
+
+// SearchClauseType defines model for SearchClause.Type.
+type SearchClauseType string
+
 // Input for a search call. Parameters are entered as key/value pairs. Concept specific query params need to be prepended with the concept name.
 type SearchRequest struct {
+	// Clauses that must match in addition to the key/value pairs. Unlike the key/value pairs, clauses can express ranges, alternatives, negation and existence of fields.
+	Clauses *[]SearchClause `json:"clauses,omitempty"`
+
 	// limit number of return values to x, default 10
 	Limit *float32 `json:"limit,omitempty"`
 
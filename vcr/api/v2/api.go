@@ -20,8 +20,11 @@ package v2
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	"net/http"
+	"strings"
 
 	"time"
 
@@ -31,8 +34,12 @@ import (
 	"github.com/nuts-foundation/go-did/vc"
 	"github.com/nuts-foundation/nuts-node/core"
 	"github.com/nuts-foundation/nuts-node/vcr"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/issuer"
 	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+	"github.com/nuts-foundation/nuts-node/vcr/verifier"
 )
 
 var clockFn = func() time.Time {
@@ -46,6 +53,13 @@ type Wrapper struct {
 	VCR                vcr.VCR
 }
 
+// ResolveStatusCode maps errors returned by this API to specific HTTP status codes.
+func (w *Wrapper) ResolveStatusCode(err error) int {
+	return core.ResolveStatusCode(err, map[error]int{
+		issuer.ErrTemplateMismatch: http.StatusBadRequest,
+	})
+}
+
 // Routes registers the handler to the echo router
 func (w *Wrapper) Routes(router core.EchoRouter) {
 	RegisterHandlers(router, w)
@@ -65,37 +79,27 @@ func (w Wrapper) IssueVC(ctx echo.Context) error {
 		return err
 	}
 
-	var (
-		publish bool
-		public  bool
-	)
-
-	// publish is true by default
-	if issueRequest.PublishToNetwork != nil {
-		publish = *issueRequest.PublishToNetwork
-	} else {
-		publish = true
-	}
-
-	// Check param constraints:
-	if issueRequest.Visibility == nil || *issueRequest.Visibility == "" {
-		if publish {
-			return core.InvalidInputError("visibility must be set when publishing credential")
-		}
-	} else { // visibility is set
-		// Visibility can only be used when publishing
-		if !publish {
-			return core.InvalidInputError("visibility setting is only allowed when publishing to the network")
+	var template *IssuanceTemplate
+	if issueRequest.Template != nil {
+		var err error
+		template, err = w.VCR.Template(*issueRequest.Template)
+		if err != nil {
+			return core.InvalidInputError("%w", err)
 		}
-		// Check if the values are in range
-		if *issueRequest.Visibility != IssueVCRequestVisibilityPublic && *issueRequest.Visibility != IssueVCRequestVisibilityPrivate {
-			return core.InvalidInputError("invalid value for visibility")
+		// the template provides the visibility if the request doesn't, visibility only applies when publishing
+		publish := issueRequest.PublishToNetwork == nil || *issueRequest.PublishToNetwork
+		if publish && issueRequest.Visibility == nil && template.Visibility != "" {
+			visibility := IssueVCRequestVisibility(template.Visibility)
+			issueRequest.Visibility = &visibility
 		}
-		// Set the actual value
-		public = *issueRequest.Visibility == IssueVCRequestVisibilityPublic
 	}
 
-	if issueRequest.Type == "" {
+	publish, public, credentialFormat, err := parseIssueOptions(issueRequest.PublishToNetwork, (*string)(issueRequest.Visibility), (*string)(issueRequest.Format))
+	if err != nil {
+		return err
+	}
+
+	if template == nil && (issueRequest.Type == nil || *issueRequest.Type == "") {
 		return core.InvalidInputError("missing credential type")
 	}
 
@@ -108,13 +112,124 @@ func (w Wrapper) IssueVC(ctx echo.Context) error {
 	if err := json.Unmarshal(rawRequest, &requestedVC); err != nil {
 		return err
 	}
+	if template != nil {
+		templatedVC, err := template.Apply(requestedVC, clockFn())
+		if err != nil {
+			return core.InvalidInputError("%w", err)
+		}
+		requestedVC = *templatedVC
+	}
 
-	vcCreated, err := w.VCR.Issuer().Issue(requestedVC, publish, public)
+	vcCreated, err := w.VCR.Issuer().Issue(requestedVC, credentialFormat, publish, public)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, vcCreated)
+	return ctx.JSON(http.StatusOK, encodeCredential(*vcCreated))
+}
+
+// IssueVCBatch handles the API request for issuing multiple credentials of the same issuer.
+func (w Wrapper) IssueVCBatch(ctx echo.Context) error {
+	batchRequest := IssueVCBatchRequest{}
+	if err := ctx.Bind(&batchRequest); err != nil {
+		return err
+	}
+
+	publish, public, format, err := parseIssueOptions(batchRequest.PublishToNetwork, (*string)(batchRequest.Visibility), (*string)(batchRequest.Format))
+	if err != nil {
+		return err
+	}
+	if batchRequest.Issuer == "" {
+		return core.InvalidInputError("missing issuer")
+	}
+	if len(batchRequest.Credentials) == 0 {
+		return core.InvalidInputError("missing credentials")
+	}
+
+	// items that are invalid get an error result, the others are issued
+	results := make([]IssueVCBatchResult, len(batchRequest.Credentials))
+	var (
+		requestedVCs []vc.VerifiableCredential
+		requested    []int
+	)
+	for idx, item := range batchRequest.Credentials {
+		requestedVC, err := batchItemToCredential(batchRequest.Issuer, item)
+		if err != nil {
+			msg := err.Error()
+			results[idx].Error = &msg
+			continue
+		}
+		requestedVCs = append(requestedVCs, *requestedVC)
+		requested = append(requested, idx)
+	}
+
+	if len(requestedVCs) > 0 {
+		for j, result := range w.VCR.Issuer().IssueBatch(requestedVCs, format, publish, public) {
+			if result.Err != nil {
+				msg := result.Err.Error()
+				results[requested[j]].Error = &msg
+				continue
+			}
+			credential := encodeCredential(*result.Credential)
+			results[requested[j]].Credential = &credential
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, IssueVCBatchResults{Results: results})
+}
+
+func batchItemToCredential(issuer string, item IssueVCBatchItem) (*vc.VerifiableCredential, error) {
+	if item.Type == "" {
+		return nil, errors.New("missing credential type")
+	}
+	if item.CredentialSubject == nil {
+		return nil, errors.New("missing credentialSubject")
+	}
+	requestedVC := vc.VerifiableCredential{}
+	rawItem, _ := json.Marshal(item)
+	if err := json.Unmarshal(rawItem, &requestedVC); err != nil {
+		return nil, err
+	}
+	issuerURI, err := ssi.ParseURI(issuer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer: %w", err)
+	}
+	requestedVC.Issuer = *issuerURI
+	return &requestedVC, nil
+}
+
+// parseIssueOptions checks the publication and format parameters of an issue request and returns their values.
+// Credentials are published by default, in which case the visibility must be set. The default format is ldp_vc.
+func parseIssueOptions(publishToNetwork *bool, visibility *string, format *string) (publish bool, public bool, credentialFormat types.Format, err error) {
+	// publish is true by default
+	publish = publishToNetwork == nil || *publishToNetwork
+
+	// Check param constraints:
+	if visibility == nil || *visibility == "" {
+		if publish {
+			return false, false, "", core.InvalidInputError("visibility must be set when publishing credential")
+		}
+	} else { // visibility is set
+		// Visibility can only be used when publishing
+		if !publish {
+			return false, false, "", core.InvalidInputError("visibility setting is only allowed when publishing to the network")
+		}
+		// Check if the values are in range
+		if *visibility != string(IssueVCRequestVisibilityPublic) && *visibility != string(IssueVCRequestVisibilityPrivate) {
+			return false, false, "", core.InvalidInputError("invalid value for visibility")
+		}
+		// Set the actual value
+		public = *visibility == string(IssueVCRequestVisibilityPublic)
+	}
+
+	credentialFormat = types.JSONLDCredentialFormat
+	if format != nil {
+		if *format != string(IssueVCRequestFormatLdpVc) && *format != string(IssueVCRequestFormatJwtVc) && *format != string(IssueVCRequestFormatVcSdJwt) {
+			return false, false, "", core.InvalidInputError("invalid value for format")
+		}
+		credentialFormat = types.Format(*format)
+	}
+	return publish, public, credentialFormat, nil
 }
 
 // RevokeVC handles the API request for revoking a credential.
@@ -151,17 +266,174 @@ func (w *Wrapper) SearchIssuedVCs(ctx echo.Context, params SearchIssuedVCsParams
 	}
 
 	foundVCs, err := w.VCR.Issuer().SearchCredential(ssi.URI{}, *credentialType, *issuerDID, subjectID)
+	if err != nil {
+		return err
+	}
 	result := make([]SearchVCResult, len(foundVCs))
-	// TODO: add revocation status
 	for i, resolvedVC := range foundVCs {
-		result[i] = SearchVCResult{VerifiableCredential: resolvedVC}
+		status, err := w.VCR.Verifier().Status(resolvedVC, nil)
+		if err != nil {
+			return err
+		}
+		result[i] = SearchVCResult{
+			VerifiableCredential: resolvedVC,
+			Revocation:           status.Revocation,
+			Status:               toVCStatus(status),
+		}
+	}
+	return ctx.JSON(http.StatusOK, SearchVCResults{VerifiableCredentials: result})
+}
+
+// defaultExpiringWithin is the default duration from now in which credentials listed by ListExpiringVCs expire.
+const defaultExpiringWithin = 30 * 24 * time.Hour
+
+// ListExpiringVCs lists the non-revoked credentials issued by this node that expire within the given duration.
+func (w *Wrapper) ListExpiringVCs(ctx echo.Context, params ListExpiringVCsParams) error {
+	within := defaultExpiringWithin
+	if params.Within != nil {
+		var err error
+		within, err = time.ParseDuration(*params.Within)
+		if err != nil {
+			return core.InvalidInputError("invalid duration: %w", err)
+		}
 	}
+
+	foundVCs, err := w.VCR.Issuer().SearchExpiringCredentials(clockFn().Add(within))
 	if err != nil {
 		return err
 	}
+	result := make([]SearchVCResult, 0, len(foundVCs))
+	for _, resolvedVC := range foundVCs {
+		status, err := w.VCR.Verifier().Status(resolvedVC, nil)
+		if err != nil {
+			return err
+		}
+		if status.Revoked() {
+			continue
+		}
+		result = append(result, SearchVCResult{
+			VerifiableCredential: resolvedVC,
+			Status:               toVCStatus(status),
+		})
+	}
 	return ctx.JSON(http.StatusOK, SearchVCResults{VerifiableCredentials: result})
 }
 
+// defaultIssuedLimit is the default number of credentials listed by ListIssuedVCs.
+const defaultIssuedLimit = 100
+
+// ListIssuedVCs lists the credentials issued by this node that match the filters, with their state.
+func (w *Wrapper) ListIssuedVCs(ctx echo.Context, params ListIssuedVCsParams) error {
+	filter, err := issuedCredentialFilter(params.Issuer, params.Subject, params.CredentialType, params.IssuedFrom, params.IssuedUntil)
+	if err != nil {
+		return err
+	}
+	query := vcr.IssuedCredentialQuery{CredentialFilter: *filter}
+	if params.State != nil {
+		query.State = vcr.IssuedCredentialState(*params.State)
+	}
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	limit := defaultIssuedLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 {
+		return core.InvalidInputError("limit must be positive")
+	}
+
+	credentials, total, err := w.VCR.ListIssued(query, offset, limit)
+	if err != nil {
+		return err
+	}
+	result := IssuedVCList{Credentials: make([]IssuedVC, len(credentials)), Total: total}
+	for i, curr := range credentials {
+		result.Credentials[i] = IssuedVC{VerifiableCredential: curr.Credential, State: IssuedVCState(curr.State)}
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// RevokeIssuedVCs revokes the active credentials issued by this node that match the filters, or previews them for a dry run.
+func (w *Wrapper) RevokeIssuedVCs(ctx echo.Context) error {
+	request := RevokeIssuedVCsRequest{}
+	if err := ctx.Bind(&request); err != nil {
+		return err
+	}
+	if strings.TrimSpace(request.Reason) == "" {
+		return core.InvalidInputError("missing reason")
+	}
+	filter, err := issuedCredentialFilter(request.Issuer, request.Subject, request.CredentialType, request.IssuedFrom, request.IssuedUntil)
+	if err != nil {
+		return err
+	}
+	dryRun := request.DryRun != nil && *request.DryRun
+
+	revocations, err := w.VCR.RevokeIssued(vcr.IssuedCredentialQuery{CredentialFilter: *filter}, request.Reason, dryRun)
+	if err != nil {
+		return err
+	}
+	results := make([]RevokeIssuedVCResult, len(revocations))
+	for i, curr := range revocations {
+		results[i] = RevokeIssuedVCResult{CredentialID: curr.Credential.ID.String(), Revocation: curr.Revocation}
+		if curr.Err != nil {
+			msg := curr.Err.Error()
+			results[i].Error = &msg
+		}
+	}
+	return ctx.JSON(http.StatusOK, RevokeIssuedVCsResults{Results: results})
+}
+
+// CreateRefreshNonce handles the API request for a nonce to refresh a credential issued by this node.
+func (w *Wrapper) CreateRefreshNonce(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, RefreshNonce{Nonce: w.VCR.CreateRefreshNonce()})
+}
+
+// RefreshVC handles the API request of a subject to refresh a credential issued by this node.
+func (w *Wrapper) RefreshVC(ctx echo.Context) error {
+	request := RefreshVCRequest{}
+	if err := ctx.Bind(&request); err != nil {
+		return err
+	}
+
+	presentation, err := decodePresentation(request.VerifiablePresentation)
+	if err != nil {
+		return core.InvalidInputError("invalid verifiablePresentation: %w", err)
+	}
+
+	if request.Nonce == "" {
+		return core.InvalidInputError("missing nonce")
+	}
+
+	successor, err := w.VCR.RefreshCredential(*presentation, request.Nonce)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, encodeCredential(*successor))
+}
+
+// issuedCredentialFilter builds the filter for issued credentials from the optional parameters of a request.
+func issuedCredentialFilter(issuerDID *string, subject *string, credentialType *string, issuedFrom *time.Time, issuedUntil *time.Time) (*issuer.CredentialFilter, error) {
+	filter := issuer.CredentialFilter{IssuedFrom: issuedFrom, IssuedUntil: issuedUntil}
+	if issuerDID != nil {
+		if _, err := did.ParseDID(*issuerDID); err != nil {
+			return nil, core.InvalidInputError("invalid issuer did: %w", err)
+		}
+		filter.Issuer = *issuerDID
+	}
+	if subject != nil {
+		filter.Subject = *subject
+	}
+	if credentialType != nil {
+		filter.CredentialType = *credentialType
+	}
+	if issuedFrom != nil && issuedUntil != nil && !issuedFrom.Before(*issuedUntil) {
+		return nil, core.InvalidInputError("issuedFrom must lie before issuedUntil")
+	}
+	return &filter, nil
+}
+
 // VerifyVC handles API request to verify a  Verifiable Credential.
 func (w *Wrapper) VerifyVC(ctx echo.Context) error {
 	verifyRequest := VCVerificationRequest{}
@@ -169,7 +441,10 @@ func (w *Wrapper) VerifyVC(ctx echo.Context) error {
 	if err := ctx.Bind(&verifyRequest); err != nil {
 		return err
 	}
-	requestedVC := verifyRequest.VerifiableCredential
+	requestedVC, err := decodeCredential(verifyRequest.VerifiableCredential)
+	if err != nil {
+		return core.InvalidInputError("invalid verifiableCredential: %w", err)
+	}
 
 	allowUntrustedIssuer := false
 
@@ -179,12 +454,174 @@ func (w *Wrapper) VerifyVC(ctx echo.Context) error {
 		}
 	}
 
-	if err := w.VCR.Validate(requestedVC, allowUntrustedIssuer, true, nil); err != nil {
-		errMsg := err.Error()
-		return ctx.JSON(http.StatusOK, VCVerificationResult{Validity: false, Message: &errMsg})
+	checkResult := w.VCR.Verifier().Check(*requestedVC, allowUntrustedIssuer, true, nil)
+	w.VCR.RecordVerification(*requestedVC, audit.Caller{Name: "vcr.VerifyVC"}, checkResult.Checks)
+	result := VCVerificationResult{
+		Validity: checkResult.Valid(),
+		Status:   toVCStatus(checkResult.Status),
+	}
+	if !checkResult.Valid() {
+		messages := make([]string, len(checkResult.Errs))
+		for i, err := range checkResult.Errs {
+			messages[i] = err.Error()
+		}
+		message := strings.Join(messages, ", ")
+		result.Message = &message
+		result.Messages = &messages
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// toVCStatus converts the credential status of the verifier to its API representation.
+func toVCStatus(status *verifier.CredentialStatus) *VCStatus {
+	if status == nil {
+		return nil
+	}
+	result := &VCStatus{
+		Revoked:        status.Revoked(),
+		Revocation:     status.Revocation,
+		Trust:          make([]CredentialTypeTrust, len(status.Trust)),
+		ValidityWindow: VCStatusValidityWindow(status.ValidityWindow),
+	}
+	for i, curr := range status.Trust {
+		result.Trust[i] = CredentialTypeTrust{CredentialType: curr.Type.String(), Trusted: curr.Trusted}
+		if curr.Reason != "" {
+			reason := curr.Reason
+			result.Trust[i].Reason = &reason
+		}
+	}
+	return result
+}
+
+// ExplainTrust handles API request to explain whether an issuer is trusted for a credential type.
+func (w *Wrapper) ExplainTrust(ctx echo.Context, params ExplainTrustParams) error {
+	credentialType, err := ssi.ParseURI(params.CredentialType)
+	if err != nil {
+		return core.InvalidInputError("invalid credentialType: %w", err)
+	}
+	issuerDID, err := did.ParseDID(params.Issuer)
+	if err != nil {
+		return core.InvalidInputError("invalid issuer did: %w", err)
+	}
+
+	decision := w.VCR.ExplainTrust(*credentialType, issuerDID.URI())
+	return ctx.JSON(http.StatusOK, TrustDecision{
+		CredentialType: params.CredentialType,
+		Issuer:         params.Issuer,
+		Trusted:        decision.Trusted,
+		Reason:         decision.Reason,
+	})
+}
+
+// ImportTrustList handles API request to import a trust list published by a governance body.
+func (w *Wrapper) ImportTrustList(ctx echo.Context) error {
+	request := ImportTrustListRequest{}
+	if err := ctx.Bind(&request); err != nil {
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, VCVerificationResult{Validity: true})
+	trustList, err := w.VCR.ImportTrustList(request.TrustList)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, trustList)
+}
+
+// VerifyVP handles API request to verify a Verifiable Presentation and the Verifiable Credentials it contains.
+func (w *Wrapper) VerifyVP(ctx echo.Context) error {
+	verifyRequest := VPVerificationRequest{}
+	if err := ctx.Bind(&verifyRequest); err != nil {
+		return err
+	}
+
+	presentation, err := decodePresentation(verifyRequest.VerifiablePresentation)
+	if err != nil {
+		return core.InvalidInputError("invalid verifiablePresentation: %w", err)
+	}
+
+	options := verifier.VPVerificationOptions{}
+	if requestOptions := verifyRequest.VerificationOptions; requestOptions != nil {
+		options.Challenge = requestOptions.Challenge
+		options.Domain = requestOptions.Domain
+		options.ValidAt = requestOptions.ValidAt
+		if allowUntrusted := requestOptions.AllowUntrustedIssuer; allowUntrusted != nil {
+			options.AllowUntrustedIssuer = *allowUntrusted
+		}
+	}
+	options.PresentationDefinition = verifyRequest.PresentationDefinition
+	options.PresentationSubmission = verifyRequest.PresentationSubmission
+
+	verificationResult := w.VCR.Verifier().VerifyVP(*presentation, options)
+	// every credential is recorded with the outcome of verifying the presentation that contained it
+	caller := audit.Caller{Name: "vcr.VerifyVP"}
+	if verificationResult.Holder != nil {
+		caller.Details = map[string]string{"holder": verificationResult.Holder.String()}
+	}
+	for _, credentialResult := range verificationResult.Credentials {
+		checks := make([]audit.Check, 0, len(credentialResult.Checks)+1)
+		checks = append(checks, credentialResult.Checks...)
+		checks = append(checks, audit.NewCheck(audit.PresentationCheck, verificationResult.Err))
+		w.VCR.RecordVerification(credentialResult.Credential, caller, checks)
+	}
+
+	result := VPVerificationResult{
+		Validity:    verificationResult.Valid(),
+		Credentials: make([]VPCredentialVerificationResult, len(verificationResult.Credentials)),
+	}
+	if verificationResult.Err != nil {
+		errMsg := verificationResult.Err.Error()
+		result.Message = &errMsg
+	}
+	if verificationResult.Holder != nil {
+		holder := DID(verificationResult.Holder.String())
+		result.Holder = &holder
+	}
+	for i, credentialResult := range verificationResult.Credentials {
+		current := VPCredentialVerificationResult{Validity: credentialResult.Err == nil}
+		if credentialResult.Credential.ID != nil {
+			id := credentialResult.Credential.ID.String()
+			current.Id = &id
+		}
+		if credentialResult.Err != nil {
+			errMsg := credentialResult.Err.Error()
+			current.Message = &errMsg
+		}
+		result.Credentials[i] = current
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// defaultVerificationsLimit is the default number of verifications returned by SearchVerifications.
+const defaultVerificationsLimit = 100
+
+// SearchVerifications handles API request to search the audit trail of credential verifications.
+func (w *Wrapper) SearchVerifications(ctx echo.Context, params SearchVerificationsParams) error {
+	query := audit.Query{From: params.From, To: params.To}
+	if params.CredentialID != nil {
+		query.CredentialID = *params.CredentialID
+	}
+	if params.Subject != nil {
+		query.Subject = *params.Subject
+	}
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return core.InvalidInputError("from must lie before to")
+	}
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	limit := defaultVerificationsLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 {
+		return core.InvalidInputError("limit must be positive")
+	}
+	entries, total, err := w.VCR.SearchVerifications(query, offset, limit)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, AuditEntryList{Entries: entries, Total: total})
 }
 
 // CreateVP handles API request to create a Verifiable Presentation for one or more Verifiable Credentials.
@@ -194,8 +631,27 @@ func (w *Wrapper) CreateVP(ctx echo.Context) error {
 		return err
 	}
 
-	if len(request.VerifiableCredentials) == 0 {
-		return core.InvalidInputError("verifiableCredentials needs at least 1 item")
+	var credentials []vc.VerifiableCredential
+	if request.VerifiableCredentials != nil {
+		for _, requested := range *request.VerifiableCredentials {
+			credential, err := decodeCredential(requested)
+			if err != nil {
+				return core.InvalidInputError("invalid verifiableCredentials: %w", err)
+			}
+			credentials = append(credentials, *credential)
+		}
+	}
+	if request.CredentialIDs != nil {
+		for _, id := range *request.CredentialIDs {
+			credential, err := w.walletCredential(id)
+			if err != nil {
+				return err
+			}
+			credentials = append(credentials, *credential)
+		}
+	}
+	if len(credentials) == 0 {
+		return core.InvalidInputError("verifiableCredentials or credentialIDs needs at least 1 item")
 	}
 
 	var signerDID *did.DID
@@ -207,6 +663,14 @@ func (w *Wrapper) CreateVP(ctx echo.Context) error {
 		}
 	}
 
+	format := types.JSONLDPresentationFormat
+	if request.Format != nil {
+		if *request.Format != CreateVPRequestFormatLdpVp && *request.Format != CreateVPRequestFormatJwtVp {
+			return core.InvalidInputError("invalid value for format")
+		}
+		format = types.Format(*request.Format)
+	}
+
 	created := clockFn()
 	var expires *time.Time
 	if request.Expires != nil {
@@ -223,9 +687,270 @@ func (w *Wrapper) CreateVP(ctx echo.Context) error {
 		ExpirationDate: expires,
 	}
 
-	vp, err := w.VCR.Holder().BuildVP(request.VerifiableCredentials, proofOptions, signerDID, true)
+	var disclose []string
+	if request.Disclose != nil {
+		disclose = *request.Disclose
+	}
+
+	vp, err := w.VCR.Holder().BuildVP(credentials, proofOptions, format, signerDID, true, disclose)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, encodePresentation(*vp))
+}
+
+// CreatePresentationSubmission handles API request to create a Verifiable Presentation that satisfies a Presentation Definition,
+// using the credentials of the holder that are known to this node.
+func (w *Wrapper) CreatePresentationSubmission(ctx echo.Context) error {
+	request := &CreatePresentationSubmissionRequest{}
+	if err := ctx.Bind(request); err != nil {
+		return err
+	}
+
+	holderDID, err := did.ParseDID(request.HolderDID)
+	if err != nil {
+		return core.InvalidInputError("invalid holder DID: %w", err)
+	}
+
+	format := types.JSONLDPresentationFormat
+	if request.Format != nil {
+		if *request.Format != CreatePresentationSubmissionRequestFormatLdpVp && *request.Format != CreatePresentationSubmissionRequestFormatJwtVp {
+			return core.InvalidInputError("invalid value for format")
+		}
+		format = types.Format(*request.Format)
+	}
+
+	created := clockFn()
+	if request.Expires != nil && request.Expires.Before(created) {
+		return core.InvalidInputError("expires can not lay in the past")
+	}
+	proofOptions := proof.ProofOptions{
+		Created:        created,
+		Domain:         request.Domain,
+		Challenge:      request.Challenge,
+		ExpirationDate: request.Expires,
+	}
+
+	candidates, err := w.holderCredentials(ctx, *holderDID)
+	if err != nil {
+		return err
+	}
+
+	vp, submission, err := w.VCR.Holder().BuildSubmission(request.PresentationDefinition, candidates, proofOptions, format, *holderDID)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, PresentationSubmissionResult{
+		VerifiablePresentation: encodePresentation(*vp),
+		PresentationSubmission: *submission,
+	})
+}
+
+// ListHolderVCs handles the API request for listing the credentials in the wallet of a DID.
+func (w *Wrapper) ListHolderVCs(ctx echo.Context, holderDID string, params ListHolderVCsParams) error {
+	subject, err := did.ParseDID(holderDID)
+	if err != nil {
+		return core.InvalidInputError("invalid holder DID: %w", err)
+	}
+	var credentialType *ssi.URI
+	if params.CredentialType != nil {
+		credentialType, err = ssi.ParseURI(*params.CredentialType)
+		if err != nil {
+			return core.InvalidInputError("invalid credentialType: %w", err)
+		}
+	}
+	var issuerDID *did.DID
+	if params.Issuer != nil {
+		issuerDID, err = did.ParseDID(*params.Issuer)
+		if err != nil {
+			return core.InvalidInputError("invalid issuer did: %w", err)
+		}
+	}
+
+	credentials, err := w.VCR.Holder().Wallet().ListCredentials(*subject, credentialType, issuerDID)
+	if err != nil {
+		return err
+	}
+	if credentials == nil {
+		credentials = []vc.VerifiableCredential{}
+	}
+	return ctx.JSON(http.StatusOK, credentials)
+}
+
+// GetHolderVC handles the API request for retrieving a credential from the wallet.
+func (w *Wrapper) GetHolderVC(ctx echo.Context, id string) error {
+	credentialID, err := ssi.ParseURI(id)
+	if err != nil {
+		return core.InvalidInputError("invalid credential id: %w", err)
+	}
+	credential, err := w.VCR.Holder().Wallet().GetCredential(*credentialID)
+	if err != nil {
+		if errors.Is(err, types.ErrNotFound) {
+			return core.NotFoundError("credential not found in wallet")
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, credential)
+}
+
+// DeleteHolderVC handles the API request for removing a credential from the wallet.
+func (w *Wrapper) DeleteHolderVC(ctx echo.Context, id string) error {
+	credentialID, err := ssi.ParseURI(id)
+	if err != nil {
+		return core.InvalidInputError("invalid credential id: %w", err)
+	}
+	if err = w.VCR.Holder().Wallet().DeleteCredential(*credentialID); err != nil {
+		if errors.Is(err, types.ErrNotFound) {
+			return core.NotFoundError("credential not found in wallet")
+		}
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// walletCredential retrieves a credential from the wallet by its ID, to be included in a presentation.
+// It returns a core.InvalidInputError if the ID is invalid or the credential isn't in the wallet.
+func (w *Wrapper) walletCredential(id string) (*vc.VerifiableCredential, error) {
+	credentialID, err := ssi.ParseURI(id)
+	if err != nil {
+		return nil, core.InvalidInputError("invalid credential id: %w", err)
+	}
+	credential, err := w.VCR.Holder().Wallet().GetCredential(*credentialID)
+	if errors.Is(err, types.ErrNotFound) {
+		return nil, core.InvalidInputError("credential not found in wallet (id=%s): %w", id, err)
+	}
+	return credential, err
+}
+
+// holderCredentials collects the credentials in the wallet of the given holder and
+// searches the credentials of all known concepts that are issued to it.
+func (w *Wrapper) holderCredentials(ctx echo.Context, holderDID did.DID) ([]vc.VerifiableCredential, error) {
+	result, err := w.VCR.Holder().Wallet().ListCredentials(holderDID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, curr := range result {
+		if curr.ID != nil {
+			seen[curr.ID.String()] = true
+		}
+	}
+	searched := map[string]bool{}
+	for _, config := range w.VCR.Registry().Concepts() {
+		// A query covers all credential types of a concept
+		if searched[config.Concept] {
+			continue
+		}
+		searched[config.Concept] = true
+		query, err := w.VCR.Registry().QueryFor(config.Concept)
+		if err != nil {
+			return nil, err
+		}
+		query.AddClause(concept.Eq("credentialSubject.id", holderDID.String()))
+		// Whether the issuers are trusted is up to the verifier of the presentation.
+		credentials, err := w.VCR.Search(ctx.Request().Context(), query, true, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, curr := range credentials {
+			if curr.ID != nil && seen[curr.ID.String()] {
+				continue
+			}
+			if curr.ID != nil {
+				seen[curr.ID.String()] = true
+			}
+			result = append(result, curr)
+		}
+	}
+	return result, nil
+}
+
+// ListConcepts handles API request to list the concept configs of the concept registry.
+func (w *Wrapper) ListConcepts(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, w.VCR.Registry().Concepts())
+}
+
+// AddConcept handles API request to add a concept config to the concept registry.
+func (w *Wrapper) AddConcept(ctx echo.Context) error {
+	config := ConceptConfig{}
+	if err := ctx.Bind(&config); err != nil {
+		return err
+	}
+
+	if err := w.VCR.AddConcept(config); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, config)
+}
+
+// RemoveConcept handles API request to remove a concept config from the concept registry.
+func (w *Wrapper) RemoveConcept(ctx echo.Context, credentialType string) error {
+	if err := w.VCR.RemoveConcept(credentialType); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListTemplates handles API request to list the issuance templates.
+func (w *Wrapper) ListTemplates(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, w.VCR.Templates())
+}
+
+// AddTemplate handles API request to add an issuance template.
+func (w *Wrapper) AddTemplate(ctx echo.Context) error {
+	template := IssuanceTemplate{}
+	if err := ctx.Bind(&template); err != nil {
+		return err
+	}
+
+	if err := w.VCR.AddTemplate(template); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, template)
+}
+
+// RemoveTemplate handles API request to remove an issuance template.
+func (w *Wrapper) RemoveTemplate(ctx echo.Context, name string) error {
+	if err := w.VCR.RemoveTemplate(name); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListJSONLDContexts handles API request to list the JSON-LD contexts the node can use.
+func (w *Wrapper) ListJSONLDContexts(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, w.VCR.JSONLDContexts())
+}
+
+// ListStoreCollections handles API request to list the collections of the VCR stores.
+func (w *Wrapper) ListStoreCollections(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, w.VCR.StoreCollections())
+}
+
+// CheckStoreCollection handles API request to check the consistency of a collection of the VCR stores.
+func (w *Wrapper) CheckStoreCollection(ctx echo.Context) error {
+	request := StoreCollectionRequest{}
+	if err := ctx.Bind(&request); err != nil {
+		return err
+	}
+
+	report, err := w.VCR.CheckCollection(request.Collection)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
+
+// ReindexStoreCollection handles API request to rebuild the indices of a collection of the VCR stores.
+func (w *Wrapper) ReindexStoreCollection(ctx echo.Context) error {
+	request := StoreCollectionRequest{}
+	if err := ctx.Bind(&request); err != nil {
+		return err
+	}
+
+	report, err := w.VCR.ReindexCollection(request.Collection)
 	if err != nil {
 		return err
 	}
-	return ctx.JSON(http.StatusOK, vp)
+	return ctx.JSON(http.StatusOK, report)
 }
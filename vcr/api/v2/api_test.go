@@ -20,7 +20,9 @@ package v2
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"time"
@@ -32,9 +34,17 @@ import (
 	"github.com/nuts-foundation/nuts-node/core"
 	"github.com/nuts-foundation/nuts-node/mock"
 	"github.com/nuts-foundation/nuts-node/vcr"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/holder"
 	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/pe"
+	"github.com/nuts-foundation/nuts-node/vcr/signature"
 	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+	"github.com/nuts-foundation/nuts-node/vcr/trust"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+	"github.com/nuts-foundation/nuts-node/vcr/verifier"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,12 +52,14 @@ func TestWrapper_IssueVC(t *testing.T) {
 
 	issuerURI, _ := ssi.ParseURI("did:nuts:123")
 	credentialType, _ := ssi.ParseURI("ExampleType")
+	credentialTypeValue := credentialType.String()
 
 	expectedRequestedVC := vc.VerifiableCredential{
 		Type:              []ssi.URI{*credentialType},
 		Issuer:            *issuerURI,
 		CredentialSubject: []interface{}{map[string]interface{}{"id": "did:nuts:456"}},
 	}
+	issuedVC := vc.VerifiableCredential{Issuer: *issuerURI}
 
 	t.Run("ok with an actual credential", func(t *testing.T) {
 		testContext := newMockContext(t)
@@ -56,14 +68,14 @@ func TestWrapper_IssueVC(t *testing.T) {
 		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
 			public := IssueVCRequestVisibilityPublic
 			issueRequest := f.(*IssueVCRequest)
-			issueRequest.Type = expectedRequestedVC.Type[0].String()
+			issueRequest.Type = &credentialTypeValue
 			issueRequest.Issuer = expectedRequestedVC.Issuer.String()
 			issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
 			issueRequest.Visibility = &public
 			return nil
 		})
-		testContext.mockIssuer.EXPECT().Issue(gomock.Eq(expectedRequestedVC), true, true)
-		testContext.echo.EXPECT().JSON(http.StatusOK, nil)
+		testContext.mockIssuer.EXPECT().Issue(gomock.Eq(expectedRequestedVC), types.JSONLDCredentialFormat, true, true).Return(&issuedVC, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, issuedVC)
 
 		err := testContext.client.IssueVC(testContext.echo)
 		assert.NoError(t, err)
@@ -78,7 +90,7 @@ func TestWrapper_IssueVC(t *testing.T) {
 			testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
 				public := IssueVCRequestVisibilityPublic
 				issueRequest := f.(*IssueVCRequest)
-				//issueRequest.Type = expectedRequestedVC.Type[0].String()
+				//issueRequest.Type = &credentialTypeValue
 				issueRequest.Issuer = expectedRequestedVC.Issuer.String()
 				issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
 				issueRequest.Visibility = &public
@@ -95,7 +107,7 @@ func TestWrapper_IssueVC(t *testing.T) {
 			testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
 				public := IssueVCRequestVisibilityPublic
 				issueRequest := f.(*IssueVCRequest)
-				issueRequest.Type = expectedRequestedVC.Type[0].String()
+				issueRequest.Type = &credentialTypeValue
 				issueRequest.Issuer = expectedRequestedVC.Issuer.String()
 				//issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
 				issueRequest.Visibility = &public
@@ -117,14 +129,14 @@ func TestWrapper_IssueVC(t *testing.T) {
 					issueRequest := f.(*IssueVCRequest)
 					publishValue := true
 					visibilityValue := IssueVCRequestVisibilityPrivate
-					issueRequest.Type = expectedRequestedVC.Type[0].String()
+					issueRequest.Type = &credentialTypeValue
 					issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
 					issueRequest.Visibility = &visibilityValue
 					issueRequest.PublishToNetwork = &publishValue
 					return nil
 				})
-				testContext.mockIssuer.EXPECT().Issue(gomock.Any(), true, false)
-				testContext.echo.EXPECT().JSON(http.StatusOK, nil)
+				testContext.mockIssuer.EXPECT().Issue(gomock.Any(), types.JSONLDCredentialFormat, true, false).Return(&issuedVC, nil)
+				testContext.echo.EXPECT().JSON(http.StatusOK, issuedVC)
 				err := testContext.client.IssueVC(testContext.echo)
 				assert.NoError(t, err)
 			})
@@ -137,14 +149,14 @@ func TestWrapper_IssueVC(t *testing.T) {
 					issueRequest := f.(*IssueVCRequest)
 					publishValue := true
 					visibilityValue := IssueVCRequestVisibilityPublic
-					issueRequest.Type = expectedRequestedVC.Type[0].String()
+					issueRequest.Type = &credentialTypeValue
 					issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
 					issueRequest.Visibility = &visibilityValue
 					issueRequest.PublishToNetwork = &publishValue
 					return nil
 				})
-				testContext.mockIssuer.EXPECT().Issue(gomock.Any(), true, true)
-				testContext.echo.EXPECT().JSON(http.StatusOK, nil)
+				testContext.mockIssuer.EXPECT().Issue(gomock.Any(), types.JSONLDCredentialFormat, true, true).Return(&issuedVC, nil)
+				testContext.echo.EXPECT().JSON(http.StatusOK, issuedVC)
 				err := testContext.client.IssueVC(testContext.echo)
 				assert.NoError(t, err)
 			})
@@ -207,17 +219,76 @@ func TestWrapper_IssueVC(t *testing.T) {
 				issueRequest := f.(*IssueVCRequest)
 				publishValue := false
 				issueRequest.PublishToNetwork = &publishValue
-				issueRequest.Type = expectedRequestedVC.Type[0].String()
+				issueRequest.Type = &credentialTypeValue
 				issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
 				return nil
 			})
-			testContext.mockIssuer.EXPECT().Issue(gomock.Any(), false, false)
-			testContext.echo.EXPECT().JSON(http.StatusOK, nil)
+			testContext.mockIssuer.EXPECT().Issue(gomock.Any(), types.JSONLDCredentialFormat, false, false).Return(&issuedVC, nil)
+			testContext.echo.EXPECT().JSON(http.StatusOK, issuedVC)
 			err := testContext.client.IssueVC(testContext.echo)
 			assert.NoError(t, err)
 		})
 	})
 
+	t.Run("test format", func(t *testing.T) {
+		t.Run("JWT", func(t *testing.T) {
+			testContext := newMockContext(t)
+
+			testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+				issueRequest := f.(*IssueVCRequest)
+				publishValue := false
+				format := IssueVCRequestFormatJwtVc
+				issueRequest.PublishToNetwork = &publishValue
+				issueRequest.Format = &format
+				issueRequest.Type = &credentialTypeValue
+				issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
+				return nil
+			})
+			jwtProof := proof.JWTProof{Type: proof.JwtProof2020, JWT: "a.b.c"}
+			jwtVC := vc.VerifiableCredential{Issuer: *issuerURI, Proof: []interface{}{jwtProof}}
+			testContext.mockIssuer.EXPECT().Issue(gomock.Any(), types.JWTCredentialFormat, false, false).Return(&jwtVC, nil)
+			testContext.echo.EXPECT().JSON(http.StatusOK, "a.b.c")
+			err := testContext.client.IssueVC(testContext.echo)
+			assert.NoError(t, err)
+		})
+
+		t.Run("SD-JWT", func(t *testing.T) {
+			testContext := newMockContext(t)
+
+			testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+				issueRequest := f.(*IssueVCRequest)
+				publishValue := false
+				format := IssueVCRequestFormatVcSdJwt
+				issueRequest.PublishToNetwork = &publishValue
+				issueRequest.Format = &format
+				issueRequest.Type = &credentialTypeValue
+				issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
+				return nil
+			})
+			testContext.mockIssuer.EXPECT().Issue(gomock.Any(), types.SDJWTCredentialFormat, false, false).Return(&issuedVC, nil)
+			testContext.echo.EXPECT().JSON(http.StatusOK, issuedVC)
+			err := testContext.client.IssueVC(testContext.echo)
+			assert.NoError(t, err)
+		})
+
+		t.Run("invalid format", func(t *testing.T) {
+			testContext := newMockContext(t)
+
+			testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+				issueRequest := f.(*IssueVCRequest)
+				publishValue := false
+				format := IssueVCRequestFormat("jwt_vp")
+				issueRequest.PublishToNetwork = &publishValue
+				issueRequest.Format = &format
+				issueRequest.Type = &credentialTypeValue
+				issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
+				return nil
+			})
+			err := testContext.client.IssueVC(testContext.echo)
+			assert.EqualError(t, err, "invalid value for format")
+		})
+	})
+
 	t.Run("test errors", func(t *testing.T) {
 		t.Run("error - bind fails", func(t *testing.T) {
 			testContext := newMockContext(t)
@@ -235,17 +306,214 @@ func TestWrapper_IssueVC(t *testing.T) {
 			testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
 				public := IssueVCRequestVisibilityPublic
 				issueRequest := f.(*IssueVCRequest)
-				issueRequest.Type = expectedRequestedVC.Type[0].String()
+				issueRequest.Type = &credentialTypeValue
 				issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
 				issueRequest.Visibility = &public
 				return nil
 			})
-			testContext.mockIssuer.EXPECT().Issue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("could not issue"))
+			testContext.mockIssuer.EXPECT().Issue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("could not issue"))
 			err := testContext.client.IssueVC(testContext.echo)
 			assert.EqualError(t, err, "could not issue")
 
 		})
 	})
+
+	t.Run("template", func(t *testing.T) {
+		templateName := "example"
+		template := IssuanceTemplate{
+			Name:           templateName,
+			CredentialType: credentialType.String(),
+			Context:        []string{"https://nuts.nl/credentials/v1"},
+			Validity:       "24h",
+			Visibility:     issuer.PrivateVisibility,
+			SubjectFields:  []issuer.TemplateField{{Path: "id"}},
+		}
+		now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+		clockFn = func() time.Time {
+			return now
+		}
+		defer func() {
+			clockFn = time.Now
+		}()
+		bindRequest := func(testContext mockContext, subject interface{}) {
+			testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+				issueRequest := f.(*IssueVCRequest)
+				issueRequest.Template = &templateName
+				issueRequest.Issuer = expectedRequestedVC.Issuer.String()
+				issueRequest.CredentialSubject = subject
+				return nil
+			})
+		}
+
+		t.Run("ok - applies template", func(t *testing.T) {
+			testContext := newMockContext(t)
+			bindRequest(testContext, expectedRequestedVC.CredentialSubject)
+			testContext.vcr.EXPECT().Template(templateName).Return(&template, nil)
+			expirationDate := now.Add(24 * time.Hour)
+			templatedVC := expectedRequestedVC
+			templatedVC.Context = []ssi.URI{ssi.MustParseURI("https://nuts.nl/credentials/v1")}
+			templatedVC.ExpirationDate = &expirationDate
+			testContext.mockIssuer.EXPECT().Issue(gomock.Eq(templatedVC), types.JSONLDCredentialFormat, true, false).Return(&issuedVC, nil)
+			testContext.echo.EXPECT().JSON(http.StatusOK, issuedVC)
+
+			err := testContext.client.IssueVC(testContext.echo)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("err - unknown template", func(t *testing.T) {
+			testContext := newMockContext(t)
+			bindRequest(testContext, expectedRequestedVC.CredentialSubject)
+			testContext.vcr.EXPECT().Template(templateName).Return(nil, core.NotFoundError("unknown issuance template (name=example)"))
+
+			err := testContext.client.IssueVC(testContext.echo)
+
+			assert.EqualError(t, err, "unknown issuance template (name=example)")
+			assert.ErrorIs(t, err, core.InvalidInputError(""))
+		})
+
+		t.Run("err - doesn't match template", func(t *testing.T) {
+			testContext := newMockContext(t)
+			bindRequest(testContext, []interface{}{map[string]interface{}{"name": "Jane"}})
+			testContext.vcr.EXPECT().Template(templateName).Return(&template, nil)
+
+			err := testContext.client.IssueVC(testContext.echo)
+
+			assert.EqualError(t, err, "credential subject misses field of template (template=example, path=id)")
+			assert.ErrorIs(t, err, core.InvalidInputError(""))
+		})
+
+		t.Run("ok - not published, visibility of template is ignored", func(t *testing.T) {
+			testContext := newMockContext(t)
+			testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+				issueRequest := f.(*IssueVCRequest)
+				issueRequest.Template = &templateName
+				issueRequest.Issuer = expectedRequestedVC.Issuer.String()
+				issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
+				publish := false
+				issueRequest.PublishToNetwork = &publish
+				return nil
+			})
+			testContext.vcr.EXPECT().Template(templateName).Return(&template, nil)
+			testContext.mockIssuer.EXPECT().Issue(gomock.Any(), types.JSONLDCredentialFormat, false, false).Return(&issuedVC, nil)
+			testContext.echo.EXPECT().JSON(http.StatusOK, issuedVC)
+
+			err := testContext.client.IssueVC(testContext.echo)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("error - violates template policy", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			private := IssueVCRequestVisibilityPrivate
+			issueRequest := f.(*IssueVCRequest)
+			issueRequest.Type = &credentialTypeValue
+			issueRequest.CredentialSubject = expectedRequestedVC.CredentialSubject
+			issueRequest.Visibility = &private
+			return nil
+		})
+		testContext.mockIssuer.EXPECT().Issue(gomock.Any(), types.JSONLDCredentialFormat, true, false).
+			Return(nil, fmt.Errorf("%w: no issuance template for credential type (credentialType=ExampleType)", issuer.ErrTemplateMismatch))
+
+		err := testContext.client.IssueVC(testContext.echo)
+
+		assert.ErrorIs(t, err, issuer.ErrTemplateMismatch)
+		assert.Equal(t, http.StatusBadRequest, testContext.client.ResolveStatusCode(err))
+	})
+}
+
+func TestWrapper_IssueVCBatch(t *testing.T) {
+	issuerURI, _ := ssi.ParseURI("did:nuts:123")
+	credentialType, _ := ssi.ParseURI("ExampleType")
+	expectedRequestedVC := vc.VerifiableCredential{
+		Type:              []ssi.URI{*credentialType},
+		Issuer:            *issuerURI,
+		CredentialSubject: []interface{}{map[string]interface{}{"id": "did:nuts:456"}},
+	}
+	item := IssueVCBatchItem{
+		Type:              credentialType.String(),
+		CredentialSubject: expectedRequestedVC.CredentialSubject,
+	}
+	private := IssueVCBatchRequestVisibilityPrivate
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*IssueVCBatchRequest) = IssueVCBatchRequest{
+				Issuer:      issuerURI.String(),
+				Visibility:  &private,
+				Credentials: []IssueVCBatchItem{item, {Type: credentialType.String()}, item},
+			}
+			return nil
+		})
+		issued := vc.VerifiableCredential{Issuer: *issuerURI}
+		testContext.mockIssuer.EXPECT().IssueBatch([]vc.VerifiableCredential{expectedRequestedVC, expectedRequestedVC}, types.JSONLDCredentialFormat, true, false).
+			Return([]issuer.BatchResult{{Credential: &issued}, {Err: errors.New("b00m!")}})
+		var response IssueVCBatchResults
+		testContext.echo.EXPECT().JSON(http.StatusOK, gomock.Any()).DoAndReturn(func(_ int, body interface{}) error {
+			response = body.(IssueVCBatchResults)
+			return nil
+		})
+
+		err := testContext.client.IssueVCBatch(testContext.echo)
+
+		if !assert.NoError(t, err) || !assert.Len(t, response.Results, 3) {
+			return
+		}
+		assert.Equal(t, issued, *response.Results[0].Credential)
+		assert.Nil(t, response.Results[0].Error)
+		assert.Equal(t, "missing credentialSubject", *response.Results[1].Error)
+		assert.Equal(t, "b00m!", *response.Results[2].Error)
+		assert.Nil(t, response.Results[2].Credential)
+	})
+
+	t.Run("error - missing visibility", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*IssueVCBatchRequest) = IssueVCBatchRequest{Issuer: issuerURI.String(), Credentials: []IssueVCBatchItem{item}}
+			return nil
+		})
+
+		err := testContext.client.IssueVCBatch(testContext.echo)
+
+		assert.EqualError(t, err, "visibility must be set when publishing credential")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+
+	t.Run("error - missing issuer", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*IssueVCBatchRequest) = IssueVCBatchRequest{Visibility: &private, Credentials: []IssueVCBatchItem{item}}
+			return nil
+		})
+
+		err := testContext.client.IssueVCBatch(testContext.echo)
+
+		assert.EqualError(t, err, "missing issuer")
+	})
+
+	t.Run("error - no credentials", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*IssueVCBatchRequest) = IssueVCBatchRequest{Issuer: issuerURI.String(), Visibility: &private}
+			return nil
+		})
+
+		err := testContext.client.IssueVCBatch(testContext.echo)
+
+		assert.EqualError(t, err, "missing credentials")
+	})
+
+	t.Run("error - bind fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).Return(errors.New("b00m!"))
+
+		err := testContext.client.IssueVCBatch(testContext.echo)
+
+		assert.EqualError(t, err, "b00m!")
+	})
 }
 
 func TestWrapper_SearchIssuedVCs(t *testing.T) {
@@ -280,8 +548,48 @@ func TestWrapper_SearchIssuedVCs(t *testing.T) {
 	t.Run("ok - without subject, 1 result", func(t *testing.T) {
 		testContext := newMockContext(t)
 		testContext.mockIssuer.EXPECT().SearchCredential(*contextURI, *testCredential, *issuerDID, nil).Return([]VerifiableCredential{foundVC}, nil)
+		testContext.mockVerifier.EXPECT().Status(foundVC, nil).Return(&verifier.CredentialStatus{
+			Trust:          []verifier.TypeTrust{{Type: *testCredential, Trusted: true, Reason: "trusted"}},
+			ValidityWindow: verifier.Valid,
+		}, nil)
+		reason := "trusted"
+
+		testContext.echo.EXPECT().JSON(http.StatusOK, SearchVCResults{VerifiableCredentials: []SearchVCResult{{
+			VerifiableCredential: foundVC,
+			Status: &VCStatus{
+				Trust:          []CredentialTypeTrust{{CredentialType: "TestCredential", Trusted: true, Reason: &reason}},
+				ValidityWindow: VCStatusValidityWindowValid,
+			},
+		}}})
 
-		testContext.echo.EXPECT().JSON(http.StatusOK, SearchVCResults{VerifiableCredentials: []SearchVCResult{{VerifiableCredential: foundVC}}})
+		params := SearchIssuedVCsParams{
+			CredentialType: "TestCredential",
+			Issuer:         issuerID.String(),
+		}
+		err := testContext.client.SearchIssuedVCs(testContext.echo, params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ok - revoked", func(t *testing.T) {
+		testContext := newMockContext(t)
+		revocation := &Revocation{Reason: "no longer valid"}
+		testContext.mockIssuer.EXPECT().SearchCredential(*contextURI, *testCredential, *issuerDID, nil).Return([]VerifiableCredential{foundVC}, nil)
+		testContext.mockVerifier.EXPECT().Status(foundVC, nil).Return(&verifier.CredentialStatus{
+			Revocation:     revocation,
+			Trust:          []verifier.TypeTrust{{Type: *testCredential, Trusted: false}},
+			ValidityWindow: verifier.Expired,
+		}, nil)
+
+		testContext.echo.EXPECT().JSON(http.StatusOK, SearchVCResults{VerifiableCredentials: []SearchVCResult{{
+			VerifiableCredential: foundVC,
+			Revocation:           revocation,
+			Status: &VCStatus{
+				Revoked:        true,
+				Revocation:     revocation,
+				Trust:          []CredentialTypeTrust{{CredentialType: "TestCredential", Trusted: false}},
+				ValidityWindow: VCStatusValidityWindowExpired,
+			},
+		}}})
 
 		params := SearchIssuedVCsParams{
 			CredentialType: "TestCredential",
@@ -291,6 +599,19 @@ func TestWrapper_SearchIssuedVCs(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("error - status can't be determined", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockIssuer.EXPECT().SearchCredential(*contextURI, *testCredential, *issuerDID, nil).Return([]VerifiableCredential{foundVC}, nil)
+		testContext.mockVerifier.EXPECT().Status(foundVC, nil).Return(nil, errors.New("b00m!"))
+
+		params := SearchIssuedVCsParams{
+			CredentialType: "TestCredential",
+			Issuer:         issuerID.String(),
+		}
+		err := testContext.client.SearchIssuedVCs(testContext.echo, params)
+		assert.EqualError(t, err, "b00m!")
+	})
+
 	t.Run("error - invalid input", func(t *testing.T) {
 
 		t.Run("invalid issuer", func(t *testing.T) {
@@ -329,16 +650,608 @@ func TestWrapper_SearchIssuedVCs(t *testing.T) {
 		})
 	})
 
-	t.Run("error - CredentialResolver returns error", func(t *testing.T) {
+	t.Run("error - CredentialResolver returns error", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockIssuer.EXPECT().SearchCredential(*contextURI, *testCredential, *issuerDID, nil).Return(nil, errors.New("b00m!"))
+
+		params := SearchIssuedVCsParams{
+			CredentialType: "TestCredential",
+			Issuer:         issuerID.String(),
+		}
+		err := testContext.client.SearchIssuedVCs(testContext.echo, params)
+		assert.EqualError(t, err, "b00m!")
+	})
+}
+
+func TestWrapper_ListExpiringVCs(t *testing.T) {
+	testCredential := ssi.MustParseURI("TestCredential")
+	credentialID := ssi.MustParseURI("did:nuts:123#1")
+	revokedID := ssi.MustParseURI("did:nuts:123#2")
+	expiringVC := vc.VerifiableCredential{ID: &credentialID, Type: []ssi.URI{testCredential}}
+	revokedVC := vc.VerifiableCredential{ID: &revokedID, Type: []ssi.URI{testCredential}}
+	now := time.Now()
+	clockFn = func() time.Time {
+		return now
+	}
+	defer func() {
+		clockFn = time.Now
+	}()
+
+	t.Run("ok - default duration, revoked credentials are excluded", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockIssuer.EXPECT().SearchExpiringCredentials(now.Add(30*24*time.Hour)).Return([]VerifiableCredential{expiringVC, revokedVC}, nil)
+		testContext.mockVerifier.EXPECT().Status(expiringVC, nil).Return(&verifier.CredentialStatus{
+			Trust:          []verifier.TypeTrust{{Type: testCredential, Trusted: true}},
+			ValidityWindow: verifier.Valid,
+		}, nil)
+		testContext.mockVerifier.EXPECT().Status(revokedVC, nil).Return(&verifier.CredentialStatus{
+			Revocation:     &Revocation{},
+			ValidityWindow: verifier.Valid,
+		}, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, SearchVCResults{VerifiableCredentials: []SearchVCResult{{
+			VerifiableCredential: expiringVC,
+			Status: &VCStatus{
+				Trust:          []CredentialTypeTrust{{CredentialType: "TestCredential", Trusted: true}},
+				ValidityWindow: VCStatusValidityWindowValid,
+			},
+		}}})
+
+		err := testContext.client.ListExpiringVCs(testContext.echo, ListExpiringVCsParams{})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("ok - with duration", func(t *testing.T) {
+		testContext := newMockContext(t)
+		within := "168h"
+		testContext.mockIssuer.EXPECT().SearchExpiringCredentials(now.Add(7*24*time.Hour)).Return(nil, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, SearchVCResults{VerifiableCredentials: []SearchVCResult{}})
+
+		err := testContext.client.ListExpiringVCs(testContext.echo, ListExpiringVCsParams{Within: &within})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - invalid duration", func(t *testing.T) {
+		testContext := newMockContext(t)
+		within := "a week"
+
+		err := testContext.client.ListExpiringVCs(testContext.echo, ListExpiringVCsParams{Within: &within})
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+		assert.Contains(t, err.Error(), "invalid duration")
+	})
+
+	t.Run("error - search fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockIssuer.EXPECT().SearchExpiringCredentials(gomock.Any()).Return(nil, errors.New("b00m"))
+
+		err := testContext.client.ListExpiringVCs(testContext.echo, ListExpiringVCsParams{})
+
+		assert.EqualError(t, err, "b00m")
+	})
+}
+
+func TestWrapper_ListIssuedVCs(t *testing.T) {
+	issuerDID := "did:nuts:123"
+	subject := "did:nuts:456"
+	credentialType := "NutsOrganizationCredential"
+	from := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := from.Add(24 * time.Hour)
+	credentialID := ssi.MustParseURI("did:nuts:123#1")
+	credential := vc.VerifiableCredential{ID: &credentialID}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		state := ListIssuedVCsParamsState("revoked")
+		offset := 10
+		limit := 5
+		query := vcr.IssuedCredentialQuery{
+			CredentialFilter: issuer.CredentialFilter{Issuer: issuerDID, Subject: subject, CredentialType: credentialType, IssuedFrom: &from, IssuedUntil: &until},
+			State:            vcr.RevokedCredentialState,
+		}
+		testContext.vcr.EXPECT().ListIssued(query, offset, limit).Return([]vcr.IssuedCredential{{Credential: credential, State: vcr.RevokedCredentialState}}, 11, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, IssuedVCList{
+			Credentials: []IssuedVC{{VerifiableCredential: credential, State: IssuedVCStateRevoked}},
+			Total:       11,
+		})
+
+		err := testContext.client.ListIssuedVCs(testContext.echo, ListIssuedVCsParams{
+			Issuer:         &issuerDID,
+			Subject:        &subject,
+			CredentialType: &credentialType,
+			IssuedFrom:     &from,
+			IssuedUntil:    &until,
+			State:          &state,
+			Offset:         &offset,
+			Limit:          &limit,
+		})
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - default page", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.vcr.EXPECT().ListIssued(vcr.IssuedCredentialQuery{}, 0, 100).Return([]vcr.IssuedCredential{}, 0, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, IssuedVCList{Credentials: []IssuedVC{}})
+
+		err := testContext.client.ListIssuedVCs(testContext.echo, ListIssuedVCsParams{})
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - invalid issuer", func(t *testing.T) {
+		testContext := newMockContext(t)
+		invalid := "not a DID"
+
+		err := testContext.client.ListIssuedVCs(testContext.echo, ListIssuedVCsParams{Issuer: &invalid})
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - issuedFrom after issuedUntil", func(t *testing.T) {
+		testContext := newMockContext(t)
+
+		err := testContext.client.ListIssuedVCs(testContext.echo, ListIssuedVCsParams{IssuedFrom: &until, IssuedUntil: &from})
+
+		assert.EqualError(t, err, "issuedFrom must lie before issuedUntil")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - invalid limit", func(t *testing.T) {
+		testContext := newMockContext(t)
+		limit := 0
+
+		err := testContext.client.ListIssuedVCs(testContext.echo, ListIssuedVCsParams{Limit: &limit})
+
+		assert.EqualError(t, err, "limit must be positive")
+	})
+	t.Run("error - list fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.vcr.EXPECT().ListIssued(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, 0, errors.New("b00m!"))
+
+		err := testContext.client.ListIssuedVCs(testContext.echo, ListIssuedVCsParams{})
+
+		assert.EqualError(t, err, "b00m!")
+	})
+}
+
+func TestWrapper_RevokeIssuedVCs(t *testing.T) {
+	subject := "did:nuts:456"
+	reason := "organization left the platform"
+	credentialID := ssi.MustParseURI("did:nuts:123#1")
+	otherID := ssi.MustParseURI("did:nuts:123#2")
+	query := vcr.IssuedCredentialQuery{CredentialFilter: issuer.CredentialFilter{Subject: subject}}
+	bindRequest := func(testContext mockContext, request RevokeIssuedVCsRequest) {
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*RevokeIssuedVCsRequest) = request
+			return nil
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext, RevokeIssuedVCsRequest{Subject: &subject, Reason: reason})
+		revocation := &Revocation{Subject: credentialID, Reason: reason}
+		testContext.vcr.EXPECT().RevokeIssued(query, reason, false).Return([]vcr.RevocationResult{
+			{Credential: vc.VerifiableCredential{ID: &credentialID}, Revocation: revocation},
+			{Credential: vc.VerifiableCredential{ID: &otherID}, Err: errors.New("b00m!")},
+		}, nil)
+		msg := "b00m!"
+		testContext.echo.EXPECT().JSON(http.StatusOK, RevokeIssuedVCsResults{Results: []RevokeIssuedVCResult{
+			{CredentialID: credentialID.String(), Revocation: revocation},
+			{CredentialID: otherID.String(), Error: &msg},
+		}})
+
+		err := testContext.client.RevokeIssuedVCs(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - dry run", func(t *testing.T) {
+		testContext := newMockContext(t)
+		dryRun := true
+		bindRequest(testContext, RevokeIssuedVCsRequest{Subject: &subject, Reason: reason, DryRun: &dryRun})
+		testContext.vcr.EXPECT().RevokeIssued(query, reason, true).Return([]vcr.RevocationResult{
+			{Credential: vc.VerifiableCredential{ID: &credentialID}},
+		}, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, RevokeIssuedVCsResults{Results: []RevokeIssuedVCResult{
+			{CredentialID: credentialID.String()},
+		}})
+
+		err := testContext.client.RevokeIssuedVCs(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - missing reason", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext, RevokeIssuedVCsRequest{Subject: &subject})
+
+		err := testContext.client.RevokeIssuedVCs(testContext.echo)
+
+		assert.EqualError(t, err, "missing reason")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - revoke fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext, RevokeIssuedVCsRequest{Reason: reason})
+		testContext.vcr.EXPECT().RevokeIssued(vcr.IssuedCredentialQuery{}, reason, false).Return(nil, core.InvalidInputError("an issuer, subject or credential type must be given"))
+
+		err := testContext.client.RevokeIssuedVCs(testContext.echo)
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - bind fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).Return(errors.New("b00m"))
+
+		err := testContext.client.RevokeIssuedVCs(testContext.echo)
+
+		assert.EqualError(t, err, "b00m")
+	})
+}
+
+func TestWrapper_RefreshVC(t *testing.T) {
+	credentialID := ssi.MustParseURI("did:nuts:123#1")
+	presentation := vc.VerifiablePresentation{VerifiableCredential: []vc.VerifiableCredential{{ID: &credentialID}}}
+	bindRequest := func(testContext mockContext) {
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*RefreshVCRequest) = RefreshVCRequest{VerifiablePresentation: presentation, Nonce: "nonce"}
+			return nil
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		successor := &vc.VerifiableCredential{ID: &credentialID}
+		testContext.vcr.EXPECT().RefreshCredential(presentation, "nonce").Return(successor, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, *successor)
+
+		err := testContext.client.RefreshVC(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - can't be refreshed", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		testContext.vcr.EXPECT().RefreshCredential(presentation, "nonce").Return(nil, core.PreconditionFailedError("credential has been revoked"))
+
+		err := testContext.client.RefreshVC(testContext.echo)
+
+		assert.ErrorIs(t, err, core.PreconditionFailedError(""))
+	})
+	t.Run("error - bind fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).Return(errors.New("b00m"))
+
+		err := testContext.client.RefreshVC(testContext.echo)
+
+		assert.EqualError(t, err, "b00m")
+	})
+	t.Run("error - missing nonce", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*RefreshVCRequest) = RefreshVCRequest{VerifiablePresentation: presentation}
+			return nil
+		})
+
+		err := testContext.client.RefreshVC(testContext.echo)
+
+		assert.EqualError(t, err, "missing nonce")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+}
+
+func TestWrapper_CreateRefreshNonce(t *testing.T) {
+	testContext := newMockContext(t)
+	testContext.vcr.EXPECT().CreateRefreshNonce().Return("nonce")
+	testContext.echo.EXPECT().JSON(http.StatusOK, RefreshNonce{Nonce: "nonce"})
+
+	err := testContext.client.CreateRefreshNonce(testContext.echo)
+
+	assert.NoError(t, err)
+}
+
+func TestWrapper_ExplainTrust(t *testing.T) {
+	credentialType := ssi.MustParseURI("NutsOrganizationCredential")
+	issuerDID := did.MustParseDID("did:nuts:123")
+	params := ExplainTrustParams{CredentialType: credentialType.String(), Issuer: issuerDID.String()}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.vcr.EXPECT().ExplainTrust(credentialType, issuerDID.URI()).Return(trust.Decision{Trusted: true, Reason: "issuer is in the list of trusted issuers"})
+		testContext.echo.EXPECT().JSON(http.StatusOK, TrustDecision{
+			CredentialType: params.CredentialType,
+			Issuer:         params.Issuer,
+			Trusted:        true,
+			Reason:         "issuer is in the list of trusted issuers",
+		})
+
+		err := testContext.client.ExplainTrust(testContext.echo, params)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - invalid issuer", func(t *testing.T) {
+		testContext := newMockContext(t)
+
+		err := testContext.client.ExplainTrust(testContext.echo, ExplainTrustParams{CredentialType: params.CredentialType, Issuer: "not a DID"})
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+		assert.Contains(t, err.Error(), "invalid issuer did")
+	})
+}
+
+func TestWrapper_ImportTrustList(t *testing.T) {
+	list := &TrustList{ID: "list", Issuer: "did:nuts:governance", Policies: []TrustPolicy{{CredentialType: "NutsOrganizationCredential", Issuer: "did:nuts:123"}}}
+	bindRequest := func(testContext mockContext) {
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			f.(*ImportTrustListRequest).TrustList = "signed"
+			return nil
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		testContext.vcr.EXPECT().ImportTrustList("signed").Return(list, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, list)
+
+		err := testContext.client.ImportTrustList(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - import fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		testContext.vcr.EXPECT().ImportTrustList("signed").Return(nil, core.InvalidInputError("invalid trust list"))
+
+		err := testContext.client.ImportTrustList(testContext.echo)
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+
+	t.Run("error - bind fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).Return(errors.New("b00m"))
+
+		err := testContext.client.ImportTrustList(testContext.echo)
+
+		assert.EqualError(t, err, "b00m")
+	})
+}
+
+func TestWrapper_ListTemplates(t *testing.T) {
+	testContext := newMockContext(t)
+	templates := []IssuanceTemplate{{Name: "example", CredentialType: "ExampleCredential"}}
+	testContext.vcr.EXPECT().Templates().Return(templates)
+	testContext.echo.EXPECT().JSON(http.StatusOK, templates)
+
+	err := testContext.client.ListTemplates(testContext.echo)
+
+	assert.NoError(t, err)
+}
+
+func TestWrapper_AddTemplate(t *testing.T) {
+	template := IssuanceTemplate{Name: "example", CredentialType: "ExampleCredential"}
+	bindRequest := func(testContext mockContext) {
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*IssuanceTemplate) = template
+			return nil
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		testContext.vcr.EXPECT().AddTemplate(template)
+		testContext.echo.EXPECT().JSON(http.StatusOK, template)
+
+		err := testContext.client.AddTemplate(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - invalid template", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		testContext.vcr.EXPECT().AddTemplate(template).Return(core.InvalidInputError("invalid issuance template"))
+
+		err := testContext.client.AddTemplate(testContext.echo)
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+
+	t.Run("error - bind fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).Return(errors.New("b00m"))
+
+		err := testContext.client.AddTemplate(testContext.echo)
+
+		assert.EqualError(t, err, "b00m")
+	})
+}
+
+func TestWrapper_RemoveTemplate(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.vcr.EXPECT().RemoveTemplate("example")
+		testContext.echo.EXPECT().NoContent(http.StatusNoContent)
+
+		err := testContext.client.RemoveTemplate(testContext.echo, "example")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - unknown template", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.vcr.EXPECT().RemoveTemplate("example").Return(core.NotFoundError("unknown issuance template"))
+
+		err := testContext.client.RemoveTemplate(testContext.echo, "example")
+
+		assert.ErrorIs(t, err, core.NotFoundError(""))
+	})
+}
+
+func TestWrapper_ListConcepts(t *testing.T) {
+	testContext := newMockContext(t)
+	registry := concept.NewRegistry()
+	_ = registry.Add(concept.ExampleConfig)
+	testContext.vcr.EXPECT().Registry().Return(registry)
+	testContext.echo.EXPECT().JSON(http.StatusOK, []concept.Config{concept.ExampleConfig})
+
+	err := testContext.client.ListConcepts(testContext.echo)
+
+	assert.NoError(t, err)
+}
+
+func TestWrapper_AddConcept(t *testing.T) {
+	bindRequest := func(testContext mockContext) {
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*ConceptConfig) = concept.ExampleConfig
+			return nil
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		testContext.vcr.EXPECT().AddConcept(concept.ExampleConfig)
+		testContext.echo.EXPECT().JSON(http.StatusOK, concept.ExampleConfig)
+
+		err := testContext.client.AddConcept(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - invalid config", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		testContext.vcr.EXPECT().AddConcept(concept.ExampleConfig).Return(core.InvalidInputError("invalid concept config"))
+
+		err := testContext.client.AddConcept(testContext.echo)
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+
+	t.Run("error - bind fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).Return(errors.New("b00m"))
+
+		err := testContext.client.AddConcept(testContext.echo)
+
+		assert.EqualError(t, err, "b00m")
+	})
+}
+
+func TestWrapper_RemoveConcept(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.vcr.EXPECT().RemoveConcept(concept.ExampleType)
+		testContext.echo.EXPECT().NoContent(http.StatusNoContent)
+
+		err := testContext.client.RemoveConcept(testContext.echo, concept.ExampleType)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - unknown credential type", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.vcr.EXPECT().RemoveConcept(concept.ExampleType).Return(core.NotFoundError("unknown credential type"))
+
+		err := testContext.client.RemoveConcept(testContext.echo, concept.ExampleType)
+
+		assert.ErrorIs(t, err, core.NotFoundError(""))
+	})
+}
+
+func TestWrapper_ListJSONLDContexts(t *testing.T) {
+	testContext := newMockContext(t)
+	contexts := []signature.ContextInfo{{URL: "https://schema.org", Source: signature.EmbeddedContextSource, Loaded: true}}
+	testContext.vcr.EXPECT().JSONLDContexts().Return(contexts)
+	testContext.echo.EXPECT().JSON(http.StatusOK, contexts)
+
+	err := testContext.client.ListJSONLDContexts(testContext.echo)
+
+	assert.NoError(t, err)
+}
+
+func TestWrapper_ListStoreCollections(t *testing.T) {
+	testContext := newMockContext(t)
+	collections := []string{"credentials/" + concept.ExampleType, "issuer/issuedCredentials"}
+	testContext.vcr.EXPECT().StoreCollections().Return(collections)
+	testContext.echo.EXPECT().JSON(http.StatusOK, collections)
+
+	err := testContext.client.ListStoreCollections(testContext.echo)
+
+	assert.NoError(t, err)
+}
+
+func TestWrapper_CheckStoreCollection(t *testing.T) {
+	bindRequest := func(testContext mockContext) {
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*StoreCollectionRequest) = StoreCollectionRequest{Collection: "issuer/issuedCredentials"}
+			return nil
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		report := &storage.Report{Collection: "issuer/issuedCredentials", Documents: 1, Problems: []storage.Problem{}}
+		testContext.vcr.EXPECT().CheckCollection("issuer/issuedCredentials").Return(report, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, report)
+
+		err := testContext.client.CheckStoreCollection(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - unknown collection", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		testContext.vcr.EXPECT().CheckCollection("issuer/issuedCredentials").Return(nil, core.NotFoundError("unknown collection"))
+
+		err := testContext.client.CheckStoreCollection(testContext.echo)
+
+		assert.ErrorIs(t, err, core.NotFoundError(""))
+	})
+
+	t.Run("error - bind fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).Return(errors.New("b00m"))
+
+		err := testContext.client.CheckStoreCollection(testContext.echo)
+
+		assert.EqualError(t, err, "b00m")
+	})
+}
+
+func TestWrapper_ReindexStoreCollection(t *testing.T) {
+	bindRequest := func(testContext mockContext) {
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*StoreCollectionRequest) = StoreCollectionRequest{Collection: "issuer/issuedCredentials"}
+			return nil
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bindRequest(testContext)
+		report := &storage.Report{Collection: "issuer/issuedCredentials", Reindexed: []string{"issuedVCs"}, Problems: []storage.Problem{}}
+		testContext.vcr.EXPECT().ReindexCollection("issuer/issuedCredentials").Return(report, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, report)
+
+		err := testContext.client.ReindexStoreCollection(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - reindex fails", func(t *testing.T) {
 		testContext := newMockContext(t)
-		testContext.mockIssuer.EXPECT().SearchCredential(*contextURI, *testCredential, *issuerDID, nil).Return(nil, errors.New("b00m!"))
+		bindRequest(testContext)
+		testContext.vcr.EXPECT().ReindexCollection("issuer/issuedCredentials").Return(nil, errors.New("b00m"))
 
-		params := SearchIssuedVCsParams{
-			CredentialType: "TestCredential",
-			Issuer:         issuerID.String(),
-		}
-		err := testContext.client.SearchIssuedVCs(testContext.echo, params)
-		assert.EqualError(t, err, "b00m!")
+		err := testContext.client.ReindexStoreCollection(testContext.echo)
+
+		assert.EqualError(t, err, "b00m")
 	})
 }
 
@@ -371,9 +1284,15 @@ func TestWrapper_VerifyVC(t *testing.T) {
 			return nil
 		})
 
-		testContext.echo.EXPECT().JSON(http.StatusOK, VCVerificationResult{Validity: true})
+		status := &verifier.CredentialStatus{
+			Trust:          []verifier.TypeTrust{{Type: *credentialType, Trusted: false}},
+			ValidityWindow: verifier.Valid,
+		}
+		testContext.echo.EXPECT().JSON(http.StatusOK, VCVerificationResult{Validity: true, Status: toVCStatus(status)})
 
-		testContext.vcr.EXPECT().Validate(expectedVC, allowUntrusted, true, nil)
+		checks := []audit.Check{{Name: audit.ContentCheck, Passed: true}}
+		testContext.mockVerifier.EXPECT().Check(expectedVC, allowUntrusted, true, nil).Return(verifier.CheckResult{Status: status, Checks: checks})
+		testContext.vcr.EXPECT().RecordVerification(expectedVC, audit.Caller{Name: "vcr.VerifyVC"}, checks)
 
 		err := testContext.client.VerifyVC(testContext.echo)
 		assert.NoError(t, err)
@@ -387,16 +1306,170 @@ func TestWrapper_VerifyVC(t *testing.T) {
 			return nil
 		})
 
-		message := "invalid vc"
-		testContext.echo.EXPECT().JSON(http.StatusOK, VCVerificationResult{Validity: false, Message: &message})
+		message := "credential is revoked, credential not valid at given time"
+		messages := []string{"credential is revoked", "credential not valid at given time"}
+		testContext.echo.EXPECT().JSON(http.StatusOK, VCVerificationResult{Validity: false, Message: &message, Messages: &messages})
 
-		testContext.vcr.EXPECT().Validate(expectedVC, true, true, nil).Return(errors.New("invalid vc"))
+		testContext.mockVerifier.EXPECT().Check(expectedVC, true, true, nil).Return(verifier.CheckResult{Errs: []error{types.ErrRevoked, types.ErrInvalidPeriod}})
+		testContext.vcr.EXPECT().RecordVerification(expectedVC, audit.Caller{Name: "vcr.VerifyVC"}, gomock.Any())
 
 		err := testContext.client.VerifyVC(testContext.echo)
 		assert.NoError(t, err)
 	})
 }
 
+func TestWrapper_SearchVerifications(t *testing.T) {
+	from := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		credentialID := "did:nuts:123#1"
+		subject := "did:nuts:456"
+		offset := 10
+		limit := 5
+		entries := []audit.Entry{{ID: "1", CredentialID: credentialID}}
+		testContext.vcr.EXPECT().SearchVerifications(audit.Query{CredentialID: credentialID, Subject: subject, From: &from, To: &to}, offset, limit).Return(entries, 11, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, AuditEntryList{Entries: entries, Total: 11})
+
+		err := testContext.client.SearchVerifications(testContext.echo, SearchVerificationsParams{CredentialID: &credentialID, Subject: &subject, From: &from, To: &to, Offset: &offset, Limit: &limit})
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - default page", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.vcr.EXPECT().SearchVerifications(audit.Query{}, 0, 100).Return([]audit.Entry{}, 0, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, AuditEntryList{Entries: []audit.Entry{}})
+
+		err := testContext.client.SearchVerifications(testContext.echo, SearchVerificationsParams{})
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - invalid limit", func(t *testing.T) {
+		testContext := newMockContext(t)
+		limit := 0
+
+		err := testContext.client.SearchVerifications(testContext.echo, SearchVerificationsParams{Limit: &limit})
+
+		assert.EqualError(t, err, "limit must be positive")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - from after to", func(t *testing.T) {
+		testContext := newMockContext(t)
+
+		err := testContext.client.SearchVerifications(testContext.echo, SearchVerificationsParams{From: &to, To: &from})
+
+		assert.EqualError(t, err, "from must lie before to")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - search fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.vcr.EXPECT().SearchVerifications(audit.Query{}, 0, 100).Return(nil, 0, errors.New("b00m!"))
+
+		err := testContext.client.SearchVerifications(testContext.echo, SearchVerificationsParams{})
+
+		assert.EqualError(t, err, "b00m!")
+	})
+}
+
+func TestWrapper_VerifyVP(t *testing.T) {
+	holderDID := did.MustParseDID("did:nuts:123")
+	credentialID := ssi.MustParseURI("did:nuts:456#1")
+	presentation := vc.VerifiablePresentation{
+		VerifiableCredential: []vc.VerifiableCredential{{ID: &credentialID}},
+	}
+	challenge := "challenge"
+	validAt := time.Now()
+	allowUntrusted := true
+
+	t.Run("valid vp", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*VPVerificationRequest) = VPVerificationRequest{
+				VerifiablePresentation: presentation,
+				VerificationOptions: &VPVerificationOptions{
+					AllowUntrustedIssuer: &allowUntrusted,
+					Challenge:            &challenge,
+					ValidAt:              &validAt,
+				},
+			}
+			return nil
+		})
+		expectedOptions := verifier.VPVerificationOptions{Challenge: &challenge, AllowUntrustedIssuer: true, ValidAt: &validAt}
+		testContext.mockVerifier.EXPECT().VerifyVP(presentation, expectedOptions).Return(verifier.VPVerificationResult{
+			Holder:      &holderDID,
+			Credentials: []verifier.VCVerificationResult{{Credential: presentation.VerifiableCredential[0], Checks: []audit.Check{{Name: audit.SignatureCheck, Passed: true}}}},
+		})
+		testContext.vcr.EXPECT().RecordVerification(presentation.VerifiableCredential[0], audit.Caller{Name: "vcr.VerifyVP", Details: map[string]string{"holder": holderDID.String()}}, []audit.Check{
+			{Name: audit.SignatureCheck, Passed: true},
+			{Name: audit.PresentationCheck, Passed: true},
+		})
+		expectedHolder := DID(holderDID.String())
+		expectedID := credentialID.String()
+		testContext.echo.EXPECT().JSON(http.StatusOK, VPVerificationResult{
+			Validity:    true,
+			Holder:      &expectedHolder,
+			Credentials: []VPCredentialVerificationResult{{Id: &expectedID, Validity: true}},
+		})
+
+		err := testContext.client.VerifyVP(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("invalid credential", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*VPVerificationRequest) = VPVerificationRequest{VerifiablePresentation: presentation}
+			return nil
+		})
+		testContext.mockVerifier.EXPECT().VerifyVP(presentation, verifier.VPVerificationOptions{}).Return(verifier.VPVerificationResult{
+			Holder:      &holderDID,
+			Credentials: []verifier.VCVerificationResult{{Credential: presentation.VerifiableCredential[0], Err: errors.New("revoked")}},
+		})
+		testContext.vcr.EXPECT().RecordVerification(presentation.VerifiableCredential[0], gomock.Any(), gomock.Any())
+		expectedHolder := DID(holderDID.String())
+		expectedID := credentialID.String()
+		expectedMessage := "revoked"
+		testContext.echo.EXPECT().JSON(http.StatusOK, VPVerificationResult{
+			Validity:    false,
+			Holder:      &expectedHolder,
+			Credentials: []VPCredentialVerificationResult{{Id: &expectedID, Validity: false, Message: &expectedMessage}},
+		})
+
+		err := testContext.client.VerifyVP(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("invalid presentation", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*VPVerificationRequest) = VPVerificationRequest{VerifiablePresentation: presentation}
+			return nil
+		})
+		testContext.mockVerifier.EXPECT().VerifyVP(presentation, verifier.VPVerificationOptions{}).Return(verifier.VPVerificationResult{
+			Err: errors.New("presentation has no proof"),
+		})
+		expectedMessage := "presentation has no proof"
+		testContext.echo.EXPECT().JSON(http.StatusOK, VPVerificationResult{
+			Validity:    false,
+			Message:     &expectedMessage,
+			Credentials: []VPCredentialVerificationResult{},
+		})
+
+		err := testContext.client.VerifyVP(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - bind fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.echo.EXPECT().Bind(gomock.Any()).Return(errors.New("b00m!"))
+
+		err := testContext.client.VerifyVP(testContext.echo)
+
+		assert.EqualError(t, err, "b00m!")
+	})
+}
+
 func TestWrapper_RevokeVC(t *testing.T) {
 	credentialID := "did:nuts:123#abc"
 	credentialURI := ssi.MustParseURI(credentialID)
@@ -442,6 +1515,7 @@ func TestWrapper_CreateVP(t *testing.T) {
 
 	subjectDID := did.MustParseDID("did:nuts:456")
 	subjectDIDString := subjectDID.String()
+	credentialID := ssi.MustParseURI("did:nuts:123#1")
 	verifiableCredential := vc.VerifiableCredential{
 		Type:              []ssi.URI{*credentialType},
 		Issuer:            *issuerURI,
@@ -450,7 +1524,7 @@ func TestWrapper_CreateVP(t *testing.T) {
 	result := &vc.VerifiablePresentation{}
 
 	createRequest := func() CreateVPRequest {
-		return CreateVPRequest{VerifiableCredentials: []VerifiableCredential{verifiableCredential}}
+		return CreateVPRequest{VerifiableCredentials: &[]VerifiableCredentialOrJWT{verifiableCredential}}
 	}
 
 	created := time.Now()
@@ -466,8 +1540,8 @@ func TestWrapper_CreateVP(t *testing.T) {
 			*verifyRequest = request
 			return nil
 		})
-		testContext.mockHolder.EXPECT().BuildVP([]VerifiableCredential{verifiableCredential}, proof.ProofOptions{Created: created}, nil, true).Return(result, nil)
-		testContext.echo.EXPECT().JSON(http.StatusOK, result)
+		testContext.mockHolder.EXPECT().BuildVP([]VerifiableCredential{verifiableCredential}, proof.ProofOptions{Created: created}, types.JSONLDPresentationFormat, nil, true, nil).Return(result, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, *result)
 
 		err := testContext.client.CreateVP(testContext.echo)
 
@@ -482,8 +1556,8 @@ func TestWrapper_CreateVP(t *testing.T) {
 			*verifyRequest = request
 			return nil
 		})
-		testContext.mockHolder.EXPECT().BuildVP([]VerifiableCredential{verifiableCredential}, proof.ProofOptions{Created: created}, &subjectDID, true).Return(result, nil)
-		testContext.echo.EXPECT().JSON(http.StatusOK, result)
+		testContext.mockHolder.EXPECT().BuildVP([]VerifiableCredential{verifiableCredential}, proof.ProofOptions{Created: created}, types.JSONLDPresentationFormat, &subjectDID, true, nil).Return(result, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, *result)
 
 		err := testContext.client.CreateVP(testContext.echo)
 
@@ -503,13 +1577,61 @@ func TestWrapper_CreateVP(t *testing.T) {
 			Created:        created,
 			ExpirationDate: &expired,
 		}
-		testContext.mockHolder.EXPECT().BuildVP([]VerifiableCredential{verifiableCredential}, opts, nil, true).Return(result, nil)
-		testContext.echo.EXPECT().JSON(http.StatusOK, result)
+		testContext.mockHolder.EXPECT().BuildVP([]VerifiableCredential{verifiableCredential}, opts, types.JSONLDPresentationFormat, nil, true, nil).Return(result, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, *result)
+
+		err := testContext.client.CreateVP(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - JWT format", func(t *testing.T) {
+		testContext := newMockContext(t)
+		request := createRequest()
+		format := CreateVPRequestFormatJwtVp
+		request.Format = &format
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			verifyRequest := f.(*CreateVPRequest)
+			*verifyRequest = request
+			return nil
+		})
+		testContext.mockHolder.EXPECT().BuildVP([]VerifiableCredential{verifiableCredential}, proof.ProofOptions{Created: created}, types.JWTPresentationFormat, nil, true, nil).Return(result, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, *result)
+
+		err := testContext.client.CreateVP(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - disclose claims", func(t *testing.T) {
+		testContext := newMockContext(t)
+		request := createRequest()
+		request.Disclose = &[]string{"organization.city"}
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			verifyRequest := f.(*CreateVPRequest)
+			*verifyRequest = request
+			return nil
+		})
+		testContext.mockHolder.EXPECT().BuildVP([]VerifiableCredential{verifiableCredential}, proof.ProofOptions{Created: created}, types.JSONLDPresentationFormat, nil, true, []string{"organization.city"}).Return(result, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, *result)
 
 		err := testContext.client.CreateVP(testContext.echo)
 
 		assert.NoError(t, err)
 	})
+	t.Run("error - invalid format", func(t *testing.T) {
+		testContext := newMockContext(t)
+		request := createRequest()
+		format := CreateVPRequestFormat("jwt_vc")
+		request.Format = &format
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			verifyRequest := f.(*CreateVPRequest)
+			*verifyRequest = request
+			return nil
+		})
+
+		err := testContext.client.CreateVP(testContext.echo)
+
+		assert.EqualError(t, err, "invalid value for format")
+	})
 	t.Run("error - with expires, but in the past", func(t *testing.T) {
 		testContext := newMockContext(t)
 		expired := time.Time{}
@@ -536,7 +1658,160 @@ func TestWrapper_CreateVP(t *testing.T) {
 
 		err := testContext.client.CreateVP(testContext.echo)
 
-		assert.EqualError(t, err, "verifiableCredentials needs at least 1 item")
+		assert.EqualError(t, err, "verifiableCredentials or credentialIDs needs at least 1 item")
+	})
+	t.Run("ok - credentials from wallet", func(t *testing.T) {
+		testContext := newMockContext(t)
+		walletCredential := vc.VerifiableCredential{ID: &credentialID}
+		request := createRequest()
+		request.CredentialIDs = &[]string{credentialID.String()}
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			verifyRequest := f.(*CreateVPRequest)
+			*verifyRequest = request
+			return nil
+		})
+		testContext.mockWallet.EXPECT().GetCredential(credentialID).Return(&walletCredential, nil)
+		testContext.mockHolder.EXPECT().BuildVP([]VerifiableCredential{verifiableCredential, walletCredential}, proof.ProofOptions{Created: created}, types.JSONLDPresentationFormat, nil, true, nil).Return(result, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, *result)
+
+		err := testContext.client.CreateVP(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - credential not in wallet", func(t *testing.T) {
+		testContext := newMockContext(t)
+		request := CreateVPRequest{CredentialIDs: &[]string{credentialID.String()}}
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			verifyRequest := f.(*CreateVPRequest)
+			*verifyRequest = request
+			return nil
+		})
+		testContext.mockWallet.EXPECT().GetCredential(credentialID).Return(nil, types.ErrNotFound)
+
+		err := testContext.client.CreateVP(testContext.echo)
+
+		assert.EqualError(t, err, "credential not found in wallet (id=did:nuts:123#1): credential not found")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+}
+
+func TestWrapper_ListHolderVCs(t *testing.T) {
+	holderDID := did.MustParseDID("did:nuts:456")
+	issuerDID := did.MustParseDID("did:nuts:123")
+	credentialType := ssi.MustParseURI("NutsOrganizationCredential")
+	credentials := []vc.VerifiableCredential{{Type: []ssi.URI{credentialType}}}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockWallet.EXPECT().ListCredentials(holderDID, nil, nil).Return(credentials, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, credentials)
+
+		err := testContext.client.ListHolderVCs(testContext.echo, holderDID.String(), ListHolderVCsParams{})
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - filtered on type and issuer", func(t *testing.T) {
+		testContext := newMockContext(t)
+		typeParam := credentialType.String()
+		issuerParam := issuerDID.String()
+		testContext.mockWallet.EXPECT().ListCredentials(holderDID, &credentialType, &issuerDID).Return(credentials, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, credentials)
+
+		err := testContext.client.ListHolderVCs(testContext.echo, holderDID.String(), ListHolderVCsParams{CredentialType: &typeParam, Issuer: &issuerParam})
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - empty wallet", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockWallet.EXPECT().ListCredentials(holderDID, nil, nil).Return(nil, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, []vc.VerifiableCredential{})
+
+		err := testContext.client.ListHolderVCs(testContext.echo, holderDID.String(), ListHolderVCsParams{})
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - invalid holder DID", func(t *testing.T) {
+		testContext := newMockContext(t)
+
+		err := testContext.client.ListHolderVCs(testContext.echo, "invalid", ListHolderVCsParams{})
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+		assert.EqualError(t, err, "invalid holder DID: invalid DID: input does not begin with 'did:' prefix")
+	})
+	t.Run("error - invalid issuer", func(t *testing.T) {
+		testContext := newMockContext(t)
+		issuerParam := "invalid"
+
+		err := testContext.client.ListHolderVCs(testContext.echo, holderDID.String(), ListHolderVCsParams{Issuer: &issuerParam})
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - wallet fails", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockWallet.EXPECT().ListCredentials(holderDID, nil, nil).Return(nil, errors.New("b00m!"))
+
+		err := testContext.client.ListHolderVCs(testContext.echo, holderDID.String(), ListHolderVCsParams{})
+
+		assert.EqualError(t, err, "b00m!")
+	})
+}
+
+func TestWrapper_GetHolderVC(t *testing.T) {
+	credentialID := ssi.MustParseURI("did:nuts:123#1")
+	credential := &vc.VerifiableCredential{ID: &credentialID}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockWallet.EXPECT().GetCredential(credentialID).Return(credential, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, credential)
+
+		err := testContext.client.GetHolderVC(testContext.echo, credentialID.String())
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - not found", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockWallet.EXPECT().GetCredential(credentialID).Return(nil, types.ErrNotFound)
+
+		err := testContext.client.GetHolderVC(testContext.echo, credentialID.String())
+
+		assert.ErrorIs(t, err, core.NotFoundError(""))
+	})
+	t.Run("error - invalid ID", func(t *testing.T) {
+		testContext := newMockContext(t)
+
+		err := testContext.client.GetHolderVC(testContext.echo, "%%")
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+}
+
+func TestWrapper_DeleteHolderVC(t *testing.T) {
+	credentialID := ssi.MustParseURI("did:nuts:123#1")
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockWallet.EXPECT().DeleteCredential(credentialID).Return(nil)
+		testContext.echo.EXPECT().NoContent(http.StatusNoContent)
+
+		err := testContext.client.DeleteHolderVC(testContext.echo, credentialID.String())
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - not found", func(t *testing.T) {
+		testContext := newMockContext(t)
+		testContext.mockWallet.EXPECT().DeleteCredential(credentialID).Return(types.ErrNotFound)
+
+		err := testContext.client.DeleteHolderVC(testContext.echo, credentialID.String())
+
+		assert.ErrorIs(t, err, core.NotFoundError(""))
+	})
+	t.Run("error - invalid ID", func(t *testing.T) {
+		testContext := newMockContext(t)
+
+		err := testContext.client.DeleteHolderVC(testContext.echo, "%%")
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
 	})
 }
 
@@ -554,12 +1829,14 @@ func TestWrapper_Preprocess(t *testing.T) {
 }
 
 type mockContext struct {
-	ctrl       *gomock.Controller
-	echo       *mock.MockContext
-	mockIssuer *issuer.MockIssuer
-	mockHolder *holder.MockHolder
-	vcr        *vcr.MockVCR
-	client     *Wrapper
+	ctrl         *gomock.Controller
+	echo         *mock.MockContext
+	mockIssuer   *issuer.MockIssuer
+	mockHolder   *holder.MockHolder
+	mockWallet   *holder.MockWallet
+	mockVerifier *verifier.MockVerifier
+	vcr          *vcr.MockVCR
+	client       *Wrapper
 }
 
 func newMockContext(t *testing.T) mockContext {
@@ -568,16 +1845,140 @@ func newMockContext(t *testing.T) mockContext {
 	mockVcr := vcr.NewMockVCR(ctrl)
 	mockIssuer := issuer.NewMockIssuer(ctrl)
 	mockHolder := holder.NewMockHolder(ctrl)
+	mockWallet := holder.NewMockWallet(ctrl)
+	mockVerifier := verifier.NewMockVerifier(ctrl)
 	mockVcr.EXPECT().Issuer().Return(mockIssuer).AnyTimes()
 	mockVcr.EXPECT().Holder().Return(mockHolder).AnyTimes()
+	mockVcr.EXPECT().Verifier().Return(mockVerifier).AnyTimes()
+	mockHolder.EXPECT().Wallet().Return(mockWallet).AnyTimes()
 	client := &Wrapper{VCR: mockVcr}
 
 	return mockContext{
-		ctrl:       ctrl,
-		echo:       mock.NewMockContext(ctrl),
-		mockIssuer: mockIssuer,
-		mockHolder: mockHolder,
-		vcr:        mockVcr,
-		client:     client,
+		ctrl:         ctrl,
+		echo:         mock.NewMockContext(ctrl),
+		mockIssuer:   mockIssuer,
+		mockHolder:   mockHolder,
+		mockWallet:   mockWallet,
+		mockVerifier: mockVerifier,
+		vcr:          mockVcr,
+		client:       client,
+	}
+}
+
+func TestWrapper_CreatePresentationSubmission(t *testing.T) {
+	holderDID := did.MustParseDID("did:nuts:456")
+	credentialID := ssi.MustParseURI("did:nuts:123#1")
+	credential := vc.VerifiableCredential{ID: &credentialID, CredentialSubject: []interface{}{map[string]interface{}{"id": holderDID.String()}}}
+	definition := PresentationDefinition{ID: "definition", InputDescriptors: []pe.InputDescriptor{{ID: "1"}}}
+	submission := &pe.PresentationSubmission{ID: "submission", DefinitionID: "definition"}
+	vp := &vc.VerifiablePresentation{}
+	registry := concept.NewRegistry()
+	_ = registry.Add(concept.Config{Concept: "organization", CredentialType: "NutsOrganizationCredential"})
+	_ = registry.Add(concept.Config{Concept: "organization", CredentialType: "OtherOrganizationCredential"})
+
+	created := time.Now()
+	clockFn = func() time.Time {
+		return created
+	}
+	createRequest := func() CreatePresentationSubmissionRequest {
+		return CreatePresentationSubmissionRequest{PresentationDefinition: definition, HolderDID: holderDID.String()}
+	}
+	bind := func(testContext mockContext, request CreatePresentationSubmissionRequest) {
+		testContext.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*CreatePresentationSubmissionRequest) = request
+			return nil
+		})
 	}
+
+	t.Run("ok", func(t *testing.T) {
+		testContext := newMockContext(t)
+		request := createRequest()
+		format := CreatePresentationSubmissionRequestFormatJwtVp
+		request.Format = &format
+		bind(testContext, request)
+		testContext.echo.EXPECT().Request().Return(httptest.NewRequest(http.MethodPost, "/", nil))
+		testContext.mockWallet.EXPECT().ListCredentials(holderDID, nil, nil).Return(nil, nil)
+		testContext.vcr.EXPECT().Registry().Return(registry).AnyTimes()
+		var capturedQuery concept.Query
+		testContext.vcr.EXPECT().Search(gomock.Any(), gomock.Any(), true, nil).DoAndReturn(
+			func(_ interface{}, query concept.Query, _ interface{}, _ interface{}) ([]VerifiableCredential, error) {
+				capturedQuery = query
+				return []VerifiableCredential{credential}, nil
+			})
+		testContext.mockHolder.EXPECT().BuildSubmission(definition, []vc.VerifiableCredential{credential}, proof.ProofOptions{Created: created}, types.JWTPresentationFormat, holderDID).Return(vp, submission, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, PresentationSubmissionResult{VerifiablePresentation: *vp, PresentationSubmission: *submission})
+
+		err := testContext.client.CreatePresentationSubmission(testContext.echo)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if assert.Len(t, capturedQuery.Parts(), 2) && assert.Len(t, capturedQuery.Parts()[0].Clauses, 1) {
+			assert.Equal(t, "credentialSubject.id", capturedQuery.Parts()[0].Clauses[0].Key())
+			assert.Equal(t, holderDID.String(), capturedQuery.Parts()[0].Clauses[0].Seek())
+		}
+	})
+	t.Run("ok - credentials from wallet", func(t *testing.T) {
+		testContext := newMockContext(t)
+		walletCredentialID := ssi.MustParseURI("did:nuts:123#2")
+		walletCredential := vc.VerifiableCredential{ID: &walletCredentialID}
+		bind(testContext, createRequest())
+		testContext.echo.EXPECT().Request().Return(httptest.NewRequest(http.MethodPost, "/", nil))
+		// the credential from the wallet is also found through search, it should only be included once
+		testContext.mockWallet.EXPECT().ListCredentials(holderDID, nil, nil).Return([]vc.VerifiableCredential{walletCredential, credential}, nil)
+		testContext.vcr.EXPECT().Registry().Return(registry).AnyTimes()
+		testContext.vcr.EXPECT().Search(gomock.Any(), gomock.Any(), true, nil).Return([]VerifiableCredential{credential}, nil)
+		testContext.mockHolder.EXPECT().BuildSubmission(definition, []vc.VerifiableCredential{walletCredential, credential}, proof.ProofOptions{Created: created}, types.JSONLDPresentationFormat, holderDID).Return(vp, submission, nil)
+		testContext.echo.EXPECT().JSON(http.StatusOK, gomock.Any())
+
+		err := testContext.client.CreatePresentationSubmission(testContext.echo)
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - no matching credentials", func(t *testing.T) {
+		testContext := newMockContext(t)
+		bind(testContext, createRequest())
+		testContext.echo.EXPECT().Request().Return(httptest.NewRequest(http.MethodPost, "/", nil))
+		testContext.mockWallet.EXPECT().ListCredentials(holderDID, nil, nil).Return(nil, nil)
+		testContext.vcr.EXPECT().Registry().Return(registry).AnyTimes()
+		testContext.vcr.EXPECT().Search(gomock.Any(), gomock.Any(), true, nil).Return(nil, nil)
+		testContext.mockHolder.EXPECT().BuildSubmission(definition, nil, proof.ProofOptions{Created: created}, types.JSONLDPresentationFormat, holderDID).
+			Return(nil, nil, core.PreconditionFailedError("unable to satisfy presentation definition: %w", pe.ErrNoCredentials))
+
+		err := testContext.client.CreatePresentationSubmission(testContext.echo)
+
+		assert.ErrorIs(t, err, pe.ErrNoCredentials)
+	})
+	t.Run("error - invalid holder DID", func(t *testing.T) {
+		testContext := newMockContext(t)
+		request := createRequest()
+		request.HolderDID = "invalid"
+		bind(testContext, request)
+
+		err := testContext.client.CreatePresentationSubmission(testContext.echo)
+
+		assert.EqualError(t, err, "invalid holder DID: invalid DID: input does not begin with 'did:' prefix")
+	})
+	t.Run("error - invalid format", func(t *testing.T) {
+		testContext := newMockContext(t)
+		request := createRequest()
+		format := CreatePresentationSubmissionRequestFormat("paper")
+		request.Format = &format
+		bind(testContext, request)
+
+		err := testContext.client.CreatePresentationSubmission(testContext.echo)
+
+		assert.EqualError(t, err, "invalid value for format")
+	})
+	t.Run("error - expires in the past", func(t *testing.T) {
+		testContext := newMockContext(t)
+		request := createRequest()
+		expires := created.Add(-time.Hour)
+		request.Expires = &expires
+		bind(testContext, request)
+
+		err := testContext.client.CreatePresentationSubmission(testContext.echo)
+
+		assert.EqualError(t, err, "expires can not lay in the past")
+	})
 }
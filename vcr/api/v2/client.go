@@ -0,0 +1,227 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
+)
+
+// HTTPClient holds the server address and other basic settings for the http client
+type HTTPClient struct {
+	ServerAddress string
+	Timeout       time.Duration
+}
+
+func (hb HTTPClient) client() ClientInterface {
+	url := hb.ServerAddress
+
+	response, err := NewClientWithResponses(url)
+	if err != nil {
+		panic(err)
+	}
+	return response
+}
+
+func (hb HTTPClient) withTimeout() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), hb.Timeout)
+}
+
+// ListHolderVCs lists the credentials in the wallet of the given DID, optionally filtered on credential type and issuer.
+func (hb HTTPClient) ListHolderVCs(holderDID string, credentialType string, issuer string) ([]vc.VerifiableCredential, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	params := &ListHolderVCsParams{}
+	if credentialType != "" {
+		params.CredentialType = &credentialType
+	}
+	if issuer != "" {
+		params.Issuer = &issuer
+	}
+	response, err := hb.client().ListHolderVCs(ctx, holderDID, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.TestResponseCode(http.StatusOK, response); err != nil {
+		return nil, err
+	}
+	credentials := make([]vc.VerifiableCredential, 0)
+	if err := readResponse(response.Body, &credentials); err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// GetHolderVC retrieves a credential from the wallet.
+func (hb HTTPClient) GetHolderVC(id string) (*vc.VerifiableCredential, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	response, err := hb.client().GetHolderVC(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.TestResponseCode(http.StatusOK, response); err != nil {
+		return nil, err
+	}
+	credential := &vc.VerifiableCredential{}
+	if err := readResponse(response.Body, credential); err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+// DeleteHolderVC removes a credential from the wallet.
+func (hb HTTPClient) DeleteHolderVC(id string) error {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	response, err := hb.client().DeleteHolderVC(ctx, id)
+	if err != nil {
+		return err
+	}
+	return core.TestResponseCode(http.StatusNoContent, response)
+}
+
+// ListJSONLDContexts lists the JSON-LD contexts the node can use.
+func (hb HTTPClient) ListJSONLDContexts() ([]JSONLDContext, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	response, err := hb.client().ListJSONLDContexts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.TestResponseCode(http.StatusOK, response); err != nil {
+		return nil, err
+	}
+	contexts := make([]JSONLDContext, 0)
+	if err := readResponse(response.Body, &contexts); err != nil {
+		return nil, err
+	}
+	return contexts, nil
+}
+
+// ListStoreCollections lists the collections of the VCR stores.
+func (hb HTTPClient) ListStoreCollections() ([]string, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	response, err := hb.client().ListStoreCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.TestResponseCode(http.StatusOK, response); err != nil {
+		return nil, err
+	}
+	collections := make([]string, 0)
+	if err := readResponse(response.Body, &collections); err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+// CheckStoreCollection checks the consistency of a collection of the VCR stores.
+func (hb HTTPClient) CheckStoreCollection(collection string) (*StoreReport, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	response, err := hb.client().CheckStoreCollection(ctx, CheckStoreCollectionJSONRequestBody{Collection: collection})
+	return storeReport(response, err)
+}
+
+// ReindexStoreCollection rebuilds the indices of a collection of the VCR stores.
+func (hb HTTPClient) ReindexStoreCollection(collection string) (*StoreReport, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	response, err := hb.client().ReindexStoreCollection(ctx, ReindexStoreCollectionJSONRequestBody{Collection: collection})
+	return storeReport(response, err)
+}
+
+// ListIssuedVCs lists the credentials issued by the node that match the params.
+func (hb HTTPClient) ListIssuedVCs(params ListIssuedVCsParams) (*IssuedVCList, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	response, err := hb.client().ListIssuedVCs(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.TestResponseCode(http.StatusOK, response); err != nil {
+		return nil, err
+	}
+	result := &IssuedVCList{}
+	if err := readResponse(response.Body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RevokeIssuedVCs revokes the credentials issued by the node that match the request, or previews them for a dry run.
+func (hb HTTPClient) RevokeIssuedVCs(request RevokeIssuedVCsRequest) (*RevokeIssuedVCsResults, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	response, err := hb.client().RevokeIssuedVCs(ctx, RevokeIssuedVCsJSONRequestBody(request))
+	if err != nil {
+		return nil, err
+	}
+	if err := core.TestResponseCode(http.StatusOK, response); err != nil {
+		return nil, err
+	}
+	result := &RevokeIssuedVCsResults{}
+	if err := readResponse(response.Body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func storeReport(response *http.Response, err error) (*StoreReport, error) {
+	if err != nil {
+		return nil, err
+	}
+	if err := core.TestResponseCode(http.StatusOK, response); err != nil {
+		return nil, err
+	}
+	report := &StoreReport{}
+	if err := readResponse(response.Body, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func readResponse(reader io.Reader, target interface{}) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("unable to read response: %w", err)
+	}
+	if err = json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("unable to unmarshal response: %w, %s", err, string(data))
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package v2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	http2 "github.com/nuts-foundation/nuts-node/test/http"
+	"github.com/stretchr/testify/assert"
+)
+
+const holderDIDString = "did:nuts:1"
+
+var walletCredentialID = ssi.MustParseURI("did:nuts:1#1")
+
+func TestHttpClient_ListHolderVCs(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: []vc.VerifiableCredential{{ID: &walletCredentialID}}})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		credentials, err := c.ListHolderVCs(holderDIDString, "NutsOrganizationCredential", "did:nuts:2")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if assert.Len(t, credentials, 1) {
+			assert.Equal(t, walletCredentialID, *credentials[0].ID)
+		}
+	})
+	t.Run("error - other status code", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusBadRequest})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.ListHolderVCs(holderDIDString, "", "")
+
+		assert.Error(t, err)
+	})
+	t.Run("error - connection problem", func(t *testing.T) {
+		c := HTTPClient{ServerAddress: "unknown", Timeout: time.Second}
+
+		_, err := c.ListHolderVCs(holderDIDString, "", "")
+
+		assert.Error(t, err)
+	})
+	t.Run("error - wrong content", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: "}"})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.ListHolderVCs(holderDIDString, "", "")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHttpClient_GetHolderVC(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: vc.VerifiableCredential{ID: &walletCredentialID}})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		credential, err := c.GetHolderVC(walletCredentialID.String())
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, walletCredentialID, *credential.ID)
+	})
+	t.Run("error - not found", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusNotFound})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.GetHolderVC(walletCredentialID.String())
+
+		assert.Error(t, err)
+	})
+	t.Run("error - connection problem", func(t *testing.T) {
+		c := HTTPClient{ServerAddress: "unknown", Timeout: time.Second}
+
+		_, err := c.GetHolderVC(walletCredentialID.String())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHttpClient_DeleteHolderVC(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusNoContent})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		err := c.DeleteHolderVC(walletCredentialID.String())
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - not found", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusNotFound})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		err := c.DeleteHolderVC(walletCredentialID.String())
+
+		assert.Error(t, err)
+	})
+	t.Run("error - connection problem", func(t *testing.T) {
+		c := HTTPClient{ServerAddress: "unknown", Timeout: time.Second}
+
+		err := c.DeleteHolderVC(walletCredentialID.String())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHttpClient_ListJSONLDContexts(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: []JSONLDContext{{URL: "https://schema.org", Source: "embedded", Loaded: true}}})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		contexts, err := c.ListJSONLDContexts()
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if assert.Len(t, contexts, 1) {
+			assert.Equal(t, "https://schema.org", contexts[0].URL)
+		}
+	})
+	t.Run("error - other status code", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusInternalServerError})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.ListJSONLDContexts()
+
+		assert.Error(t, err)
+	})
+	t.Run("error - connection problem", func(t *testing.T) {
+		c := HTTPClient{ServerAddress: "unknown", Timeout: time.Second}
+
+		_, err := c.ListJSONLDContexts()
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHttpClient_ListStoreCollections(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: []string{"issuer/issuedCredentials"}})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		collections, err := c.ListStoreCollections()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"issuer/issuedCredentials"}, collections)
+	})
+	t.Run("error - other status code", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusInternalServerError})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.ListStoreCollections()
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHttpClient_CheckStoreCollection(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: StoreReport{Collection: "issuer/issuedCredentials", Documents: 2}})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		report, err := c.CheckStoreCollection("issuer/issuedCredentials")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 2, report.Documents)
+	})
+	t.Run("error - unknown collection", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusNotFound})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.CheckStoreCollection("unknown")
+
+		assert.Error(t, err)
+	})
+	t.Run("error - connection problem", func(t *testing.T) {
+		c := HTTPClient{ServerAddress: "unknown", Timeout: time.Second}
+
+		_, err := c.CheckStoreCollection("unknown")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHttpClient_ReindexStoreCollection(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: StoreReport{Collection: "issuer/issuedCredentials", Reindexed: []string{"issuedVCs"}}})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		report, err := c.ReindexStoreCollection("issuer/issuedCredentials")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{"issuedVCs"}, report.Reindexed)
+	})
+	t.Run("error - other status code", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusInternalServerError})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.ReindexStoreCollection("issuer/issuedCredentials")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,89 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+)
+
+// decodeCredential decodes a credential of a request, which is given in JSON form or as compact JWT or SD-JWT.
+func decodeCredential(input VerifiableCredentialOrJWT) (*vc.VerifiableCredential, error) {
+	if compact, ok := input.(string); ok {
+		return proof.ParseCompactCredential(compact)
+	}
+	result := vc.VerifiableCredential{}
+	if err := remarshal(input, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// decodePresentation decodes a presentation of a request, which is given in JSON form or as compact JWT.
+func decodePresentation(input VerifiablePresentationOrJWT) (*vc.VerifiablePresentation, error) {
+	if compact, ok := input.(string); ok {
+		return proof.ParseJWTPresentation(compact)
+	}
+	result := vc.VerifiablePresentation{}
+	if err := remarshal(input, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// encodeCredential returns the credential in the form it's returned by the API: as compact JWT or SD-JWT for credentials in these formats,
+// otherwise in JSON form.
+func encodeCredential(credential vc.VerifiableCredential) VerifiableCredentialOrJWT {
+	if compact, ok := compactJWT(credential); ok {
+		return compact
+	}
+	return credential
+}
+
+// encodePresentation returns the presentation in the form it's returned by the API: as compact JWT for presentations in JWT format,
+// otherwise in JSON form.
+func encodePresentation(presentation vc.VerifiablePresentation) VerifiablePresentationOrJWT {
+	if compact, ok := compactJWT(presentation); ok {
+		return compact
+	}
+	return presentation
+}
+
+func compactJWT(document interface{}) (string, bool) {
+	signedDocument, err := proof.NewSignedDocument(document)
+	if err != nil {
+		return "", false
+	}
+	return signedDocument.CompactJWT()
+}
+
+func remarshal(source interface{}, target interface{}) error {
+	if source == nil {
+		return errors.New("missing value")
+	}
+	data, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
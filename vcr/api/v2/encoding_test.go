@@ -0,0 +1,112 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package v2
+
+import (
+	"testing"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_decodeCredential(t *testing.T) {
+	t.Run("JSON form", func(t *testing.T) {
+		credential, err := decodeCredential(map[string]interface{}{
+			"issuer":            "did:nuts:issuer",
+			"credentialSubject": map[string]interface{}{"id": "did:nuts:subject"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "did:nuts:issuer", credential.Issuer.String())
+	})
+	t.Run("compact JWT", func(t *testing.T) {
+		claims := map[string]interface{}{
+			"iss": "did:nuts:issuer",
+			"sub": "did:nuts:subject",
+			"vc":  map[string]interface{}{"credentialSubject": map[string]interface{}{}},
+		}
+		jwtProof, _ := proof.NewJWTProof(claims, crypto.NewTestKey("did:nuts:issuer#1"))
+
+		credential, err := decodeCredential(jwtProof.JWT)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "did:nuts:issuer", credential.Issuer.String())
+		assert.Equal(t, jwtProof.JWT, encodeCredential(*credential))
+	})
+	t.Run("compact SD-JWT", func(t *testing.T) {
+		claims := map[string]interface{}{
+			"iss": "did:nuts:issuer",
+			"sub": "did:nuts:subject",
+			"vc":  map[string]interface{}{"credentialSubject": map[string]interface{}{"name": "Jane"}},
+		}
+		sdJWTProof, _ := proof.NewSDJWTProof(claims, []string{"vc.credentialSubject.name"}, crypto.NewTestKey("did:nuts:issuer#1"))
+
+		credential, err := decodeCredential(sdJWTProof.JWT)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "Jane", credential.CredentialSubject[0].(map[string]interface{})["name"])
+		assert.Equal(t, sdJWTProof.JWT, encodeCredential(*credential))
+	})
+	t.Run("error - invalid JWT", func(t *testing.T) {
+		_, err := decodeCredential("not a JWT")
+
+		assert.Error(t, err)
+	})
+	t.Run("error - missing", func(t *testing.T) {
+		_, err := decodeCredential(nil)
+
+		assert.EqualError(t, err, "missing value")
+	})
+}
+
+func Test_decodePresentation(t *testing.T) {
+	t.Run("compact JWT", func(t *testing.T) {
+		claims := map[string]interface{}{
+			"iss": "did:nuts:holder",
+			"vp":  map[string]interface{}{"type": "VerifiablePresentation"},
+		}
+		jwtProof, _ := proof.NewJWTProof(claims, crypto.NewTestKey("did:nuts:holder#1"))
+
+		presentation, err := decodePresentation(jwtProof.JWT)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "did:nuts:holder", presentation.Holder.String())
+		assert.Equal(t, jwtProof.JWT, encodePresentation(*presentation))
+	})
+	t.Run("JSON form", func(t *testing.T) {
+		holder := ssi.MustParseURI("did:nuts:holder")
+		source := vc.VerifiablePresentation{Holder: &holder}
+
+		presentation, err := decodePresentation(source)
+
+		assert.NoError(t, err)
+		assert.Equal(t, source, *presentation)
+		assert.Equal(t, source, encodePresentation(source))
+	})
+}
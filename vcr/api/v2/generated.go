@@ -19,6 +19,45 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// Defines values for CreatePresentationSubmissionRequestFormat.
+const (
+	CreatePresentationSubmissionRequestFormatJwtVp CreatePresentationSubmissionRequestFormat = "jwt_vp"
+
+	CreatePresentationSubmissionRequestFormatLdpVp CreatePresentationSubmissionRequestFormat = "ldp_vp"
+)
+
+// Defines values for CreateVPRequestFormat.
+const (
+	CreateVPRequestFormatJwtVp CreateVPRequestFormat = "jwt_vp"
+
+	CreateVPRequestFormatLdpVp CreateVPRequestFormat = "ldp_vp"
+)
+
+// Defines values for IssueVCBatchRequestFormat.
+const (
+	IssueVCBatchRequestFormatJwtVc IssueVCBatchRequestFormat = "jwt_vc"
+
+	IssueVCBatchRequestFormatLdpVc IssueVCBatchRequestFormat = "ldp_vc"
+
+	IssueVCBatchRequestFormatVcSdJwt IssueVCBatchRequestFormat = "vc+sd-jwt"
+)
+
+// Defines values for IssueVCBatchRequestVisibility.
+const (
+	IssueVCBatchRequestVisibilityPrivate IssueVCBatchRequestVisibility = "private"
+
+	IssueVCBatchRequestVisibilityPublic IssueVCBatchRequestVisibility = "public"
+)
+
+// Defines values for IssueVCRequestFormat.
+const (
+	IssueVCRequestFormatJwtVc IssueVCRequestFormat = "jwt_vc"
+
+	IssueVCRequestFormatLdpVc IssueVCRequestFormat = "ldp_vc"
+
+	IssueVCRequestFormatVcSdJwt IssueVCRequestFormat = "vc+sd-jwt"
+)
+
 // Defines values for IssueVCRequestVisibility.
 const (
 	IssueVCRequestVisibilityPrivate IssueVCRequestVisibility = "private"
@@ -26,11 +65,71 @@ const (
 	IssueVCRequestVisibilityPublic IssueVCRequestVisibility = "public"
 )
 
+// Defines values for IssuedVCState.
+const (
+	IssuedVCStateActive IssuedVCState = "active"
+
+	IssuedVCStateRevoked IssuedVCState = "revoked"
+)
+
+// Defines values for VCStatusValidityWindow.
+const (
+	VCStatusValidityWindowExpired VCStatusValidityWindow = "expired"
+
+	VCStatusValidityWindowNotYetValid VCStatusValidityWindow = "not_yet_valid"
+
+	VCStatusValidityWindowValid VCStatusValidityWindow = "valid"
+)
+
+// A page of recorded verifications of credentials.
+type AuditEntryList struct {
+	Entries []AuditEntry `json:"entries"`
+
+	// The total number of recorded verifications that match the parameters.
+	Total int `json:"total"`
+}
+
+// A request for creating a Verifiable Presentation that satisfies a Presentation Definition.
+type CreatePresentationSubmissionRequest struct {
+	// A random or pseudo-random value used by some authentication protocols to mitigate replay attacks.
+	Challenge *string `json:"challenge,omitempty"`
+
+	// A string value that specifies the operational domain of the presentation proof.
+	Domain *string `json:"domain,omitempty"`
+
+	// Date and time at which proof will expire. If omitted, the proof does not have an end date.
+	Expires *time.Time `json:"expires,omitempty"`
+
+	// The format of the presentation, see CreateVPRequest.
+	Format *CreatePresentationSubmissionRequestFormat `json:"format,omitempty"`
+
+	// The DID of the holder, whose credentials are used and who signs the presentation.
+	HolderDID string `json:"holderDID"`
+
+	// A Presentation Definition as specified by DIF Presentation Exchange v2 (https://identity.foundation/presentation-exchange/spec/v2.0.0/#presentation-definition).
+	// Input descriptor fields support JSONPath expressions with member access, array indices and wildcards,
+	// and filters with the JSON Schema keywords type, const, enum, pattern, minimum, maximum, exclusiveMinimum, exclusiveMaximum, minLength, maxLength, contains and not.
+	// Submission requirements are not supported: presentation definitions that contain them are rejected.
+	PresentationDefinition PresentationDefinition `json:"presentationDefinition"`
+}
+
+// The format of the presentation, see CreateVPRequest.
+type CreatePresentationSubmissionRequestFormat string
+
 // A request for creating a new Verifiable Presentation for a set of Verifiable Credentials.
+// The credentials can be given in full, or referenced by ID from the wallet. At least 1 credential must be given.
 type CreateVPRequest struct {
 	// A random or pseudo-random value used by some authentication protocols to mitigate replay attacks.
 	Challenge *string `json:"challenge,omitempty"`
 
+	// IDs of credentials in the wallet to add to the presentation, after the given verifiableCredentials.
+	CredentialIDs *[]string `json:"credentialIDs,omitempty"`
+
+	// Claims of the credential subject to disclose of the credentials in SD-JWT format, as object keys separated by dots (e.g. "organization.city").
+	// Selectively disclosable claims that aren't listed are withheld. If omitted, all claims are disclosed.
+	// Credentials in SD-JWT format are bound to the presentation with a key binding JWT, signed by the credential subject.
+	Disclose *[]string `json:"disclose,omitempty"`
+
 	// A string value that specifies the operational domain of a digital proof. This could be an Internet domain
 	// name like example.com, an ad-hoc value such as mycorp-level3-access, or a very specific transaction value
 	// like 8zF6T$mqP. A signer could include a domain in its digital proof to restrict its use to particular
@@ -40,6 +139,11 @@ type CreateVPRequest struct {
 	// Date and time at which proof will expire. If omitted, the proof does not have an end date.
 	Expires *time.Time `json:"expires,omitempty"`
 
+	// The format of the presentation. "ldp_vp" signs the presentation with a JSON-LD proof,
+	// "jwt_vp" signs it as JWT (VP-JWT), which is returned in its compact form. Contained credentials in JWT format are included in their compact form.
+	// For JWT presentations, the challenge and domain are stored in the "nonce" and "aud" claims.
+	Format *CreateVPRequestFormat `json:"format,omitempty"`
+
 	// The specific intent for the proof, the reason why an entity created it. Acts as a safeguard to prevent the
 	// proof from being misused for a purpose other than the one it was intended for.
 	ProofPurpose *string `json:"proofPurpose,omitempty"`
@@ -47,8 +151,24 @@ type CreateVPRequest struct {
 	// Specifies the DID of the signing party that must be used to create the digital signature.
 	// If not specified, it is derived from the given Verifiable Credentials' subjectCredential ID.
 	// It can only be derived if all given Verifiable Credentials have the same, single subjectCredential.
-	SignerDID             *string                `json:"signerDID,omitempty"`
-	VerifiableCredentials []VerifiableCredential `json:"verifiableCredentials"`
+	SignerDID             *string                      `json:"signerDID,omitempty"`
+	VerifiableCredentials *[]VerifiableCredentialOrJWT `json:"verifiableCredentials,omitempty"`
+}
+
+// The format of the presentation. "ldp_vp" signs the presentation with a JSON-LD proof,
+// "jwt_vp" signs it as JWT (VP-JWT), which is returned in its compact form. Contained credentials in JWT format are included in their compact form.
+// For JWT presentations, the challenge and domain are stored in the "nonce" and "aud" claims.
+type CreateVPRequestFormat string
+
+// CredentialTypeTrust defines model for CredentialTypeTrust.
+type CredentialTypeTrust struct {
+	CredentialType string `json:"credentialType"`
+
+	// Explains why the issuer of the credential is (not) trusted for the credential type.
+	Reason *string `json:"reason,omitempty"`
+
+	// Indicates whether the issuer of the credential is trusted for the credential type.
+	Trusted bool `json:"trusted"`
 }
 
 // DID according to Nuts specification
@@ -91,7 +211,71 @@ type EmbeddedProof struct {
 	VerificationMethod string `json:"verificationMethod"`
 }
 
-// A request for issuing a new Verifiable Credential.
+// ImportTrustListRequest defines model for ImportTrustListRequest.
+type ImportTrustListRequest struct {
+	// The trust list as compact JWS. The key ID in its header must refer to a key of the trust list issuer.
+	TrustList string `json:"trustList"`
+}
+
+// A credential to issue as part of a batch.
+type IssueVCBatchItem struct {
+	// The resolvable context of the credentialSubject as URI. If omitted, the "https://nuts.nl/credentials/v1" context is used.
+	Context *string `json:"@context,omitempty"`
+
+	// Subject of a Verifiable Credential identifying the holder and expressing claims.
+	CredentialSubject CredentialSubject `json:"credentialSubject"`
+
+	// rfc3339 time string until when the credential is valid.
+	ExpirationDate *string `json:"expirationDate,omitempty"`
+
+	// Type definition for the credential.
+	Type string `json:"type"`
+}
+
+// A request for issuing multiple Verifiable Credentials of the same issuer.
+type IssueVCBatchRequest struct {
+	// The credentials to issue.
+	Credentials []IssueVCBatchItem `json:"credentials"`
+
+	// The format of the issued credentials. See IssueVCRequest.
+	Format *IssueVCBatchRequestFormat `json:"format,omitempty"`
+
+	// DID according to Nuts specification.
+	Issuer string `json:"issuer"`
+
+	// If set, the node publishes the credentials to the network. This is the default behaviour. See IssueVCRequest.
+	PublishToNetwork *bool `json:"publishToNetwork,omitempty"`
+
+	// When publishToNetwork is true, the credentials are published publicly or privately to their holders.
+	// This field is mandatory if publishToNetwork is true to prevent accidents.
+	Visibility *IssueVCBatchRequestVisibility `json:"visibility,omitempty"`
+}
+
+// The format of the issued credentials. See IssueVCRequest.
+type IssueVCBatchRequestFormat string
+
+// When publishToNetwork is true, the credentials are published publicly or privately to their holders.
+// This field is mandatory if publishToNetwork is true to prevent accidents.
+type IssueVCBatchRequestVisibility string
+
+// The result of issuing a single credential of a batch. Either credential or error is set.
+type IssueVCBatchResult struct {
+	// A credential in JSON form, or a credential in JWT format (VC-JWT) as compact JWT.
+	// Credentials in JWT format are decoded to their JSON form as specified by the W3C Verifiable Credentials Data Model (section 6.3.2),
+	// with the JWT as proof (type JwtProof2020). They're returned in that form when listed or searched.
+	Credential *VerifiableCredentialOrJWT `json:"credential,omitempty"`
+
+	// The reason the credential couldn't be issued.
+	Error *string `json:"error,omitempty"`
+}
+
+// The results of issuing a batch of credentials.
+type IssueVCBatchResults struct {
+	// The result for every requested credential, in the same order as the request.
+	Results []IssueVCBatchResult `json:"results"`
+}
+
+// A request for issuing a new Verifiable Credential. Either type or template must be provided.
 type IssueVCRequest struct {
 	// The resolvable context of the credentialSubject as URI. If omitted, the "https://nuts.nl/credentials/v1" context is used.
 	// Note: it is not needed to provide the "https://www.w3.org/2018/credentials/v1" context here.
@@ -103,6 +287,12 @@ type IssueVCRequest struct {
 	// rfc3339 time string until when the credential is valid.
 	ExpirationDate *string `json:"expirationDate,omitempty"`
 
+	// The format of the issued credential. "ldp_vc" signs the credential with a JSON-LD proof,
+	// "jwt_vc" signs it as JWT (VC-JWT), which is returned in its compact form.
+	// "vc+sd-jwt" signs it as Selective Disclosure JWT (SD-JWT), of which the holder can withhold the claims listed as selectivelyDisclosable
+	// in the concept config of the credential type. It's returned in the compact form of the SD-JWT: <issuer-jwt>~<disclosure>~...~
+	Format *IssueVCRequestFormat `json:"format,omitempty"`
+
 	// DID according to Nuts specification.
 	Issuer string `json:"issuer"`
 
@@ -112,18 +302,109 @@ type IssueVCRequest struct {
 	// Note: a not published credential can still be publicaly revoked.
 	PublishToNetwork *bool `json:"publishToNetwork,omitempty"`
 
-	// Type definition for the credential.
-	Type string `json:"type"`
+	// Name of the issuance template to issue the credential with. The template provides the defaults for the type,
+	// context, expiration date and visibility of the credential, and the credential subject must contain the fields of the template.
+	Template *string `json:"template,omitempty"`
+
+	// Type definition for the credential. If a template is given, it defaults to the credential type of the template.
+	Type *string `json:"type,omitempty"`
 
 	// When publishToNetwork is true, the credential can be published publicly of privately to the holder.
-	// This field is mandatory if publishToNetwork is true to prevent accidents.
+	// This field is mandatory if publishToNetwork is true to prevent accidents, unless the template specifies the visibility.
 	Visibility *IssueVCRequestVisibility `json:"visibility,omitempty"`
 }
 
+// The format of the issued credential. "ldp_vc" signs the credential with a JSON-LD proof,
+// "jwt_vc" signs it as JWT (VC-JWT), which is returned in its compact form.
+// "vc+sd-jwt" signs it as Selective Disclosure JWT (SD-JWT), of which the holder can withhold the claims listed as selectivelyDisclosable
+// in the concept config of the credential type. It's returned in the compact form of the SD-JWT: <issuer-jwt>~<disclosure>~...~
+type IssueVCRequestFormat string
+
 // When publishToNetwork is true, the credential can be published publicly of privately to the holder.
-// This field is mandatory if publishToNetwork is true to prevent accidents.
+// This field is mandatory if publishToNetwork is true to prevent accidents, unless the template specifies the visibility.
 type IssueVCRequestVisibility string
 
+// A credential issued by this node with its state.
+type IssuedVC struct {
+	State IssuedVCState `json:"state"`
+
+	// A credential according to the W3C and Nuts specs.
+	VerifiableCredential VerifiableCredential `json:"verifiableCredential"`
+}
+
+// IssuedVCState defines model for IssuedVC.State.
+type IssuedVCState string
+
+// A page of credentials issued by this node.
+type IssuedVCList struct {
+	Credentials []IssuedVC `json:"credentials"`
+
+	// The total number of issued credentials that match the filters.
+	Total int `json:"total"`
+}
+
+// A Verifiable Presentation and the Presentation Submission that describes how it satisfies the Presentation Definition.
+type PresentationSubmissionResult struct {
+	// A Presentation Submission as specified by DIF Presentation Exchange v2 (https://identity.foundation/presentation-exchange/spec/v2.0.0/#presentation-submission).
+	// The paths in the descriptor map refer to the credentials of the accompanying presentation, e.g. $.verifiableCredential[0].
+	PresentationSubmission PresentationSubmission `json:"presentationSubmission"`
+
+	// A presentation in JSON form, or a presentation in JWT format (VP-JWT) as compact JWT.
+	VerifiablePresentation VerifiablePresentationOrJWT `json:"verifiablePresentation"`
+}
+
+// A nonce for refreshing a credential issued by this node.
+type RefreshNonce struct {
+	Nonce string `json:"nonce"`
+}
+
+// A request for refreshing a credential issued by this node.
+type RefreshVCRequest struct {
+	// The nonce created with the nonce endpoint, which the presentation contains as challenge.
+	Nonce string `json:"nonce"`
+
+	// A presentation in JSON form, or a presentation in JWT format (VP-JWT) as compact JWT.
+	VerifiablePresentation VerifiablePresentationOrJWT `json:"verifiablePresentation"`
+}
+
+// The result of revoking a single credential. Contains the revocation if revoked, or the error if revoking failed.
+type RevokeIssuedVCResult struct {
+	CredentialID string  `json:"credentialID"`
+	Error        *string `json:"error,omitempty"`
+
+	// Credential revocation record
+	Revocation *Revocation `json:"revocation,omitempty"`
+}
+
+// A request for revoking the credentials issued by this node that match the filters.
+type RevokeIssuedVCsRequest struct {
+	// The type of the credentials.
+	CredentialType *string `json:"credentialType,omitempty"`
+
+	// If true, the credentials that would be revoked are returned without revoking them.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Selects the credentials issued at or after this moment (RFC3339).
+	IssuedFrom *time.Time `json:"issuedFrom,omitempty"`
+
+	// Selects the credentials issued before this moment (RFC3339).
+	IssuedUntil *time.Time `json:"issuedUntil,omitempty"`
+
+	// The DID of the issuer.
+	Issuer *string `json:"issuer,omitempty"`
+
+	// Describes why the credentials are revoked, it's added to the revocations.
+	Reason string `json:"reason"`
+
+	// The ID of the credential subject (usually a DID).
+	Subject *string `json:"subject,omitempty"`
+}
+
+// The results of revoking credentials issued by this node.
+type RevokeIssuedVCsResults struct {
+	Results []RevokeIssuedVCResult `json:"results"`
+}
+
 // SearchOptions defines model for SearchOptions.
 type SearchOptions struct {
 	// If set to true, VCs from an untrusted issuer are returned.
@@ -142,6 +423,9 @@ type SearchVCResult struct {
 	// Credential revocation record
 	Revocation *Revocation `json:"revocation,omitempty"`
 
+	// The revocation, trust and validity window status of a credential.
+	Status *VCStatus `json:"status,omitempty"`
+
 	// A credential according to the W3C and Nuts specs.
 	VerifiableCredential VerifiableCredential `json:"verifiableCredential"`
 }
@@ -151,6 +435,42 @@ type SearchVCResults struct {
 	VerifiableCredentials []SearchVCResult `json:"verifiableCredentials"`
 }
 
+// StoreCollectionRequest defines model for StoreCollectionRequest.
+type StoreCollectionRequest struct {
+	// The name of the collection, as listed by listStoreCollections.
+	Collection string `json:"collection"`
+}
+
+// TrustDecision defines model for TrustDecision.
+type TrustDecision struct {
+	CredentialType string `json:"credentialType"`
+	Issuer         string `json:"issuer"`
+
+	// Explains why the issuer is (not) trusted for the credential type.
+	Reason string `json:"reason"`
+
+	// Indicates whether the issuer is trusted for the credential type.
+	Trusted bool `json:"trusted"`
+}
+
+// The revocation, trust and validity window status of a credential.
+type VCStatus struct {
+	// Credential revocation record
+	Revocation *Revocation `json:"revocation,omitempty"`
+
+	// Indicates whether the credential has been revoked.
+	Revoked bool `json:"revoked"`
+
+	// Indicates for every type of the credential whether its issuer is trusted.
+	Trust []CredentialTypeTrust `json:"trust"`
+
+	// Indicates whether the credential is valid according to its issuance and expiration date.
+	ValidityWindow VCStatusValidityWindow `json:"validityWindow"`
+}
+
+// Indicates whether the credential is valid according to its issuance and expiration date.
+type VCStatusValidityWindow string
+
 // VCVerificationOptions defines model for VCVerificationOptions.
 type VCVerificationOptions struct {
 	// If set to true, an untrusted credential issuer is alowed.
@@ -159,51 +479,174 @@ type VCVerificationOptions struct {
 
 // VCVerificationRequest defines model for VCVerificationRequest.
 type VCVerificationRequest struct {
-	// A credential according to the W3C and Nuts specs.
-	VerifiableCredential VerifiableCredential   `json:"verifiableCredential"`
-	VerificationOptions  *VCVerificationOptions `json:"verificationOptions,omitempty"`
+	// A credential in JSON form, or a credential in JWT format (VC-JWT) as compact JWT.
+	// Credentials in JWT format are decoded to their JSON form as specified by the W3C Verifiable Credentials Data Model (section 6.3.2),
+	// with the JWT as proof (type JwtProof2020). They're returned in that form when listed or searched.
+	VerifiableCredential VerifiableCredentialOrJWT `json:"verifiableCredential"`
+	VerificationOptions  *VCVerificationOptions    `json:"verificationOptions,omitempty"`
 }
 
 // Contains the verifiable credential verification result.
 type VCVerificationResult struct {
-	// Indicates what went wrong
+	// Indicates what went wrong. If multiple checks failed, their messages are joined.
 	Message *string `json:"message,omitempty"`
 
+	// Contains a message for every check that failed.
+	Messages *[]string `json:"messages,omitempty"`
+
+	// The revocation, trust and validity window status of a credential.
+	Status *VCStatus `json:"status,omitempty"`
+
 	// Indicates the validity of the signature, issuer and revocation state.
 	Validity bool `json:"validity"`
 }
 
-// Verifiable Presentation
-type VerifiablePresentation struct {
-	// An ordered set where the first item is a URI https://www.w3.org/2018/credentials/v1. It is used to define
-	// terms and help to express specific identifiers in a compact manner.
-	Context []string `json:"@context"`
+// Contains the verification result of a credential in a verifiable presentation.
+type VPCredentialVerificationResult struct {
+	// The ID of the credential.
+	Id *string `json:"id,omitempty"`
+
+	// Indicates what went wrong
+	Message *string `json:"message,omitempty"`
+
+	// Indicates the validity of the signature, issuer, revocation state and validity period of the credential.
+	Validity bool `json:"validity"`
+}
+
+// VPVerificationOptions defines model for VPVerificationOptions.
+type VPVerificationOptions struct {
+	// If set to true, credentials of untrusted issuers are allowed.
+	AllowUntrustedIssuer *bool `json:"allowUntrustedIssuer,omitempty"`
 
-	// URI of the entity that is generating the presentation.
-	Holder *string `json:"holder,omitempty"`
+	// If set, the challenge of the presentation proof must be equal to this value.
+	Challenge *string `json:"challenge,omitempty"`
 
-	// URI that is used to unambiguously refer to an object, such as a person, product, or organization.
-	Id *string `json:"id,omitempty"`
+	// If set, the domain of the presentation proof must be equal to this value.
+	Domain *string `json:"domain,omitempty"`
+
+	// Date and time at which the presentation and its credentials must be valid. If omitted, the current time is used.
+	ValidAt *time.Time `json:"validAt,omitempty"`
+}
+
+// VPVerificationRequest defines model for VPVerificationRequest.
+type VPVerificationRequest struct {
+	// A Presentation Definition as specified by DIF Presentation Exchange v2 (https://identity.foundation/presentation-exchange/spec/v2.0.0/#presentation-definition).
+	// Input descriptor fields support JSONPath expressions with member access, array indices and wildcards,
+	// and filters with the JSON Schema keywords type, const, enum, pattern, minimum, maximum, exclusiveMinimum, exclusiveMaximum, minLength, maxLength, contains and not.
+	// Submission requirements are not supported: presentation definitions that contain them are rejected.
+	PresentationDefinition *PresentationDefinition `json:"presentationDefinition,omitempty"`
+
+	// A Presentation Submission as specified by DIF Presentation Exchange v2 (https://identity.foundation/presentation-exchange/spec/v2.0.0/#presentation-submission).
+	// The paths in the descriptor map refer to the credentials of the accompanying presentation, e.g. $.verifiableCredential[0].
+	PresentationSubmission *PresentationSubmission `json:"presentationSubmission,omitempty"`
+
+	// A presentation in JSON form, or a presentation in JWT format (VP-JWT) as compact JWT.
+	VerifiablePresentation VerifiablePresentationOrJWT `json:"verifiablePresentation"`
+	VerificationOptions    *VPVerificationOptions      `json:"verificationOptions,omitempty"`
+}
+
+// Contains the verifiable presentation verification result.
+type VPVerificationResult struct {
+	// The verification result of every credential in the presentation, in the same order.
+	Credentials []VPCredentialVerificationResult `json:"credentials"`
+
+	// DID according to Nuts specification
+	Holder *DID `json:"holder,omitempty"`
+
+	// Indicates what went wrong verifying the presentation itself.
+	Message *string `json:"message,omitempty"`
+
+	// Indicates whether both the presentation and all of its credentials are valid.
+	Validity bool `json:"validity"`
+}
+
+// SearchVerificationsParams defines parameters for SearchVerifications.
+type SearchVerificationsParams struct {
+	// The ID of the verified credential
+	CredentialID *string `json:"credentialID,omitempty"`
+
+	// The ID of the subject of the verified credentials (usually a DID)
+	Subject *string `json:"subject,omitempty"`
+
+	// Selects the verifications at or after this moment (RFC3339)
+	From *time.Time `json:"from,omitempty"`
 
-	// Cryptographic proofs that can be used to detect tampering and verify the authorship of a
-	// credential or presentation. An embedded proof is a mechanism where the proof is included in
-	// the data, such as a Linked Data Signature.
-	Proof *EmbeddedProof `json:"proof,omitempty"`
+	// Selects the verifications before this moment (RFC3339)
+	To *time.Time `json:"to,omitempty"`
 
-	// Type of the object or the datatype of the typed value.
-	Type []string `json:"type"`
+	// The number of matching verifications to skip
+	Offset *int `json:"offset,omitempty"`
 
-	// VerifiableCredential is composed of a list containing one or more verifiable credentials, in a
-	// cryptographically verifiable format.
-	VerifiableCredential *[]VerifiableCredential `json:"verifiableCredential,omitempty"`
+	// The maximum number of verifications to return
+	Limit *int `json:"limit,omitempty"`
 }
 
+// AddConceptJSONBody defines parameters for AddConcept.
+type AddConceptJSONBody ConceptConfig
+
+// CreatePresentationSubmissionJSONBody defines parameters for CreatePresentationSubmission.
+type CreatePresentationSubmissionJSONBody CreatePresentationSubmissionRequest
+
 // CreateVPJSONBody defines parameters for CreateVP.
 type CreateVPJSONBody CreateVPRequest
 
+// ListHolderVCsParams defines parameters for ListHolderVCs.
+type ListHolderVCsParams struct {
+	// The type of the credential
+	CredentialType *string `json:"credentialType,omitempty"`
+
+	// the DID of the issuer
+	Issuer *string `json:"issuer,omitempty"`
+}
+
+// ListExpiringVCsParams defines parameters for ListExpiringVCs.
+type ListExpiringVCsParams struct {
+	// The duration from now in which the credentials expire, e.g. 720h. Defaults to 720h (30 days).
+	Within *string `json:"within,omitempty"`
+}
+
+// AddTemplateJSONBody defines parameters for AddTemplate.
+type AddTemplateJSONBody IssuanceTemplate
+
+// ListIssuedVCsParams defines parameters for ListIssuedVCs.
+type ListIssuedVCsParams struct {
+	// The DID of the issuer
+	Issuer *string `json:"issuer,omitempty"`
+
+	// The ID of the credential subject (usually a DID)
+	Subject *string `json:"subject,omitempty"`
+
+	// The type of the credentials
+	CredentialType *string `json:"credentialType,omitempty"`
+
+	// Selects the credentials issued at or after this moment (RFC3339)
+	IssuedFrom *time.Time `json:"issuedFrom,omitempty"`
+
+	// Selects the credentials issued before this moment (RFC3339)
+	IssuedUntil *time.Time `json:"issuedUntil,omitempty"`
+
+	// Selects the credentials in this state. If omitted, credentials in any state are selected.
+	State *ListIssuedVCsParamsState `json:"state,omitempty"`
+
+	// The number of matching credentials to skip
+	Offset *int `json:"offset,omitempty"`
+
+	// The maximum number of credentials to return
+	Limit *int `json:"limit,omitempty"`
+}
+
+// ListIssuedVCsParamsState defines parameters for ListIssuedVCs.
+type ListIssuedVCsParamsState string
+
 // IssueVCJSONBody defines parameters for IssueVC.
 type IssueVCJSONBody IssueVCRequest
 
+// IssueVCBatchJSONBody defines parameters for IssueVCBatch.
+type IssueVCBatchJSONBody IssueVCBatchRequest
+
+// RevokeIssuedVCsJSONBody defines parameters for RevokeIssuedVCs.
+type RevokeIssuedVCsJSONBody RevokeIssuedVCsRequest
+
 // SearchIssuedVCsParams defines parameters for SearchIssuedVCs.
 type SearchIssuedVCsParams struct {
 	// The type of the credential
@@ -216,18 +659,72 @@ type SearchIssuedVCsParams struct {
 	Subject *string `json:"subject,omitempty"`
 }
 
+// CheckStoreCollectionJSONBody defines parameters for CheckStoreCollection.
+type CheckStoreCollectionJSONBody StoreCollectionRequest
+
+// ReindexStoreCollectionJSONBody defines parameters for ReindexStoreCollection.
+type ReindexStoreCollectionJSONBody StoreCollectionRequest
+
+// ExplainTrustParams defines parameters for ExplainTrust.
+type ExplainTrustParams struct {
+	// The type of the credential
+	CredentialType string `json:"credentialType"`
+
+	// the DID of the issuer
+	Issuer string `json:"issuer"`
+}
+
+// ImportTrustListJSONBody defines parameters for ImportTrustList.
+type ImportTrustListJSONBody ImportTrustListRequest
+
 // VerifyVCJSONBody defines parameters for VerifyVC.
 type VerifyVCJSONBody VCVerificationRequest
 
+// VerifyVPJSONBody defines parameters for VerifyVP.
+type VerifyVPJSONBody VPVerificationRequest
+
+// RefreshVCJSONBody defines parameters for RefreshVC.
+type RefreshVCJSONBody RefreshVCRequest
+
+// AddConceptJSONRequestBody defines body for AddConcept for application/json ContentType.
+type AddConceptJSONRequestBody AddConceptJSONBody
+
+// CreatePresentationSubmissionJSONRequestBody defines body for CreatePresentationSubmission for application/json ContentType.
+type CreatePresentationSubmissionJSONRequestBody CreatePresentationSubmissionJSONBody
+
 // CreateVPJSONRequestBody defines body for CreateVP for application/json ContentType.
 type CreateVPJSONRequestBody CreateVPJSONBody
 
+// AddTemplateJSONRequestBody defines body for AddTemplate for application/json ContentType.
+type AddTemplateJSONRequestBody AddTemplateJSONBody
+
 // IssueVCJSONRequestBody defines body for IssueVC for application/json ContentType.
 type IssueVCJSONRequestBody IssueVCJSONBody
 
+// IssueVCBatchJSONRequestBody defines body for IssueVCBatch for application/json ContentType.
+type IssueVCBatchJSONRequestBody IssueVCBatchJSONBody
+
+// RevokeIssuedVCsJSONRequestBody defines body for RevokeIssuedVCs for application/json ContentType.
+type RevokeIssuedVCsJSONRequestBody RevokeIssuedVCsJSONBody
+
+// CheckStoreCollectionJSONRequestBody defines body for CheckStoreCollection for application/json ContentType.
+type CheckStoreCollectionJSONRequestBody CheckStoreCollectionJSONBody
+
+// ReindexStoreCollectionJSONRequestBody defines body for ReindexStoreCollection for application/json ContentType.
+type ReindexStoreCollectionJSONRequestBody ReindexStoreCollectionJSONBody
+
+// ImportTrustListJSONRequestBody defines body for ImportTrustList for application/json ContentType.
+type ImportTrustListJSONRequestBody ImportTrustListJSONBody
+
 // VerifyVCJSONRequestBody defines body for VerifyVC for application/json ContentType.
 type VerifyVCJSONRequestBody VerifyVCJSONBody
 
+// VerifyVPJSONRequestBody defines body for VerifyVP for application/json ContentType.
+type VerifyVPJSONRequestBody VerifyVPJSONBody
+
+// RefreshVCJSONRequestBody defines body for RefreshVC for application/json ContentType.
+type RefreshVCJSONRequestBody RefreshVCJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -301,33 +798,125 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// SearchVerifications request
+	SearchVerifications(ctx context.Context, params *SearchVerificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListConcepts request
+	ListConcepts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddConcept request with any body
+	AddConceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddConcept(ctx context.Context, body AddConceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveConcept request
+	RemoveConcept(ctx context.Context, credentialType string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePresentationSubmission request with any body
+	CreatePresentationSubmissionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePresentationSubmission(ctx context.Context, body CreatePresentationSubmissionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SearchVCs request with any body
 	SearchVCsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteHolderVC request
+	DeleteHolderVC(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHolderVC request
+	GetHolderVC(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateVP request with any body
 	CreateVPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateVP(ctx context.Context, body CreateVPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListHolderVCs request
+	ListHolderVCs(ctx context.Context, did string, params *ListHolderVCsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListExpiringVCs request
+	ListExpiringVCs(ctx context.Context, params *ListExpiringVCsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTemplates request
+	ListTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddTemplate request with any body
+	AddTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddTemplate(ctx context.Context, body AddTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveTemplate request
+	RemoveTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIssuedVCs request
+	ListIssuedVCs(ctx context.Context, params *ListIssuedVCsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// IssueVC request with any body
 	IssueVCWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	IssueVC(ctx context.Context, body IssueVCJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// IssueVCBatch request with any body
+	IssueVCBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	IssueVCBatch(ctx context.Context, body IssueVCBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeIssuedVCs request with any body
+	RevokeIssuedVCsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RevokeIssuedVCs(ctx context.Context, body RevokeIssuedVCsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SearchIssuedVCs request
 	SearchIssuedVCs(ctx context.Context, params *SearchIssuedVCsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RevokeVC request
 	RevokeVC(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListJSONLDContexts request
+	ListJSONLDContexts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CheckStoreCollection request with any body
+	CheckStoreCollectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CheckStoreCollection(ctx context.Context, body CheckStoreCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListStoreCollections request
+	ListStoreCollections(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReindexStoreCollection request with any body
+	ReindexStoreCollectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReindexStoreCollection(ctx context.Context, body ReindexStoreCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExplainTrust request
+	ExplainTrust(ctx context.Context, params *ExplainTrustParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportTrustList request with any body
+	ImportTrustListWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportTrustList(ctx context.Context, body ImportTrustListJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// VerifyVC request with any body
 	VerifyVCWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	VerifyVC(ctx context.Context, body VerifyVCJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyVP request with any body
+	VerifyVPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyVP(ctx context.Context, body VerifyVPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshVC request with any body
+	RefreshVCWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshVC(ctx context.Context, body RefreshVCJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRefreshNonce request
+	CreateRefreshNonce(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) SearchVCsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchVCsRequestWithBody(c.Server, contentType, body)
+func (c *Client) SearchVerifications(ctx context.Context, params *SearchVerificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchVerificationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -338,8 +927,8 @@ func (c *Client) SearchVCsWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateVPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateVPRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListConcepts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListConceptsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -350,8 +939,8 @@ func (c *Client) CreateVPWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateVP(ctx context.Context, body CreateVPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateVPRequest(c.Server, body)
+func (c *Client) AddConceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddConceptRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -362,8 +951,8 @@ func (c *Client) CreateVP(ctx context.Context, body CreateVPJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) IssueVCWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewIssueVCRequestWithBody(c.Server, contentType, body)
+func (c *Client) AddConcept(ctx context.Context, body AddConceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddConceptRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -374,8 +963,8 @@ func (c *Client) IssueVCWithBody(ctx context.Context, contentType string, body i
 	return c.Client.Do(req)
 }
 
-func (c *Client) IssueVC(ctx context.Context, body IssueVCJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewIssueVCRequest(c.Server, body)
+func (c *Client) RemoveConcept(ctx context.Context, credentialType string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveConceptRequest(c.Server, credentialType)
 	if err != nil {
 		return nil, err
 	}
@@ -386,8 +975,8 @@ func (c *Client) IssueVC(ctx context.Context, body IssueVCJSONRequestBody, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchIssuedVCs(ctx context.Context, params *SearchIssuedVCsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchIssuedVCsRequest(c.Server, params)
+func (c *Client) CreatePresentationSubmissionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePresentationSubmissionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -398,8 +987,8 @@ func (c *Client) SearchIssuedVCs(ctx context.Context, params *SearchIssuedVCsPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) RevokeVC(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRevokeVCRequest(c.Server, id)
+func (c *Client) CreatePresentationSubmission(ctx context.Context, body CreatePresentationSubmissionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePresentationSubmissionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -410,8 +999,8 @@ func (c *Client) RevokeVC(ctx context.Context, id string, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) VerifyVCWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVerifyVCRequestWithBody(c.Server, contentType, body)
+func (c *Client) SearchVCsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchVCsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -422,8 +1011,8 @@ func (c *Client) VerifyVCWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) VerifyVC(ctx context.Context, body VerifyVCJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVerifyVCRequest(c.Server, body)
+func (c *Client) DeleteHolderVC(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteHolderVCRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -434,734 +1023,4147 @@ func (c *Client) VerifyVC(ctx context.Context, body VerifyVCJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-// NewSearchVCsRequestWithBody generates requests for SearchVCs with any type of body
-func NewSearchVCsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetHolderVC(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHolderVCRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/internal/vcr/v2/holder/vc/search")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateVPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVPRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateVPRequest calls the generic CreateVP builder with application/json body
-func NewCreateVPRequest(server string, body CreateVPJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateVP(ctx context.Context, body CreateVPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVPRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateVPRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateVPRequestWithBody generates requests for CreateVP with any type of body
-func NewCreateVPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListHolderVCs(ctx context.Context, did string, params *ListHolderVCsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHolderVCsRequest(c.Server, did, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/internal/vcr/v2/holder/vp")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListExpiringVCs(ctx context.Context, params *ListExpiringVCsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListExpiringVCsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewIssueVCRequest calls the generic IssueVC builder with application/json body
-func NewIssueVCRequest(server string, body IssueVCJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTemplatesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewIssueVCRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewIssueVCRequestWithBody generates requests for IssueVC with any type of body
-func NewIssueVCRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AddTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTemplateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/vc")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddTemplate(ctx context.Context, body AddTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTemplateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewSearchIssuedVCsRequest generates requests for SearchIssuedVCs
-func NewSearchIssuedVCsRequest(server string, params *SearchIssuedVCsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTemplateRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/vc/search")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListIssuedVCs(ctx context.Context, params *ListIssuedVCsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIssuedVCsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "credentialType", runtime.ParamLocationQuery, params.CredentialType); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
 	}
+	return c.Client.Do(req)
+}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issuer", runtime.ParamLocationQuery, params.Issuer); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+func (c *Client) IssueVCWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueVCRequestWithBody(c.Server, contentType, body)
+	if err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
-	}
-
-	if params.Subject != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
 	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRevokeVCRequest generates requests for RevokeVC
-func NewRevokeVCRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) IssueVC(ctx context.Context, body IssueVCJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueVCRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) IssueVCBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueVCBatchRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/vc/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) IssueVCBatch(ctx context.Context, body IssueVCBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueVCBatchRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewVerifyVCRequest calls the generic VerifyVC builder with application/json body
-func NewVerifyVCRequest(server string, body VerifyVCJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) RevokeIssuedVCsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeIssuedVCsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewVerifyVCRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewVerifyVCRequestWithBody generates requests for VerifyVC with any type of body
-func NewVerifyVCRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RevokeIssuedVCs(ctx context.Context, body RevokeIssuedVCsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeIssuedVCsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/internal/vcr/v2/verifier/vc")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SearchIssuedVCs(ctx context.Context, params *SearchIssuedVCsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchIssuedVCsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeVC(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeVCRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListJSONLDContexts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListJSONLDContextsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckStoreCollectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckStoreCollectionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckStoreCollection(ctx context.Context, body CheckStoreCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckStoreCollectionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListStoreCollections(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListStoreCollectionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReindexStoreCollectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReindexStoreCollectionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReindexStoreCollection(ctx context.Context, body ReindexStoreCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReindexStoreCollectionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExplainTrust(ctx context.Context, params *ExplainTrustParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExplainTrustRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportTrustListWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTrustListRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportTrustList(ctx context.Context, body ImportTrustListJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTrustListRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyVCWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyVCRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyVC(ctx context.Context, body VerifyVCJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyVCRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyVPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyVPRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyVP(ctx context.Context, body VerifyVPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyVPRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshVCWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshVCRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshVC(ctx context.Context, body RefreshVCJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshVCRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRefreshNonce(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRefreshNonceRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewSearchVerificationsRequest generates requests for SearchVerifications
+func NewSearchVerificationsRequest(server string, params *SearchVerificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/audit/verifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.CredentialID != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "credentialID", runtime.ParamLocationQuery, *params.CredentialID); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Subject != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.From != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.To != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListConceptsRequest generates requests for ListConcepts
+func NewListConceptsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/concept")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddConceptRequest calls the generic AddConcept builder with application/json body
+func NewAddConceptRequest(server string, body AddConceptJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddConceptRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddConceptRequestWithBody generates requests for AddConcept with any type of body
+func NewAddConceptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/concept")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveConceptRequest generates requests for RemoveConcept
+func NewRemoveConceptRequest(server string, credentialType string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "credentialType", runtime.ParamLocationPath, credentialType)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/concept/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePresentationSubmissionRequest calls the generic CreatePresentationSubmission builder with application/json body
+func NewCreatePresentationSubmissionRequest(server string, body CreatePresentationSubmissionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePresentationSubmissionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePresentationSubmissionRequestWithBody generates requests for CreatePresentationSubmission with any type of body
+func NewCreatePresentationSubmissionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/holder/presentation-submission")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchVCsRequestWithBody generates requests for SearchVCs with any type of body
+func NewSearchVCsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/holder/vc/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteHolderVCRequest generates requests for DeleteHolderVC
+func NewDeleteHolderVCRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/holder/vc/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHolderVCRequest generates requests for GetHolderVC
+func NewGetHolderVCRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/holder/vc/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateVPRequest calls the generic CreateVP builder with application/json body
+func NewCreateVPRequest(server string, body CreateVPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateVPRequestWithBody generates requests for CreateVP with any type of body
+func NewCreateVPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/holder/vp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListHolderVCsRequest generates requests for ListHolderVCs
+func NewListHolderVCsRequest(server string, did string, params *ListHolderVCsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "did", runtime.ParamLocationPath, did)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/holder/%s/vc", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.CredentialType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "credentialType", runtime.ParamLocationQuery, *params.CredentialType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Issuer != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issuer", runtime.ParamLocationQuery, *params.Issuer); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListExpiringVCsRequest generates requests for ListExpiringVCs
+func NewListExpiringVCsRequest(server string, params *ListExpiringVCsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/expiring")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Within != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "within", runtime.ParamLocationQuery, *params.Within); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTemplatesRequest generates requests for ListTemplates
+func NewListTemplatesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/template")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTemplateRequest calls the generic AddTemplate builder with application/json body
+func NewAddTemplateRequest(server string, body AddTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTemplateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddTemplateRequestWithBody generates requests for AddTemplate with any type of body
+func NewAddTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/template")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveTemplateRequest generates requests for RemoveTemplate
+func NewRemoveTemplateRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/template/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListIssuedVCsRequest generates requests for ListIssuedVCs
+func NewListIssuedVCsRequest(server string, params *ListIssuedVCsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/vc")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Issuer != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issuer", runtime.ParamLocationQuery, *params.Issuer); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Subject != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.CredentialType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "credentialType", runtime.ParamLocationQuery, *params.CredentialType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.IssuedFrom != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issuedFrom", runtime.ParamLocationQuery, *params.IssuedFrom); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.IssuedUntil != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issuedUntil", runtime.ParamLocationQuery, *params.IssuedUntil); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.State != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewIssueVCRequest calls the generic IssueVC builder with application/json body
+func NewIssueVCRequest(server string, body IssueVCJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewIssueVCRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewIssueVCRequestWithBody generates requests for IssueVC with any type of body
+func NewIssueVCRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/vc")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewIssueVCBatchRequest calls the generic IssueVCBatch builder with application/json body
+func NewIssueVCBatchRequest(server string, body IssueVCBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewIssueVCBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewIssueVCBatchRequestWithBody generates requests for IssueVCBatch with any type of body
+func NewIssueVCBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/vc/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeIssuedVCsRequest calls the generic RevokeIssuedVCs builder with application/json body
+func NewRevokeIssuedVCsRequest(server string, body RevokeIssuedVCsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRevokeIssuedVCsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRevokeIssuedVCsRequestWithBody generates requests for RevokeIssuedVCs with any type of body
+func NewRevokeIssuedVCsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/vc/revoke")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchIssuedVCsRequest generates requests for SearchIssuedVCs
+func NewSearchIssuedVCsRequest(server string, params *SearchIssuedVCsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/vc/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "credentialType", runtime.ParamLocationQuery, params.CredentialType); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issuer", runtime.ParamLocationQuery, params.Issuer); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Subject != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeVCRequest generates requests for RevokeVC
+func NewRevokeVCRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/issuer/vc/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListJSONLDContextsRequest generates requests for ListJSONLDContexts
+func NewListJSONLDContextsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/jsonld/contexts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCheckStoreCollectionRequest calls the generic CheckStoreCollection builder with application/json body
+func NewCheckStoreCollectionRequest(server string, body CheckStoreCollectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCheckStoreCollectionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCheckStoreCollectionRequestWithBody generates requests for CheckStoreCollection with any type of body
+func NewCheckStoreCollectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/store/check")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListStoreCollectionsRequest generates requests for ListStoreCollections
+func NewListStoreCollectionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/store/collections")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReindexStoreCollectionRequest calls the generic ReindexStoreCollection builder with application/json body
+func NewReindexStoreCollectionRequest(server string, body ReindexStoreCollectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReindexStoreCollectionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReindexStoreCollectionRequestWithBody generates requests for ReindexStoreCollection with any type of body
+func NewReindexStoreCollectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/store/reindex")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExplainTrustRequest generates requests for ExplainTrust
+func NewExplainTrustRequest(server string, params *ExplainTrustParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/verifier/trust")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "credentialType", runtime.ParamLocationQuery, params.CredentialType); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issuer", runtime.ParamLocationQuery, params.Issuer); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportTrustListRequest calls the generic ImportTrustList builder with application/json body
+func NewImportTrustListRequest(server string, body ImportTrustListJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportTrustListRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewImportTrustListRequestWithBody generates requests for ImportTrustList with any type of body
+func NewImportTrustListRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/verifier/trust/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyVCRequest calls the generic VerifyVC builder with application/json body
+func NewVerifyVCRequest(server string, body VerifyVCJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyVCRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyVCRequestWithBody generates requests for VerifyVC with any type of body
+func NewVerifyVCRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/verifier/vc")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyVPRequest calls the generic VerifyVP builder with application/json body
+func NewVerifyVPRequest(server string, body VerifyVPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyVPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyVPRequestWithBody generates requests for VerifyVP with any type of body
+func NewVerifyVPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vcr/v2/verifier/vp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRefreshVCRequest calls the generic RefreshVC builder with application/json body
+func NewRefreshVCRequest(server string, body RefreshVCJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshVCRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshVCRequestWithBody generates requests for RefreshVC with any type of body
+func NewRefreshVCRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/n2n/vcr/v2/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateRefreshNonceRequest generates requests for CreateRefreshNonce
+func NewCreateRefreshNonceRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/n2n/vcr/v2/refresh/nonce")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// SearchVerifications request
+	SearchVerificationsWithResponse(ctx context.Context, params *SearchVerificationsParams, reqEditors ...RequestEditorFn) (*SearchVerificationsResponse, error)
+
+	// ListConcepts request
+	ListConceptsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListConceptsResponse, error)
+
+	// AddConcept request with any body
+	AddConceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddConceptResponse, error)
+
+	AddConceptWithResponse(ctx context.Context, body AddConceptJSONRequestBody, reqEditors ...RequestEditorFn) (*AddConceptResponse, error)
+
+	// RemoveConcept request
+	RemoveConceptWithResponse(ctx context.Context, credentialType string, reqEditors ...RequestEditorFn) (*RemoveConceptResponse, error)
+
+	// CreatePresentationSubmission request with any body
+	CreatePresentationSubmissionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePresentationSubmissionResponse, error)
+
+	CreatePresentationSubmissionWithResponse(ctx context.Context, body CreatePresentationSubmissionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePresentationSubmissionResponse, error)
+
+	// SearchVCs request with any body
+	SearchVCsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchVCsResponse, error)
+
+	// DeleteHolderVC request
+	DeleteHolderVCWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteHolderVCResponse, error)
+
+	// GetHolderVC request
+	GetHolderVCWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetHolderVCResponse, error)
+
+	// CreateVP request with any body
+	CreateVPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVPResponse, error)
+
+	CreateVPWithResponse(ctx context.Context, body CreateVPJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVPResponse, error)
+
+	// ListHolderVCs request
+	ListHolderVCsWithResponse(ctx context.Context, did string, params *ListHolderVCsParams, reqEditors ...RequestEditorFn) (*ListHolderVCsResponse, error)
+
+	// ListExpiringVCs request
+	ListExpiringVCsWithResponse(ctx context.Context, params *ListExpiringVCsParams, reqEditors ...RequestEditorFn) (*ListExpiringVCsResponse, error)
+
+	// ListTemplates request
+	ListTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTemplatesResponse, error)
+
+	// AddTemplate request with any body
+	AddTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTemplateResponse, error)
+
+	AddTemplateWithResponse(ctx context.Context, body AddTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTemplateResponse, error)
+
+	// RemoveTemplate request
+	RemoveTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*RemoveTemplateResponse, error)
+
+	// ListIssuedVCs request
+	ListIssuedVCsWithResponse(ctx context.Context, params *ListIssuedVCsParams, reqEditors ...RequestEditorFn) (*ListIssuedVCsResponse, error)
+
+	// IssueVC request with any body
+	IssueVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueVCResponse, error)
+
+	IssueVCWithResponse(ctx context.Context, body IssueVCJSONRequestBody, reqEditors ...RequestEditorFn) (*IssueVCResponse, error)
+
+	// IssueVCBatch request with any body
+	IssueVCBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueVCBatchResponse, error)
+
+	IssueVCBatchWithResponse(ctx context.Context, body IssueVCBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*IssueVCBatchResponse, error)
+
+	// RevokeIssuedVCs request with any body
+	RevokeIssuedVCsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevokeIssuedVCsResponse, error)
+
+	RevokeIssuedVCsWithResponse(ctx context.Context, body RevokeIssuedVCsJSONRequestBody, reqEditors ...RequestEditorFn) (*RevokeIssuedVCsResponse, error)
+
+	// SearchIssuedVCs request
+	SearchIssuedVCsWithResponse(ctx context.Context, params *SearchIssuedVCsParams, reqEditors ...RequestEditorFn) (*SearchIssuedVCsResponse, error)
+
+	// RevokeVC request
+	RevokeVCWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RevokeVCResponse, error)
+
+	// ListJSONLDContexts request
+	ListJSONLDContextsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListJSONLDContextsResponse, error)
+
+	// CheckStoreCollection request with any body
+	CheckStoreCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckStoreCollectionResponse, error)
+
+	CheckStoreCollectionWithResponse(ctx context.Context, body CheckStoreCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckStoreCollectionResponse, error)
+
+	// ListStoreCollections request
+	ListStoreCollectionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStoreCollectionsResponse, error)
+
+	// ReindexStoreCollection request with any body
+	ReindexStoreCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReindexStoreCollectionResponse, error)
+
+	ReindexStoreCollectionWithResponse(ctx context.Context, body ReindexStoreCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*ReindexStoreCollectionResponse, error)
+
+	// ExplainTrust request
+	ExplainTrustWithResponse(ctx context.Context, params *ExplainTrustParams, reqEditors ...RequestEditorFn) (*ExplainTrustResponse, error)
+
+	// ImportTrustList request with any body
+	ImportTrustListWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTrustListResponse, error)
+
+	ImportTrustListWithResponse(ctx context.Context, body ImportTrustListJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportTrustListResponse, error)
+
+	// VerifyVC request with any body
+	VerifyVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyVCResponse, error)
+
+	VerifyVCWithResponse(ctx context.Context, body VerifyVCJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyVCResponse, error)
+
+	// VerifyVP request with any body
+	VerifyVPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyVPResponse, error)
+
+	VerifyVPWithResponse(ctx context.Context, body VerifyVPJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyVPResponse, error)
+
+	// RefreshVC request with any body
+	RefreshVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshVCResponse, error)
+
+	RefreshVCWithResponse(ctx context.Context, body RefreshVCJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshVCResponse, error)
+
+	// CreateRefreshNonce request
+	CreateRefreshNonceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateRefreshNonceResponse, error)
+}
+
+type SearchVerificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditEntryList
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchVerificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchVerificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListConceptsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ConceptConfig
+}
+
+// Status returns HTTPResponse.Status
+func (r ListConceptsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListConceptsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddConceptResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConceptConfig
+}
+
+// Status returns HTTPResponse.Status
+func (r AddConceptResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddConceptResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveConceptResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveConceptResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveConceptResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePresentationSubmissionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PresentationSubmissionResult
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePresentationSubmissionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePresentationSubmissionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchVCsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchVCResults
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchVCsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchVCsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteHolderVCResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteHolderVCResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteHolderVCResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHolderVCResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VerifiableCredential
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHolderVCResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHolderVCResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateVPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VerifiablePresentationOrJWT
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateVPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHolderVCsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]VerifiableCredential
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHolderVCsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHolderVCsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListExpiringVCsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchVCResults
+}
+
+// Status returns HTTPResponse.Status
+func (r ListExpiringVCsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListExpiringVCsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IssuanceTemplate
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssuanceTemplate
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIssuedVCsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssuedVCList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIssuedVCsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIssuedVCsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssueVCResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VerifiableCredentialOrJWT
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueVCResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueVCResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssueVCBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssueVCBatchResults
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueVCBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueVCBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeIssuedVCsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevokeIssuedVCsResults
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeIssuedVCsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeIssuedVCsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchIssuedVCsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchIssuedVCsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchIssuedVCsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeVCResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Revocation
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeVCResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeVCResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListJSONLDContextsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]JSONLDContext
+}
+
+// Status returns HTTPResponse.Status
+func (r ListJSONLDContextsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListJSONLDContextsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckStoreCollectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StoreReport
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckStoreCollectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckStoreCollectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListStoreCollectionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r ListStoreCollectionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListStoreCollectionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReindexStoreCollectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StoreReport
+}
+
+// Status returns HTTPResponse.Status
+func (r ReindexStoreCollectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReindexStoreCollectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExplainTrustResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrustDecision
+}
+
+// Status returns HTTPResponse.Status
+func (r ExplainTrustResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExplainTrustResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportTrustListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrustList
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportTrustListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportTrustListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyVCResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VCVerificationResult
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyVCResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyVCResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyVPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VPVerificationResult
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyVPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyVPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshVCResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VerifiableCredentialOrJWT
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshVCResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshVCResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRefreshNonceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RefreshNonce
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRefreshNonceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRefreshNonceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// SearchVerificationsWithResponse request returning *SearchVerificationsResponse
+func (c *ClientWithResponses) SearchVerificationsWithResponse(ctx context.Context, params *SearchVerificationsParams, reqEditors ...RequestEditorFn) (*SearchVerificationsResponse, error) {
+	rsp, err := c.SearchVerifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchVerificationsResponse(rsp)
+}
+
+// ListConceptsWithResponse request returning *ListConceptsResponse
+func (c *ClientWithResponses) ListConceptsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListConceptsResponse, error) {
+	rsp, err := c.ListConcepts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListConceptsResponse(rsp)
+}
+
+// AddConceptWithBodyWithResponse request with arbitrary body returning *AddConceptResponse
+func (c *ClientWithResponses) AddConceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddConceptResponse, error) {
+	rsp, err := c.AddConceptWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddConceptResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddConceptWithResponse(ctx context.Context, body AddConceptJSONRequestBody, reqEditors ...RequestEditorFn) (*AddConceptResponse, error) {
+	rsp, err := c.AddConcept(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddConceptResponse(rsp)
+}
+
+// RemoveConceptWithResponse request returning *RemoveConceptResponse
+func (c *ClientWithResponses) RemoveConceptWithResponse(ctx context.Context, credentialType string, reqEditors ...RequestEditorFn) (*RemoveConceptResponse, error) {
+	rsp, err := c.RemoveConcept(ctx, credentialType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveConceptResponse(rsp)
+}
+
+// CreatePresentationSubmissionWithBodyWithResponse request with arbitrary body returning *CreatePresentationSubmissionResponse
+func (c *ClientWithResponses) CreatePresentationSubmissionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePresentationSubmissionResponse, error) {
+	rsp, err := c.CreatePresentationSubmissionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePresentationSubmissionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePresentationSubmissionWithResponse(ctx context.Context, body CreatePresentationSubmissionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePresentationSubmissionResponse, error) {
+	rsp, err := c.CreatePresentationSubmission(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePresentationSubmissionResponse(rsp)
+}
+
+// SearchVCsWithBodyWithResponse request with arbitrary body returning *SearchVCsResponse
+func (c *ClientWithResponses) SearchVCsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchVCsResponse, error) {
+	rsp, err := c.SearchVCsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchVCsResponse(rsp)
+}
+
+// DeleteHolderVCWithResponse request returning *DeleteHolderVCResponse
+func (c *ClientWithResponses) DeleteHolderVCWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteHolderVCResponse, error) {
+	rsp, err := c.DeleteHolderVC(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteHolderVCResponse(rsp)
+}
+
+// GetHolderVCWithResponse request returning *GetHolderVCResponse
+func (c *ClientWithResponses) GetHolderVCWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetHolderVCResponse, error) {
+	rsp, err := c.GetHolderVC(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHolderVCResponse(rsp)
+}
+
+// CreateVPWithBodyWithResponse request with arbitrary body returning *CreateVPResponse
+func (c *ClientWithResponses) CreateVPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVPResponse, error) {
+	rsp, err := c.CreateVPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateVPWithResponse(ctx context.Context, body CreateVPJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVPResponse, error) {
+	rsp, err := c.CreateVP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVPResponse(rsp)
+}
+
+// ListHolderVCsWithResponse request returning *ListHolderVCsResponse
+func (c *ClientWithResponses) ListHolderVCsWithResponse(ctx context.Context, did string, params *ListHolderVCsParams, reqEditors ...RequestEditorFn) (*ListHolderVCsResponse, error) {
+	rsp, err := c.ListHolderVCs(ctx, did, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHolderVCsResponse(rsp)
+}
+
+// ListExpiringVCsWithResponse request returning *ListExpiringVCsResponse
+func (c *ClientWithResponses) ListExpiringVCsWithResponse(ctx context.Context, params *ListExpiringVCsParams, reqEditors ...RequestEditorFn) (*ListExpiringVCsResponse, error) {
+	rsp, err := c.ListExpiringVCs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListExpiringVCsResponse(rsp)
+}
+
+// ListTemplatesWithResponse request returning *ListTemplatesResponse
+func (c *ClientWithResponses) ListTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTemplatesResponse, error) {
+	rsp, err := c.ListTemplates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTemplatesResponse(rsp)
+}
+
+// AddTemplateWithBodyWithResponse request with arbitrary body returning *AddTemplateResponse
+func (c *ClientWithResponses) AddTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTemplateResponse, error) {
+	rsp, err := c.AddTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddTemplateWithResponse(ctx context.Context, body AddTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTemplateResponse, error) {
+	rsp, err := c.AddTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTemplateResponse(rsp)
+}
+
+// RemoveTemplateWithResponse request returning *RemoveTemplateResponse
+func (c *ClientWithResponses) RemoveTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*RemoveTemplateResponse, error) {
+	rsp, err := c.RemoveTemplate(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTemplateResponse(rsp)
+}
+
+// ListIssuedVCsWithResponse request returning *ListIssuedVCsResponse
+func (c *ClientWithResponses) ListIssuedVCsWithResponse(ctx context.Context, params *ListIssuedVCsParams, reqEditors ...RequestEditorFn) (*ListIssuedVCsResponse, error) {
+	rsp, err := c.ListIssuedVCs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIssuedVCsResponse(rsp)
+}
+
+// IssueVCWithBodyWithResponse request with arbitrary body returning *IssueVCResponse
+func (c *ClientWithResponses) IssueVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueVCResponse, error) {
+	rsp, err := c.IssueVCWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueVCResponse(rsp)
+}
+
+func (c *ClientWithResponses) IssueVCWithResponse(ctx context.Context, body IssueVCJSONRequestBody, reqEditors ...RequestEditorFn) (*IssueVCResponse, error) {
+	rsp, err := c.IssueVC(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueVCResponse(rsp)
+}
+
+// IssueVCBatchWithBodyWithResponse request with arbitrary body returning *IssueVCBatchResponse
+func (c *ClientWithResponses) IssueVCBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueVCBatchResponse, error) {
+	rsp, err := c.IssueVCBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueVCBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) IssueVCBatchWithResponse(ctx context.Context, body IssueVCBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*IssueVCBatchResponse, error) {
+	rsp, err := c.IssueVCBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueVCBatchResponse(rsp)
+}
+
+// RevokeIssuedVCsWithBodyWithResponse request with arbitrary body returning *RevokeIssuedVCsResponse
+func (c *ClientWithResponses) RevokeIssuedVCsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevokeIssuedVCsResponse, error) {
+	rsp, err := c.RevokeIssuedVCsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeIssuedVCsResponse(rsp)
+}
+
+func (c *ClientWithResponses) RevokeIssuedVCsWithResponse(ctx context.Context, body RevokeIssuedVCsJSONRequestBody, reqEditors ...RequestEditorFn) (*RevokeIssuedVCsResponse, error) {
+	rsp, err := c.RevokeIssuedVCs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeIssuedVCsResponse(rsp)
+}
+
+// SearchIssuedVCsWithResponse request returning *SearchIssuedVCsResponse
+func (c *ClientWithResponses) SearchIssuedVCsWithResponse(ctx context.Context, params *SearchIssuedVCsParams, reqEditors ...RequestEditorFn) (*SearchIssuedVCsResponse, error) {
+	rsp, err := c.SearchIssuedVCs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchIssuedVCsResponse(rsp)
+}
+
+// RevokeVCWithResponse request returning *RevokeVCResponse
+func (c *ClientWithResponses) RevokeVCWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RevokeVCResponse, error) {
+	rsp, err := c.RevokeVC(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeVCResponse(rsp)
+}
+
+// ListJSONLDContextsWithResponse request returning *ListJSONLDContextsResponse
+func (c *ClientWithResponses) ListJSONLDContextsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListJSONLDContextsResponse, error) {
+	rsp, err := c.ListJSONLDContexts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListJSONLDContextsResponse(rsp)
+}
+
+// CheckStoreCollectionWithBodyWithResponse request with arbitrary body returning *CheckStoreCollectionResponse
+func (c *ClientWithResponses) CheckStoreCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckStoreCollectionResponse, error) {
+	rsp, err := c.CheckStoreCollectionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckStoreCollectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CheckStoreCollectionWithResponse(ctx context.Context, body CheckStoreCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckStoreCollectionResponse, error) {
+	rsp, err := c.CheckStoreCollection(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckStoreCollectionResponse(rsp)
+}
+
+// ListStoreCollectionsWithResponse request returning *ListStoreCollectionsResponse
+func (c *ClientWithResponses) ListStoreCollectionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStoreCollectionsResponse, error) {
+	rsp, err := c.ListStoreCollections(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListStoreCollectionsResponse(rsp)
+}
+
+// ReindexStoreCollectionWithBodyWithResponse request with arbitrary body returning *ReindexStoreCollectionResponse
+func (c *ClientWithResponses) ReindexStoreCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReindexStoreCollectionResponse, error) {
+	rsp, err := c.ReindexStoreCollectionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReindexStoreCollectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReindexStoreCollectionWithResponse(ctx context.Context, body ReindexStoreCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*ReindexStoreCollectionResponse, error) {
+	rsp, err := c.ReindexStoreCollection(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReindexStoreCollectionResponse(rsp)
+}
+
+// ExplainTrustWithResponse request returning *ExplainTrustResponse
+func (c *ClientWithResponses) ExplainTrustWithResponse(ctx context.Context, params *ExplainTrustParams, reqEditors ...RequestEditorFn) (*ExplainTrustResponse, error) {
+	rsp, err := c.ExplainTrust(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExplainTrustResponse(rsp)
+}
+
+// ImportTrustListWithBodyWithResponse request with arbitrary body returning *ImportTrustListResponse
+func (c *ClientWithResponses) ImportTrustListWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTrustListResponse, error) {
+	rsp, err := c.ImportTrustListWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTrustListResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportTrustListWithResponse(ctx context.Context, body ImportTrustListJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportTrustListResponse, error) {
+	rsp, err := c.ImportTrustList(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTrustListResponse(rsp)
+}
+
+// VerifyVCWithBodyWithResponse request with arbitrary body returning *VerifyVCResponse
+func (c *ClientWithResponses) VerifyVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyVCResponse, error) {
+	rsp, err := c.VerifyVCWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyVCResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyVCWithResponse(ctx context.Context, body VerifyVCJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyVCResponse, error) {
+	rsp, err := c.VerifyVC(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyVCResponse(rsp)
+}
+
+// VerifyVPWithBodyWithResponse request with arbitrary body returning *VerifyVPResponse
+func (c *ClientWithResponses) VerifyVPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyVPResponse, error) {
+	rsp, err := c.VerifyVPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyVPResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyVPWithResponse(ctx context.Context, body VerifyVPJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyVPResponse, error) {
+	rsp, err := c.VerifyVP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyVPResponse(rsp)
+}
+
+// RefreshVCWithBodyWithResponse request with arbitrary body returning *RefreshVCResponse
+func (c *ClientWithResponses) RefreshVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshVCResponse, error) {
+	rsp, err := c.RefreshVCWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshVCResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshVCWithResponse(ctx context.Context, body RefreshVCJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshVCResponse, error) {
+	rsp, err := c.RefreshVC(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshVCResponse(rsp)
+}
+
+// CreateRefreshNonceWithResponse request returning *CreateRefreshNonceResponse
+func (c *ClientWithResponses) CreateRefreshNonceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateRefreshNonceResponse, error) {
+	rsp, err := c.CreateRefreshNonce(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRefreshNonceResponse(rsp)
+}
+
+// ParseSearchVerificationsResponse parses an HTTP response from a SearchVerificationsWithResponse call
+func ParseSearchVerificationsResponse(rsp *http.Response) (*SearchVerificationsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchVerificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditEntryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListConceptsResponse parses an HTTP response from a ListConceptsWithResponse call
+func ParseListConceptsResponse(rsp *http.Response) (*ListConceptsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListConceptsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ConceptConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddConceptResponse parses an HTTP response from a AddConceptWithResponse call
+func ParseAddConceptResponse(rsp *http.Response) (*AddConceptResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddConceptResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConceptConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveConceptResponse parses an HTTP response from a RemoveConceptWithResponse call
+func ParseRemoveConceptResponse(rsp *http.Response) (*RemoveConceptResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveConceptResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCreatePresentationSubmissionResponse parses an HTTP response from a CreatePresentationSubmissionWithResponse call
+func ParseCreatePresentationSubmissionResponse(rsp *http.Response) (*CreatePresentationSubmissionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePresentationSubmissionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PresentationSubmissionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchVCsResponse parses an HTTP response from a SearchVCsWithResponse call
+func ParseSearchVCsResponse(rsp *http.Response) (*SearchVCsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchVCsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchVCResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteHolderVCResponse parses an HTTP response from a DeleteHolderVCWithResponse call
+func ParseDeleteHolderVCResponse(rsp *http.Response) (*DeleteHolderVCResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteHolderVCResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetHolderVCResponse parses an HTTP response from a GetHolderVCWithResponse call
+func ParseGetHolderVCResponse(rsp *http.Response) (*GetHolderVCResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHolderVCResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VerifiableCredential
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateVPResponse parses an HTTP response from a CreateVPWithResponse call
+func ParseCreateVPResponse(rsp *http.Response) (*CreateVPResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateVPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VerifiablePresentationOrJWT
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHolderVCsResponse parses an HTTP response from a ListHolderVCsWithResponse call
+func ParseListHolderVCsResponse(rsp *http.Response) (*ListHolderVCsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHolderVCsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []VerifiableCredential
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListExpiringVCsResponse parses an HTTP response from a ListExpiringVCsWithResponse call
+func ParseListExpiringVCsResponse(rsp *http.Response) (*ListExpiringVCsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListExpiringVCsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchVCResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTemplatesResponse parses an HTTP response from a ListTemplatesWithResponse call
+func ParseListTemplatesResponse(rsp *http.Response) (*ListTemplatesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IssuanceTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddTemplateResponse parses an HTTP response from a AddTemplateWithResponse call
+func ParseAddTemplateResponse(rsp *http.Response) (*AddTemplateResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssuanceTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveTemplateResponse parses an HTTP response from a RemoveTemplateWithResponse call
+func ParseRemoveTemplateResponse(rsp *http.Response) (*RemoveTemplateResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListIssuedVCsResponse parses an HTTP response from a ListIssuedVCsWithResponse call
+func ParseListIssuedVCsResponse(rsp *http.Response) (*ListIssuedVCsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIssuedVCsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssuedVCList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseIssueVCResponse parses an HTTP response from a IssueVCWithResponse call
+func ParseIssueVCResponse(rsp *http.Response) (*IssueVCResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &IssueVCResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VerifiableCredentialOrJWT
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseIssueVCBatchResponse parses an HTTP response from a IssueVCBatchWithResponse call
+func ParseIssueVCBatchResponse(rsp *http.Response) (*IssueVCBatchResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &IssueVCBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssueVCBatchResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeIssuedVCsResponse parses an HTTP response from a RevokeIssuedVCsWithResponse call
+func ParseRevokeIssuedVCsResponse(rsp *http.Response) (*RevokeIssuedVCsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeIssuedVCsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevokeIssuedVCsResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
 
-	req.Header.Add("Content-Type", contentType)
+	return response, nil
+}
 
-	return req, nil
+// ParseSearchIssuedVCsResponse parses an HTTP response from a SearchIssuedVCsWithResponse call
+func ParseSearchIssuedVCsResponse(rsp *http.Response) (*SearchIssuedVCsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchIssuedVCsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// ParseRevokeVCResponse parses an HTTP response from a RevokeVCWithResponse call
+func ParseRevokeVCResponse(rsp *http.Response) (*RevokeVCResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+	response := &RevokeVCResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Revocation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return response, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// ParseListJSONLDContextsResponse parses an HTTP response from a ListJSONLDContextsWithResponse call
+func ParseListJSONLDContextsResponse(rsp *http.Response) (*ListJSONLDContextsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
+	response := &ListJSONLDContextsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []JSONLDContext
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
-		c.Server = newBaseURL.String()
-		return nil
+		response.JSON200 = &dest
+
 	}
+
+	return response, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// SearchVCs request with any body
-	SearchVCsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchVCsResponse, error)
+// ParseCheckStoreCollectionResponse parses an HTTP response from a CheckStoreCollectionWithResponse call
+func ParseCheckStoreCollectionResponse(rsp *http.Response) (*CheckStoreCollectionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateVP request with any body
-	CreateVPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVPResponse, error)
+	response := &CheckStoreCollectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	CreateVPWithResponse(ctx context.Context, body CreateVPJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVPResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StoreReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// IssueVC request with any body
-	IssueVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueVCResponse, error)
+	}
 
-	IssueVCWithResponse(ctx context.Context, body IssueVCJSONRequestBody, reqEditors ...RequestEditorFn) (*IssueVCResponse, error)
+	return response, nil
+}
 
-	// SearchIssuedVCs request
-	SearchIssuedVCsWithResponse(ctx context.Context, params *SearchIssuedVCsParams, reqEditors ...RequestEditorFn) (*SearchIssuedVCsResponse, error)
+// ParseListStoreCollectionsResponse parses an HTTP response from a ListStoreCollectionsWithResponse call
+func ParseListStoreCollectionsResponse(rsp *http.Response) (*ListStoreCollectionsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
 
-	// RevokeVC request
-	RevokeVCWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RevokeVCResponse, error)
+	response := &ListStoreCollectionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// VerifyVC request with any body
-	VerifyVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyVCResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	VerifyVCWithResponse(ctx context.Context, body VerifyVCJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyVCResponse, error)
-}
+	}
 
-type SearchVCsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SearchVCResults
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SearchVCsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseReindexStoreCollectionResponse parses an HTTP response from a ReindexStoreCollectionWithResponse call
+func ParseReindexStoreCollectionResponse(rsp *http.Response) (*ReindexStoreCollectionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchVCsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ReindexStoreCollectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StoreReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-type CreateVPResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VerifiablePresentation
+// ParseExplainTrustResponse parses an HTTP response from a ExplainTrustWithResponse call
+func ParseExplainTrustResponse(rsp *http.Response) (*ExplainTrustResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExplainTrustResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrustDecision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateVPResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseImportTrustListResponse parses an HTTP response from a ImportTrustListWithResponse call
+func ParseImportTrustListResponse(rsp *http.Response) (*ImportTrustListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &ImportTrustListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrustList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateVPResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseVerifyVCResponse parses an HTTP response from a VerifyVCWithResponse call
+func ParseVerifyVCResponse(rsp *http.Response) (*VerifyVCResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &VerifyVCResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VCVerificationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-type IssueVCResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VerifiableCredential
+// ParseVerifyVPResponse parses an HTTP response from a VerifyVPWithResponse call
+func ParseVerifyVPResponse(rsp *http.Response) (*VerifyVPResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyVPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VPVerificationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r IssueVCResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRefreshVCResponse parses an HTTP response from a RefreshVCWithResponse call
+func ParseRefreshVCResponse(rsp *http.Response) (*RefreshVCResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r IssueVCResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &RefreshVCResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type SearchIssuedVCsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VerifiableCredentialOrJWT
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r SearchIssuedVCsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchIssuedVCsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type RevokeVCResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Revocation
-}
+// ParseCreateRefreshNonceResponse parses an HTTP response from a CreateRefreshNonceWithResponse call
+func ParseCreateRefreshNonceResponse(rsp *http.Response) (*CreateRefreshNonceResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r RevokeVCResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &CreateRefreshNonceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RevokeVCResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RefreshNonce
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type VerifyVCResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VCVerificationResult
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r VerifyVCResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Searches the audit trail of credential verifications
+	// (GET /internal/vcr/v2/audit/verifications)
+	SearchVerifications(ctx echo.Context, params SearchVerificationsParams) error
+	// Lists the concept configs of the concept registry
+	// (GET /internal/vcr/v2/concept)
+	ListConcepts(ctx echo.Context) error
+	// Adds a concept config to the concept registry
+	// (POST /internal/vcr/v2/concept)
+	AddConcept(ctx echo.Context) error
+	// Removes a concept config from the concept registry
+	// (DELETE /internal/vcr/v2/concept/{credentialType})
+	RemoveConcept(ctx echo.Context, credentialType string) error
+	// Create a new Verifiable Presentation that satisfies a Presentation Definition.
+	// (POST /internal/vcr/v2/holder/presentation-submission)
+	CreatePresentationSubmission(ctx echo.Context) error
+	// Searches for verifiable credentials that could be used for different use-cases.
+	// (POST /internal/vcr/v2/holder/vc/search)
+	SearchVCs(ctx echo.Context) error
+	// Removes a credential from the wallet
+	// (DELETE /internal/vcr/v2/holder/vc/{id})
+	DeleteHolderVC(ctx echo.Context, id string) error
+	// Retrieves a credential from the wallet
+	// (GET /internal/vcr/v2/holder/vc/{id})
+	GetHolderVC(ctx echo.Context, id string) error
+	// Create a new Verifiable Presentation for a set of Verifiable Credentials.
+	// (POST /internal/vcr/v2/holder/vp)
+	CreateVP(ctx echo.Context) error
+	// Lists the credentials in the wallet of a DID managed by this node
+	// (GET /internal/vcr/v2/holder/{did}/vc)
+	ListHolderVCs(ctx echo.Context, did string, params ListHolderVCsParams) error
+	// Lists the credentials issued by this node that are about to expire
+	// (GET /internal/vcr/v2/issuer/expiring)
+	ListExpiringVCs(ctx echo.Context, params ListExpiringVCsParams) error
+	// Lists the issuance templates
+	// (GET /internal/vcr/v2/issuer/template)
+	ListTemplates(ctx echo.Context) error
+	// Adds an issuance template
+	// (POST /internal/vcr/v2/issuer/template)
+	AddTemplate(ctx echo.Context) error
+	// Removes an issuance template
+	// (DELETE /internal/vcr/v2/issuer/template/{name})
+	RemoveTemplate(ctx echo.Context, name string) error
+	// Lists the credentials issued by this node
+	// (GET /internal/vcr/v2/issuer/vc)
+	ListIssuedVCs(ctx echo.Context, params ListIssuedVCsParams) error
+	// Issues a new Verifiable Credential
+	// (POST /internal/vcr/v2/issuer/vc)
+	IssueVC(ctx echo.Context) error
+	// Issues multiple Verifiable Credentials of the same issuer
+	// (POST /internal/vcr/v2/issuer/vc/batch)
+	IssueVCBatch(ctx echo.Context) error
+	// Revokes the credentials issued by this node that match the filters
+	// (POST /internal/vcr/v2/issuer/vc/revoke)
+	RevokeIssuedVCs(ctx echo.Context) error
+	// Searches for verifiable credentials issued by this node which matches the search params
+	// (GET /internal/vcr/v2/issuer/vc/search)
+	SearchIssuedVCs(ctx echo.Context, params SearchIssuedVCsParams) error
+	// Revoke an issued credential
+	// (DELETE /internal/vcr/v2/issuer/vc/{id})
+	RevokeVC(ctx echo.Context, id string) error
+	// Lists the JSON-LD contexts the node can use
+	// (GET /internal/vcr/v2/jsonld/contexts)
+	ListJSONLDContexts(ctx echo.Context) error
+	// Checks the consistency of a collection of the VCR stores
+	// (POST /internal/vcr/v2/store/check)
+	CheckStoreCollection(ctx echo.Context) error
+	// Lists the collections of the VCR stores
+	// (GET /internal/vcr/v2/store/collections)
+	ListStoreCollections(ctx echo.Context) error
+	// Rebuilds the indices of a collection of the VCR stores
+	// (POST /internal/vcr/v2/store/reindex)
+	ReindexStoreCollection(ctx echo.Context) error
+	// Explains whether an issuer is trusted for a credential type
+	// (GET /internal/vcr/v2/verifier/trust)
+	ExplainTrust(ctx echo.Context, params ExplainTrustParams) error
+	// Imports a trust list published by a governance body
+	// (POST /internal/vcr/v2/verifier/trust/list)
+	ImportTrustList(ctx echo.Context) error
+	// Verifies a Verifiable Credential
+	// (POST /internal/vcr/v2/verifier/vc)
+	VerifyVC(ctx echo.Context) error
+	// Verifies a Verifiable Presentation
+	// (POST /internal/vcr/v2/verifier/vp)
+	VerifyVP(ctx echo.Context) error
+	// Refreshes a credential issued by this node at the request of its subject
+	// (POST /n2n/vcr/v2/refresh)
+	RefreshVC(ctx echo.Context) error
+	// Creates a nonce for refreshing a credential issued by this node
+	// (POST /n2n/vcr/v2/refresh/nonce)
+	CreateRefreshNonce(ctx echo.Context) error
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VerifyVCResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
 }
 
-// SearchVCsWithBodyWithResponse request with arbitrary body returning *SearchVCsResponse
-func (c *ClientWithResponses) SearchVCsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchVCsResponse, error) {
-	rsp, err := c.SearchVCsWithBody(ctx, contentType, body, reqEditors...)
+// SearchVerifications converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchVerifications(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchVerificationsParams
+	// ------------- Optional query parameter "credentialID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "credentialID", ctx.QueryParams(), &params.CredentialID)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter credentialID: %s", err))
 	}
-	return ParseSearchVCsResponse(rsp)
-}
 
-// CreateVPWithBodyWithResponse request with arbitrary body returning *CreateVPResponse
-func (c *ClientWithResponses) CreateVPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVPResponse, error) {
-	rsp, err := c.CreateVPWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "subject" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subject", ctx.QueryParams(), &params.Subject)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject: %s", err))
 	}
-	return ParseCreateVPResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateVPWithResponse(ctx context.Context, body CreateVPJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVPResponse, error) {
-	rsp, err := c.CreateVP(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
-	return ParseCreateVPResponse(rsp)
-}
 
-// IssueVCWithBodyWithResponse request with arbitrary body returning *IssueVCResponse
-func (c *ClientWithResponses) IssueVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueVCResponse, error) {
-	rsp, err := c.IssueVCWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
-	return ParseIssueVCResponse(rsp)
-}
 
-func (c *ClientWithResponses) IssueVCWithResponse(ctx context.Context, body IssueVCJSONRequestBody, reqEditors ...RequestEditorFn) (*IssueVCResponse, error) {
-	rsp, err := c.IssueVC(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	return ParseIssueVCResponse(rsp)
-}
 
-// SearchIssuedVCsWithResponse request returning *SearchIssuedVCsResponse
-func (c *ClientWithResponses) SearchIssuedVCsWithResponse(ctx context.Context, params *SearchIssuedVCsParams, reqEditors ...RequestEditorFn) (*SearchIssuedVCsResponse, error) {
-	rsp, err := c.SearchIssuedVCs(ctx, params, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return ParseSearchIssuedVCsResponse(rsp)
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchVerifications(ctx, params)
+	return err
 }
 
-// RevokeVCWithResponse request returning *RevokeVCResponse
-func (c *ClientWithResponses) RevokeVCWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RevokeVCResponse, error) {
-	rsp, err := c.RevokeVC(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseRevokeVCResponse(rsp)
+// ListConcepts converts echo context to params.
+func (w *ServerInterfaceWrapper) ListConcepts(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListConcepts(ctx)
+	return err
 }
 
-// VerifyVCWithBodyWithResponse request with arbitrary body returning *VerifyVCResponse
-func (c *ClientWithResponses) VerifyVCWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyVCResponse, error) {
-	rsp, err := c.VerifyVCWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseVerifyVCResponse(rsp)
+// AddConcept converts echo context to params.
+func (w *ServerInterfaceWrapper) AddConcept(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddConcept(ctx)
+	return err
 }
 
-func (c *ClientWithResponses) VerifyVCWithResponse(ctx context.Context, body VerifyVCJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyVCResponse, error) {
-	rsp, err := c.VerifyVC(ctx, body, reqEditors...)
+// RemoveConcept converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveConcept(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "credentialType" -------------
+	var credentialType string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "credentialType", runtime.ParamLocationPath, ctx.Param("credentialType"), &credentialType)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter credentialType: %s", err))
 	}
-	return ParseVerifyVCResponse(rsp)
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RemoveConcept(ctx, credentialType)
+	return err
 }
 
-// ParseSearchVCsResponse parses an HTTP response from a SearchVCsWithResponse call
-func ParseSearchVCsResponse(rsp *http.Response) (*SearchVCsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// CreatePresentationSubmission converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePresentationSubmission(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreatePresentationSubmission(ctx)
+	return err
+}
+
+// SearchVCs converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchVCs(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchVCs(ctx)
+	return err
+}
+
+// DeleteHolderVC converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteHolderVC(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	response := &SearchVCsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteHolderVC(ctx, id)
+	return err
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SearchVCResults
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// GetHolderVC converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHolderVC(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetHolderVC(ctx, id)
+	return err
+}
+
+// CreateVP converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateVP(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateVP(ctx)
+	return err
 }
 
-// ParseCreateVPResponse parses an HTTP response from a CreateVPWithResponse call
-func ParseCreateVPResponse(rsp *http.Response) (*CreateVPResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// ListHolderVCs converts echo context to params.
+func (w *ServerInterfaceWrapper) ListHolderVCs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "did" -------------
+	var did string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "did", runtime.ParamLocationPath, ctx.Param("did"), &did)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter did: %s", err))
 	}
 
-	response := &CreateVPResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListHolderVCsParams
+	// ------------- Optional query parameter "credentialType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "credentialType", ctx.QueryParams(), &params.CredentialType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter credentialType: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VerifiablePresentation
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "issuer" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "issuer", ctx.QueryParams(), &params.Issuer)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issuer: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListHolderVCs(ctx, did, params)
+	return err
 }
 
-// ParseIssueVCResponse parses an HTTP response from a IssueVCWithResponse call
-func ParseIssueVCResponse(rsp *http.Response) (*IssueVCResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// ListExpiringVCs converts echo context to params.
+func (w *ServerInterfaceWrapper) ListExpiringVCs(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListExpiringVCsParams
+	// ------------- Optional query parameter "within" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "within", ctx.QueryParams(), &params.Within)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter within: %s", err))
 	}
 
-	response := &IssueVCResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListExpiringVCs(ctx, params)
+	return err
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VerifiableCredential
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ListTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTemplates(ctx echo.Context) error {
+	var err error
 
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListTemplates(ctx)
+	return err
+}
 
-	return response, nil
+// AddTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) AddTemplate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddTemplate(ctx)
+	return err
 }
 
-// ParseSearchIssuedVCsResponse parses an HTTP response from a SearchIssuedVCsWithResponse call
-func ParseSearchIssuedVCsResponse(rsp *http.Response) (*SearchIssuedVCsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// RemoveTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	response := &SearchIssuedVCsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RemoveTemplate(ctx, name)
+	return err
+}
+
+// ListIssuedVCs converts echo context to params.
+func (w *ServerInterfaceWrapper) ListIssuedVCs(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListIssuedVCsParams
+	// ------------- Optional query parameter "issuer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "issuer", ctx.QueryParams(), &params.Issuer)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issuer: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "subject" -------------
 
-// ParseRevokeVCResponse parses an HTTP response from a RevokeVCWithResponse call
-func ParseRevokeVCResponse(rsp *http.Response) (*RevokeVCResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+	err = runtime.BindQueryParameter("form", true, false, "subject", ctx.QueryParams(), &params.Subject)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject: %s", err))
 	}
 
-	response := &RevokeVCResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "credentialType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "credentialType", ctx.QueryParams(), &params.CredentialType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter credentialType: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Revocation
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "issuedFrom" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "issuedFrom", ctx.QueryParams(), &params.IssuedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issuedFrom: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "issuedUntil" -------------
 
-// ParseVerifyVCResponse parses an HTTP response from a VerifyVCWithResponse call
-func ParseVerifyVCResponse(rsp *http.Response) (*VerifyVCResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+	err = runtime.BindQueryParameter("form", true, false, "issuedUntil", ctx.QueryParams(), &params.IssuedUntil)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issuedUntil: %s", err))
 	}
 
-	response := &VerifyVCResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VCVerificationResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "offset" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Searches for verifiable credentials that could be used for different use-cases.
-	// (POST /internal/vcr/v2/holder/vc/search)
-	SearchVCs(ctx echo.Context) error
-	// Create a new Verifiable Presentation for a set of Verifiable Credentials.
-	// (POST /internal/vcr/v2/holder/vp)
-	CreateVP(ctx echo.Context) error
-	// Issues a new Verifiable Credential
-	// (POST /internal/vcr/v2/issuer/vc)
-	IssueVC(ctx echo.Context) error
-	// Searches for verifiable credentials issued by this node which matches the search params
-	// (GET /internal/vcr/v2/issuer/vc/search)
-	SearchIssuedVCs(ctx echo.Context, params SearchIssuedVCsParams) error
-	// Revoke an issued credential
-	// (DELETE /internal/vcr/v2/issuer/vc/{id})
-	RevokeVC(ctx echo.Context, id string) error
-	// Verifies a Verifiable Credential
-	// (POST /internal/vcr/v2/verifier/vc)
-	VerifyVC(ctx echo.Context) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListIssuedVCs(ctx, params)
+	return err
 }
 
-// SearchVCs converts echo context to params.
-func (w *ServerInterfaceWrapper) SearchVCs(ctx echo.Context) error {
+// IssueVC converts echo context to params.
+func (w *ServerInterfaceWrapper) IssueVC(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.SearchVCs(ctx)
+	err = w.Handler.IssueVC(ctx)
 	return err
 }
 
-// CreateVP converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateVP(ctx echo.Context) error {
+// IssueVCBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) IssueVCBatch(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.CreateVP(ctx)
+	err = w.Handler.IssueVCBatch(ctx)
 	return err
 }
 
-// IssueVC converts echo context to params.
-func (w *ServerInterfaceWrapper) IssueVC(ctx echo.Context) error {
+// RevokeIssuedVCs converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeIssuedVCs(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.IssueVC(ctx)
+	err = w.Handler.RevokeIssuedVCs(ctx)
 	return err
 }
 
@@ -1213,6 +5215,76 @@ func (w *ServerInterfaceWrapper) RevokeVC(ctx echo.Context) error {
 	return err
 }
 
+// ListJSONLDContexts converts echo context to params.
+func (w *ServerInterfaceWrapper) ListJSONLDContexts(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListJSONLDContexts(ctx)
+	return err
+}
+
+// CheckStoreCollection converts echo context to params.
+func (w *ServerInterfaceWrapper) CheckStoreCollection(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CheckStoreCollection(ctx)
+	return err
+}
+
+// ListStoreCollections converts echo context to params.
+func (w *ServerInterfaceWrapper) ListStoreCollections(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListStoreCollections(ctx)
+	return err
+}
+
+// ReindexStoreCollection converts echo context to params.
+func (w *ServerInterfaceWrapper) ReindexStoreCollection(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ReindexStoreCollection(ctx)
+	return err
+}
+
+// ExplainTrust converts echo context to params.
+func (w *ServerInterfaceWrapper) ExplainTrust(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExplainTrustParams
+	// ------------- Required query parameter "credentialType" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "credentialType", ctx.QueryParams(), &params.CredentialType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter credentialType: %s", err))
+	}
+
+	// ------------- Required query parameter "issuer" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "issuer", ctx.QueryParams(), &params.Issuer)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issuer: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ExplainTrust(ctx, params)
+	return err
+}
+
+// ImportTrustList converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportTrustList(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ImportTrustList(ctx)
+	return err
+}
+
 // VerifyVC converts echo context to params.
 func (w *ServerInterfaceWrapper) VerifyVC(ctx echo.Context) error {
 	var err error
@@ -1222,6 +5294,33 @@ func (w *ServerInterfaceWrapper) VerifyVC(ctx echo.Context) error {
 	return err
 }
 
+// VerifyVP converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyVP(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.VerifyVP(ctx)
+	return err
+}
+
+// RefreshVC converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshVC(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RefreshVC(ctx)
+	return err
+}
+
+// CreateRefreshNonce converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateRefreshNonce(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateRefreshNonce(ctx)
+	return err
+}
+
 // PATCH: This template file was taken from pkg/codegen/templates/register.tmpl
 
 // This is a simple interface which specifies echo.Route addition functions which
@@ -1254,18 +5353,78 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	// PATCH: This alteration wraps the call to the implementation in a function that sets the "OperationId" context parameter,
 	// so it can be used in error reporting middleware.
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/audit/verifications", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("SearchVerifications", context)
+		return wrapper.SearchVerifications(context)
+	})
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/concept", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ListConcepts", context)
+		return wrapper.ListConcepts(context)
+	})
+	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/concept", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("AddConcept", context)
+		return wrapper.AddConcept(context)
+	})
+	router.Add(http.MethodDelete, baseURL+"/internal/vcr/v2/concept/:credentialType", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("RemoveConcept", context)
+		return wrapper.RemoveConcept(context)
+	})
+	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/holder/presentation-submission", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("CreatePresentationSubmission", context)
+		return wrapper.CreatePresentationSubmission(context)
+	})
 	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/holder/vc/search", func(context echo.Context) error {
 		si.(Preprocessor).Preprocess("SearchVCs", context)
 		return wrapper.SearchVCs(context)
 	})
+	router.Add(http.MethodDelete, baseURL+"/internal/vcr/v2/holder/vc/:id", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("DeleteHolderVC", context)
+		return wrapper.DeleteHolderVC(context)
+	})
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/holder/vc/:id", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("GetHolderVC", context)
+		return wrapper.GetHolderVC(context)
+	})
 	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/holder/vp", func(context echo.Context) error {
 		si.(Preprocessor).Preprocess("CreateVP", context)
 		return wrapper.CreateVP(context)
 	})
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/holder/:did/vc", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ListHolderVCs", context)
+		return wrapper.ListHolderVCs(context)
+	})
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/issuer/expiring", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ListExpiringVCs", context)
+		return wrapper.ListExpiringVCs(context)
+	})
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/issuer/template", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ListTemplates", context)
+		return wrapper.ListTemplates(context)
+	})
+	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/issuer/template", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("AddTemplate", context)
+		return wrapper.AddTemplate(context)
+	})
+	router.Add(http.MethodDelete, baseURL+"/internal/vcr/v2/issuer/template/:name", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("RemoveTemplate", context)
+		return wrapper.RemoveTemplate(context)
+	})
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/issuer/vc", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ListIssuedVCs", context)
+		return wrapper.ListIssuedVCs(context)
+	})
 	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/issuer/vc", func(context echo.Context) error {
 		si.(Preprocessor).Preprocess("IssueVC", context)
 		return wrapper.IssueVC(context)
 	})
+	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/issuer/vc/batch", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("IssueVCBatch", context)
+		return wrapper.IssueVCBatch(context)
+	})
+	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/issuer/vc/revoke", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("RevokeIssuedVCs", context)
+		return wrapper.RevokeIssuedVCs(context)
+	})
 	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/issuer/vc/search", func(context echo.Context) error {
 		si.(Preprocessor).Preprocess("SearchIssuedVCs", context)
 		return wrapper.SearchIssuedVCs(context)
@@ -1274,9 +5433,45 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		si.(Preprocessor).Preprocess("RevokeVC", context)
 		return wrapper.RevokeVC(context)
 	})
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/jsonld/contexts", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ListJSONLDContexts", context)
+		return wrapper.ListJSONLDContexts(context)
+	})
+	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/store/check", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("CheckStoreCollection", context)
+		return wrapper.CheckStoreCollection(context)
+	})
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/store/collections", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ListStoreCollections", context)
+		return wrapper.ListStoreCollections(context)
+	})
+	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/store/reindex", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ReindexStoreCollection", context)
+		return wrapper.ReindexStoreCollection(context)
+	})
+	router.Add(http.MethodGet, baseURL+"/internal/vcr/v2/verifier/trust", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ExplainTrust", context)
+		return wrapper.ExplainTrust(context)
+	})
+	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/verifier/trust/list", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ImportTrustList", context)
+		return wrapper.ImportTrustList(context)
+	})
 	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/verifier/vc", func(context echo.Context) error {
 		si.(Preprocessor).Preprocess("VerifyVC", context)
 		return wrapper.VerifyVC(context)
 	})
+	router.Add(http.MethodPost, baseURL+"/internal/vcr/v2/verifier/vp", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("VerifyVP", context)
+		return wrapper.VerifyVP(context)
+	})
+	router.Add(http.MethodPost, baseURL+"/n2n/vcr/v2/refresh", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("RefreshVC", context)
+		return wrapper.RefreshVC(context)
+	})
+	router.Add(http.MethodPost, baseURL+"/n2n/vcr/v2/refresh/nonce", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("CreateRefreshNonce", context)
+		return wrapper.CreateRefreshNonce(context)
+	})
 
 }
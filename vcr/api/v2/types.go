@@ -21,14 +21,60 @@ package v2
 
 import (
 	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/pe"
+	"github.com/nuts-foundation/nuts-node/vcr/signature"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+	"github.com/nuts-foundation/nuts-node/vcr/trust"
 )
 
 // VerifiableCredential is an alias to use from within the API
 type VerifiableCredential = vc.VerifiableCredential
 
+// VerifiablePresentation is an alias to use from within the API
+type VerifiablePresentation = vc.VerifiablePresentation
+
+// VerifiableCredentialOrJWT is a credential in JSON form (VerifiableCredential) or a credential in JWT format as compact JWT (string)
+type VerifiableCredentialOrJWT = interface{}
+
+// VerifiablePresentationOrJWT is a presentation in JSON form (VerifiablePresentation) or a presentation in JWT format as compact JWT (string)
+type VerifiablePresentationOrJWT = interface{}
+
 // CredentialSubject is an alias to use from within the API
 type CredentialSubject = interface{}
 
 // Revocation is an alias to use from within the API
 type Revocation = credential.Revocation
+
+// PresentationDefinition is an alias to use from within the API
+type PresentationDefinition = pe.PresentationDefinition
+
+// PresentationSubmission is an alias to use from within the API
+type PresentationSubmission = pe.PresentationSubmission
+
+// TrustList is an alias to use from within the API
+type TrustList = trust.TrustList
+
+// TrustPolicy is an alias to use from within the API
+type TrustPolicy = trust.Policy
+
+// ConceptConfig is an alias to use from within the API
+type ConceptConfig = concept.Config
+
+// JSONLDContext is an alias to use from within the API
+type JSONLDContext = signature.ContextInfo
+
+// StoreReport is an alias to use from within the API
+type StoreReport = storage.Report
+
+// StoreProblem is an alias to use from within the API
+type StoreProblem = storage.Problem
+
+// AuditEntry is an alias to use from within the API
+type AuditEntry = audit.Entry
+
+// IssuanceTemplate is an alias to use from within the API
+type IssuanceTemplate = issuer.Template
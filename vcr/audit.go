@@ -0,0 +1,73 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"context"
+	"time"
+
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+)
+
+// auditPruneInterval specifies how often the entries that exceeded the retention period are removed from the audit trail.
+const auditPruneInterval = time.Hour
+
+func (c *vcr) RecordVerification(credential vc.VerifiableCredential, caller audit.Caller, checks []audit.Check) {
+	if c.auditLog == nil {
+		return
+	}
+	entry := audit.NewEntry(credential, caller, checks, timeFunc())
+	if err := c.auditLog.Record(entry); err != nil {
+		log.Logger().Errorf("Unable to record verification of credential (id=%s): %v", entry.CredentialID, err)
+	}
+}
+
+func (c *vcr) SearchVerifications(query audit.Query, offset int, limit int) ([]audit.Entry, int, error) {
+	if offset < 0 || limit < 0 {
+		return nil, 0, core.InvalidInputError("offset and limit must not be negative")
+	}
+	if c.auditLog == nil {
+		return []audit.Entry{}, 0, nil
+	}
+	return c.auditLog.Search(query, offset, limit)
+}
+
+// pruneAuditLog removes the entries that exceeded the retention period from the audit trail immediately and then every interval,
+// until the context is cancelled.
+func (c *vcr) pruneAuditLog(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		count, err := c.auditLog.Prune(timeFunc().Add(-c.config.Audit.Retention))
+		if err != nil {
+			log.Logger().Errorf("Unable to prune audit trail of credential verifications: %v", err)
+		} else if count > 0 {
+			log.Logger().Infof("Removed %d entries from the audit trail of credential verifications", count)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,166 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+)
+
+// timestampLayout is the layout of the timestamps of stored entries. It has a fixed width, so timestamps sort lexicographically.
+const timestampLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// Names of the checks performed on a credential.
+const (
+	// ContentCheck checks whether the credential has the required fields and values for its type.
+	ContentCheck = "content"
+	// RevocationCheck checks whether the credential hasn't been revoked.
+	RevocationCheck = "revocation"
+	// IssuerCheck checks whether the DID document of the issuer was active at the moment of validation.
+	IssuerCheck = "issuer"
+	// TrustCheck checks whether the issuer is trusted for the credential type.
+	TrustCheck = "trust"
+	// ValidityCheck checks whether the moment of validation lies between the issuance and expiration date of the credential.
+	ValidityCheck = "validity"
+	// SignatureCheck checks the proof of the credential.
+	SignatureCheck = "signature"
+	// PresentationCheck checks the presentation that contained the credential.
+	PresentationCheck = "presentation"
+	// KeyBindingCheck checks whether a presented credential in SD-JWT format is bound to the presentation by its subject.
+	KeyBindingCheck = "binding"
+	// CredentialCheck is the outcome of verifying the credential as a whole, when the separate checks aren't known.
+	CredentialCheck = "credential"
+)
+
+// Caller identifies the part of the node that verified a credential, and why.
+type Caller struct {
+	// Name identifies the caller, e.g. the API operation or the flow that verified the credential.
+	Name string `json:"name"`
+	// Details contains information about the context of the verification, e.g. the parties of an access token request.
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Check is the outcome of a single check performed on a credential.
+type Check struct {
+	// Name is the name of the check, e.g. 'signature'.
+	Name string `json:"name"`
+	// Passed indicates whether the credential passed the check.
+	Passed bool `json:"passed"`
+	// Message contains the reason the check failed.
+	Message string `json:"message,omitempty"`
+}
+
+// NewCheck returns the outcome of a check, which passed if err is nil.
+func NewCheck(name string, err error) Check {
+	if err != nil {
+		return Check{Name: name, Passed: false, Message: err.Error()}
+	}
+	return Check{Name: name, Passed: true}
+}
+
+// Entry records the verification of a credential.
+type Entry struct {
+	// ID uniquely identifies the entry.
+	ID string `json:"id"`
+	// Timestamp is the moment the credential was verified.
+	Timestamp time.Time `json:"timestamp"`
+	// CredentialID is the ID of the verified credential.
+	CredentialID string `json:"credentialID"`
+	// Issuer is the issuer of the verified credential.
+	Issuer string `json:"issuer"`
+	// Subject is the ID of the subject of the verified credential, if it has one.
+	Subject string `json:"subject,omitempty"`
+	// Caller identifies who verified the credential.
+	Caller Caller `json:"caller"`
+	// Checks contains the checks that were performed, in order, with their outcome.
+	Checks []Check `json:"checks"`
+	// Valid indicates whether the credential passed all checks.
+	Valid bool `json:"valid"`
+}
+
+// MarshalJSON marshals the entry with a fixed width timestamp.
+func (e Entry) MarshalJSON() ([]byte, error) {
+	type alias Entry
+	return json.Marshal(struct {
+		alias
+		Timestamp string `json:"timestamp"`
+	}{alias: alias(e), Timestamp: formatTimestamp(e.Timestamp)})
+}
+
+// NewEntry creates an entry for the verification of the credential by the caller at the given moment.
+// The credential is valid if it passed all checks.
+func NewEntry(credential vc.VerifiableCredential, caller Caller, checks []Check, timestamp time.Time) Entry {
+	entry := Entry{
+		ID:        uuid.NewString(),
+		Timestamp: timestamp,
+		Issuer:    credential.Issuer.String(),
+		Caller:    caller,
+		Checks:    checks,
+		Valid:     true,
+	}
+	if credential.ID != nil {
+		entry.CredentialID = credential.ID.String()
+	}
+	if len(credential.CredentialSubject) > 0 {
+		if subject, ok := credential.CredentialSubject[0].(map[string]interface{}); ok {
+			entry.Subject, _ = subject["id"].(string)
+		}
+	}
+	for _, check := range checks {
+		entry.Valid = entry.Valid && check.Passed
+	}
+	return entry
+}
+
+// Query selects entries of the log. Empty fields don't restrict the result.
+type Query struct {
+	// CredentialID selects the verifications of the credential with this ID.
+	CredentialID string
+	// Subject selects the verifications of credentials issued to this subject.
+	Subject string
+	// From selects the verifications at or after this moment.
+	From *time.Time
+	// To selects the verifications before this moment.
+	To *time.Time
+}
+
+// Log is an append-only log of credential verifications.
+type Log interface {
+	// Record appends the entry to the log.
+	Record(entry Entry) error
+	// Search returns the page of entries matching the query, ordered by timestamp, together with the total number of matching entries.
+	// The page starts at offset and contains at most limit entries, all remaining entries if limit is 0.
+	Search(query Query, offset int, limit int) ([]Entry, int, error)
+	// Prune removes the entries recorded before the given moment, to enforce the retention period.
+	// It returns the number of removed entries.
+	Prune(before time.Time) (int, error)
+	// Collection returns the collection of the log with its indices, so it can be checked and reindexed.
+	Collection() storage.Collection
+	io.Closer
+}
+
+func formatTimestamp(timestamp time.Time) string {
+	return timestamp.UTC().Format(timestampLayout)
+}
@@ -0,0 +1,76 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEntry(t *testing.T) {
+	id := ssi.MustParseURI("did:nuts:issuer#1")
+	credential := vc.VerifiableCredential{
+		ID:                &id,
+		Issuer:            ssi.MustParseURI("did:nuts:issuer"),
+		CredentialSubject: []interface{}{map[string]interface{}{"id": "did:nuts:subject"}},
+	}
+	caller := Caller{Name: "test"}
+	timestamp := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("valid", func(t *testing.T) {
+		entry := NewEntry(credential, caller, []Check{NewCheck(SignatureCheck, nil)}, timestamp)
+
+		assert.NotEmpty(t, entry.ID)
+		assert.Equal(t, "did:nuts:issuer#1", entry.CredentialID)
+		assert.Equal(t, "did:nuts:issuer", entry.Issuer)
+		assert.Equal(t, "did:nuts:subject", entry.Subject)
+		assert.Equal(t, caller, entry.Caller)
+		assert.Equal(t, timestamp, entry.Timestamp)
+		assert.True(t, entry.Valid)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		checks := []Check{NewCheck(RevocationCheck, nil), NewCheck(SignatureCheck, errors.New("invalid signature"))}
+
+		entry := NewEntry(credential, caller, checks, timestamp)
+
+		assert.False(t, entry.Valid)
+		assert.Equal(t, Check{Name: SignatureCheck, Passed: false, Message: "invalid signature"}, entry.Checks[1])
+	})
+}
+
+func TestEntry_MarshalJSON(t *testing.T) {
+	entry := Entry{ID: "1", Timestamp: time.Date(2022, 10, 1, 14, 0, 0, 500, time.FixedZone("CEST", 2*60*60))}
+
+	data, err := json.Marshal(entry)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Contains(t, string(data), `"timestamp":"2022-10-01T12:00:00.000000500Z"`)
+	unmarshalled := Entry{}
+	_ = json.Unmarshal(data, &unmarshalled)
+	assert.True(t, entry.Timestamp.Equal(unmarshalled.Timestamp))
+}
@@ -0,0 +1,160 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+)
+
+const (
+	idField           = "id"
+	timestampField    = "timestamp"
+	credentialIDField = "credentialID"
+	subjectField      = "subject"
+)
+
+// maxTimestamp is used as end of the time range when a query has no end.
+var maxTimestamp = time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)
+
+// leiaLog implements the Log interface. It is a simple and fast JSON store.
+// Note: It can not be used in a clustered setup.
+type leiaLog struct {
+	// verifications is a leia collection containing the entries of the log
+	verifications leia.Collection
+	store         leia.Store
+}
+
+// NewLeiaLog creates a new instance of leiaLog which implements the Log interface.
+func NewLeiaLog(dbPath string) (Log, error) {
+	store, err := leia.NewStore(dbPath, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leiaLog: %w", err)
+	}
+	log := &leiaLog{
+		verifications: store.Collection("verifications"),
+		store:         store,
+	}
+	for _, index := range logIndices() {
+		if err := log.verifications.AddIndex(index.Index); err != nil {
+			return nil, err
+		}
+	}
+	return log, nil
+}
+
+func (l leiaLog) Record(entry Entry) error {
+	entryAsBytes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return l.verifications.Add([]leia.Document{leia.DocumentFromBytes(entryAsBytes)})
+}
+
+func (l leiaLog) Search(query Query, offset int, limit int) ([]Entry, int, error) {
+	from := time.Time{}
+	if query.From != nil {
+		from = *query.From
+	}
+	to := maxTimestamp
+	if query.To != nil {
+		// the end of a range is inclusive
+		to = query.To.Add(-time.Nanosecond)
+	}
+	// the time range is added last, so the indices on credential ID and subject can be used for it as well
+	var parts []leia.QueryPart
+	if query.CredentialID != "" {
+		parts = append(parts, leia.Eq(credentialIDField, query.CredentialID))
+	}
+	if query.Subject != "" {
+		parts = append(parts, leia.Eq(subjectField, query.Subject))
+	}
+	parts = append(parts, leia.Range(timestampField, formatTimestamp(from), formatTimestamp(to)))
+	leiaQuery := leia.New(parts[0])
+	for _, part := range parts[1:] {
+		leiaQuery = leiaQuery.And(part)
+	}
+
+	// every index ends with the timestamp, so the matching entries are iterated in order of their timestamp.
+	// Only the entries of the page are parsed, the others are just counted.
+	result := make([]Entry, 0)
+	total := 0
+	err := l.verifications.Iterate(leiaQuery, func(_ leia.Reference, value []byte) error {
+		total++
+		if total <= offset || (limit > 0 && len(result) >= limit) {
+			return nil
+		}
+		var entry Entry
+		if err := json.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		result = append(result, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to search verification log: %w", err)
+	}
+	return result, total, nil
+}
+
+func (l leiaLog) Prune(before time.Time) (int, error) {
+	query := leia.New(leia.Range(timestampField, formatTimestamp(time.Time{}), formatTimestamp(before.Add(-time.Nanosecond))))
+	docs, err := l.verifications.Find(context.Background(), query)
+	if err != nil {
+		return 0, fmt.Errorf("unable to find expired entries of verification log: %w", err)
+	}
+	for i, doc := range docs {
+		if err := l.verifications.Delete(doc); err != nil {
+			return i, fmt.Errorf("unable to remove expired entry of verification log: %w", err)
+		}
+	}
+	return len(docs), nil
+}
+
+func (l leiaLog) Collection() storage.Collection {
+	return storage.Collection{
+		Name:       "verifications",
+		Collection: l.verifications,
+		Indices:    logIndices(),
+		IDField:    idField,
+	}
+}
+
+func (l leiaLog) Close() error {
+	return l.store.Close()
+}
+
+// logIndices returns the indices of the log. Every index ends with the timestamp, so entries can be selected by time range.
+// leia selects the index that matches the most leading parts of a query, so a query on credential ID or subject uses the index on it.
+func logIndices() []storage.Index {
+	return []storage.Index{
+		// Index used for selecting the verifications of a credential
+		storage.NewIndex("verificationsByCredential", credentialIDField, timestampField),
+		// Index used for selecting the verifications of credentials issued to a subject
+		storage.NewIndex("verificationsBySubject", subjectField, timestampField),
+		// Index used for selecting entries by time range, and for pruning
+		storage.NewIndex("verificationsByTimestamp", timestampField),
+	}
+}
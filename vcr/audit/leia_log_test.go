@@ -0,0 +1,155 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package audit
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLog(t *testing.T) Log {
+	log, err := NewLeiaLog(path.Join(io.TestDirectory(t), "audit.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = log.Close()
+	})
+	return log
+}
+
+func testEntry(credentialID string, subject string, timestamp time.Time) Entry {
+	return Entry{
+		ID:           credentialID + "@" + timestamp.String(),
+		Timestamp:    timestamp,
+		CredentialID: credentialID,
+		Issuer:       "did:nuts:issuer",
+		Subject:      subject,
+		Caller:       Caller{Name: "test"},
+		Checks:       []Check{{Name: SignatureCheck, Passed: true}},
+		Valid:        true,
+	}
+}
+
+func TestLeiaLog_Search(t *testing.T) {
+	t1 := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Millisecond)
+	t3 := t1.Add(time.Hour)
+	log := newTestLog(t)
+	entries := []Entry{
+		testEntry("did:nuts:issuer#1", "did:nuts:a", t3),
+		testEntry("did:nuts:issuer#1", "did:nuts:a", t1),
+		testEntry("did:nuts:issuer#2", "did:nuts:b", t2),
+	}
+	for _, entry := range entries {
+		if !assert.NoError(t, log.Record(entry)) {
+			return
+		}
+	}
+	ids := func(entries []Entry) []string {
+		result := make([]string, len(entries))
+		for i, entry := range entries {
+			result[i] = entry.ID
+		}
+		return result
+	}
+
+	t.Run("all, ordered by timestamp", func(t *testing.T) {
+		result, _, err := log.Search(Query{}, 0, 0)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{entries[1].ID, entries[2].ID, entries[0].ID}, ids(result))
+		assert.Equal(t, entries[1].Checks, result[0].Checks)
+		assert.True(t, t1.Equal(result[0].Timestamp))
+	})
+	t.Run("by credential", func(t *testing.T) {
+		result, _, err := log.Search(Query{CredentialID: "did:nuts:issuer#1"}, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{entries[1].ID, entries[0].ID}, ids(result))
+	})
+	t.Run("by subject", func(t *testing.T) {
+		result, _, err := log.Search(Query{Subject: "did:nuts:b"}, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{entries[2].ID}, ids(result))
+	})
+	t.Run("by time range", func(t *testing.T) {
+		result, _, err := log.Search(Query{From: &t2, To: &t3}, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{entries[2].ID}, ids(result))
+	})
+	t.Run("by credential and time range", func(t *testing.T) {
+		result, _, err := log.Search(Query{CredentialID: "did:nuts:issuer#1", From: &t2}, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{entries[0].ID}, ids(result))
+	})
+	t.Run("by credential and subject", func(t *testing.T) {
+		result, _, err := log.Search(Query{CredentialID: "did:nuts:issuer#1", Subject: "did:nuts:b"}, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+	t.Run("page", func(t *testing.T) {
+		result, total, err := log.Search(Query{}, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Equal(t, []string{entries[2].ID}, ids(result))
+	})
+	t.Run("page after the last entry", func(t *testing.T) {
+		result, total, err := log.Search(Query{CredentialID: "did:nuts:issuer#1"}, 5, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Empty(t, result)
+	})
+}
+
+func TestLeiaLog_Prune(t *testing.T) {
+	t1 := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	log := newTestLog(t)
+	_ = log.Record(testEntry("did:nuts:issuer#1", "did:nuts:a", t1))
+	_ = log.Record(testEntry("did:nuts:issuer#1", "did:nuts:a", t2))
+
+	count, err := log.Prune(t2)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 1, count)
+	result, _, _ := log.Search(Query{CredentialID: "did:nuts:issuer#1"}, 0, 0)
+	if assert.Len(t, result, 1) {
+		assert.True(t, t2.Equal(result[0].Timestamp))
+	}
+	report, err := storage.Check(log.Collection())
+	assert.NoError(t, err)
+	assert.Empty(t, report.Problems)
+}
@@ -0,0 +1,103 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVcr_RecordVerification(t *testing.T) {
+	id := ssi.MustParseURI("did:nuts:issuer#1")
+	credential := vc.VerifiableCredential{ID: &id, Issuer: ssi.MustParseURI("did:nuts:issuer")}
+	checks := []audit.Check{{Name: audit.SignatureCheck, Passed: true}}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		ctx.vcr.RecordVerification(credential, audit.Caller{Name: "test"}, checks)
+
+		entries, _, err := ctx.vcr.SearchVerifications(audit.Query{CredentialID: id.String()}, 0, 0)
+		if !assert.NoError(t, err) {
+			return
+		}
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, "did:nuts:issuer", entries[0].Issuer)
+			assert.Equal(t, checks, entries[0].Checks)
+			assert.True(t, entries[0].Valid)
+		}
+	})
+	t.Run("disabled", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.auditLog.Close()
+		ctx.vcr.auditLog = nil
+
+		ctx.vcr.RecordVerification(credential, audit.Caller{Name: "test"}, checks)
+
+		entries, _, err := ctx.vcr.SearchVerifications(audit.Query{}, 0, 0)
+		assert.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestVcr_SearchVerifications(t *testing.T) {
+	ctx := newMockContext(t)
+
+	_, _, err := ctx.vcr.SearchVerifications(audit.Query{}, -1, 0)
+
+	assert.EqualError(t, err, "offset and limit must not be negative")
+	assert.ErrorIs(t, err, core.InvalidInputError(""))
+}
+
+func TestVcr_pruneAuditLog(t *testing.T) {
+	id := ssi.MustParseURI("did:nuts:issuer#1")
+	credential := vc.VerifiableCredential{ID: &id, Issuer: ssi.MustParseURI("did:nuts:issuer")}
+	now := time.Now()
+	timeFunc = func() time.Time {
+		return now.Add(-2 * time.Hour)
+	}
+	defer func() {
+		timeFunc = time.Now
+	}()
+	ctx := newMockContext(t)
+	ctx.vcr.config.Audit.Retention = time.Hour
+	ctx.vcr.RecordVerification(credential, audit.Caller{Name: "old"}, nil)
+	timeFunc = func() time.Time {
+		return now
+	}
+	ctx.vcr.RecordVerification(credential, audit.Caller{Name: "recent"}, nil)
+	pruneCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the entries are pruned once before the cancelled context is noticed
+	ctx.vcr.pruneAuditLog(pruneCtx, time.Hour)
+
+	entries, _, _ := ctx.vcr.SearchVerifications(audit.Query{}, 0, 0)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "recent", entries[0].Caller.Name)
+	}
+}
@@ -19,15 +19,20 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/nuts-foundation/nuts-node/vcr"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/nuts-foundation/nuts-node/core"
 	api "github.com/nuts-foundation/nuts-node/vcr/api/v1"
+	apiv2 "github.com/nuts-foundation/nuts-node/vcr/api/v2"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
 )
 
 // FlagSet contains flags relevant for VCR
@@ -36,6 +41,31 @@ func FlagSet() *pflag.FlagSet {
 	flagSet := pflag.NewFlagSet("vcr", pflag.ContinueOnError)
 	flagSet.Bool("vcr.overrideissueallpublic", defs.OverrideIssueAllPublic, "Overrides the \"Public\" property of a credential when issuing credentials: "+
 		"if set to true, all issued credentials are published as public credentials, regardless of whether they're actually marked as public.")
+	flagSet.Bool("vcr.batchtransactions", defs.BatchTransactions, "If set to true, credentials issued in a batch are combined in transactions of the 'application/vc+json;type=batch' payload type. "+
+		"Nodes that don't support it ignore these transactions, so only enable it when all nodes of the network do. If false, every credential gets its own transaction.")
+	flagSet.Duration("vcr.expiry.interval", defs.Expiry.Interval, "Interval at which issued credentials are checked for their expiry, such as '1h'. If 0, they aren't checked. "+
+		"Refer to Golang's 'time.Duration' syntax for a more elaborate description of the syntax.")
+	flagSet.Duration("vcr.expiry.window", defs.Expiry.Window, "Period before their expiration date issued credentials are reported as expiring, such as '720h'.")
+	flagSet.StringSlice("vcr.expiry.reissue", defs.Expiry.Reissue, "Credential types that are reissued automatically when they're about to expire, "+
+		"the expiring credential is revoked when it has expired.")
+	flagSet.String("vcr.conceptsdir", defs.ConceptsDir, "Directory from which additional concept configurations (files ending with '.config.yaml') are loaded. "+
+		"Concepts added through the API are stored in it as well. Defaults to the 'vcr/concepts' directory in the data directory.")
+	flagSet.StringSlice("vcr.trustlists.signers", defs.TrustLists.Signers, "DIDs of the governance bodies whose signed trust lists can be imported.")
+	flagSet.String("vcr.jsonld.contextsdir", defs.JSONLD.ContextsDir, "Directory containing 'contexts.yaml', which lists the JSON-LD contexts that can be used "+
+		"in addition to the embedded contexts, with their SHA-256 hash. Contexts are loaded from the file in the directory or fetched from their URL. "+
+		"Defaults to the 'vcr/contexts' directory in the data directory.")
+	flagSet.String("vcr.schemasdir", defs.SchemasDir, "Directory containing 'schemas.yaml', which lists the JSON Schemas (JsonSchemaValidator2018) credentials are validated against "+
+		"on issuance and when they're received, by credential type. Defaults to the 'vcr/schemas' directory in the data directory.")
+	flagSet.String("vcr.notifications.webhook", defs.Notifications.Webhook, "URL to which an event (JSON) is POSTed for every credential received for a DID managed by this node. "+
+		"Must use HTTPS in strict mode. If not set, no webhook is called.")
+	flagSet.Duration("vcr.notifications.webhooktimeout", defs.Notifications.WebhookTimeout, "Maximum time to wait for the webhook to respond, such as '5s'.")
+	flagSet.String("vcr.templates.dir", defs.Templates.Dir, "Directory from which issuance templates (files ending with '.template.yaml') are loaded. "+
+		"Templates added through the API are stored in it as well. Defaults to the 'vcr/templates' directory in the data directory.")
+	flagSet.Bool("vcr.templates.strict", defs.Templates.Strict, "If set to true, issued credentials must match an issuance template of their credential type.")
+	flagSet.StringSlice("vcr.refresh.credentialtypes", defs.Refresh.CredentialTypes, "Credential types of which the subject can request a renewed credential "+
+		"through the refresh endpoint ('/n2n/vcr/v2/refresh'). If empty, no credentials can be refreshed.")
+	flagSet.Bool("vcr.audit.enabled", defs.Audit.Enabled, "Whether verifications of credentials are recorded in the audit trail, which is stored in the 'vcr/audit.db' file in the data directory.")
+	flagSet.Duration("vcr.audit.retention", defs.Audit.Retention, "Period entries are kept in the audit trail of credential verifications, such as '43800h' (5 years). If 0, they're kept forever.")
 	return flagSet
 }
 
@@ -54,9 +84,232 @@ func Cmd() *cobra.Command {
 
 	cmd.AddCommand(listUntrustedCmd())
 
+	cmd.AddCommand(walletCmd())
+
+	cmd.AddCommand(issuerCmd())
+
+	cmd.AddCommand(listContextsCmd())
+
+	cmd.AddCommand(checkCmd())
+
+	cmd.AddCommand(reindexCmd())
+
+	return cmd
+}
+
+func walletCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet",
+		Short: "Manage the credentials held by the DIDs managed by this node",
+	}
+
+	cmd.AddCommand(walletListCmd())
+
+	cmd.AddCommand(walletGetCmd())
+
+	cmd.AddCommand(walletDeleteCmd())
+
 	return cmd
 }
 
+func walletListCmd() *cobra.Command {
+	var credentialType, issuer string
+	result := &cobra.Command{
+		Use:   "list [DID]",
+		Short: "List the credentials in the wallet of the given DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			credentials, err := httpClientV2(cmd.Flags()).ListHolderVCs(args[0], credentialType, issuer)
+			if err != nil {
+				return fmt.Errorf("unable to list credentials: %v", err)
+			}
+			bytes, _ := json.MarshalIndent(credentials, "", "  ")
+			cmd.Println(string(bytes))
+			return nil
+		},
+	}
+	result.Flags().StringVar(&credentialType, "type", "", "Only list credentials of the given type.")
+	result.Flags().StringVar(&issuer, "issuer", "", "Only list credentials issued by the given DID.")
+	return result
+}
+
+func walletGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get [ID]",
+		Short: "Show a credential in the wallet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			credential, err := httpClientV2(cmd.Flags()).GetHolderVC(args[0])
+			if err != nil {
+				return fmt.Errorf("unable to get credential: %v", err)
+			}
+			bytes, _ := json.MarshalIndent(credential, "", "  ")
+			cmd.Println(string(bytes))
+			return nil
+		},
+	}
+}
+
+func walletDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete [ID]",
+		Short: "Remove a credential from the wallet. It does not revoke the credential.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := httpClientV2(cmd.Flags()).DeleteHolderVC(args[0]); err != nil {
+				return fmt.Errorf("unable to delete credential: %v", err)
+			}
+			cmd.Println(fmt.Sprintf("%s has been removed from the wallet", args[0]))
+			return nil
+		},
+	}
+}
+
+func issuerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuer",
+		Short: "Manage the credentials issued by this node",
+	}
+
+	cmd.AddCommand(issuerListCmd())
+
+	cmd.AddCommand(issuerRevokeCmd())
+
+	return cmd
+}
+
+// issuedFilterFlags contains the flags that select issued credentials.
+type issuedFilterFlags struct {
+	issuer, subject, credentialType, issuedFrom, issuedUntil string
+}
+
+func (f *issuedFilterFlags) register(flags *pflag.FlagSet) {
+	flags.StringVar(&f.issuer, "issuer", "", "Only select credentials issued by the given DID.")
+	flags.StringVar(&f.subject, "subject", "", "Only select credentials about the given subject (usually a DID).")
+	flags.StringVar(&f.credentialType, "type", "", "Only select credentials of the given type.")
+	flags.StringVar(&f.issuedFrom, "issued-from", "", "Only select credentials issued at or after the given moment (RFC3339).")
+	flags.StringVar(&f.issuedUntil, "issued-until", "", "Only select credentials issued before the given moment (RFC3339).")
+}
+
+// parse returns the values of the flags that have been set, nil for the others.
+func (f issuedFilterFlags) parse() (issuer, subject, credentialType *string, issuedFrom, issuedUntil *time.Time, err error) {
+	optional := func(value string) *string {
+		if value == "" {
+			return nil
+		}
+		return &value
+	}
+	optionalTime := func(name string, value string) (*time.Time, error) {
+		if value == "" {
+			return nil, nil
+		}
+		result, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		return &result, nil
+	}
+	if issuedFrom, err = optionalTime("issued-from", f.issuedFrom); err != nil {
+		return
+	}
+	if issuedUntil, err = optionalTime("issued-until", f.issuedUntil); err != nil {
+		return
+	}
+	return optional(f.issuer), optional(f.subject), optional(f.credentialType), issuedFrom, issuedUntil, nil
+}
+
+func issuerListCmd() *cobra.Command {
+	var (
+		filter        issuedFilterFlags
+		state         string
+		offset, limit int
+	)
+	result := &cobra.Command{
+		Use:   "list",
+		Short: "List the credentials issued by this node",
+		Long:  "Lists the credentials issued by this node that match the given filters with their state, ordered by issuance date.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			params := apiv2.ListIssuedVCsParams{Offset: &offset, Limit: &limit}
+			var err error
+			params.Issuer, params.Subject, params.CredentialType, params.IssuedFrom, params.IssuedUntil, err = filter.parse()
+			if err != nil {
+				return err
+			}
+			if state != "" {
+				stateParam := apiv2.ListIssuedVCsParamsState(state)
+				params.State = &stateParam
+			}
+			page, err := httpClientV2(cmd.Flags()).ListIssuedVCs(params)
+			if err != nil {
+				return fmt.Errorf("unable to list issued credentials: %v", err)
+			}
+			bytes, _ := json.MarshalIndent(page, "", "  ")
+			cmd.Println(string(bytes))
+			return nil
+		},
+	}
+	filter.register(result.Flags())
+	result.Flags().StringVar(&state, "state", "", "Only list credentials in the given state: active or revoked.")
+	result.Flags().IntVar(&offset, "offset", 0, "Number of matching credentials to skip.")
+	result.Flags().IntVar(&limit, "limit", 100, "Maximum number of credentials to list.")
+	return result
+}
+
+func issuerRevokeCmd() *cobra.Command {
+	var (
+		filter issuedFilterFlags
+		reason string
+		dryRun bool
+	)
+	result := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke the credentials issued by this node that match the filters",
+		Long: "Revokes the active credentials issued by this node that match the given filters, for the given reason. " +
+			"An issuer, subject or type must be given. Use --dry-run to list the credentials that would be revoked first. " +
+			"Exits with an error if a credential can't be revoked.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			request := apiv2.RevokeIssuedVCsRequest{Reason: reason, DryRun: &dryRun}
+			var err error
+			request.Issuer, request.Subject, request.CredentialType, request.IssuedFrom, request.IssuedUntil, err = filter.parse()
+			if err != nil {
+				return err
+			}
+			results, err := httpClientV2(cmd.Flags()).RevokeIssuedVCs(request)
+			if err != nil {
+				return fmt.Errorf("unable to revoke issued credentials: %v", err)
+			}
+			failed := 0
+			for _, curr := range results.Results {
+				switch {
+				case dryRun:
+					cmd.Println(fmt.Sprintf("would revoke %s", curr.CredentialID))
+				case curr.Error != nil:
+					failed++
+					cmd.Println(fmt.Sprintf("failed to revoke %s: %s", curr.CredentialID, *curr.Error))
+				default:
+					cmd.Println(fmt.Sprintf("revoked %s", curr.CredentialID))
+				}
+			}
+			if dryRun {
+				cmd.Println(fmt.Sprintf("%d credentials would be revoked", len(results.Results)))
+				return nil
+			}
+			cmd.Println(fmt.Sprintf("%d credentials revoked", len(results.Results)-failed))
+			if failed > 0 {
+				return fmt.Errorf("failed to revoke %d credentials", failed)
+			}
+			return nil
+		},
+	}
+	filter.register(result.Flags())
+	result.Flags().StringVar(&reason, "reason", "", "Reason the credentials are revoked, it's added to the revocations (required).")
+	result.Flags().BoolVar(&dryRun, "dry-run", false, "List the credentials that would be revoked, without revoking them.")
+	_ = result.MarkFlagRequired("reason")
+	return result
+}
+
 func trustCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "trust [type] [issuer DID]",
@@ -135,6 +388,126 @@ func listUntrustedCmd() *cobra.Command {
 	}
 }
 
+func listContextsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-contexts",
+		Short: "List the JSON-LD contexts the node can use to sign and verify credentials",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contexts, err := httpClientV2(cmd.Flags()).ListJSONLDContexts()
+			if err != nil {
+				return fmt.Errorf("unable to list JSON-LD contexts: %v", err)
+			}
+			bytes, _ := json.MarshalIndent(contexts, "", "  ")
+			cmd.Println(string(bytes))
+			return nil
+		},
+	}
+}
+
+func checkCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check [collection]",
+		Short: "Check the consistency of the VCR stores",
+		Long: "Checks whether every stored credential and revocation still parses, validates and can be found through its indices, " +
+			"and reports invalid, duplicate and unreachable documents and orphaned index entries. " +
+			"Checks all collections if no collection is given. Exits with an error if problems are found.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := httpClientV2(cmd.Flags())
+			problems, err := forEachCollection(cmd, args, "Checking", client.CheckStoreCollection)
+			if err != nil {
+				return err
+			}
+			if count := printRemediations(cmd, problems); count > 0 {
+				return fmt.Errorf("found %d problems", count)
+			}
+			return nil
+		},
+	}
+}
+
+func reindexCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reindex [collection]",
+		Short: "Rebuild the indices of the VCR stores",
+		Long: "Rebuilds the indices of the VCR stores from the stored documents and checks the stores afterwards. " +
+			"Reindexes all collections if no collection is given.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := httpClientV2(cmd.Flags())
+			problems, err := forEachCollection(cmd, args, "Reindexing", client.ReindexStoreCollection)
+			printRemediations(cmd, problems)
+			return err
+		},
+	}
+}
+
+// forEachCollection calls the given func for the collection given as argument, or for all collections if there's none,
+// printing the progress and reports. It returns the number of problems found per problem type.
+func forEachCollection(cmd *cobra.Command, args []string, action string, fn func(collection string) (*apiv2.StoreReport, error)) (map[string]int, error) {
+	collections := args
+	if len(collections) == 0 {
+		var err error
+		collections, err = httpClientV2(cmd.Flags()).ListStoreCollections()
+		if err != nil {
+			return nil, fmt.Errorf("unable to list collections: %v", err)
+		}
+	}
+	problems := map[string]int{}
+	for i, collection := range collections {
+		cmd.Println(fmt.Sprintf("%s %s (%d/%d)...", action, collection, i+1, len(collections)))
+		report, err := fn(collection)
+		if err != nil {
+			return problems, fmt.Errorf("unable to process %s: %v", collection, err)
+		}
+		if len(report.Reindexed) > 0 {
+			cmd.Println(fmt.Sprintf("  rebuilt indices: %s", strings.Join(report.Reindexed, ", ")))
+		}
+		cmd.Println(fmt.Sprintf("  %d documents, %d problems", report.Documents, len(report.Problems)))
+		for _, problem := range report.Problems {
+			subject := problem.Reference
+			if problem.ID != "" {
+				subject = fmt.Sprintf("%s (%s)", problem.ID, problem.Reference)
+			}
+			if problem.Index != "" {
+				subject = fmt.Sprintf("%s in index %s", subject, problem.Index)
+			}
+			cmd.Println(fmt.Sprintf("  %s: %s: %s", problem.Type, subject, problem.Message))
+			problems[problem.Type]++
+		}
+	}
+	return problems, nil
+}
+
+// remediations describes how to resolve each type of problem reported by a store check.
+var remediations = map[string]string{
+	storage.InvalidProblem: "reindexing doesn't resolve them, check whether the configured JSON-LD contexts or credential schemas changed, " +
+		"otherwise restore the store from a backup",
+	storage.DuplicateProblem:   "reindexing doesn't resolve them, restore the store from a backup",
+	storage.UnreachableProblem: "run 'nuts vcr reindex' to rebuild the indices",
+	storage.OrphanProblem:      "run 'nuts vcr reindex' to rebuild the indices",
+}
+
+// printRemediations prints how to resolve the problems of each type that was found. It returns the total number of problems.
+func printRemediations(cmd *cobra.Command, problems map[string]int) int {
+	problemTypes := make([]string, 0, len(problems))
+	count := 0
+	for problemType, n := range problems {
+		problemTypes = append(problemTypes, problemType)
+		count += n
+	}
+	sort.Strings(problemTypes)
+	for _, problemType := range problemTypes {
+		remediation, ok := remediations[problemType]
+		if !ok {
+			remediation = "unknown problem type, inspect the documents"
+		}
+		cmd.Println(fmt.Sprintf("%d %s: %s", problems[problemType], problemType, remediation))
+	}
+	return count
+}
+
 // httpClient creates a remote client
 func httpClient(set *pflag.FlagSet) api.HTTPClient {
 	config := core.NewClientConfig(set)
@@ -143,3 +516,12 @@ func httpClient(set *pflag.FlagSet) api.HTTPClient {
 		Timeout:       config.Timeout,
 	}
 }
+
+// httpClientV2 creates a remote client for the v2 API
+func httpClientV2(set *pflag.FlagSet) apiv2.HTTPClient {
+	config := core.NewClientConfig(set)
+	return apiv2.HTTPClient{
+		ServerAddress: config.GetAddress(),
+		Timeout:       config.Timeout,
+	}
+}
@@ -26,7 +26,10 @@ import (
 	"os"
 	"testing"
 
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
 	http2 "github.com/nuts-foundation/nuts-node/test/http"
+	apiv2 "github.com/nuts-foundation/nuts-node/vcr/api/v2"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 )
@@ -141,6 +144,368 @@ func TestCmd(t *testing.T) {
 	}
 }
 
+func TestCmd_Wallet(t *testing.T) {
+	holderDID := "did:nuts:1"
+	credentialID := ssi.MustParseURI("did:nuts:2#1")
+	credential := vc.VerifiableCredential{ID: &credentialID}
+
+	buf := new(bytes.Buffer)
+
+	newCmd := func(t *testing.T) *cobra.Command {
+		t.Helper()
+		buf.Reset()
+		command := Cmd()
+		command.SetOut(buf)
+		return command
+	}
+
+	t.Run("list", func(t *testing.T) {
+		t.Run("ok", func(t *testing.T) {
+			cmd := newCmd(t)
+			s := setupServer(cmd, http.StatusOK, []vc.VerifiableCredential{credential})
+			defer reset(s)
+
+			cmd.SetArgs([]string{"wallet", "list", holderDID, "--type", "NutsOrganizationCredential"})
+			err := cmd.Execute()
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Contains(t, buf.String(), credentialID.String())
+		})
+		t.Run("error - server error", func(t *testing.T) {
+			cmd := newCmd(t)
+			s := setupServer(cmd, http.StatusInternalServerError, nil)
+			defer reset(s)
+
+			cmd.SetArgs([]string{"wallet", "list", holderDID})
+			err := cmd.Execute()
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Contains(t, err.Error(), "server returned HTTP 500")
+		})
+	})
+	t.Run("get", func(t *testing.T) {
+		t.Run("ok", func(t *testing.T) {
+			cmd := newCmd(t)
+			s := setupServer(cmd, http.StatusOK, credential)
+			defer reset(s)
+
+			cmd.SetArgs([]string{"wallet", "get", credentialID.String()})
+			err := cmd.Execute()
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Contains(t, buf.String(), credentialID.String())
+		})
+		t.Run("error - not found", func(t *testing.T) {
+			cmd := newCmd(t)
+			s := setupServer(cmd, http.StatusNotFound, nil)
+			defer reset(s)
+
+			cmd.SetArgs([]string{"wallet", "get", credentialID.String()})
+			err := cmd.Execute()
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Contains(t, err.Error(), "server returned HTTP 404")
+		})
+	})
+	t.Run("delete", func(t *testing.T) {
+		t.Run("ok", func(t *testing.T) {
+			cmd := newCmd(t)
+			s := setupServer(cmd, http.StatusNoContent, nil)
+			defer reset(s)
+
+			cmd.SetArgs([]string{"wallet", "delete", credentialID.String()})
+			err := cmd.Execute()
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Contains(t, buf.String(), "has been removed from the wallet")
+		})
+		t.Run("error - not enough args", func(t *testing.T) {
+			cmd := newCmd(t)
+
+			cmd.SetArgs([]string{"wallet", "delete"})
+			err := cmd.Execute()
+
+			assert.Error(t, err)
+		})
+	})
+}
+
+func TestCmd_Issuer(t *testing.T) {
+	credentialID := ssi.MustParseURI("did:nuts:1#1")
+	otherID := ssi.MustParseURI("did:nuts:1#2")
+	buf := new(bytes.Buffer)
+
+	newCmd := func(t *testing.T) *cobra.Command {
+		t.Helper()
+		buf.Reset()
+		command := Cmd()
+		command.SetOut(buf)
+		return command
+	}
+
+	t.Run("list", func(t *testing.T) {
+		t.Run("ok", func(t *testing.T) {
+			cmd := newCmd(t)
+			s := setupServer(cmd, http.StatusOK, apiv2.IssuedVCList{
+				Credentials: []apiv2.IssuedVC{{VerifiableCredential: vc.VerifiableCredential{ID: &credentialID}, State: apiv2.IssuedVCStateActive}},
+				Total:       1,
+			})
+			defer reset(s)
+
+			cmd.SetArgs([]string{"issuer", "list", "--subject", "did:nuts:2", "--state", "active", "--issued-from", "2022-10-01T00:00:00Z"})
+			err := cmd.Execute()
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Contains(t, buf.String(), credentialID.String())
+		})
+		t.Run("error - invalid moment", func(t *testing.T) {
+			cmd := newCmd(t)
+
+			cmd.SetArgs([]string{"issuer", "list", "--issued-until", "yesterday"})
+			err := cmd.Execute()
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Contains(t, err.Error(), "invalid issued-until")
+		})
+		t.Run("error - server error", func(t *testing.T) {
+			cmd := newCmd(t)
+			s := setupServer(cmd, http.StatusInternalServerError, nil)
+			defer reset(s)
+
+			cmd.SetArgs([]string{"issuer", "list"})
+			err := cmd.Execute()
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Contains(t, err.Error(), "server returned HTTP 500")
+		})
+	})
+	t.Run("revoke", func(t *testing.T) {
+		t.Run("ok", func(t *testing.T) {
+			cmd := newCmd(t)
+			s := setupServer(cmd, http.StatusOK, apiv2.RevokeIssuedVCsResults{Results: []apiv2.RevokeIssuedVCResult{{CredentialID: credentialID.String()}}})
+			defer reset(s)
+
+			cmd.SetArgs([]string{"issuer", "revoke", "--subject", "did:nuts:2", "--reason", "left the platform"})
+			err := cmd.Execute()
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Contains(t, buf.String(), "revoked did:nuts:1#1")
+			assert.Contains(t, buf.String(), "1 credentials revoked")
+		})
+		t.Run("ok - dry run", func(t *testing.T) {
+			cmd := newCmd(t)
+			s := setupServer(cmd, http.StatusOK, apiv2.RevokeIssuedVCsResults{Results: []apiv2.RevokeIssuedVCResult{{CredentialID: credentialID.String()}}})
+			defer reset(s)
+
+			cmd.SetArgs([]string{"issuer", "revoke", "--subject", "did:nuts:2", "--reason", "left the platform", "--dry-run"})
+			err := cmd.Execute()
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Contains(t, buf.String(), "would revoke did:nuts:1#1")
+			assert.Contains(t, buf.String(), "1 credentials would be revoked")
+		})
+		t.Run("error - credential not revoked", func(t *testing.T) {
+			cmd := newCmd(t)
+			msg := "unable to sign"
+			s := setupServer(cmd, http.StatusOK, apiv2.RevokeIssuedVCsResults{Results: []apiv2.RevokeIssuedVCResult{
+				{CredentialID: credentialID.String()},
+				{CredentialID: otherID.String(), Error: &msg},
+			}})
+			defer reset(s)
+
+			cmd.SetArgs([]string{"issuer", "revoke", "--subject", "did:nuts:2", "--reason", "left the platform"})
+			err := cmd.Execute()
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Contains(t, err.Error(), "failed to revoke 1 credentials")
+			assert.Contains(t, buf.String(), "failed to revoke did:nuts:1#2: unable to sign")
+		})
+		t.Run("error - missing reason", func(t *testing.T) {
+			cmd := newCmd(t)
+
+			cmd.SetArgs([]string{"issuer", "revoke", "--subject", "did:nuts:2"})
+			err := cmd.Execute()
+
+			assert.Error(t, err)
+		})
+	})
+}
+
+func TestCmd_ListContexts(t *testing.T) {
+	buf := new(bytes.Buffer)
+	newCmd := func(t *testing.T) *cobra.Command {
+		t.Helper()
+		buf.Reset()
+		command := Cmd()
+		command.SetOut(buf)
+		return command
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		cmd := newCmd(t)
+		s := setupServer(cmd, http.StatusOK, []apiv2.JSONLDContext{{URL: "https://schema.org", Source: "embedded", Loaded: true}})
+		defer reset(s)
+
+		cmd.SetArgs([]string{"list-contexts"})
+		err := cmd.Execute()
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Contains(t, buf.String(), "https://schema.org")
+	})
+	t.Run("error - server error", func(t *testing.T) {
+		cmd := newCmd(t)
+		s := setupServer(cmd, http.StatusInternalServerError, nil)
+		defer reset(s)
+
+		cmd.SetArgs([]string{"list-contexts"})
+		err := cmd.Execute()
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "unable to list JSON-LD contexts")
+	})
+}
+
+func TestCmd_Check(t *testing.T) {
+	buf := new(bytes.Buffer)
+	newCmd := func(t *testing.T) *cobra.Command {
+		t.Helper()
+		buf.Reset()
+		command := Cmd()
+		command.SetOut(buf)
+		return command
+	}
+
+	t.Run("ok - single collection", func(t *testing.T) {
+		cmd := newCmd(t)
+		s := setupServer(cmd, http.StatusOK, apiv2.StoreReport{Collection: "issuer/issuedCredentials", Documents: 2, Problems: []apiv2.StoreProblem{}})
+		defer reset(s)
+
+		cmd.SetArgs([]string{"check", "issuer/issuedCredentials"})
+		err := cmd.Execute()
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Contains(t, buf.String(), "Checking issuer/issuedCredentials (1/1)...")
+		assert.Contains(t, buf.String(), "2 documents, 0 problems")
+	})
+	t.Run("ok - all collections", func(t *testing.T) {
+		cmd := newCmd(t)
+		mux := http.NewServeMux()
+		mux.Handle("/internal/vcr/v2/store/collections", http2.Handler{StatusCode: http.StatusOK, ResponseData: []string{"holder/heldCredentials", "issuer/issuedCredentials"}})
+		mux.Handle("/internal/vcr/v2/store/check", http2.Handler{StatusCode: http.StatusOK, ResponseData: apiv2.StoreReport{Documents: 1, Problems: []apiv2.StoreProblem{}}})
+		s := httptest.NewServer(mux)
+		os.Setenv("NUTS_ADDRESS", s.URL)
+		defer reset(s)
+
+		cmd.SetArgs([]string{"check"})
+		err := cmd.Execute()
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Contains(t, buf.String(), "Checking holder/heldCredentials (1/2)...")
+		assert.Contains(t, buf.String(), "Checking issuer/issuedCredentials (2/2)...")
+	})
+	t.Run("error - problems found", func(t *testing.T) {
+		cmd := newCmd(t)
+		s := setupServer(cmd, http.StatusOK, apiv2.StoreReport{Documents: 1, Problems: []apiv2.StoreProblem{
+			{Type: "unreachable", Reference: "abcd", ID: "did:nuts:1#1", Index: "issuedVCs", Message: "document is not in the index"},
+			{Type: "duplicate", Reference: "efgh", ID: "did:nuts:1#1", Message: "document has the same ID as abcd"},
+		}})
+		defer reset(s)
+
+		cmd.SetArgs([]string{"check", "issuer/issuedCredentials"})
+		err := cmd.Execute()
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.EqualError(t, err, "found 2 problems")
+		assert.Contains(t, buf.String(), "unreachable: did:nuts:1#1 (abcd) in index issuedVCs: document is not in the index")
+		assert.Contains(t, buf.String(), "1 duplicate: reindexing doesn't resolve them, restore the store from a backup")
+		assert.Contains(t, buf.String(), "1 unreachable: run 'nuts vcr reindex' to rebuild the indices")
+	})
+	t.Run("error - server error", func(t *testing.T) {
+		cmd := newCmd(t)
+		s := setupServer(cmd, http.StatusInternalServerError, nil)
+		defer reset(s)
+
+		cmd.SetArgs([]string{"check"})
+		err := cmd.Execute()
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "unable to list collections")
+	})
+}
+
+func TestCmd_Reindex(t *testing.T) {
+	buf := new(bytes.Buffer)
+	newCmd := func(t *testing.T) *cobra.Command {
+		t.Helper()
+		buf.Reset()
+		command := Cmd()
+		command.SetOut(buf)
+		return command
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		cmd := newCmd(t)
+		s := setupServer(cmd, http.StatusOK, apiv2.StoreReport{Documents: 1, Reindexed: []string{"issuedVCs", "issuedVCByID"}, Problems: []apiv2.StoreProblem{}})
+		defer reset(s)
+
+		cmd.SetArgs([]string{"reindex", "issuer/issuedCredentials"})
+		err := cmd.Execute()
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Contains(t, buf.String(), "Reindexing issuer/issuedCredentials (1/1)...")
+		assert.Contains(t, buf.String(), "rebuilt indices: issuedVCs, issuedVCByID")
+	})
+	t.Run("error - unknown collection", func(t *testing.T) {
+		cmd := newCmd(t)
+		s := setupServer(cmd, http.StatusNotFound, nil)
+		defer reset(s)
+
+		cmd.SetArgs([]string{"reindex", "unknown"})
+		err := cmd.Execute()
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "unable to process unknown")
+	})
+}
+
 func setupServer(cmd *cobra.Command, statusCode int, responseData interface{}) *httptest.Server {
 	s := httptest.NewServer(http2.Handler{StatusCode: statusCode, ResponseData: responseData})
 	os.Setenv("NUTS_ADDRESS", s.URL)
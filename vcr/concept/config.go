@@ -31,16 +31,19 @@ import (
 // Config defines the concept configuration for a VerifiableCredential
 type Config struct {
 	// Concept groups multiple credentials under a single name
-	Concept string `yaml:"concept"`
+	Concept string `yaml:"concept" json:"concept"`
 	// CredentialType defines the type of the credential. 'VerifiableCredential' is omitted.
-	CredentialType string `yaml:"credentialType"`
+	CredentialType string `yaml:"credentialType" json:"credentialType"`
 	// Indices contains a set of Index values
-	Indices []Index `yaml:"indices"`
+	Indices []Index `yaml:"indices" json:"indices,omitempty"`
 	// Public indicates if this credential may be published on the DAG
-	Public bool `yaml:"public"`
+	Public bool `yaml:"public" json:"public"`
 	// Template is the string template for outputting a credential to a common format
 	// Each <<JSONPath>> value is substituted with the outcome of the JSONPath query
-	Template *string `yaml:"template"`
+	Template *string `yaml:"template,omitempty" json:"template,omitempty"`
+	// SelectivelyDisclosable contains the claims of the credential subject that are selectively disclosable when the
+	// credential is issued as SD-JWT, as paths of object keys separated by dots (e.g. 'organization.city').
+	SelectivelyDisclosable []string `yaml:"selectivelyDisclosable,omitempty" json:"selectivelyDisclosable,omitempty"`
 }
 
 var templateStringMatcher = regexp.MustCompile(`<<([a-zA-Z\\._\\-]+)>>`)
@@ -75,19 +78,19 @@ func (c Config) transform(vc vc.VerifiableCredential) (Concept, error) {
 // Index for a credential
 type Index struct {
 	// Name identifies the index, must be unique per credential
-	Name string `yaml:"name"`
+	Name string `yaml:"name" json:"name"`
 	// Parts defines the individual index parts, the ordering is significant
-	Parts []IndexPart `yaml:"parts"`
+	Parts []IndexPart `yaml:"parts" json:"parts"`
 }
 
 // IndexPart defines the JSONPath and type of index for a partial index within a compound index
 type IndexPart struct {
 	// Alias defines an optional alias that can be used within a search query
-	Alias *string `yaml:"alias"`
+	Alias *string `yaml:"alias,omitempty" json:"alias,omitempty"`
 	// JSONPath defines the JSON search path
-	JSONPath string `yaml:"path"`
+	JSONPath string `yaml:"path" json:"path"`
 	// Tokenizer defines an optional tokenizer. Possible values: [whitespace]
-	Tokenizer *string `yaml:"tokenizer"`
+	Tokenizer *string `yaml:"tokenizer,omitempty" json:"tokenizer,omitempty"`
 	// Transformer defines an optional transformer. Possible values: [cologne, lowercase]
-	Transformer *string `yaml:"transformer"`
+	Transformer *string `yaml:"transformer,omitempty" json:"transformer,omitempty"`
 }
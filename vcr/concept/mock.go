@@ -129,6 +129,20 @@ func (mr *MockWriterMockRecorder) Add(config interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockWriter)(nil).Add), config)
 }
 
+// Remove mocks base method.
+func (m *MockWriter) Remove(credentialType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", credentialType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockWriterMockRecorder) Remove(credentialType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockWriter)(nil).Remove), credentialType)
+}
+
 // MockRegistry is a mock of Registry interface.
 type MockRegistry struct {
 	ctrl     *gomock.Controller
@@ -209,6 +223,20 @@ func (mr *MockRegistryMockRecorder) QueryFor(concept interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFor", reflect.TypeOf((*MockRegistry)(nil).QueryFor), concept)
 }
 
+// Remove mocks base method.
+func (m *MockRegistry) Remove(credentialType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", credentialType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockRegistryMockRecorder) Remove(credentialType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockRegistry)(nil).Remove), credentialType)
+}
+
 // Transform mocks base method.
 func (m *MockRegistry) Transform(concept string, VC vc.VerifiableCredential) (Concept, error) {
 	m.ctrl.T.Helper()
@@ -142,3 +142,134 @@ func (e prefix) Seek() string {
 func (e prefix) Match() string {
 	return e.value
 }
+
+// RangeType is the identifier for a range clause
+const RangeType = "range"
+
+// Range creates a Clause that matches values between from and to, both inclusive.
+// An empty from or to leaves that end of the range open.
+func Range(key string, from string, to string) Clause {
+	return rangeClause{key, from, to}
+}
+
+type rangeClause struct {
+	key  string
+	from string
+	to   string
+}
+
+func (r rangeClause) Type() string {
+	return RangeType
+}
+
+func (r rangeClause) Key() string {
+	return r.key
+}
+
+func (r rangeClause) Seek() string {
+	return r.from
+}
+
+func (r rangeClause) Match() string {
+	return r.to
+}
+
+// ExistsType is the identifier for an exists clause
+const ExistsType = "exists"
+
+// Exists creates a Clause that matches when the key has a value.
+func Exists(key string) Clause {
+	return exists{key}
+}
+
+type exists struct {
+	key string
+}
+
+func (e exists) Type() string {
+	return ExistsType
+}
+
+func (e exists) Key() string {
+	return e.key
+}
+
+func (e exists) Seek() string {
+	return ""
+}
+
+func (e exists) Match() string {
+	return ""
+}
+
+// GroupClause is a Clause that is composed of other clauses.
+// Its Key, Seek and Match aren't applicable, the clauses it contains must be evaluated instead.
+type GroupClause interface {
+	Clause
+	// Clauses returns the clauses of the group.
+	Clauses() []Clause
+}
+
+// OrType is the identifier for an OR clause
+const OrType = "or"
+
+// Or creates a GroupClause that matches when at least one of the given clauses matches.
+func Or(clauses ...Clause) GroupClause {
+	return or{clauses}
+}
+
+type or struct {
+	clauses []Clause
+}
+
+func (o or) Type() string {
+	return OrType
+}
+
+func (o or) Key() string {
+	return ""
+}
+
+func (o or) Seek() string {
+	return ""
+}
+
+func (o or) Match() string {
+	return ""
+}
+
+func (o or) Clauses() []Clause {
+	return o.clauses
+}
+
+// NotType is the identifier for a negated clause
+const NotType = "not"
+
+// Not creates a GroupClause that matches when the given clause doesn't match.
+func Not(clause Clause) GroupClause {
+	return not{clause}
+}
+
+type not struct {
+	clause Clause
+}
+
+func (n not) Type() string {
+	return NotType
+}
+
+func (n not) Key() string {
+	return ""
+}
+
+func (n not) Seek() string {
+	return ""
+}
+
+func (n not) Match() string {
+	return ""
+}
+
+func (n not) Clauses() []Clause {
+	return []Clause{n.clause}
+}
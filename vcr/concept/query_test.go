@@ -71,6 +71,51 @@ func TestPrefix(t *testing.T) {
 	})
 }
 
+func TestRange(t *testing.T) {
+	q := Range("key", "a", "b")
+
+	t.Run("ok - type", func(t *testing.T) {
+		assert.Equal(t, RangeType, q.Type())
+	})
+
+	t.Run("ok - key", func(t *testing.T) {
+		assert.Equal(t, "key", q.Key())
+	})
+
+	t.Run("ok - match", func(t *testing.T) {
+		assert.Equal(t, "b", q.Match())
+	})
+
+	t.Run("ok - seek", func(t *testing.T) {
+		assert.Equal(t, "a", q.Seek())
+	})
+}
+
+func TestExists(t *testing.T) {
+	q := Exists("key")
+
+	assert.Equal(t, ExistsType, q.Type())
+	assert.Equal(t, "key", q.Key())
+	assert.Empty(t, q.Seek())
+	assert.Empty(t, q.Match())
+}
+
+func TestOr(t *testing.T) {
+	q := Or(Eq("key", "a"), Eq("key", "b"))
+
+	assert.Equal(t, OrType, q.Type())
+	assert.Empty(t, q.Key())
+	assert.Equal(t, []Clause{Eq("key", "a"), Eq("key", "b")}, q.Clauses())
+}
+
+func TestNot(t *testing.T) {
+	q := Not(Eq("key", "a"))
+
+	assert.Equal(t, NotType, q.Type())
+	assert.Empty(t, q.Key())
+	assert.Equal(t, []Clause{Eq("key", "a")}, q.Clauses())
+}
+
 func TestQuery(t *testing.T) {
 	q := query{
 		concept: "concept",
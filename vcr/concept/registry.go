@@ -20,6 +20,8 @@
 package concept
 
 import (
+	"sync"
+
 	"github.com/nuts-foundation/go-did/vc"
 )
 
@@ -39,8 +41,11 @@ type Reader interface {
 
 // Writer contains state changing operations for the concept registry
 type Writer interface {
-	// Add a credential config to the registry
+	// Add a credential config to the registry. It replaces the config of the same credential type, if present.
 	Add(config Config) error
+	// Remove the credential config of the given credential type from the registry.
+	// It returns ErrUnknownCredentialType if there's no config for the credential type.
+	Remove(credentialType string) error
 }
 
 // Registry defines the interface for accessing loaded concepts and using the templates
@@ -64,8 +69,10 @@ const (
 // registry holds parsed credential configs which contain all the mappings from concept names to json paths.
 // Queries are created through the conceptRegistry to add the correct templates.
 // The registry can also do transformations of VCs to the correct format.
+// It's safe for concurrent use, since configs can be added and removed at runtime.
 type registry struct {
 	configs []Config
+	mutex   sync.RWMutex
 }
 
 // NewRegistry creates a new registry instance with no templates.
@@ -78,23 +85,53 @@ func NewRegistry() Registry {
 }
 
 func (r *registry) Concepts() []Config {
-	return r.configs
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append([]Config{}, r.configs...)
 }
 
 // Add adds a new template to a concept and parses it.
+// It replaces the template of the same credential type, if present.
 func (r *registry) Add(config Config) error {
 	// check for type
 	if config.CredentialType == "" {
 		return ErrNoType
 	}
 
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, c := range r.configs {
+		if c.CredentialType == config.CredentialType {
+			r.configs[i] = config
+			return nil
+		}
+	}
 	r.configs = append(r.configs, config)
 
 	return nil
 }
 
+// Remove removes the template of the given credential type.
+func (r *registry) Remove(credentialType string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, c := range r.configs {
+		if c.CredentialType == credentialType {
+			r.configs = append(r.configs[:i], r.configs[i+1:]...)
+			return nil
+		}
+	}
+	return ErrUnknownCredentialType
+}
+
 // Transform a raw VC to a Concept
 func (r *registry) Transform(concept string, VC vc.VerifiableCredential) (Concept, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
 	if !r.hasConcept(concept) {
 		return nil, ErrUnknownConcept
 	}
@@ -115,6 +152,9 @@ func (r *registry) Transform(concept string, VC vc.VerifiableCredential) (Concep
 // QueryFor returns a query specific for the given concept.
 // Returns ErrUnknownConcept for an unknown concept.
 func (r *registry) QueryFor(concept string) (Query, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
 	if !r.hasConcept(concept) {
 		return nil, ErrUnknownConcept
 	}
@@ -133,6 +173,9 @@ func (r *registry) QueryFor(concept string) (Query, error) {
 }
 
 func (r *registry) FindByType(credentialType string) *Config {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
 	for _, c := range r.configs {
 		if c.CredentialType == credentialType {
 			return &c
@@ -45,6 +45,21 @@ func TestRegistry_Add(t *testing.T) {
 		})
 	})
 
+	t.Run("replaces config of same credential type", func(t *testing.T) {
+		r := NewRegistry().(*registry)
+		_ = r.Add(ExampleConfig)
+		replacement := ExampleConfig
+		replacement.Concept = "other"
+
+		err := r.Add(replacement)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, r.configs, 1)
+		assert.Equal(t, "other", r.FindByType(ExampleType).Concept)
+	})
+
 	t.Run("error - no type error", func(t *testing.T) {
 		r := NewRegistry().(*registry)
 
@@ -57,6 +72,29 @@ func TestRegistry_Add(t *testing.T) {
 	})
 }
 
+func TestRegistry_Remove(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		r := NewRegistry().(*registry)
+		_ = r.Add(ExampleConfig)
+
+		err := r.Remove(ExampleType)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, r.configs)
+		assert.Nil(t, r.FindByType(ExampleType))
+	})
+
+	t.Run("error - unknown credential type", func(t *testing.T) {
+		r := NewRegistry().(*registry)
+
+		err := r.Remove(ExampleType)
+
+		assert.ErrorIs(t, err, ErrUnknownCredentialType)
+	})
+}
+
 func TestRegistry_Transform(t *testing.T) {
 	r := NewRegistry().(*registry)
 
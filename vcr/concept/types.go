@@ -32,6 +32,9 @@ var ErrUnknownConcept = errors.New("unknown concept")
 // ErrNoType is returned when a template is loaded which doesn't have a type
 var ErrNoType = errors.New("no template type found")
 
+// ErrUnknownCredentialType is returned when there's no template for a credential type
+var ErrUnknownCredentialType = errors.New("unknown credential type")
+
 // ErrIncorrectType is returned when a requested value type is different tham the set type.
 var ErrIncorrectType = errors.New("set value is not of correct type")
 
@@ -0,0 +1,250 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+	"gopkg.in/yaml.v2"
+)
+
+// conceptFileSuffix is the suffix of concept config files in the concepts directory.
+const conceptFileSuffix = ".config.yaml"
+
+// unsafeFilenameChars matches the characters of a credential type that can't be used in a concept config filename.
+var unsafeFilenameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// loadConcepts loads the concept configs from the concepts directory, if it exists.
+// Built-in concepts can't be replaced by concept configs in the concepts directory.
+func (c *vcr) loadConcepts() error {
+	files, err := filepath.Glob(path.Join(c.config.ConceptsDir, "*"+conceptFileSuffix))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		config := concept.Config{}
+		if err = yaml.Unmarshal(data, &config); err != nil {
+			return fmt.Errorf("invalid concept config (file=%s): %w", file, err)
+		}
+		if err = c.validateConcept(config); err != nil {
+			return fmt.Errorf("invalid concept config (file=%s): %w", file, err)
+		}
+		if err = c.registry.Add(config); err != nil {
+			return err
+		}
+		c.conceptFiles[config.CredentialType] = file
+		log.Logger().Infof("Loaded concept config (file=%s, concept=%s, credentialType=%s)", file, config.Concept, config.CredentialType)
+	}
+	return nil
+}
+
+// AddConcept adds the concept config to the registry, or replaces the config of its credential type.
+// The indices of the credential type are (re)built from the credentials in the store before the config is added,
+// so they can be found through the concept immediately. The indices of a replaced config that are no longer used are dropped afterwards.
+// Finally, the config is stored in the concepts directory. If any of the steps fails, the previous config and its indices are restored.
+func (c *vcr) AddConcept(config concept.Config) error {
+	if err := c.validateConcept(config); err != nil {
+		return core.InvalidInputError("invalid concept config: %w", err)
+	}
+	indices, _ := conceptIndices(config)
+
+	c.conceptMutex.Lock()
+	defer c.conceptMutex.Unlock()
+
+	collection := c.store.Collection(config.CredentialType)
+	previousIndices := map[string]concept.Index{}
+	previous := c.registry.FindByType(config.CredentialType)
+	if previous != nil {
+		for _, index := range previous.Indices {
+			previousIndices[index.Name] = index
+		}
+	}
+	var built []leia.Index
+	rollback := func() {
+		for _, index := range built {
+			if err := collection.DropIndex(index.Name()); err != nil {
+				log.Logger().WithError(err).Errorf("Unable to drop index %s of %s", index.Name(), config.CredentialType)
+			}
+		}
+		if previous == nil {
+			_ = c.registry.Remove(config.CredentialType)
+			return
+		}
+		_ = c.registry.Add(*previous)
+		// rebuilds the indices of the previous config that have been dropped
+		previousLeiaIndices, _ := conceptIndices(*previous)
+		if err := collection.AddIndex(previousLeiaIndices...); err != nil {
+			log.Logger().WithError(err).Errorf("Unable to restore indices of %s", config.CredentialType)
+		}
+	}
+
+	// 1. build the new indices; an index of which the definition changed must be dropped first,
+	// since leia doesn't rebuild an existing index with a changed definition
+	for i, index := range config.Indices {
+		if current, ok := previousIndices[index.Name]; ok {
+			if reflect.DeepEqual(current, index) {
+				continue
+			}
+			if err := collection.DropIndex(index.Name); err != nil {
+				rollback()
+				return fmt.Errorf("unable to drop index %s of %s: %w", index.Name, config.CredentialType, err)
+			}
+		}
+		if err := collection.AddIndex(indices[i]); err != nil {
+			rollback()
+			return err
+		}
+		built = append(built, indices[i])
+	}
+	// 2. switch to the new config
+	if err := c.registry.Add(config); err != nil {
+		rollback()
+		return err
+	}
+	// 3. drop the indices of the previous config that are no longer used
+	for name := range previousIndices {
+		if containsIndex(config, name) {
+			continue
+		}
+		if err := collection.DropIndex(name); err != nil {
+			rollback()
+			return fmt.Errorf("unable to drop index %s of %s: %w", name, config.CredentialType, err)
+		}
+	}
+	// 4. store the config
+	file, ok := c.conceptFiles[config.CredentialType]
+	if !ok {
+		file = path.Join(c.config.ConceptsDir, unsafeFilenameChars.ReplaceAllString(config.CredentialType, "_")+conceptFileSuffix)
+	}
+	if err := storeConceptFile(file, config); err != nil {
+		rollback()
+		return fmt.Errorf("unable to store concept config: %w", err)
+	}
+	c.conceptFiles[config.CredentialType] = file
+
+	log.Logger().Infof("Added concept config (concept=%s, credentialType=%s)", config.Concept, config.CredentialType)
+	return nil
+}
+
+// storeConceptFile writes the concept config to the given file. It's written to a temporary file first, which replaces the file
+// when it has been written completely, so a failure doesn't leave a partially written config that prevents the node from starting.
+// The temporary file is removed if writing fails.
+func storeConceptFile(file string, config concept.Config) error {
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(path.Dir(file), os.ModePerm); err != nil {
+		return err
+	}
+	tmpFile := file + ".tmp"
+	if err = os.WriteFile(tmpFile, data, 0644); err == nil {
+		err = os.Rename(tmpFile, file)
+	}
+	if err != nil {
+		_ = os.Remove(tmpFile)
+		return err
+	}
+	return nil
+}
+
+// containsIndex checks whether the concept config has an index with the given name.
+func containsIndex(config concept.Config, name string) bool {
+	for _, index := range config.Indices {
+		if index.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveConcept removes the concept config of the credential type from the registry and the concepts directory.
+// The indices of the credential type are dropped, but the credentials are kept.
+func (c *vcr) RemoveConcept(credentialType string) error {
+	if c.builtinConcepts[credentialType] {
+		return core.PreconditionFailedError("built-in concept can't be removed (credentialType=%s)", credentialType)
+	}
+
+	c.conceptMutex.Lock()
+	defer c.conceptMutex.Unlock()
+
+	config := c.registry.FindByType(credentialType)
+	if config == nil {
+		return core.NotFoundError("%w (credentialType=%s)", concept.ErrUnknownCredentialType, credentialType)
+	}
+	if file, ok := c.conceptFiles[credentialType]; ok {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		delete(c.conceptFiles, credentialType)
+	}
+	collection := c.store.Collection(credentialType)
+	for _, index := range config.Indices {
+		if err := collection.DropIndex(index.Name); err != nil {
+			return fmt.Errorf("unable to drop index %s of %s: %w", index.Name, credentialType, err)
+		}
+	}
+	if err := c.registry.Remove(credentialType); err != nil {
+		return err
+	}
+
+	log.Logger().Infof("Removed concept config (concept=%s, credentialType=%s)", config.Concept, credentialType)
+	return nil
+}
+
+// validateConcept checks whether the concept config is complete, its indices can be created and it doesn't replace a built-in concept.
+func (c *vcr) validateConcept(config concept.Config) error {
+	if config.CredentialType == "" {
+		return concept.ErrNoType
+	}
+	if config.Concept == "" {
+		return errors.New("no concept name")
+	}
+	if c.builtinConcepts[config.CredentialType] {
+		return fmt.Errorf("built-in concept can't be replaced (credentialType=%s)", config.CredentialType)
+	}
+	names := map[string]bool{}
+	for _, index := range config.Indices {
+		if index.Name == "" || names[index.Name] {
+			return fmt.Errorf("index names must be non-empty and unique (name=%s)", index.Name)
+		}
+		names[index.Name] = true
+		if len(index.Parts) == 0 {
+			return fmt.Errorf("index must have at least 1 part (name=%s)", index.Name)
+		}
+	}
+	_, err := conceptIndices(config)
+	return err
+}
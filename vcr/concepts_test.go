@@ -0,0 +1,264 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestVcr_loadConcepts(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		data, _ := yaml.Marshal(concept.ExampleConfig)
+		writeConceptFile(t, ctx.vcr.config.ConceptsDir, "human", data)
+
+		err := ctx.vcr.loadConcepts()
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NotNil(t, ctx.vcr.registry.FindByType(concept.ExampleType))
+		assert.Contains(t, ctx.vcr.conceptFiles, concept.ExampleType)
+	})
+
+	t.Run("ok - directory does not exist", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.vcr.config.ConceptsDir = path.Join(ctx.vcr.config.ConceptsDir, "unknown")
+
+		err := ctx.vcr.loadConcepts()
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - invalid config", func(t *testing.T) {
+		ctx := newMockContext(t)
+		writeConceptFile(t, ctx.vcr.config.ConceptsDir, "human", []byte("concept: human"))
+
+		err := ctx.vcr.loadConcepts()
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "invalid concept config")
+	})
+
+	t.Run("error - replaces built-in concept", func(t *testing.T) {
+		ctx := newMockContext(t)
+		config := concept.ExampleConfig
+		config.CredentialType = "NutsOrganizationCredential"
+		data, _ := yaml.Marshal(config)
+		writeConceptFile(t, ctx.vcr.config.ConceptsDir, "organization", data)
+
+		err := ctx.vcr.loadConcepts()
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "built-in concept can't be replaced")
+	})
+}
+
+func TestVcr_AddConcept(t *testing.T) {
+	vc := concept.TestVC()
+
+	t.Run("ok - existing credentials are indexed", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.vcr.Trust(vc.Type[1], vc.Issuer)
+		_ = ctx.vcr.store.Collection(concept.ExampleType).Add([]leia.Document{leia.DocumentFromString(concept.TestCredential)})
+
+		err := ctx.vcr.AddConcept(concept.ExampleConfig)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.FileExists(t, path.Join(ctx.vcr.config.ConceptsDir, concept.ExampleType+conceptFileSuffix))
+		results, err := ctx.vcr.SearchConcept(context.Background(), concept.ExampleConcept, false, map[string]string{"human.eyeColour": "blue/grey"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("ok - replaces config", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.AddConcept(concept.ExampleConfig)
+		replacement := concept.ExampleConfig
+		replacement.Indices = concept.ExampleConfig.Indices[1:]
+
+		err := ctx.vcr.AddConcept(replacement)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, ctx.vcr.registry.FindByType(concept.ExampleType).Indices, 3)
+		data, _ := os.ReadFile(ctx.vcr.conceptFiles[concept.ExampleType])
+		stored := concept.Config{}
+		_ = yaml.Unmarshal(data, &stored)
+		assert.Len(t, stored.Indices, 3)
+	})
+
+	t.Run("ok - replaces index with changed definition", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.vcr.Trust(vc.Type[1], vc.Issuer)
+		_ = ctx.vcr.store.Collection(concept.ExampleType).Add([]leia.Document{leia.DocumentFromString(concept.TestCredential)})
+		_ = ctx.vcr.AddConcept(concept.ExampleConfig)
+		hairColour := "human.hairColour"
+		replacement := concept.ExampleConfig
+		replacement.Indices = append([]concept.Index{{
+			Name:  "human",
+			Parts: []concept.IndexPart{{Alias: &hairColour, JSONPath: "credentialSubject.human.hairColour"}},
+		}}, concept.ExampleConfig.Indices[1:]...)
+
+		err := ctx.vcr.AddConcept(replacement)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		results, err := ctx.vcr.SearchConcept(context.Background(), concept.ExampleConcept, false, map[string]string{"human.hairColour": "fair"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("error - storing config fails, previous config is restored", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.vcr.Trust(vc.Type[1], vc.Issuer)
+		_ = ctx.vcr.store.Collection(concept.ExampleType).Add([]leia.Document{leia.DocumentFromString(concept.TestCredential)})
+		_ = ctx.vcr.AddConcept(concept.ExampleConfig)
+		file := ctx.vcr.conceptFiles[concept.ExampleType]
+		// a directory in place of the temporary file makes writing it fail
+		_ = os.MkdirAll(path.Join(file+".tmp", "dir"), os.ModePerm)
+		replacement := concept.ExampleConfig
+		replacement.Indices = concept.ExampleConfig.Indices[1:]
+
+		err := ctx.vcr.AddConcept(replacement)
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "unable to store concept config")
+		assert.Len(t, ctx.vcr.registry.FindByType(concept.ExampleType).Indices, 4)
+		data, _ := os.ReadFile(file)
+		stored := concept.Config{}
+		_ = yaml.Unmarshal(data, &stored)
+		assert.Len(t, stored.Indices, 4)
+		results, err := ctx.vcr.SearchConcept(context.Background(), concept.ExampleConcept, false, map[string]string{"human.eyeColour": "blue/grey"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("error - storing new config fails, config is not added", func(t *testing.T) {
+		ctx := newMockContext(t)
+		// a file in place of the concepts directory makes storing the config fail
+		_ = os.WriteFile(ctx.vcr.config.ConceptsDir, []byte{}, 0644)
+
+		err := ctx.vcr.AddConcept(concept.ExampleConfig)
+
+		assert.Error(t, err)
+		assert.Nil(t, ctx.vcr.registry.FindByType(concept.ExampleType))
+	})
+
+	t.Run("error - built-in concept", func(t *testing.T) {
+		ctx := newMockContext(t)
+		config := concept.ExampleConfig
+		config.CredentialType = "NutsOrganizationCredential"
+
+		err := ctx.vcr.AddConcept(config)
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+
+	t.Run("error - duplicate index name", func(t *testing.T) {
+		ctx := newMockContext(t)
+		config := concept.ExampleConfig
+		config.Indices = append([]concept.Index{config.Indices[0]}, config.Indices...)
+
+		err := ctx.vcr.AddConcept(config)
+
+		if !assert.ErrorIs(t, err, core.InvalidInputError("")) {
+			return
+		}
+		assert.Contains(t, err.Error(), "index names must be non-empty and unique")
+	})
+
+	t.Run("error - missing concept name", func(t *testing.T) {
+		ctx := newMockContext(t)
+		config := concept.ExampleConfig
+		config.Concept = ""
+
+		err := ctx.vcr.AddConcept(config)
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+}
+
+func TestVcr_RemoveConcept(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.AddConcept(concept.ExampleConfig)
+		file := ctx.vcr.conceptFiles[concept.ExampleType]
+
+		err := ctx.vcr.RemoveConcept(concept.ExampleType)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NoFileExists(t, file)
+		assert.Nil(t, ctx.vcr.registry.FindByType(concept.ExampleType))
+	})
+
+	t.Run("error - built-in concept", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		err := ctx.vcr.RemoveConcept("NutsOrganizationCredential")
+
+		assert.ErrorIs(t, err, core.PreconditionFailedError(""))
+	})
+
+	t.Run("error - unknown credential type", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		err := ctx.vcr.RemoveConcept(concept.ExampleType)
+
+		assert.ErrorIs(t, err, core.NotFoundError(""))
+		assert.ErrorIs(t, err, concept.ErrUnknownCredentialType)
+	})
+}
+
+func writeConceptFile(t *testing.T, dir string, name string, data []byte) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(dir, name+conceptFileSuffix), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
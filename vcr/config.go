@@ -19,6 +19,8 @@
 
 package vcr
 
+import "time"
+
 const moduleName = "VCR"
 
 // Config holds the config for the vcr engine
@@ -28,11 +30,133 @@ type Config struct {
 	// OverrideAllPublic overrides the "Public" property of a credential when issuing credentials:
 	// if set to true, all issued credentials are published as public credentials, regardless of whether they're actually marked as public.
 	OverrideIssueAllPublic bool `koanf:"vcr.overrideissueallpublic"`
+	// BatchTransactions specifies whether credentials issued in a batch are combined in transactions of the batch payload type.
+	// Nodes that don't support the batch payload type ignore these transactions, so it must only be enabled when all nodes
+	// of the network support it. If false, every credential of a batch is published in its own transaction.
+	BatchTransactions bool `koanf:"vcr.batchtransactions"`
+	// Expiry holds the configuration for monitoring issued credentials that are about to expire.
+	Expiry ExpiryConfig `koanf:"vcr.expiry"`
+	// ConceptsDir is the directory from which additional concept configs are loaded, and in which concept configs
+	// managed at runtime are stored. If not set, the 'vcr/concepts' directory in the data directory is used.
+	ConceptsDir string `koanf:"vcr.conceptsdir"`
+	// TrustLists holds the configuration for importing trust lists.
+	TrustLists TrustListConfig `koanf:"vcr.trustlists"`
+	// JSONLD holds the configuration for the JSON-LD contexts used to sign and verify credentials.
+	JSONLD JSONLDConfig `koanf:"vcr.jsonld"`
+	// SchemasDir is the directory containing the schemas file, which lists the JSON Schemas credentials are validated against
+	// on issuance and when they're received. If not set, the 'vcr/schemas' directory in the data directory is used.
+	SchemasDir string `koanf:"vcr.schemasdir"`
+	// Notifications holds the configuration for notifying the application of credentials received for DIDs managed by this node.
+	Notifications NotificationConfig `koanf:"vcr.notifications"`
+	// Audit holds the configuration for the audit trail of credential verifications.
+	Audit AuditConfig `koanf:"vcr.audit"`
+	// Templates holds the configuration for the issuance templates.
+	Templates TemplateConfig `koanf:"vcr.templates"`
+	// Refresh holds the configuration for refreshing issued credentials at the request of their subject.
+	Refresh RefreshConfig `koanf:"vcr.refresh"`
 	// datadir holds the location the VCR files are stored
 	datadir string
 }
 
+// ExpiryConfig holds the config for monitoring issued credentials that are about to expire.
+type ExpiryConfig struct {
+	// Interval specifies how often the issued credentials are checked for their expiry. If 0, they aren't checked.
+	Interval time.Duration `koanf:"interval"`
+	// Window specifies how long before their expiration date issued credentials are reported as expiring.
+	Window time.Duration `koanf:"window"`
+	// Reissue contains the credential types that are reissued automatically when they're about to expire.
+	// The credential that expires is revoked when its expiration date has passed.
+	Reissue []string `koanf:"reissue"`
+}
+
+// reissues returns true if credentials of the given type must be reissued when they're about to expire.
+func (c ExpiryConfig) reissues(credentialType string) bool {
+	for _, curr := range c.Reissue {
+		if curr == credentialType {
+			return true
+		}
+	}
+	return false
+}
+
+// TrustListConfig holds the config for importing trust lists published by governance bodies.
+type TrustListConfig struct {
+	// Signers contains the DIDs of the governance bodies whose signed trust lists can be imported.
+	Signers []string `koanf:"signers"`
+}
+
+// isSigner returns true if trust lists signed by the given DID can be imported.
+func (c TrustListConfig) isSigner(signer string) bool {
+	for _, curr := range c.Signers {
+		if curr == signer {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONLDConfig holds the config for the JSON-LD contexts the node can use, in addition to the contexts embedded in the node.
+type JSONLDConfig struct {
+	// ContextsDir is the directory containing the contexts file, which lists the additional contexts with their SHA-256 hash,
+	// and the files of the listed local contexts. If not set, the 'vcr/contexts' directory in the data directory is used.
+	ContextsDir string `koanf:"contextsdir"`
+}
+
+// NotificationConfig holds the config for notifying the application of credentials received for DIDs managed by this node.
+type NotificationConfig struct {
+	// Webhook is the URL to which an event is POSTed for every received credential. If not set, no webhook is called.
+	Webhook string `koanf:"webhook"`
+	// WebhookTimeout specifies how long to wait for the webhook to respond.
+	WebhookTimeout time.Duration `koanf:"webhooktimeout"`
+}
+
+// AuditConfig holds the config for the audit trail of credential verifications.
+type AuditConfig struct {
+	// Enabled specifies whether verifications of credentials are recorded in the audit trail.
+	Enabled bool `koanf:"enabled"`
+	// Retention specifies how long entries are kept in the audit trail. If 0, they're kept forever.
+	Retention time.Duration `koanf:"retention"`
+}
+
+// TemplateConfig holds the config for the issuance templates, which define how credentials of a type are issued.
+type TemplateConfig struct {
+	// Dir is the directory from which issuance templates are loaded, and in which templates managed at runtime are stored.
+	// If not set, the 'vcr/templates' directory in the data directory is used.
+	Dir string `koanf:"dir"`
+	// Strict specifies whether issued credentials must match an issuance template of their type.
+	Strict bool `koanf:"strict"`
+}
+
+// RefreshConfig holds the config for refreshing issued credentials at the request of their subject.
+type RefreshConfig struct {
+	// CredentialTypes contains the credential types that can be refreshed. If empty, no credentials can be refreshed.
+	CredentialTypes []string `koanf:"credentialtypes"`
+}
+
+// refreshes returns true if credentials of the given type can be refreshed.
+func (c RefreshConfig) refreshes(credentialType string) bool {
+	for _, curr := range c.CredentialTypes {
+		if curr == credentialType {
+			return true
+		}
+	}
+	return false
+}
+
 // DefaultConfig returns a fresh Config filled with default values
 func DefaultConfig() Config {
-	return Config{OverrideIssueAllPublic: true}
+	return Config{
+		OverrideIssueAllPublic: true,
+		Expiry: ExpiryConfig{
+			Interval: time.Hour,
+			Window:   30 * 24 * time.Hour,
+		},
+		Notifications: NotificationConfig{
+			WebhookTimeout: 5 * time.Second,
+		},
+		Audit: AuditConfig{
+			// 5 years
+			Retention: 5 * 365 * 24 * time.Hour,
+		},
+	}
 }
@@ -0,0 +1,232 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package credential
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
+)
+
+// SchemasFile is the name of the file in the schemas directory that lists the credential schemas.
+const SchemasFile = "schemas.yaml"
+
+// JSONSchemaValidator2018Type is the credentialSchema type for validating credentials against a JSON Schema.
+const JSONSchemaValidator2018Type = "JsonSchemaValidator2018"
+
+// SchemaEntry describes a credential schema. It's an entry of the schemas file.
+type SchemaEntry struct {
+	// ID is the identifier of the schema, as used in the 'credentialSchema' property of credentials.
+	ID string `yaml:"id"`
+	// Type is the type of the schema. Only JsonSchemaValidator2018 is supported, which is also the default.
+	Type string `yaml:"type,omitempty"`
+	// CredentialType is the credential type the schema applies to.
+	CredentialType string `yaml:"credentialType"`
+	// File is the file in the schemas directory that contains the JSON Schema.
+	File string `yaml:"file"`
+}
+
+// schemasFile is the content of the schemas file.
+type schemasFile struct {
+	Schemas []SchemaEntry `yaml:"schemas"`
+}
+
+type credentialSchema struct {
+	id     string
+	schema *gojsonschema.Schema
+}
+
+// SchemaValidator validates credentials against the JSON Schemas listed in their 'credentialSchema' property.
+// Credentials without 'credentialSchema' entries are validated against the JSON Schema of their credential type.
+// Credentials of types without a schema are not validated.
+type SchemaValidator struct {
+	// schemas contains the schemas by credential type
+	schemas map[string]credentialSchema
+	// byID contains the schemas by schema ID
+	byID map[string]credentialSchema
+}
+
+// NewSchemaValidator creates a SchemaValidator with the schemas listed in the schemas file of the given directory.
+// If the schemas file doesn't exist, no credentials are validated.
+// References in schemas are resolved relative to the schema file.
+func NewSchemaValidator(schemasDir string) (*SchemaValidator, error) {
+	validator := &SchemaValidator{schemas: map[string]credentialSchema{}, byID: map[string]credentialSchema{}}
+	if schemasDir == "" {
+		return validator, nil
+	}
+	data, err := os.ReadFile(filepath.Join(schemasDir, SchemasFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return validator, nil
+	} else if err != nil {
+		return nil, err
+	}
+	entries := schemasFile{}
+	if err = yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", SchemasFile, err)
+	}
+	for _, entry := range entries.Schemas {
+		if err = validator.add(schemasDir, entry); err != nil {
+			return nil, fmt.Errorf("invalid schema (id=%s): %w", entry.ID, err)
+		}
+	}
+	return validator, nil
+}
+
+func (s *SchemaValidator) add(schemasDir string, entry SchemaEntry) error {
+	if entry.ID == "" {
+		return errors.New("id is required")
+	}
+	if entry.Type != "" && entry.Type != JSONSchemaValidator2018Type {
+		return fmt.Errorf("unsupported schema type: %s", entry.Type)
+	}
+	if entry.CredentialType == "" {
+		return errors.New("credentialType is required")
+	}
+	if _, exists := s.schemas[entry.CredentialType]; exists {
+		return fmt.Errorf("credential type already has a schema: %s", entry.CredentialType)
+	}
+	if _, exists := s.byID[entry.ID]; exists {
+		return errors.New("id is already used by another schema")
+	}
+	file, err := filepath.Abs(filepath.Join(schemasDir, entry.File))
+	if err != nil {
+		return err
+	}
+	fileURL := url.URL{Scheme: "file", Path: filepath.ToSlash(file)}
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewReferenceLoader(fileURL.String()))
+	if err != nil {
+		return fmt.Errorf("unable to load schema: %w", err)
+	}
+	s.schemas[entry.CredentialType] = credentialSchema{id: entry.ID, schema: schema}
+	s.byID[entry.ID] = s.schemas[entry.CredentialType]
+	return nil
+}
+
+// SchemaID returns the ID of the schema of the given credential type. It returns false if the type has no schema.
+func (s *SchemaValidator) SchemaID(credentialType string) (string, bool) {
+	current, ok := s.schemas[credentialType]
+	return current.id, ok
+}
+
+// Validate validates the credential against the schemas of its 'credentialSchema' entries or, if it has none, the schemas of its types.
+// It returns an ErrValidation when the credential doesn't conform to a schema or refers to an unknown or unsupported schema.
+func (s *SchemaValidator) Validate(credential vc.VerifiableCredential) error {
+	references, err := schemaReferences(credential)
+	if err != nil {
+		return failure("invalid credentialSchema: %s", err)
+	}
+	var selected []credentialSchema
+	if len(references) > 0 {
+		for _, reference := range references {
+			if reference.Type != JSONSchemaValidator2018Type {
+				return failure("unsupported credentialSchema type: %s", reference.Type)
+			}
+			current, ok := s.byID[reference.ID]
+			if !ok {
+				return failure("unknown credentialSchema (id=%s)", reference.ID)
+			}
+			selected = append(selected, current)
+		}
+	} else {
+		for _, credentialType := range ExtractTypes(credential) {
+			if current, ok := s.schemas[credentialType]; ok {
+				selected = append(selected, current)
+			}
+		}
+	}
+	if len(selected) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(credential)
+	if err != nil {
+		return err
+	}
+	for _, current := range selected {
+		result, err := current.schema.Validate(gojsonschema.NewBytesLoader(data))
+		if err != nil {
+			return fmt.Errorf("unable to validate credential against schema (id=%s): %w", current.id, err)
+		}
+		if !result.Valid() {
+			var msgs []string
+			for _, resultErr := range result.Errors() {
+				msgs = append(msgs, resultErr.String())
+			}
+			return failure("credential doesn't conform to schema (id=%s): %s", current.id, strings.Join(msgs, ", "))
+		}
+	}
+	return nil
+}
+
+// SchemaReference is an entry of the 'credentialSchema' property of a credential.
+type SchemaReference struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// schemaReferences returns the 'credentialSchema' entries of the credential.
+// The property isn't retained in the JSON form of credentials, so it's read from the 'vc' claim of credentials in (SD-)JWT format.
+// Credentials in JSON-LD format never have entries.
+func schemaReferences(credential vc.VerifiableCredential) ([]SchemaReference, error) {
+	document, err := proof.NewSignedDocument(credential)
+	if err != nil {
+		return nil, err
+	}
+	var claims map[string]interface{}
+	if document.HasJWTProof() {
+		jwtProof := proof.JWTProof{}
+		if err = document.UnmarshalProofValue(&jwtProof); err == nil {
+			claims, err = jwtProof.Claims()
+		}
+	} else if document.HasSDJWTProof() {
+		sdJWTProof := proof.SDJWTProof{}
+		if err = document.UnmarshalProofValue(&sdJWTProof); err == nil {
+			claims, err = sdJWTProof.Claims()
+		}
+	} else {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	credentialClaim, _ := claims["vc"].(map[string]interface{})
+	value, ok := credentialClaim["credentialSchema"]
+	if !ok {
+		return nil, nil
+	}
+	if _, isSingle := value.(map[string]interface{}); isSingle {
+		value = []interface{}{value}
+	}
+	data, _ := json.Marshal(value)
+	var references []SchemaReference
+	if err = json.Unmarshal(data, &references); err != nil {
+		return nil, err
+	}
+	return references, nil
+}
@@ -0,0 +1,344 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package credential
+
+import (
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/stretchr/testify/assert"
+)
+
+const organizationSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "required": ["credentialSubject"],
+  "properties": {
+    "credentialSubject": {
+      "type": "object",
+      "required": ["organization"],
+      "properties": {
+        "organization": {"$ref": "organization.json"}
+      }
+    }
+  }
+}`
+
+const organizationDefinition = `{
+  "type": "object",
+  "required": ["name", "city"],
+  "properties": {
+    "name": {"type": "string", "minLength": 1},
+    "city": {"type": "string", "minLength": 1}
+  }
+}`
+
+const schemasConfig = `
+schemas:
+  - id: https://example.com/schemas/organization.json
+    type: JsonSchemaValidator2018
+    credentialType: NutsOrganizationCredential
+    file: organization-credential.json
+`
+
+func writeSchemaFiles(t *testing.T, files map[string]string) string {
+	dir := io.TestDirectory(t)
+	for name, data := range files {
+		if err := os.WriteFile(path.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestNewSchemaValidator(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		dir := writeSchemaFiles(t, map[string]string{
+			SchemasFile:                    schemasConfig,
+			"organization-credential.json": organizationSchema,
+			"organization.json":            organizationDefinition,
+		})
+
+		validator, err := NewSchemaValidator(dir)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, validator.schemas, 1)
+		assert.Equal(t, "https://example.com/schemas/organization.json", validator.schemas[NutsOrganizationCredentialType].id)
+	})
+	t.Run("ok - no schemas file", func(t *testing.T) {
+		validator, err := NewSchemaValidator(io.TestDirectory(t))
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, validator.schemas)
+	})
+	t.Run("ok - no directory", func(t *testing.T) {
+		validator, err := NewSchemaValidator("")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, validator.schemas)
+	})
+	t.Run("error - invalid schemas file", func(t *testing.T) {
+		dir := writeSchemaFiles(t, map[string]string{SchemasFile: "schemas: {"})
+
+		_, err := NewSchemaValidator(dir)
+
+		assert.Contains(t, err.Error(), "unable to parse schemas.yaml")
+	})
+	t.Run("error - unsupported type", func(t *testing.T) {
+		dir := writeSchemaFiles(t, map[string]string{SchemasFile: `
+schemas:
+  - id: https://example.com/schemas/organization.json
+    type: ZkpExampleSchema2018
+    credentialType: NutsOrganizationCredential
+    file: organization-credential.json
+`})
+
+		_, err := NewSchemaValidator(dir)
+
+		assert.EqualError(t, err, "invalid schema (id=https://example.com/schemas/organization.json): unsupported schema type: ZkpExampleSchema2018")
+	})
+	t.Run("error - missing id", func(t *testing.T) {
+		dir := writeSchemaFiles(t, map[string]string{SchemasFile: `
+schemas:
+  - credentialType: NutsOrganizationCredential
+    file: organization-credential.json
+`})
+
+		_, err := NewSchemaValidator(dir)
+
+		assert.EqualError(t, err, "invalid schema (id=): id is required")
+	})
+	t.Run("error - missing credential type", func(t *testing.T) {
+		dir := writeSchemaFiles(t, map[string]string{SchemasFile: `
+schemas:
+  - id: https://example.com/schemas/organization.json
+    file: organization-credential.json
+`})
+
+		_, err := NewSchemaValidator(dir)
+
+		assert.EqualError(t, err, "invalid schema (id=https://example.com/schemas/organization.json): credentialType is required")
+	})
+	t.Run("error - credential type with multiple schemas", func(t *testing.T) {
+		dir := writeSchemaFiles(t, map[string]string{
+			SchemasFile: schemasConfig + `
+  - id: https://example.com/schemas/other.json
+    credentialType: NutsOrganizationCredential
+    file: organization-credential.json
+`,
+			"organization-credential.json": organizationSchema,
+			"organization.json":            organizationDefinition,
+		})
+
+		_, err := NewSchemaValidator(dir)
+
+		assert.EqualError(t, err, "invalid schema (id=https://example.com/schemas/other.json): credential type already has a schema: NutsOrganizationCredential")
+	})
+	t.Run("error - id used by multiple schemas", func(t *testing.T) {
+		dir := writeSchemaFiles(t, map[string]string{
+			SchemasFile: schemasConfig + `
+  - id: https://example.com/schemas/organization.json
+    credentialType: NutsAuthorizationCredential
+    file: organization-credential.json
+`,
+			"organization-credential.json": organizationSchema,
+			"organization.json":            organizationDefinition,
+		})
+
+		_, err := NewSchemaValidator(dir)
+
+		assert.EqualError(t, err, "invalid schema (id=https://example.com/schemas/organization.json): id is already used by another schema")
+	})
+	t.Run("error - missing schema file", func(t *testing.T) {
+		dir := writeSchemaFiles(t, map[string]string{SchemasFile: schemasConfig})
+
+		_, err := NewSchemaValidator(dir)
+
+		assert.Contains(t, err.Error(), "invalid schema (id=https://example.com/schemas/organization.json): unable to load schema")
+	})
+	t.Run("error - invalid schema", func(t *testing.T) {
+		dir := writeSchemaFiles(t, map[string]string{
+			SchemasFile:                    schemasConfig,
+			"organization-credential.json": `{"type": 1}`,
+		})
+
+		_, err := NewSchemaValidator(dir)
+
+		assert.Contains(t, err.Error(), "unable to load schema")
+	})
+}
+
+func TestSchemaValidator_Validate(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{
+		SchemasFile:                    schemasConfig,
+		"organization-credential.json": organizationSchema,
+		"organization.json":            organizationDefinition,
+	})
+	validator, err := NewSchemaValidator(dir)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		err := validator.Validate(*validNutsOrganizationCredential())
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - no schema for credential type", func(t *testing.T) {
+		err := validator.Validate(*ValidExplicitNutsAuthorizationCredential())
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - credential doesn't conform to schema", func(t *testing.T) {
+		credential := validNutsOrganizationCredential()
+		credential.CredentialSubject = []interface{}{map[string]interface{}{
+			"id": "did:nuts:123",
+			"organization": map[string]interface{}{
+				"name": "Because we care B.V.",
+			},
+		}}
+
+		err := validator.Validate(*credential)
+
+		assert.True(t, errors.Is(err, ErrValidation))
+		assert.EqualError(t, err, "validation failed: credential doesn't conform to schema (id=https://example.com/schemas/organization.json): "+
+			"credentialSubject.organization: city is required")
+	})
+}
+
+func TestSchemaValidator_SchemaID(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{
+		SchemasFile:                    schemasConfig,
+		"organization-credential.json": organizationSchema,
+		"organization.json":            organizationDefinition,
+	})
+	validator, _ := NewSchemaValidator(dir)
+
+	id, ok := validator.SchemaID(NutsOrganizationCredentialType)
+	assert.True(t, ok)
+	assert.Equal(t, "https://example.com/schemas/organization.json", id)
+
+	_, ok = validator.SchemaID(NutsAuthorizationCredentialType)
+	assert.False(t, ok)
+}
+
+func TestSchemaValidator_Validate_CredentialSchema(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{
+		SchemasFile:                    schemasConfig,
+		"organization-credential.json": organizationSchema,
+		"organization.json":            organizationDefinition,
+	})
+	validator, err := NewSchemaValidator(dir)
+	if !assert.NoError(t, err) {
+		return
+	}
+	key := crypto.NewTestKey("did:nuts:CuE3qeFGGLhEAS3gKzhMCeqd1dGa9at5JCbmCfyMU2Ey#sNGDQ3NlOe6Icv0E7_ufviOLG6Y25bSEyS5EbXBgp8Y")
+	// jwtCredential returns the credential in JWT format with the given 'credentialSchema' entries
+	jwtCredential := func(source vc.VerifiableCredential, credentialSchema interface{}) vc.VerifiableCredential {
+		claims, err := proof.CredentialClaims(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if credentialSchema != nil {
+			claims["vc"].(map[string]interface{})["credentialSchema"] = credentialSchema
+		}
+		jwtProof, err := proof.NewJWTProof(claims, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := jwtProof.Credential()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *result
+	}
+	organizationSchemaRef := map[string]interface{}{"id": "https://example.com/schemas/organization.json", "type": JSONSchemaValidator2018Type}
+	invalidSubject := []interface{}{map[string]interface{}{
+		"id":           "did:nuts:123",
+		"organization": map[string]interface{}{"name": "Because we care B.V."},
+	}}
+
+	t.Run("ok", func(t *testing.T) {
+		err := validator.Validate(jwtCredential(*validNutsOrganizationCredential(), []interface{}{organizationSchemaRef}))
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - single entry", func(t *testing.T) {
+		err := validator.Validate(jwtCredential(*validNutsOrganizationCredential(), organizationSchemaRef))
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - schema selected by entry instead of credential type", func(t *testing.T) {
+		credential := ValidExplicitNutsAuthorizationCredential()
+
+		err := validator.Validate(jwtCredential(*credential, []interface{}{organizationSchemaRef}))
+
+		assert.ErrorIs(t, err, ErrValidation)
+		assert.Contains(t, err.Error(), "credential doesn't conform to schema (id=https://example.com/schemas/organization.json)")
+	})
+	t.Run("error - no entries, selected by credential type", func(t *testing.T) {
+		credential := validNutsOrganizationCredential()
+		credential.CredentialSubject = invalidSubject
+
+		err := validator.Validate(jwtCredential(*credential, nil))
+
+		assert.ErrorIs(t, err, ErrValidation)
+	})
+	t.Run("error - doesn't conform to schema", func(t *testing.T) {
+		credential := validNutsOrganizationCredential()
+		credential.CredentialSubject = invalidSubject
+
+		err := validator.Validate(jwtCredential(*credential, []interface{}{organizationSchemaRef}))
+
+		assert.ErrorIs(t, err, ErrValidation)
+	})
+	t.Run("error - unknown schema", func(t *testing.T) {
+		ref := map[string]interface{}{"id": "https://example.com/schemas/other.json", "type": JSONSchemaValidator2018Type}
+
+		err := validator.Validate(jwtCredential(*validNutsOrganizationCredential(), []interface{}{ref}))
+
+		assert.EqualError(t, err, "validation failed: unknown credentialSchema (id=https://example.com/schemas/other.json)")
+	})
+	t.Run("error - unsupported schema type", func(t *testing.T) {
+		ref := map[string]interface{}{"id": "https://example.com/schemas/organization.json", "type": "ZkpExampleSchema2018"}
+
+		err := validator.Validate(jwtCredential(*validNutsOrganizationCredential(), []interface{}{ref}))
+
+		assert.EqualError(t, err, "validation failed: unsupported credentialSchema type: ZkpExampleSchema2018")
+	})
+	t.Run("error - invalid entries", func(t *testing.T) {
+		err := validator.Validate(jwtCredential(*validNutsOrganizationCredential(), "https://example.com/schemas/organization.json"))
+
+		assert.ErrorIs(t, err, ErrValidation)
+		assert.Contains(t, err.Error(), "invalid credentialSchema")
+	})
+}
@@ -0,0 +1,325 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/events"
+	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+	"github.com/nuts-foundation/nuts-node/vcr/pe"
+	"github.com/nuts-foundation/nuts-node/vcr/verifier"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// publishTimeout is the maximum time it may take to acquire a connection for publishing an event.
+const publishTimeout = 5 * time.Second
+
+// ExpiryEventType defines the kind of CredentialExpiryEvent.
+type ExpiryEventType string
+
+const (
+	// CredentialExpiringEvent is published for an issued credential that is about to expire.
+	// It's published once per credential while the node runs, but again after a restart: delivery is at-least-once.
+	CredentialExpiringEvent ExpiryEventType = "expiring"
+	// CredentialReissuedEvent is published when a successor has been issued for a credential that is about to expire.
+	CredentialReissuedEvent ExpiryEventType = "reissued"
+	// CredentialExpiredEvent is published when a reissued credential has been revoked because it expired.
+	CredentialExpiredEvent ExpiryEventType = "revoked"
+)
+
+// CredentialExpiryEvent is published on the VCR stream for issued credentials that are about to expire.
+type CredentialExpiryEvent struct {
+	// Type specifies what happened to the credential.
+	Type ExpiryEventType `json:"type"`
+	// CredentialID contains the ID of the issued credential.
+	CredentialID string `json:"credentialID"`
+	// CredentialType contains the type of the issued credential.
+	CredentialType string `json:"credentialType"`
+	// ExpirationDate contains the expiration date of the issued credential.
+	ExpirationDate time.Time `json:"expirationDate"`
+	// SuccessorID contains the ID of the credential that replaces the issued credential, if it has been reissued.
+	SuccessorID string `json:"successorID,omitempty"`
+}
+
+var (
+	expiringCredentialsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "nuts",
+		Subsystem: "vcr",
+		Name:      "issued_credentials_expiring",
+		Help:      "Number of non-revoked issued credentials that are about to expire (or have expired), per credential type.",
+	}, []string{"credential_type"})
+	reissuedCredentialsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "nuts",
+		Subsystem: "vcr",
+		Name:      "issued_credentials_reissued_total",
+		Help:      "Number of issued credentials that have been reissued because they were about to expire, per credential type.",
+	}, []string{"credential_type"})
+	expiredCredentialsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "nuts",
+		Subsystem: "vcr",
+		Name:      "issued_credentials_expired_revoked_total",
+		Help:      "Number of reissued credentials that have been revoked because they expired, per credential type.",
+	}, []string{"credential_type"})
+)
+
+func registerExpiryMetrics() error {
+	for _, collector := range []prometheus.Collector{expiringCredentialsGauge, reissuedCredentialsCounter, expiredCredentialsCounter} {
+		if err := prometheus.Register(collector); err != nil && !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+			return err
+		}
+	}
+	return nil
+}
+
+// expiryMonitor checks the issued credentials for credentials that are about to expire.
+// It publishes an event for every credential that is about to expire, and reissues credentials of the configured types.
+// A reissued credential is revoked once it has expired.
+type expiryMonitor struct {
+	config   ExpiryConfig
+	issuer   issuer.Issuer
+	verifier verifier.Verifier
+	// reissue issues the successor of a credential that is about to expire, which expires at the given moment.
+	reissue func(predecessor vc.VerifiableCredential, credentialType ssi.URI, expirationDate time.Time) (*vc.VerifiableCredential, error)
+	// publish publishes the given event.
+	publish func(event CredentialExpiryEvent) error
+	// notified contains the IDs of the credentials for which an expiring event has been published.
+	// It's only accessed from the goroutine that runs the checks. It isn't persisted, so after a restart the expiring event
+	// is published again for credentials that are still about to expire. Consumers must handle duplicate events.
+	notified map[string]bool
+}
+
+// run checks the issued credentials immediately and then every interval, until the context is cancelled.
+func (m *expiryMonitor) run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+	for {
+		if err := m.check(timeFunc()); err != nil {
+			log.Logger().Errorf("Unable to check issued credentials for expiry: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check handles the non-revoked issued credentials that expire within the configured window from now.
+// Errors for a single credential are logged, so they don't prevent the other credentials from being handled.
+func (m *expiryMonitor) check(now time.Time) error {
+	credentials, err := m.issuer.SearchExpiringCredentials(now.Add(m.config.Window))
+	if err != nil {
+		return err
+	}
+	expiring := map[string]float64{}
+	for _, credential := range credentials {
+		if credential.ID == nil {
+			continue
+		}
+		revoked, err := m.verifier.IsRevoked(*credential.ID)
+		if err != nil {
+			log.Logger().Errorf("Unable to check revocation of expiring credential (id=%s): %v", credential.ID, err)
+			continue
+		}
+		if revoked {
+			continue
+		}
+		credentialType, err := credentialTypeOf(credential)
+		if err != nil {
+			log.Logger().Errorf("Unable to handle expiring credential (id=%s): %v", credential.ID, err)
+			continue
+		}
+		expiring[credentialType.String()]++
+		if err := m.handle(credential, *credentialType, now); err != nil {
+			log.Logger().Errorf("Unable to handle expiring credential (id=%s): %v", credential.ID, err)
+		}
+	}
+
+	expiringCredentialsGauge.Reset()
+	for credentialType, count := range expiring {
+		expiringCredentialsGauge.WithLabelValues(credentialType).Set(count)
+	}
+	return nil
+}
+
+func (m *expiryMonitor) handle(credential vc.VerifiableCredential, credentialType ssi.URI, now time.Time) error {
+	id := credential.ID.String()
+	if !m.notified[id] {
+		log.Logger().Infof("Issued credential is about to expire (id=%s, type=%s, expirationDate=%s)", id, credentialType, credential.ExpirationDate)
+		if err := m.publish(newExpiryEvent(CredentialExpiringEvent, credential, credentialType, nil)); err != nil {
+			return fmt.Errorf("unable to publish expiry event: %w", err)
+		}
+		m.notified[id] = true
+	}
+
+	if !m.config.reissues(credentialType.String()) {
+		return nil
+	}
+	successor, err := m.findSuccessor(credential, credentialType)
+	if err != nil {
+		return err
+	}
+	if successor == nil {
+		validity := credential.ExpirationDate.Sub(credential.IssuanceDate)
+		if validity <= m.config.Window {
+			// the successor would be reported as expiring right away, causing it to be reissued as well
+			return fmt.Errorf("validity period of the credential (%s) must be longer than the expiry window (%s) to be reissued", validity, m.config.Window)
+		}
+		// the successor is valid for the same period as the predecessor, counting from its own issuance,
+		// so it doesn't exceed the validity of the issuance template the predecessor was issued with.
+		successor, err = m.reissue(credential, credentialType, now.Add(validity))
+		if err != nil {
+			return fmt.Errorf("unable to reissue credential: %w", err)
+		}
+		reissuedCredentialsCounter.WithLabelValues(credentialType.String()).Inc()
+		log.Logger().Infof("Issued credential has been reissued (id=%s, successor=%s)", id, successor.ID)
+		if err := m.publish(newExpiryEvent(CredentialReissuedEvent, credential, credentialType, successor.ID)); err != nil {
+			return fmt.Errorf("unable to publish expiry event: %w", err)
+		}
+	}
+
+	if credential.ExpirationDate.After(now) {
+		return nil
+	}
+	if _, err := m.issuer.Revoke(*credential.ID, ""); err != nil {
+		return fmt.Errorf("unable to revoke expired credential: %w", err)
+	}
+	expiredCredentialsCounter.WithLabelValues(credentialType.String()).Inc()
+	log.Logger().Infof("Expired credential has been revoked (id=%s, successor=%s)", id, successor.ID)
+	if err := m.publish(newExpiryEvent(CredentialExpiredEvent, credential, credentialType, successor.ID)); err != nil {
+		return fmt.Errorf("unable to publish expiry event: %w", err)
+	}
+	return nil
+}
+
+// findSuccessor searches the issued credentials for a non-revoked credential with the same type, issuer and subject,
+// which expires after the given credential. It returns nil if there is none.
+func (m *expiryMonitor) findSuccessor(credential vc.VerifiableCredential, credentialType ssi.URI) (*vc.VerifiableCredential, error) {
+	issuerDID, err := did.ParseDID(credential.Issuer.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer: %w", err)
+	}
+	subjectID, err := credentialSubjectID(credential)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := m.issuer.SearchCredential(vc.VCContextV1URI(), credentialType, *issuerDID, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		if candidate.ID == nil || candidate.ID.String() == credential.ID.String() {
+			continue
+		}
+		if candidate.ExpirationDate != nil && !candidate.ExpirationDate.After(*credential.ExpirationDate) {
+			continue
+		}
+		revoked, err := m.verifier.IsRevoked(*candidate.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !revoked {
+			return &candidate, nil
+		}
+	}
+	return nil, nil
+}
+
+func newExpiryEvent(eventType ExpiryEventType, credential vc.VerifiableCredential, credentialType ssi.URI, successorID *ssi.URI) CredentialExpiryEvent {
+	event := CredentialExpiryEvent{
+		Type:           eventType,
+		CredentialID:   credential.ID.String(),
+		CredentialType: credentialType.String(),
+		ExpirationDate: *credential.ExpirationDate,
+	}
+	if successorID != nil {
+		event.SuccessorID = successorID.String()
+	}
+	return event
+}
+
+// credentialTypeOf returns the type of the credential, besides the VerifiableCredential base type.
+func credentialTypeOf(credential vc.VerifiableCredential) (*ssi.URI, error) {
+	var result *ssi.URI
+	for _, curr := range credential.Type {
+		if curr == vc.VerifiableCredentialTypeV1URI() {
+			continue
+		}
+		if result != nil {
+			return nil, errors.New("credential has multiple types")
+		}
+		credentialType := curr
+		result = &credentialType
+	}
+	if result == nil {
+		return nil, errors.New("credential has no type")
+	}
+	return result, nil
+}
+
+// credentialSubjectID returns the ID of the subject of the credential, which must have exactly 1 subject.
+func credentialSubjectID(credential vc.VerifiableCredential) (*ssi.URI, error) {
+	if len(credential.CredentialSubject) != 1 {
+		return nil, errors.New("credential must have exactly 1 subject")
+	}
+	subject, ok := credential.CredentialSubject[0].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid credential subject")
+	}
+	id, ok := subject["id"].(string)
+	if !ok {
+		return nil, errors.New("credential subject has no ID")
+	}
+	return ssi.ParseURI(id)
+}
+
+// reissue issues a successor for the given credential with the same type, issuer and subject, which expires at the given moment.
+// It's issued in the same format as the predecessor and published like credentials issued through Issue.
+func (c *vcr) reissue(predecessor vc.VerifiableCredential, credentialType ssi.URI, expirationDate time.Time) (*vc.VerifiableCredential, error) {
+	template := vc.VerifiableCredential{
+		Type:              []ssi.URI{credentialType},
+		Issuer:            predecessor.Issuer,
+		CredentialSubject: predecessor.CredentialSubject,
+		ExpirationDate:    &expirationDate,
+	}
+	for _, curr := range predecessor.Context {
+		if curr != vc.VCContextV1URI() {
+			template.Context = append(template.Context, curr)
+		}
+	}
+	public := c.config.OverrideIssueAllPublic
+	if conceptConfig := c.registry.FindByType(credentialType.String()); conceptConfig != nil {
+		public = public || conceptConfig.Public
+	}
+	return c.issuer.Issue(template, pe.CredentialFormat(predecessor), true, public)
+}
+
+// publishExpiryEvent publishes the given event on the VCR stream.
+func (c *vcr) publishExpiryEvent(event CredentialExpiryEvent) error {
+	return c.publishEvent(events.CredentialExpirySubject, event)
+}
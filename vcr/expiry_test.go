@@ -0,0 +1,341 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
+	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+	"github.com/nuts-foundation/nuts-node/vcr/verifier"
+	"github.com/nuts-foundation/nuts-node/vdr"
+	vdrTypes "github.com/nuts-foundation/nuts-node/vdr/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type expiryTestContext struct {
+	monitor  *expiryMonitor
+	issuer   *issuer.MockIssuer
+	verifier *verifier.MockVerifier
+	events   []CredentialExpiryEvent
+	reissued []time.Time
+}
+
+func newExpiryTestContext(t *testing.T, reissue ...string) *expiryTestContext {
+	ctrl := gomock.NewController(t)
+	ctx := &expiryTestContext{
+		issuer:   issuer.NewMockIssuer(ctrl),
+		verifier: verifier.NewMockVerifier(ctrl),
+	}
+	ctx.monitor = &expiryMonitor{
+		config:   ExpiryConfig{Interval: time.Hour, Window: 30 * 24 * time.Hour, Reissue: reissue},
+		issuer:   ctx.issuer,
+		verifier: ctx.verifier,
+		reissue: func(predecessor vc.VerifiableCredential, credentialType ssi.URI, expirationDate time.Time) (*vc.VerifiableCredential, error) {
+			ctx.reissued = append(ctx.reissued, expirationDate)
+			successorID := ssi.MustParseURI("did:nuts:issuer#successor")
+			return &vc.VerifiableCredential{ID: &successorID, ExpirationDate: &expirationDate}, nil
+		},
+		publish: func(event CredentialExpiryEvent) error {
+			ctx.events = append(ctx.events, event)
+			return nil
+		},
+		notified: map[string]bool{},
+	}
+	return ctx
+}
+
+func TestExpiryMonitor_Check(t *testing.T) {
+	now := time.Now()
+	window := 30 * 24 * time.Hour
+	credentialType := ssi.MustParseURI("TestCredential")
+	issuerDID := did.MustParseDID("did:nuts:issuer")
+	subjectID := ssi.MustParseURI("did:nuts:subject")
+	credentialID := ssi.MustParseURI("did:nuts:issuer#1")
+	successorID := ssi.MustParseURI("did:nuts:issuer#successor")
+	expiringCredential := func(expirationDate time.Time) vc.VerifiableCredential {
+		return vc.VerifiableCredential{
+			ID:                &credentialID,
+			Type:              []ssi.URI{vc.VerifiableCredentialTypeV1URI(), credentialType},
+			Issuer:            issuerDID.URI(),
+			IssuanceDate:      expirationDate.Add(-365 * 24 * time.Hour),
+			ExpirationDate:    &expirationDate,
+			CredentialSubject: []interface{}{map[string]interface{}{"id": subjectID.String()}},
+		}
+	}
+
+	t.Run("expiring credential is reported once", func(t *testing.T) {
+		ctx := newExpiryTestContext(t)
+		credential := expiringCredential(now.Add(24 * time.Hour))
+		ctx.issuer.EXPECT().SearchExpiringCredentials(now.Add(window)).Return([]vc.VerifiableCredential{credential}, nil).Times(2)
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil).Times(2)
+
+		err := ctx.monitor.check(now)
+		assert.NoError(t, err)
+		err = ctx.monitor.check(now)
+		assert.NoError(t, err)
+
+		if !assert.Len(t, ctx.events, 1) {
+			return
+		}
+		assert.Equal(t, CredentialExpiryEvent{
+			Type:           CredentialExpiringEvent,
+			CredentialID:   credentialID.String(),
+			CredentialType: credentialType.String(),
+			ExpirationDate: *credential.ExpirationDate,
+		}, ctx.events[0])
+		assert.Empty(t, ctx.reissued)
+	})
+
+	t.Run("revoked credential is ignored", func(t *testing.T) {
+		ctx := newExpiryTestContext(t, credentialType.String())
+		credential := expiringCredential(now.Add(24 * time.Hour))
+		ctx.issuer.EXPECT().SearchExpiringCredentials(now.Add(window)).Return([]vc.VerifiableCredential{credential}, nil)
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(true, nil)
+
+		err := ctx.monitor.check(now)
+
+		assert.NoError(t, err)
+		assert.Empty(t, ctx.events)
+	})
+
+	t.Run("credential is reissued", func(t *testing.T) {
+		ctx := newExpiryTestContext(t, credentialType.String())
+		credential := expiringCredential(now.Add(24 * time.Hour))
+		ctx.issuer.EXPECT().SearchExpiringCredentials(now.Add(window)).Return([]vc.VerifiableCredential{credential}, nil)
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
+		ctx.issuer.EXPECT().SearchCredential(vc.VCContextV1URI(), credentialType, issuerDID, &subjectID).Return([]vc.VerifiableCredential{credential}, nil)
+
+		err := ctx.monitor.check(now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Time{now.Add(365 * 24 * time.Hour)}, ctx.reissued)
+		if !assert.Len(t, ctx.events, 2) {
+			return
+		}
+		assert.Equal(t, CredentialReissuedEvent, ctx.events[1].Type)
+		assert.Equal(t, successorID.String(), ctx.events[1].SuccessorID)
+	})
+
+	t.Run("credential with successor is not reissued", func(t *testing.T) {
+		ctx := newExpiryTestContext(t, credentialType.String())
+		credential := expiringCredential(now.Add(24 * time.Hour))
+		successorExpiration := now.Add(365 * 24 * time.Hour)
+		successor := vc.VerifiableCredential{ID: &successorID, ExpirationDate: &successorExpiration}
+		ctx.issuer.EXPECT().SearchExpiringCredentials(now.Add(window)).Return([]vc.VerifiableCredential{credential}, nil)
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
+		ctx.issuer.EXPECT().SearchCredential(vc.VCContextV1URI(), credentialType, issuerDID, &subjectID).Return([]vc.VerifiableCredential{credential, successor}, nil)
+		ctx.verifier.EXPECT().IsRevoked(successorID).Return(false, nil)
+
+		err := ctx.monitor.check(now)
+
+		assert.NoError(t, err)
+		assert.Empty(t, ctx.reissued)
+		assert.Len(t, ctx.events, 1)
+	})
+
+	t.Run("expired credential with successor is revoked", func(t *testing.T) {
+		ctx := newExpiryTestContext(t, credentialType.String())
+		credential := expiringCredential(now.Add(-time.Hour))
+		successorExpiration := now.Add(365 * 24 * time.Hour)
+		successor := vc.VerifiableCredential{ID: &successorID, ExpirationDate: &successorExpiration}
+		ctx.issuer.EXPECT().SearchExpiringCredentials(now.Add(window)).Return([]vc.VerifiableCredential{credential}, nil)
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
+		ctx.issuer.EXPECT().SearchCredential(vc.VCContextV1URI(), credentialType, issuerDID, &subjectID).Return([]vc.VerifiableCredential{successor}, nil)
+		ctx.verifier.EXPECT().IsRevoked(successorID).Return(false, nil)
+		ctx.issuer.EXPECT().Revoke(credentialID, "").Return(nil, nil)
+
+		err := ctx.monitor.check(now)
+
+		assert.NoError(t, err)
+		if !assert.Len(t, ctx.events, 2) {
+			return
+		}
+		assert.Equal(t, CredentialExpiredEvent, ctx.events[1].Type)
+		assert.Equal(t, successorID.String(), ctx.events[1].SuccessorID)
+	})
+
+	t.Run("validity period shorter than window is not reissued", func(t *testing.T) {
+		ctx := newExpiryTestContext(t, credentialType.String())
+		credential := expiringCredential(now.Add(24 * time.Hour))
+		credential.IssuanceDate = now.Add(-24 * time.Hour)
+		ctx.issuer.EXPECT().SearchExpiringCredentials(now.Add(window)).Return([]vc.VerifiableCredential{credential}, nil)
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
+		ctx.issuer.EXPECT().SearchCredential(vc.VCContextV1URI(), credentialType, issuerDID, &subjectID).Return(nil, nil)
+
+		err := ctx.monitor.check(now)
+
+		assert.NoError(t, err)
+		assert.Empty(t, ctx.reissued)
+	})
+
+	t.Run("error - search fails", func(t *testing.T) {
+		ctx := newExpiryTestContext(t)
+		ctx.issuer.EXPECT().SearchExpiringCredentials(gomock.Any()).Return(nil, errors.New("b00m"))
+
+		err := ctx.monitor.check(now)
+
+		assert.EqualError(t, err, "b00m")
+	})
+
+	t.Run("error - publish fails, event is published on next check", func(t *testing.T) {
+		ctx := newExpiryTestContext(t)
+		ctx.monitor.publish = func(event CredentialExpiryEvent) error {
+			return errors.New("b00m")
+		}
+		credential := expiringCredential(now.Add(24 * time.Hour))
+		ctx.issuer.EXPECT().SearchExpiringCredentials(now.Add(window)).Return([]vc.VerifiableCredential{credential}, nil)
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
+
+		err := ctx.monitor.check(now)
+
+		assert.NoError(t, err)
+		assert.False(t, ctx.monitor.notified[credentialID.String()])
+	})
+}
+
+func TestExpiryMonitor_strictTemplates(t *testing.T) {
+	credentialType := ssi.MustParseURI(testIssuanceTemplate.CredentialType)
+	ctx := newMockContext(t, func(instance *vcr) {
+		instance.config.Templates.Strict = true
+		instance.config.OverrideIssueAllPublic = true
+		instance.config.Expiry.Window = 12 * time.Hour
+		instance.config.Expiry.Reissue = []string{credentialType.String()}
+	})
+	instance := ctx.vcr
+	var events []CredentialExpiryEvent
+	instance.expiryMonitor.publish = func(event CredentialExpiryEvent) error {
+		events = append(events, event)
+		return nil
+	}
+	_ = instance.AddTemplate(testIssuanceTemplate)
+	document := did.Document{}
+	document.AddAssertionMethod(&did.VerificationMethod{ID: *vdr.TestMethodDIDA})
+	ctx.docResolver.EXPECT().Resolve(*vdr.TestDIDA, nil).Return(&document, &vdrTypes.DocumentMetadata{}, nil).AnyTimes()
+	ctx.crypto.EXPECT().Resolve(vdr.TestMethodDIDA.String()).Return(crypto.NewTestKey("kid"), nil).AnyTimes()
+	ctx.tx.EXPECT().CreateTransaction(gomock.Any())
+	// issued 20 hours ago with the 24 hour validity of the template, so it expires within the window
+	now := time.Now()
+	expirationDate := now.Add(4 * time.Hour)
+	credentialID := ssi.MustParseURI(vdr.TestDIDA.String() + "#1")
+	predecessor := vc.VerifiableCredential{
+		Context:           []ssi.URI{vc.VCContextV1URI()},
+		ID:                &credentialID,
+		Type:              []ssi.URI{vc.VerifiableCredentialTypeV1URI(), credentialType},
+		Issuer:            vdr.TestDIDA.URI(),
+		IssuanceDate:      now.Add(-20 * time.Hour),
+		ExpirationDate:    &expirationDate,
+		CredentialSubject: []interface{}{map[string]interface{}{"id": vdr.TestDIDB.String(), "human": map[string]interface{}{"eyeColour": "blue"}}},
+	}
+	_ = instance.issuerStore.StoreCredential(predecessor)
+
+	err := instance.expiryMonitor.check(now)
+
+	assert.NoError(t, err)
+	if !assert.Len(t, events, 2) {
+		return
+	}
+	assert.Equal(t, CredentialReissuedEvent, events[1].Type)
+	successors, _ := instance.issuerStore.SearchCredential(vc.VCContextV1URI(), credentialType, *vdr.TestDIDA, nil)
+	if !assert.Len(t, successors, 2) {
+		return
+	}
+	for _, successor := range successors {
+		assert.NoError(t, testIssuanceTemplate.Match(successor))
+	}
+}
+
+func TestVCR_reissue(t *testing.T) {
+	credentialType := ssi.MustParseURI("TestCredential")
+	otherContext := ssi.MustParseURI("https://example.com/context")
+	expirationDate := time.Now().Add(365 * 24 * time.Hour)
+	predecessor := vc.VerifiableCredential{
+		Context:           []ssi.URI{vc.VCContextV1URI(), otherContext},
+		Type:              []ssi.URI{vc.VerifiableCredentialTypeV1URI(), credentialType},
+		Issuer:            ssi.MustParseURI("did:nuts:issuer"),
+		CredentialSubject: []interface{}{map[string]interface{}{"id": "did:nuts:subject"}},
+	}
+	expected := vc.VerifiableCredential{
+		Context:           []ssi.URI{otherContext},
+		Type:              []ssi.URI{credentialType},
+		Issuer:            predecessor.Issuer,
+		CredentialSubject: predecessor.CredentialSubject,
+		ExpirationDate:    &expirationDate,
+	}
+
+	t.Run("ok - public according to concept", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockIssuer := issuer.NewMockIssuer(ctrl)
+		instance := NewVCRInstance(nil, nil, nil, nil, nil).(*vcr)
+		instance.config.OverrideIssueAllPublic = false
+		instance.issuer = mockIssuer
+		_ = instance.registry.Add(concept.Config{Concept: "test", CredentialType: credentialType.String(), Public: true})
+		mockIssuer.EXPECT().Issue(expected, types.JSONLDCredentialFormat, true, true).Return(&expected, nil)
+
+		result, err := instance.reissue(predecessor, credentialType, expirationDate)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, result)
+	})
+
+	t.Run("ok - private without concept", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockIssuer := issuer.NewMockIssuer(ctrl)
+		instance := NewVCRInstance(nil, nil, nil, nil, nil).(*vcr)
+		instance.config.OverrideIssueAllPublic = false
+		instance.issuer = mockIssuer
+		mockIssuer.EXPECT().Issue(expected, types.JSONLDCredentialFormat, true, false).Return(&expected, nil)
+
+		_, err := instance.reissue(predecessor, credentialType, expirationDate)
+
+		assert.NoError(t, err)
+	})
+}
+
+func Test_credentialTypeOf(t *testing.T) {
+	credentialType := ssi.MustParseURI("TestCredential")
+
+	t.Run("ok", func(t *testing.T) {
+		result, err := credentialTypeOf(vc.VerifiableCredential{Type: []ssi.URI{vc.VerifiableCredentialTypeV1URI(), credentialType}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, credentialType, *result)
+	})
+
+	t.Run("error - no type", func(t *testing.T) {
+		_, err := credentialTypeOf(vc.VerifiableCredential{Type: []ssi.URI{vc.VerifiableCredentialTypeV1URI()}})
+
+		assert.EqualError(t, err, "credential has no type")
+	})
+
+	t.Run("error - multiple types", func(t *testing.T) {
+		_, err := credentialTypeOf(vc.VerifiableCredential{Type: []ssi.URI{credentialType, ssi.MustParseURI("OtherCredential")}})
+
+		assert.EqualError(t, err, "credential has multiple types")
+	})
+}
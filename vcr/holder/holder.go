@@ -9,11 +9,15 @@ import (
 	"github.com/nuts-foundation/go-did/vc"
 	"github.com/nuts-foundation/nuts-node/core"
 	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+	"github.com/nuts-foundation/nuts-node/vcr/pe"
 	"github.com/nuts-foundation/nuts-node/vcr/signature"
 	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
 	"github.com/nuts-foundation/nuts-node/vcr/verifier"
 	vdr "github.com/nuts-foundation/nuts-node/vdr/types"
 	"github.com/piprate/json-gold/ld"
+	"time"
 )
 
 type vcHolder struct {
@@ -21,19 +25,74 @@ type vcHolder struct {
 	keyStore      crypto.KeyStore
 	verifier      verifier.Verifier
 	contextLoader ld.DocumentLoader
+	store         Store
+	managedDIDs   *managedDIDs
 }
 
-// New creates a new Holder.
-func New(keyResolver vdr.KeyResolver, keyStore crypto.KeyStore, verifier verifier.Verifier, contextLoader ld.DocumentLoader) Holder {
+// New creates a new Holder. The store contains the credentials of the wallet.
+func New(keyResolver vdr.KeyResolver, keyStore crypto.KeyStore, verifier verifier.Verifier, contextLoader ld.DocumentLoader, store Store) Holder {
 	return &vcHolder{
 		keyResolver:   keyResolver,
 		keyStore:      keyStore,
 		verifier:      verifier,
 		contextLoader: contextLoader,
+		store:         store,
+		managedDIDs:   &managedDIDs{keyStore: keyStore},
 	}
 }
 
-func (h vcHolder) BuildVP(credentials []vc.VerifiableCredential, proofOptions proof.ProofOptions, signerDID *did.DID, validateVC bool) (*vc.VerifiablePresentation, error) {
+func (h vcHolder) Wallet() Wallet {
+	return h.store
+}
+
+func (h vcHolder) ReceiveCredential(credential vc.VerifiableCredential) (*did.DID, error) {
+	type credentialSubject struct {
+		ID string `json:"id"`
+	}
+	var subjects []credentialSubject
+	if err := credential.UnmarshalCredentialSubject(&subjects); err != nil {
+		return nil, fmt.Errorf("invalid credentialSubject: %w", err)
+	}
+	for _, subject := range subjects {
+		subjectDID, err := did.ParseDID(subject.ID)
+		if err != nil {
+			// not a DID, so it can't be managed by this node
+			continue
+		}
+		if !h.managedDIDs.contains(*subjectDID) {
+			continue
+		}
+		if credential.ID != nil {
+			// the same credential can be received more than once, e.g. when the network is reprocessed
+			_, err := h.store.GetCredential(*credential.ID)
+			if err == nil {
+				return nil, nil
+			} else if !errors.Is(err, types.ErrNotFound) {
+				return nil, err
+			}
+			// a credential that has been deleted from the wallet isn't added again
+			deleted, err := h.store.IsDeleted(*credential.ID)
+			if err != nil {
+				return nil, err
+			}
+			if deleted {
+				return nil, nil
+			}
+		}
+		log.Logger().Debugf("Storing credential in wallet (id=%s, subject=%s)", credential.ID, subjectDID)
+		if err := h.store.StoreCredential(credential); err != nil {
+			return nil, err
+		}
+		return subjectDID, nil
+	}
+	return nil, nil
+}
+
+func (h vcHolder) BuildVP(credentials []vc.VerifiableCredential, proofOptions proof.ProofOptions, format types.Format, signerDID *did.DID, validateVC bool, disclose []string) (*vc.VerifiablePresentation, error) {
+	if format != types.JSONLDPresentationFormat && format != types.JWTPresentationFormat {
+		return nil, core.InvalidInputError("unsupported presentation format: %s", format)
+	}
+
 	var err error
 	if signerDID == nil {
 		signerDID, err = h.resolveSubjectDID(credentials)
@@ -60,8 +119,35 @@ func (h vcHolder) BuildVP(credentials []vc.VerifiableCredential, proofOptions pr
 		}
 	}
 
+	if disclose != nil {
+		disclosedCredentials := make([]vc.VerifiableCredential, len(credentials))
+		for i, cred := range credentials {
+			disclosedCredential, err := discloseClaims(cred, disclose)
+			if err != nil {
+				return nil, fmt.Errorf("unable to disclose claims of credential (id=%s): %w", cred.ID, err)
+			}
+			disclosedCredentials[i] = *disclosedCredential
+		}
+		credentials = disclosedCredentials
+	}
+
+	boundCredentials := make([]vc.VerifiableCredential, len(credentials))
+	for i, cred := range credentials {
+		boundCredential, err := h.bindCredential(cred, proofOptions)
+		if err != nil {
+			return nil, fmt.Errorf("unable to bind credential to its subject (id=%s): %w", cred.ID, err)
+		}
+		boundCredentials[i] = *boundCredential
+	}
+	credentials = boundCredentials
+
+	if format == types.JWTPresentationFormat {
+		return signJWTPresentation(credentials, proofOptions, *signerDID, key)
+	}
+
+	// The context of the proof is added up front, since adding it after signing changes the canonical form of the contained credentials.
 	unsignedVP := &vc.VerifiablePresentation{
-		Context:              []ssi.URI{VerifiableCredentialLDContextV1},
+		Context:              []ssi.URI{VerifiableCredentialLDContextV1, signature.JSONWebSignature2020Context},
 		Type:                 []ssi.URI{VerifiablePresentationLDType},
 		VerifiableCredential: credentials,
 	}
@@ -77,7 +163,6 @@ func (h vcHolder) BuildVP(credentials []vc.VerifiableCredential, proofOptions pr
 		return nil, err
 	}
 
-	// TODO: choose between different proof types (JWT or LD-Proof)
 	signingResult, err := proof.
 		NewLDProof(proofOptions).
 		Sign(document, signature.JSONWebSignature2020{ContextLoader: h.contextLoader}, key)
@@ -95,6 +180,124 @@ func (h vcHolder) BuildVP(credentials []vc.VerifiableCredential, proofOptions pr
 	return &signedVP, nil
 }
 
+// discloseClaims returns the credential with only the given selectively disclosable claims of the credential subject.
+// Credentials that aren't in SD-JWT format are returned as is.
+func discloseClaims(credential vc.VerifiableCredential, claims []string) (*vc.VerifiableCredential, error) {
+	signedDocument, err := proof.NewSignedDocument(credential)
+	if err != nil {
+		return nil, err
+	}
+	if !signedDocument.HasSDJWTProof() {
+		return &credential, nil
+	}
+	sdJWTProof := proof.SDJWTProof{}
+	if err = signedDocument.UnmarshalProofValue(&sdJWTProof); err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(claims))
+	for i, claim := range claims {
+		paths[i] = "vc.credentialSubject." + claim
+	}
+	disclosedProof, err := sdJWTProof.Disclose(paths)
+	if err != nil {
+		return nil, err
+	}
+	// the credential contains exactly the claims that are disclosed
+	return disclosedProof.Credential()
+}
+
+// bindCredential adds a key binding JWT to a credential in SD-JWT format, signed with the assertion key of its subject,
+// so the verifier knows the credential is presented by its subject. The challenge and domain of the proof options
+// are set as nonce and audience of the key binding JWT. Credentials that aren't in SD-JWT format are returned as is.
+func (h vcHolder) bindCredential(credential vc.VerifiableCredential, proofOptions proof.ProofOptions) (*vc.VerifiableCredential, error) {
+	signedDocument, err := proof.NewSignedDocument(credential)
+	if err != nil {
+		return nil, err
+	}
+	if !signedDocument.HasSDJWTProof() {
+		return &credential, nil
+	}
+	sdJWTProof := proof.SDJWTProof{}
+	if err = signedDocument.UnmarshalProofValue(&sdJWTProof); err != nil {
+		return nil, err
+	}
+	subjectDID, err := h.resolveSubjectDID([]vc.VerifiableCredential{credential})
+	if err != nil {
+		return nil, err
+	}
+	kid, err := h.keyResolver.ResolveAssertionKeyID(*subjectDID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve assertion key of subject (did=%s): %w", *subjectDID, err)
+	}
+	key, err := h.keyStore.Resolve(kid.String())
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve assertion key of subject from key store (did=%s): %w", *subjectDID, err)
+	}
+	var nonce, audience string
+	if proofOptions.Challenge != nil {
+		nonce = *proofOptions.Challenge
+	}
+	if proofOptions.Domain != nil {
+		audience = *proofOptions.Domain
+	}
+	issuedAt := proofOptions.Created
+	if issuedAt.IsZero() {
+		issuedAt = time.Now()
+	}
+	boundProof, err := sdJWTProof.WithKeyBinding(key, nonce, audience, issuedAt)
+	if err != nil {
+		return nil, err
+	}
+	return boundProof.Credential()
+}
+
+func (h vcHolder) BuildSubmission(definition pe.PresentationDefinition, candidates []vc.VerifiableCredential, proofOptions proof.ProofOptions, format types.Format, signerDID did.DID) (*vc.VerifiablePresentation, *pe.PresentationSubmission, error) {
+	validCandidates := make([]vc.VerifiableCredential, 0, len(candidates))
+	for _, candidate := range candidates {
+		if err := h.verifier.Validate(candidate, &proofOptions.Created); err != nil {
+			log.Logger().WithError(err).Debugf("Credential not considered for presentation definition (id=%s)", candidate.ID)
+			continue
+		}
+		validCandidates = append(validCandidates, candidate)
+	}
+
+	credentials, submission, err := pe.Match(definition, validCandidates)
+	if errors.Is(err, pe.ErrNoCredentials) {
+		return nil, nil, core.PreconditionFailedError("unable to satisfy presentation definition: %w", err)
+	} else if err != nil {
+		return nil, nil, core.InvalidInputError("invalid presentation definition: %w", err)
+	}
+
+	// The credentials have been validated already
+	vp, err := h.BuildVP(credentials, proofOptions, format, &signerDID, false, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vp, submission, nil
+}
+
+// signJWTPresentation signs the presentation as JWT according to https://www.w3.org/TR/vc-data-model/#jwt-encoding
+// The challenge and domain of the proof options are mapped to the 'nonce' and 'aud' claims.
+// The presentation is returned in its JSON form with the JWT as proof.
+func signJWTPresentation(credentials []vc.VerifiableCredential, proofOptions proof.ProofOptions, signerDID did.DID, key crypto.Key) (*vc.VerifiablePresentation, error) {
+	holder := signerDID.URI()
+	unsignedVP := vc.VerifiablePresentation{
+		Context:              []ssi.URI{VerifiableCredentialLDContextV1},
+		Type:                 []ssi.URI{VerifiablePresentationLDType},
+		Holder:               &holder,
+		VerifiableCredential: credentials,
+	}
+	claims, err := proof.PresentationClaims(unsignedVP, proofOptions)
+	if err != nil {
+		return nil, err
+	}
+	jwtProof, err := proof.NewJWTProof(claims, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign VP as JWT: %w", err)
+	}
+	return jwtProof.Presentation()
+}
+
 func (h vcHolder) resolveSubjectDID(credentials []vc.VerifiableCredential) (*did.DID, error) {
 	type credentialSubject struct {
 		ID did.DID `json:"id"`
@@ -6,13 +6,18 @@ import (
 	"github.com/golang/mock/gomock"
 	ssi "github.com/nuts-foundation/go-did"
 	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
 	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/nuts-foundation/nuts-node/vcr/pe"
 	"github.com/nuts-foundation/nuts-node/vcr/signature"
 	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	vcrTypes "github.com/nuts-foundation/nuts-node/vcr/types"
 	"github.com/nuts-foundation/nuts-node/vcr/verifier"
 	"github.com/nuts-foundation/nuts-node/vdr"
 	"github.com/nuts-foundation/nuts-node/vdr/types"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 )
@@ -63,13 +68,97 @@ func TestHolder_BuildVP(t *testing.T) {
 		keyStore.EXPECT().Resolve(vdr.TestMethodDIDA.URI().String()).Return(key, nil)
 
 		contextLoader, _ := signature.NewContextLoader(false)
-		holder := New(keyResolver, keyStore, nil, contextLoader)
+		holder := New(keyResolver, keyStore, nil, contextLoader, nil)
 
 		options := proof.ProofOptions{}
-		resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential}, options, vdr.TestDIDA, false)
+		resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential}, options, vcrTypes.JSONLDPresentationFormat, vdr.TestDIDA, false, nil)
+
+		if !assert.NoError(t, err) || !assert.NotNil(t, resultingPresentation) {
+			return
+		}
+		// the proof must be verifiable on the presentation as it is returned
+		signedDocument, _ := proof.NewSignedDocument(resultingPresentation)
+		ldProof := proof.LDProof{}
+		_ = signedDocument.UnmarshalProofValue(&ldProof)
+		assert.NoError(t, ldProof.Verify(signedDocument.DocumentWithoutProof(), signature.JSONWebSignature2020{ContextLoader: contextLoader}, key.Public()))
+	})
+	t.Run("ok - JWT", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
 
-		assert.NoError(t, err)
-		assert.NotNil(t, resultingPresentation)
+		keyResolver := types.NewMockKeyResolver(ctrl)
+		keyStore := crypto.NewMockKeyStore(ctrl)
+
+		keyResolver.EXPECT().ResolveAssertionKeyID(*vdr.TestDIDA).Return(ssi.MustParseURI(kid), nil)
+		keyStore.EXPECT().Resolve(vdr.TestMethodDIDA.URI().String()).Return(key, nil)
+
+		holder := New(keyResolver, keyStore, nil, nil, nil)
+
+		created := time.Now()
+		challenge := "challenge"
+		domain := "domain"
+		options := proof.ProofOptions{Created: created, Challenge: &challenge, Domain: &domain}
+		resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{}, options, vcrTypes.JWTPresentationFormat, vdr.TestDIDA, false, nil)
+
+		if !assert.NoError(t, err) || !assert.NotNil(t, resultingPresentation) {
+			return
+		}
+		assert.Equal(t, vdr.TestDIDA.String(), resultingPresentation.Holder.String())
+		// the presentation must be verifiable as it is returned
+		keyResolver.EXPECT().ResolveSigningKey(kid, gomock.Any()).Return(key.Public(), nil)
+		result := verifier.NewVerifier(nil, keyResolver, nil, nil, nil).
+			VerifyVP(*resultingPresentation, verifier.VPVerificationOptions{Challenge: &challenge, Domain: &domain})
+		assert.NoError(t, result.Err)
+		assert.Equal(t, vdr.TestDIDA.String(), result.Holder.String())
+	})
+	t.Run("ok - SD-JWT credential with withheld claims", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		keyResolver := types.NewMockKeyResolver(ctrl)
+		keyStore := crypto.NewMockKeyStore(ctrl)
+
+		// the key of the subject signs both the presentation and the key binding JWT
+		keyResolver.EXPECT().ResolveAssertionKeyID(*vdr.TestDIDA).Return(ssi.MustParseURI(kid), nil).Times(2)
+		keyStore.EXPECT().Resolve(vdr.TestMethodDIDA.URI().String()).Return(key, nil).Times(2)
+
+		claims, _ := proof.CredentialClaims(testCredential)
+		sdJWTProof, err := proof.NewSDJWTProof(claims, []string{"vc.credentialSubject.company.city"}, key)
+		if !assert.NoError(t, err) {
+			return
+		}
+		sdJWTCredentialPtr, err := sdJWTProof.Credential()
+		if !assert.NoError(t, err) {
+			return
+		}
+		sdJWTCredential := *sdJWTCredentialPtr
+
+		holder := New(keyResolver, keyStore, nil, nil, nil)
+
+		challenge := "nonce"
+		resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{sdJWTCredential, testCredential}, proof.ProofOptions{Challenge: &challenge}, vcrTypes.JWTPresentationFormat, vdr.TestDIDA, false, []string{})
+
+		if !assert.NoError(t, err) || !assert.Len(t, resultingPresentation.VerifiableCredential, 2) {
+			return
+		}
+		disclosedCredential := resultingPresentation.VerifiableCredential[0]
+		assert.Equal(t, map[string]interface{}{"name": "De beste zorg"}, disclosedCredential.CredentialSubject[0].(map[string]interface{})["company"])
+		var disclosedProofs []proof.SDJWTProof
+		_ = disclosedCredential.UnmarshalProofValue(&disclosedProofs)
+		if assert.Len(t, disclosedProofs, 1) {
+			assert.Equal(t, 1, strings.Count(disclosedProofs[0].JWT, "~"), "expected no disclosures")
+			keyBindingClaims, err := disclosedProofs[0].VerifyKeyBinding(key.Public())
+			assert.NoError(t, err, "expected key binding JWT of the subject")
+			assert.Equal(t, challenge, keyBindingClaims["nonce"])
+		}
+		// credentials that aren't SD-JWT are presented as is
+		assert.Equal(t, testCredential, resultingPresentation.VerifiableCredential[1])
+	})
+	t.Run("error - unsupported format", func(t *testing.T) {
+		holder := New(nil, nil, nil, nil, nil)
+
+		resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential}, proof.ProofOptions{}, vcrTypes.JWTCredentialFormat, vdr.TestDIDA, false, nil)
+
+		assert.EqualError(t, err, "unsupported presentation format: jwt_vc")
+		assert.Nil(t, resultingPresentation)
 	})
 	t.Run("ok - multiple VCs", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -82,10 +171,10 @@ func TestHolder_BuildVP(t *testing.T) {
 		keyStore.EXPECT().Resolve(vdr.TestMethodDIDA.URI().String()).Return(key, nil)
 
 		contextLoader, _ := signature.NewContextLoader(false)
-		holder := New(keyResolver, keyStore, nil, contextLoader)
+		holder := New(keyResolver, keyStore, nil, contextLoader, nil)
 
 		options := proof.ProofOptions{}
-		resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential, testCredential}, options, vdr.TestDIDA, false)
+		resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential, testCredential}, options, vcrTypes.JSONLDPresentationFormat, vdr.TestDIDA, false, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resultingPresentation)
@@ -106,10 +195,10 @@ func TestHolder_BuildVP(t *testing.T) {
 			keyStore.EXPECT().Resolve(vdr.TestMethodDIDA.URI().String()).Return(key, nil)
 
 			contextLoader, _ := signature.NewContextLoader(false)
-			holder := New(keyResolver, keyStore, mockVerifier, contextLoader)
+			holder := New(keyResolver, keyStore, mockVerifier, contextLoader, nil)
 
 			options := proof.ProofOptions{Created: created}
-			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential}, options, vdr.TestDIDA, true)
+			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential}, options, vcrTypes.JSONLDPresentationFormat, vdr.TestDIDA, true, nil)
 
 			assert.NoError(t, err)
 			assert.NotNil(t, resultingPresentation)
@@ -129,10 +218,10 @@ func TestHolder_BuildVP(t *testing.T) {
 			keyStore.EXPECT().Resolve(vdr.TestMethodDIDA.URI().String()).Return(key, nil)
 
 			contextLoader, _ := signature.NewContextLoader(false)
-			holder := New(keyResolver, keyStore, mockVerifier, contextLoader)
+			holder := New(keyResolver, keyStore, mockVerifier, contextLoader, nil)
 
 			options := proof.ProofOptions{Created: created}
-			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential}, options, vdr.TestDIDA, true)
+			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential}, options, vcrTypes.JSONLDPresentationFormat, vdr.TestDIDA, true, nil)
 
 			assert.EqualError(t, err, "invalid credential (id=did:nuts:4tzMaWfpizVKeA8fscC3JTdWBc3asUWWMj5hUFHdWX3H#d2aa8189-db59-4dad-a3e5-60ca54f8fcc0): failed")
 			assert.Nil(t, resultingPresentation)
@@ -150,10 +239,10 @@ func TestHolder_BuildVP(t *testing.T) {
 			keyStore.EXPECT().Resolve(vdr.TestMethodDIDA.URI().String()).Return(key, nil)
 
 			contextLoader, _ := signature.NewContextLoader(false)
-			holder := New(keyResolver, keyStore, nil, contextLoader)
+			holder := New(keyResolver, keyStore, nil, contextLoader, nil)
 
 			options := proof.ProofOptions{}
-			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential, testCredential}, options, nil, false)
+			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential, testCredential}, options, vcrTypes.JSONLDPresentationFormat, nil, false, nil)
 
 			assert.NoError(t, err)
 			assert.NotNil(t, resultingPresentation)
@@ -169,10 +258,10 @@ func TestHolder_BuildVP(t *testing.T) {
 			keyStore := crypto.NewMockKeyStore(ctrl)
 
 			contextLoader, _ := signature.NewContextLoader(false)
-			holder := New(keyResolver, keyStore, nil, contextLoader)
+			holder := New(keyResolver, keyStore, nil, contextLoader, nil)
 
 			options := proof.ProofOptions{}
-			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential, secondCredential}, options, nil, false)
+			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential, secondCredential}, options, vcrTypes.JSONLDPresentationFormat, nil, false, nil)
 
 			assert.EqualError(t, err, "unable to resolve signer DID from VCs for creating VP: not all VCs have the same credentialSubject.id")
 			assert.Nil(t, resultingPresentation)
@@ -188,13 +277,195 @@ func TestHolder_BuildVP(t *testing.T) {
 			keyStore := crypto.NewMockKeyStore(ctrl)
 
 			contextLoader, _ := signature.NewContextLoader(false)
-			holder := New(keyResolver, keyStore, nil, contextLoader)
+			holder := New(keyResolver, keyStore, nil, contextLoader, nil)
 
 			options := proof.ProofOptions{}
-			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential, secondCredential}, options, nil, false)
+			resultingPresentation, err := holder.BuildVP([]vc.VerifiableCredential{testCredential, secondCredential}, options, vcrTypes.JSONLDPresentationFormat, nil, false, nil)
 
 			assert.EqualError(t, err, "unable to resolve signer DID from VCs for creating VP: not all VCs contain credentialSubject.id")
 			assert.Nil(t, resultingPresentation)
 		})
 	})
 }
+
+func TestHolder_BuildSubmission(t *testing.T) {
+	var kid = vdr.TestMethodDIDA.String()
+	key := crypto.NewTestKey(kid)
+	credentialID := ssi.MustParseURI("did:nuts:issuer#1")
+	testCredential := vc.VerifiableCredential{
+		ID:   &credentialID,
+		Type: []ssi.URI{ssi.MustParseURI("CompanyCredential"), vc.VerifiableCredentialTypeV1URI()},
+		CredentialSubject: []interface{}{map[string]interface{}{
+			"id":      vdr.TestDIDA.String(),
+			"company": map[string]interface{}{"city": "Hengelo"},
+		}},
+	}
+	otherCredential := testCredential
+	otherCredential.CredentialSubject = []interface{}{map[string]interface{}{
+		"id":      vdr.TestDIDA.String(),
+		"company": map[string]interface{}{"city": "Enschede"},
+	}}
+	definition := pe.PresentationDefinition{
+		ID: "definition",
+		InputDescriptors: []pe.InputDescriptor{{
+			ID: "company",
+			Constraints: &pe.Constraints{Fields: []pe.Field{{
+				Path:   []string{"$.credentialSubject.company.city"},
+				Filter: map[string]interface{}{"const": "Hengelo"},
+			}}},
+		}},
+	}
+	options := proof.ProofOptions{Created: time.Now()}
+
+	t.Run("ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		keyResolver := types.NewMockKeyResolver(ctrl)
+		keyStore := crypto.NewMockKeyStore(ctrl)
+		mockVerifier := verifier.NewMockVerifier(ctrl)
+		keyResolver.EXPECT().ResolveAssertionKeyID(*vdr.TestDIDA).Return(ssi.MustParseURI(kid), nil)
+		keyStore.EXPECT().Resolve(kid).Return(key, nil)
+		mockVerifier.EXPECT().Validate(gomock.Any(), &options.Created).Times(2)
+		holder := New(keyResolver, keyStore, mockVerifier, nil, nil)
+
+		vp, submission, err := holder.BuildSubmission(definition, []vc.VerifiableCredential{otherCredential, testCredential}, options, vcrTypes.JWTPresentationFormat, *vdr.TestDIDA)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if assert.Len(t, vp.VerifiableCredential, 1) {
+			assert.Equal(t, "Hengelo", vp.VerifiableCredential[0].CredentialSubject[0].(map[string]interface{})["company"].(map[string]interface{})["city"])
+		}
+		assert.Equal(t, "definition", submission.DefinitionID)
+		assert.Equal(t, []pe.InputDescriptorMapping{{ID: "company", Format: "ldp_vc", Path: "$.verifiableCredential[0]"}}, submission.DescriptorMap)
+		assert.NoError(t, pe.Validate(definition, *submission, *vp))
+	})
+	t.Run("error - matching credential is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockVerifier := verifier.NewMockVerifier(ctrl)
+		mockVerifier.EXPECT().Validate(gomock.Any(), &options.Created).Return(errors.New("expired"))
+		holder := New(nil, nil, mockVerifier, nil, nil)
+
+		vp, submission, err := holder.BuildSubmission(definition, []vc.VerifiableCredential{testCredential}, options, vcrTypes.JWTPresentationFormat, *vdr.TestDIDA)
+
+		assert.ErrorIs(t, err, pe.ErrNoCredentials)
+		assert.Equal(t, http.StatusPreconditionFailed, err.(core.HTTPStatusCodeError).StatusCode())
+		assert.Nil(t, vp)
+		assert.Nil(t, submission)
+	})
+	t.Run("error - invalid presentation definition", func(t *testing.T) {
+		invalidDefinition := pe.PresentationDefinition{SubmissionRequirements: []interface{}{map[string]interface{}{}}}
+		holder := New(nil, nil, nil, nil, nil)
+
+		_, _, err := holder.BuildSubmission(invalidDefinition, nil, options, vcrTypes.JWTPresentationFormat, *vdr.TestDIDA)
+
+		assert.EqualError(t, err, "invalid presentation definition: submission requirements are not supported")
+	})
+}
+
+func TestHolder_ReceiveCredential(t *testing.T) {
+	kid := vdr.TestMethodDIDA.String()
+	credentialID := ssi.MustParseURI("did:nuts:issuer#1")
+	testCredential := vc.VerifiableCredential{
+		ID:                &credentialID,
+		CredentialSubject: []interface{}{map[string]interface{}{"id": vdr.TestDIDA.String()}},
+	}
+	type testContext struct {
+		keyStore *crypto.MockKeyStore
+		store    *MockStore
+		holder   Holder
+	}
+	newTestContext := func(t *testing.T) testContext {
+		ctrl := gomock.NewController(t)
+		ctx := testContext{
+			keyStore: crypto.NewMockKeyStore(ctrl),
+			store:    NewMockStore(ctrl),
+		}
+		ctx.keyStore.EXPECT().RegisterObserver(gomock.Any()).AnyTimes()
+		ctx.holder = New(nil, ctx.keyStore, nil, nil, ctx.store)
+		return ctx
+	}
+
+	t.Run("ok - subject managed by this node", func(t *testing.T) {
+		ctx := newTestContext(t)
+		ctx.keyStore.EXPECT().List().Return([]string{"did:nuts:other#1", kid})
+		ctx.store.EXPECT().GetCredential(credentialID).Return(nil, vcrTypes.ErrNotFound)
+		ctx.store.EXPECT().IsDeleted(credentialID).Return(false, nil)
+		ctx.store.EXPECT().StoreCredential(testCredential)
+
+		subject, err := ctx.holder.ReceiveCredential(testCredential)
+
+		assert.NoError(t, err)
+		assert.Equal(t, vdr.TestDIDA, subject)
+	})
+	t.Run("ok - already in the wallet", func(t *testing.T) {
+		ctx := newTestContext(t)
+		ctx.keyStore.EXPECT().List().Return([]string{"did:nuts:other#1", kid})
+		ctx.store.EXPECT().GetCredential(credentialID).Return(&testCredential, nil)
+
+		subject, err := ctx.holder.ReceiveCredential(testCredential)
+
+		assert.NoError(t, err)
+		assert.Nil(t, subject)
+	})
+	t.Run("ok - deleted from the wallet", func(t *testing.T) {
+		ctx := newTestContext(t)
+		ctx.keyStore.EXPECT().List().Return([]string{"did:nuts:other#1", kid})
+		ctx.store.EXPECT().GetCredential(credentialID).Return(nil, vcrTypes.ErrNotFound)
+		ctx.store.EXPECT().IsDeleted(credentialID).Return(true, nil)
+
+		subject, err := ctx.holder.ReceiveCredential(testCredential)
+
+		assert.NoError(t, err)
+		assert.Nil(t, subject)
+	})
+	t.Run("ok - private key not present", func(t *testing.T) {
+		ctx := newTestContext(t)
+		ctx.keyStore.EXPECT().List().Return([]string{"did:nuts:other#1", "not a DID"})
+
+		subject, err := ctx.holder.ReceiveCredential(testCredential)
+
+		assert.NoError(t, err)
+		assert.Nil(t, subject)
+	})
+	t.Run("ok - subject is not a DID", func(t *testing.T) {
+		ctx := newTestContext(t)
+		credential := testCredential
+		credential.CredentialSubject = []interface{}{map[string]interface{}{"id": "urn:oid:1.2.3"}}
+
+		subject, err := ctx.holder.ReceiveCredential(credential)
+
+		assert.NoError(t, err)
+		assert.Nil(t, subject)
+	})
+	t.Run("error - storing", func(t *testing.T) {
+		ctx := newTestContext(t)
+		ctx.keyStore.EXPECT().List().Return([]string{"did:nuts:other#1", kid})
+		ctx.store.EXPECT().GetCredential(credentialID).Return(nil, vcrTypes.ErrNotFound)
+		ctx.store.EXPECT().IsDeleted(credentialID).Return(false, nil)
+		ctx.store.EXPECT().StoreCredential(testCredential).Return(errors.New("b00m!"))
+
+		subject, err := ctx.holder.ReceiveCredential(testCredential)
+
+		assert.EqualError(t, err, "b00m!")
+		assert.Nil(t, subject)
+	})
+	t.Run("error - checking wallet", func(t *testing.T) {
+		ctx := newTestContext(t)
+		ctx.keyStore.EXPECT().List().Return([]string{"did:nuts:other#1", kid})
+		ctx.store.EXPECT().GetCredential(credentialID).Return(nil, errors.New("b00m!"))
+
+		_, err := ctx.holder.ReceiveCredential(testCredential)
+
+		assert.EqualError(t, err, "b00m!")
+	})
+	t.Run("error - checking deletion", func(t *testing.T) {
+		ctx := newTestContext(t)
+		ctx.keyStore.EXPECT().List().Return([]string{"did:nuts:other#1", kid})
+		ctx.store.EXPECT().GetCredential(credentialID).Return(nil, vcrTypes.ErrNotFound)
+		ctx.store.EXPECT().IsDeleted(credentialID).Return(false, errors.New("b00m!"))
+
+		_, err := ctx.holder.ReceiveCredential(testCredential)
+
+		assert.EqualError(t, err, "b00m!")
+	})
+}
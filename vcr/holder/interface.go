@@ -1,10 +1,15 @@
 package holder
 
 import (
+	"io"
+
 	ssi "github.com/nuts-foundation/go-did"
 	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/pe"
 	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
 )
 
 // VerifiableCredentialLDContextV1 holds the URI of the JSON-LD context for Verifiable Credentials.
@@ -22,5 +27,44 @@ type Holder interface {
 	// BuildVP builds and signs a Verifiable Presentation using the given Verifiable Credentials.
 	// The assertion key used for signing it is taken from signerDID's DID document.
 	// If signerDID is not provided, it will be derived from the credentials credentialSubject.id fields. But only if all provided credentials have the same (singular) credentialSubject.id field.
-	BuildVP(credentials []vc.VerifiableCredential, proofOptions proof.ProofOptions, signerDID *did.DID, validateVC bool) (*vc.VerifiablePresentation, error)
+	// The format specifies whether the presentation is signed with a JSON-LD proof (ldp_vp) or as JWT (jwt_vp).
+	// If disclose is not nil, only the listed selectively disclosable claims of credentials in SD-JWT format are disclosed,
+	// as paths of object keys in the credential subject separated by dots (e.g. 'organization.city'). Other claims are withheld.
+	BuildVP(credentials []vc.VerifiableCredential, proofOptions proof.ProofOptions, format types.Format, signerDID *did.DID, validateVC bool, disclose []string) (*vc.VerifiablePresentation, error)
+	// BuildSubmission selects a credential from the given candidates for every input descriptor of the presentation definition (DIF Presentation Exchange),
+	// and builds and signs a Verifiable Presentation that contains them. Only candidates that are valid at the time of the proof are considered.
+	// It returns the presentation and the presentation submission that maps the input descriptors to the credentials in the presentation.
+	// If the candidates don't satisfy the presentation definition, an error wrapping pe.ErrNoCredentials is returned.
+	BuildSubmission(definition pe.PresentationDefinition, candidates []vc.VerifiableCredential, proofOptions proof.ProofOptions, format types.Format, signerDID did.DID) (*vc.VerifiablePresentation, *pe.PresentationSubmission, error)
+	// ReceiveCredential stores the credential in the wallet if its subject is a DID managed by this node (of which the private key is present).
+	// Credentials of other subjects are ignored. The credential must have been validated by the caller.
+	// It returns the managed subject DID if the credential has been added to the wallet, or nil if it was ignored or already in the wallet.
+	ReceiveCredential(credential vc.VerifiableCredential) (*did.DID, error)
+	// Wallet returns the credentials held by the DIDs managed by this node.
+	Wallet() Wallet
+}
+
+// Wallet defines the functions to access the credentials held by the DIDs managed by this node.
+type Wallet interface {
+	// GetCredential retrieves a credential from the wallet by ID.
+	// Returns types.ErrNotFound when the credential is not in the wallet.
+	GetCredential(id ssi.URI) (*vc.VerifiableCredential, error)
+	// ListCredentials returns the credentials of the given subject, optionally filtered by credential type and issuer.
+	ListCredentials(subject did.DID, credentialType *ssi.URI, issuer *did.DID) ([]vc.VerifiableCredential, error)
+	// DeleteCredential removes a credential from the wallet. The deletion is remembered, so the credential isn't added to the wallet again when it's received again.
+	// Returns types.ErrNotFound when the credential is not in the wallet.
+	DeleteCredential(id ssi.URI) error
+}
+
+// Store defines the interface for a holder store, which contains the credentials of the wallet.
+type Store interface {
+	Wallet
+	// StoreCredential writes a VC to storage. Storing a credential that is already stored has no effect.
+	StoreCredential(credential vc.VerifiableCredential) error
+	// IsDeleted returns whether the credential has been deleted from the wallet.
+	IsDeleted(id ssi.URI) (bool, error)
+	// Collection returns the collection of the store with its indices, so it can be checked and reindexed.
+	Collection() storage.Collection
+	// Closer closes and frees the underlying resources the store uses.
+	io.Closer
 }
@@ -0,0 +1,177 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package holder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+)
+
+// leiaHolderStore implements the holder Store interface. It is a simple and fast JSON store.
+// Note: It can not be used in a clustered setup.
+type leiaHolderStore struct {
+	heldCredentials leia.Collection
+	// deletedCredentials contains the IDs of the credentials that have been deleted from the wallet
+	deletedCredentials leia.Collection
+	store              leia.Store
+}
+
+// deletedCredential is the document that remains of a credential that has been deleted from the wallet.
+type deletedCredential struct {
+	ID string `json:"id"`
+}
+
+// NewLeiaHolderStore creates a new instance of leiaHolderStore which implements the Store interface.
+func NewLeiaHolderStore(dbPath string) (Store, error) {
+	store, err := leia.NewStore(dbPath, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leiaHolderStore: %w", err)
+	}
+	newLeiaStore := &leiaHolderStore{
+		heldCredentials:    store.Collection("heldCredentials"),
+		deletedCredentials: store.Collection("deletedCredentials"),
+		store:              store,
+	}
+	if err := newLeiaStore.createIndices(); err != nil {
+		return nil, err
+	}
+	return newLeiaStore, nil
+}
+
+func (s leiaHolderStore) StoreCredential(credential vc.VerifiableCredential) error {
+	vcAsBytes, _ := json.Marshal(credential)
+	doc := leia.DocumentFromBytes(vcAsBytes)
+	return s.heldCredentials.Add([]leia.Document{doc})
+}
+
+func (s leiaHolderStore) ListCredentials(subject did.DID, credentialType *ssi.URI, issuer *did.DID) ([]vc.VerifiableCredential, error) {
+	query := leia.New(leia.Eq("credentialSubject.id", subject.String()))
+	if credentialType != nil {
+		query = query.And(leia.Eq("type", credentialType.String()))
+	}
+	if issuer != nil {
+		query = query.And(leia.Eq("issuer", issuer.String()))
+	}
+
+	docs, err := s.heldCredentials.Find(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]vc.VerifiableCredential, len(docs))
+	for i, doc := range docs {
+		if err := json.Unmarshal(doc.Bytes(), &result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s leiaHolderStore) GetCredential(id ssi.URI) (*vc.VerifiableCredential, error) {
+	doc, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+	credential := &vc.VerifiableCredential{}
+	if err := json.Unmarshal(doc.Bytes(), credential); err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+func (s leiaHolderStore) DeleteCredential(id ssi.URI) error {
+	doc, err := s.findByID(id)
+	if err != nil {
+		return err
+	}
+	// the deletion is recorded first, so the credential can't be received again if deleting it fails halfway
+	tombstone, _ := json.Marshal(deletedCredential{ID: id.String()})
+	if err = s.deletedCredentials.Add([]leia.Document{leia.DocumentFromBytes(tombstone)}); err != nil {
+		return err
+	}
+	return s.heldCredentials.Delete(*doc)
+}
+
+func (s leiaHolderStore) IsDeleted(id ssi.URI) (bool, error) {
+	results, err := s.deletedCredentials.Find(context.Background(), leia.New(leia.Eq(concept.IDField, id.String())))
+	if err != nil {
+		return false, fmt.Errorf("could not get deleted credential by id: %w", err)
+	}
+	return len(results) > 0, nil
+}
+
+func (s leiaHolderStore) findByID(id ssi.URI) (*leia.Document, error) {
+	query := leia.New(leia.Eq(concept.IDField, id.String()))
+
+	results, err := s.heldCredentials.Find(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("could not get credential by id: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, types.ErrNotFound
+	}
+	if len(results) > 1 {
+		return nil, errors.New("found more than one credential by id")
+	}
+	return &results[0], nil
+}
+
+func (s leiaHolderStore) Collection() storage.Collection {
+	return storage.Collection{
+		Name:       "heldCredentials",
+		Collection: s.heldCredentials,
+		Indices:    holderIndices(),
+		IDField:    concept.IDField,
+	}
+}
+
+func (s leiaHolderStore) Close() error {
+	return s.store.Close()
+}
+
+// holderIndices returns the indices of the held VC store.
+// They allow faster searching on subject, type and issuer values.
+func holderIndices() []storage.Index {
+	return []storage.Index{
+		storage.NewIndex("heldVCs", "credentialSubject.id", "type", "issuer"),
+		// Index used for getting held VCs by id
+		storage.NewIndex("heldVCByID", "id"),
+	}
+}
+
+// createIndices creates the needed indices for the held VC store
+func (s leiaHolderStore) createIndices() error {
+	for _, index := range holderIndices() {
+		if err := s.heldCredentials.AddIndex(index.Index); err != nil {
+			return err
+		}
+	}
+	return s.deletedCredentials.AddIndex(storage.NewIndex("deletedVCByID", concept.IDField).Index)
+}
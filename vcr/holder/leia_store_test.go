@@ -0,0 +1,148 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package holder
+
+import (
+	"encoding/json"
+	"path"
+	"testing"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStore(t *testing.T) Store {
+	testDir := io.TestDirectory(t)
+	sut, err := NewLeiaHolderStore(path.Join(testDir, "vcr", "holder-store.db"))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() {
+		_ = sut.Close()
+	})
+	return sut
+}
+
+func TestNewLeiaHolderStore(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		testDir := io.TestDirectory(t)
+		sut, err := NewLeiaHolderStore(path.Join(testDir, "vcr", "holder-store.db"))
+
+		assert.NoError(t, err)
+		assert.IsType(t, &leiaHolderStore{}, sut)
+		assert.NoError(t, sut.Close())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		sut, err := NewLeiaHolderStore("/")
+
+		assert.Contains(t, err.Error(), "failed to create leiaHolderStore:")
+		assert.Nil(t, sut)
+	})
+}
+
+func Test_leiaHolderStore(t *testing.T) {
+	vcToStore := vc.VerifiableCredential{}
+	_ = json.Unmarshal([]byte(concept.TestCredential), &vcToStore)
+	subjectDID := did.MustParseDID("did:nuts:GvkzxsezHvEc8nGhgz6Xo3jbqkHwswLmWw3CYtCm7hAW")
+	issuerDID := did.MustParseDID(vcToStore.Issuer.String())
+	otherDID := did.MustParseDID("did:nuts:123")
+	otherType := ssi.MustParseURI("OtherCredential")
+
+	t.Run("store, get and list", func(t *testing.T) {
+		sut := newTestStore(t)
+
+		err := sut.StoreCredential(vcToStore)
+		if !assert.NoError(t, err) {
+			return
+		}
+		// storing twice has no effect
+		_ = sut.StoreCredential(vcToStore)
+
+		t.Run("get", func(t *testing.T) {
+			result, err := sut.GetCredential(*vcToStore.ID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, vcToStore, *result)
+		})
+		t.Run("list for subject", func(t *testing.T) {
+			results, err := sut.ListCredentials(subjectDID, nil, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []vc.VerifiableCredential{vcToStore}, results)
+		})
+		t.Run("list for subject, type and issuer", func(t *testing.T) {
+			results, err := sut.ListCredentials(subjectDID, &vcToStore.Type[1], &issuerDID)
+
+			assert.NoError(t, err)
+			assert.Len(t, results, 1)
+		})
+		t.Run("list - no results", func(t *testing.T) {
+			results, err := sut.ListCredentials(otherDID, nil, nil)
+			assert.NoError(t, err)
+			assert.Empty(t, results)
+
+			results, err = sut.ListCredentials(subjectDID, &otherType, nil)
+			assert.NoError(t, err)
+			assert.Empty(t, results)
+
+			results, err = sut.ListCredentials(subjectDID, nil, &otherDID)
+			assert.NoError(t, err)
+			assert.Empty(t, results)
+		})
+	})
+	t.Run("get - not found", func(t *testing.T) {
+		sut := newTestStore(t)
+
+		result, err := sut.GetCredential(*vcToStore.ID)
+
+		assert.ErrorIs(t, err, types.ErrNotFound)
+		assert.Nil(t, result)
+	})
+	t.Run("delete", func(t *testing.T) {
+		sut := newTestStore(t)
+		_ = sut.StoreCredential(vcToStore)
+
+		err := sut.DeleteCredential(*vcToStore.ID)
+
+		assert.NoError(t, err)
+		_, err = sut.GetCredential(*vcToStore.ID)
+		assert.ErrorIs(t, err, types.ErrNotFound)
+		results, _ := sut.ListCredentials(subjectDID, nil, nil)
+		assert.Empty(t, results)
+		deleted, err := sut.IsDeleted(*vcToStore.ID)
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	})
+	t.Run("delete - not found", func(t *testing.T) {
+		sut := newTestStore(t)
+
+		err := sut.DeleteCredential(*vcToStore.ID)
+
+		assert.ErrorIs(t, err, types.ErrNotFound)
+		deleted, _ := sut.IsDeleted(*vcToStore.ID)
+		assert.False(t, deleted)
+	})
+}
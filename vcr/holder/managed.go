@@ -0,0 +1,65 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package holder
+
+import (
+	"sync"
+
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/nuts-node/crypto"
+)
+
+// managedDIDs keeps the set of DIDs managed by this node, which are the DIDs of which a private key is present in the key store.
+// It doesn't depend on the DID document, so a DID without (valid) assertion key is managed as well.
+// The set is derived from the key store when it's first needed, and again after a key has been added to or removed from the key store.
+type managedDIDs struct {
+	keyStore crypto.KeyStore
+	observe  sync.Once
+	mux      sync.Mutex
+	// dids is nil when the set must be derived from the key store
+	dids map[string]bool
+}
+
+// contains checks whether the DID is managed by this node.
+func (m *managedDIDs) contains(id did.DID) bool {
+	m.observe.Do(func() {
+		m.keyStore.RegisterObserver(m.invalidate)
+	})
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	if m.dids == nil {
+		m.dids = map[string]bool{}
+		for _, kid := range m.keyStore.List() {
+			keyID, err := did.ParseDIDURL(kid)
+			if err != nil {
+				continue
+			}
+			keyID.Fragment = ""
+			m.dids[keyID.String()] = true
+		}
+	}
+	return m.dids[id.String()]
+}
+
+func (m *managedDIDs) invalidate(_ string) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.dids = nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package holder
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/nuts-foundation/nuts-node/vdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_managedDIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	keyStore := crypto.NewMockKeyStore(ctrl)
+	var observer crypto.KeyObserverFunc
+	keyStore.EXPECT().RegisterObserver(gomock.Any()).Do(func(f crypto.KeyObserverFunc) {
+		observer = f
+	})
+	sut := &managedDIDs{keyStore: keyStore}
+
+	t.Run("keys are listed once", func(t *testing.T) {
+		keyStore.EXPECT().List().Return([]string{vdr.TestMethodDIDA.String(), "not a DID"})
+
+		assert.True(t, sut.contains(*vdr.TestDIDA))
+		assert.False(t, sut.contains(*vdr.TestDIDB))
+	})
+	t.Run("keys are listed again after a key has been added", func(t *testing.T) {
+		keyStore.EXPECT().List().Return([]string{vdr.TestMethodDIDA.String(), vdr.TestMethodDIDB.String()})
+
+		observer(vdr.TestMethodDIDB.String())
+
+		assert.True(t, sut.contains(*vdr.TestDIDB))
+	})
+	t.Run("keys are listed again after a key has been removed", func(t *testing.T) {
+		keyStore.EXPECT().List().Return([]string{vdr.TestMethodDIDB.String()})
+
+		observer(vdr.TestMethodDIDA.String())
+
+		assert.False(t, sut.contains(*vdr.TestDIDA))
+		assert.True(t, sut.contains(*vdr.TestDIDB))
+	})
+}
@@ -8,9 +8,13 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	ssi "github.com/nuts-foundation/go-did"
 	did "github.com/nuts-foundation/go-did/did"
 	vc "github.com/nuts-foundation/go-did/vc"
+	pe "github.com/nuts-foundation/nuts-node/vcr/pe"
 	proof "github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	storage "github.com/nuts-foundation/nuts-node/vcr/storage"
+	types "github.com/nuts-foundation/nuts-node/vcr/types"
 )
 
 // MockHolder is a mock of Holder interface.
@@ -36,17 +40,253 @@ func (m *MockHolder) EXPECT() *MockHolderMockRecorder {
 	return m.recorder
 }
 
+// BuildSubmission mocks base method.
+func (m *MockHolder) BuildSubmission(definition pe.PresentationDefinition, candidates []vc.VerifiableCredential, proofOptions proof.ProofOptions, format types.Format, signerDID did.DID) (*vc.VerifiablePresentation, *pe.PresentationSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildSubmission", definition, candidates, proofOptions, format, signerDID)
+	ret0, _ := ret[0].(*vc.VerifiablePresentation)
+	ret1, _ := ret[1].(*pe.PresentationSubmission)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BuildSubmission indicates an expected call of BuildSubmission.
+func (mr *MockHolderMockRecorder) BuildSubmission(definition, candidates, proofOptions, format, signerDID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildSubmission", reflect.TypeOf((*MockHolder)(nil).BuildSubmission), definition, candidates, proofOptions, format, signerDID)
+}
+
 // BuildVP mocks base method.
-func (m *MockHolder) BuildVP(credentials []vc.VerifiableCredential, proofOptions proof.ProofOptions, signerDID *did.DID, validateVC bool) (*vc.VerifiablePresentation, error) {
+func (m *MockHolder) BuildVP(credentials []vc.VerifiableCredential, proofOptions proof.ProofOptions, format types.Format, signerDID *did.DID, validateVC bool, disclose []string) (*vc.VerifiablePresentation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildVP", credentials, proofOptions, signerDID, validateVC)
+	ret := m.ctrl.Call(m, "BuildVP", credentials, proofOptions, format, signerDID, validateVC, disclose)
 	ret0, _ := ret[0].(*vc.VerifiablePresentation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // BuildVP indicates an expected call of BuildVP.
-func (mr *MockHolderMockRecorder) BuildVP(credentials, proofOptions, signerDID, validateVC interface{}) *gomock.Call {
+func (mr *MockHolderMockRecorder) BuildVP(credentials, proofOptions, format, signerDID, validateVC, disclose interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildVP", reflect.TypeOf((*MockHolder)(nil).BuildVP), credentials, proofOptions, format, signerDID, validateVC, disclose)
+}
+
+// ReceiveCredential mocks base method.
+func (m *MockHolder) ReceiveCredential(credential vc.VerifiableCredential) (*did.DID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveCredential", credential)
+	ret0, _ := ret[0].(*did.DID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveCredential indicates an expected call of ReceiveCredential.
+func (mr *MockHolderMockRecorder) ReceiveCredential(credential interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveCredential", reflect.TypeOf((*MockHolder)(nil).ReceiveCredential), credential)
+}
+
+// Wallet mocks base method.
+func (m *MockHolder) Wallet() Wallet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wallet")
+	ret0, _ := ret[0].(Wallet)
+	return ret0
+}
+
+// Wallet indicates an expected call of Wallet.
+func (mr *MockHolderMockRecorder) Wallet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wallet", reflect.TypeOf((*MockHolder)(nil).Wallet))
+}
+
+// MockWallet is a mock of Wallet interface.
+type MockWallet struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletMockRecorder
+}
+
+// MockWalletMockRecorder is the mock recorder for MockWallet.
+type MockWalletMockRecorder struct {
+	mock *MockWallet
+}
+
+// NewMockWallet creates a new mock instance.
+func NewMockWallet(ctrl *gomock.Controller) *MockWallet {
+	mock := &MockWallet{ctrl: ctrl}
+	mock.recorder = &MockWalletMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWallet) EXPECT() *MockWalletMockRecorder {
+	return m.recorder
+}
+
+// DeleteCredential mocks base method.
+func (m *MockWallet) DeleteCredential(id ssi.URI) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCredential", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCredential indicates an expected call of DeleteCredential.
+func (mr *MockWalletMockRecorder) DeleteCredential(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCredential", reflect.TypeOf((*MockWallet)(nil).DeleteCredential), id)
+}
+
+// GetCredential mocks base method.
+func (m *MockWallet) GetCredential(id ssi.URI) (*vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCredential", id)
+	ret0, _ := ret[0].(*vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCredential indicates an expected call of GetCredential.
+func (mr *MockWalletMockRecorder) GetCredential(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredential", reflect.TypeOf((*MockWallet)(nil).GetCredential), id)
+}
+
+// ListCredentials mocks base method.
+func (m *MockWallet) ListCredentials(subject did.DID, credentialType *ssi.URI, issuer *did.DID) ([]vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCredentials", subject, credentialType, issuer)
+	ret0, _ := ret[0].([]vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCredentials indicates an expected call of ListCredentials.
+func (mr *MockWalletMockRecorder) ListCredentials(subject, credentialType, issuer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCredentials", reflect.TypeOf((*MockWallet)(nil).ListCredentials), subject, credentialType, issuer)
+}
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockStore) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockStoreMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStore)(nil).Close))
+}
+
+// Collection mocks base method.
+func (m *MockStore) Collection() storage.Collection {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collection")
+	ret0, _ := ret[0].(storage.Collection)
+	return ret0
+}
+
+// Collection indicates an expected call of Collection.
+func (mr *MockStoreMockRecorder) Collection() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collection", reflect.TypeOf((*MockStore)(nil).Collection))
+}
+
+// DeleteCredential mocks base method.
+func (m *MockStore) DeleteCredential(id ssi.URI) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCredential", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCredential indicates an expected call of DeleteCredential.
+func (mr *MockStoreMockRecorder) DeleteCredential(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCredential", reflect.TypeOf((*MockStore)(nil).DeleteCredential), id)
+}
+
+// GetCredential mocks base method.
+func (m *MockStore) GetCredential(id ssi.URI) (*vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCredential", id)
+	ret0, _ := ret[0].(*vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCredential indicates an expected call of GetCredential.
+func (mr *MockStoreMockRecorder) GetCredential(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredential", reflect.TypeOf((*MockStore)(nil).GetCredential), id)
+}
+
+// IsDeleted mocks base method.
+func (m *MockStore) IsDeleted(id ssi.URI) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDeleted", id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDeleted indicates an expected call of IsDeleted.
+func (mr *MockStoreMockRecorder) IsDeleted(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDeleted", reflect.TypeOf((*MockStore)(nil).IsDeleted), id)
+}
+
+// ListCredentials mocks base method.
+func (m *MockStore) ListCredentials(subject did.DID, credentialType *ssi.URI, issuer *did.DID) ([]vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCredentials", subject, credentialType, issuer)
+	ret0, _ := ret[0].([]vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCredentials indicates an expected call of ListCredentials.
+func (mr *MockStoreMockRecorder) ListCredentials(subject, credentialType, issuer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCredentials", reflect.TypeOf((*MockStore)(nil).ListCredentials), subject, credentialType, issuer)
+}
+
+// StoreCredential mocks base method.
+func (m *MockStore) StoreCredential(credential vc.VerifiableCredential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreCredential", credential)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreCredential indicates an expected call of StoreCredential.
+func (mr *MockStoreMockRecorder) StoreCredential(credential interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildVP", reflect.TypeOf((*MockHolder)(nil).BuildVP), credentials, proofOptions, signerDID, validateVC)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreCredential", reflect.TypeOf((*MockStore)(nil).StoreCredential), credential)
 }
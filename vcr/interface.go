@@ -26,10 +26,15 @@ import (
 	"github.com/nuts-foundation/go-did"
 
 	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
 	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
 	"github.com/nuts-foundation/nuts-node/vcr/holder"
 	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/signature"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+	"github.com/nuts-foundation/nuts-node/vcr/trust"
+	"github.com/nuts-foundation/nuts-node/vcr/verifier"
 )
 
 // ConceptFinder can resolve VC backed concepts for a DID.
@@ -42,6 +47,89 @@ type ConceptFinder interface {
 	// It also returns untrusted credentials when allowUntrusted == true
 	// a context must be passed to prevent long-running queries
 	SearchConcept(ctx context.Context, conceptName string, allowUntrusted bool, query map[string]string) ([]concept.Concept, error)
+
+	// SearchConceptClauses returns the concepts matching all given clauses. It returns an empty list if no matches have been found.
+	// It also returns untrusted credentials when allowUntrusted == true
+	// a context must be passed to prevent long-running queries
+	SearchConceptClauses(ctx context.Context, conceptName string, allowUntrusted bool, clauses []concept.Clause) ([]concept.Concept, error)
+}
+
+// ConceptManager manages the concept configs of the concept registry at runtime.
+type ConceptManager interface {
+	// AddConcept adds the concept config to the registry, or replaces the config of its credential type.
+	// The config is stored in the concepts directory and the indices of the credential type are (re)built,
+	// so credentials of the type can be found through the concept immediately. Built-in concepts can't be replaced.
+	AddConcept(config concept.Config) error
+	// RemoveConcept removes the concept config of the credential type from the registry and the concepts directory.
+	// Credentials of the type are kept. Built-in concepts can't be removed.
+	RemoveConcept(credentialType string) error
+}
+
+// TemplateManager manages the issuance templates at runtime, which define how credentials of a type are issued.
+type TemplateManager interface {
+	// Templates returns the issuance templates, sorted by name.
+	Templates() []issuer.Template
+	// Template returns the issuance template with the given name. It returns a core.NotFoundError if it doesn't exist.
+	Template(name string) (*issuer.Template, error)
+	// AddTemplate adds the issuance template, or replaces the template with the same name.
+	// The template is stored in the templates directory.
+	AddTemplate(template issuer.Template) error
+	// RemoveTemplate removes the issuance template from the templates directory.
+	// It returns a core.NotFoundError if it doesn't exist.
+	RemoveTemplate(name string) error
+}
+
+// IssuedCredentialManager lists and revokes the credentials issued by this node.
+type IssuedCredentialManager interface {
+	// ListIssued returns the page of issued credentials that match the query, ordered by issuance date,
+	// together with the total number of matching credentials. The page starts at offset and contains at most limit credentials,
+	// all remaining credentials if limit is 0.
+	ListIssued(query IssuedCredentialQuery, offset int, limit int) ([]IssuedCredential, int, error)
+	// RevokeIssued revokes the active issued credentials that match the query, for the given reason.
+	// The query must select an issuer, subject or credential type. When dryRun is true, it returns the credentials that would be revoked
+	// without revoking them. A credential that can't be revoked doesn't affect the others: its result contains the error.
+	RevokeIssued(query IssuedCredentialQuery, reason string, dryRun bool) ([]RevocationResult, error)
+}
+
+// RefreshPolicy decides whether an issued credential may be refreshed at the request of its subject.
+// It returns an error describing why the credential can't be refreshed.
+type RefreshPolicy func(credential vc.VerifiableCredential) error
+
+// CredentialRefresher renews credentials issued by this node at the request of their subject.
+type CredentialRefresher interface {
+	// CreateRefreshNonce creates a nonce the presentation for refreshing a credential must contain as challenge.
+	// It can be used once, within a few minutes.
+	CreateRefreshNonce() string
+	// RefreshCredential issues a successor for the credential in the presentation, which must be issued by this node.
+	// The presentation must contain exactly one credential and be signed by the subject of the credential, with the issuer as domain
+	// and a nonce created with CreateRefreshNonce as challenge. The successor is valid for the same period as the credential,
+	// counting from now, and the credential is revoked. Only credentials of the types configured to be refreshed can be refreshed,
+	// if all registered policies allow it.
+	RefreshCredential(presentation vc.VerifiablePresentation, nonce string) (*vc.VerifiableCredential, error)
+	// RegisterRefreshPolicy registers a policy that must allow a credential to be refreshed.
+	RegisterRefreshPolicy(policy RefreshPolicy)
+}
+
+// StoreMaintainer checks the consistency of the collections of the VCR stores and rebuilds their indices.
+// The collections are named after their store: credentials, issuer, holder, verifier or audit.
+type StoreMaintainer interface {
+	// StoreCollections returns the names of the collections of the VCR stores, sorted by name.
+	StoreCollections() []string
+	// CheckCollection checks whether every document of the collection parses, validates and can be found through the indices it should be in.
+	// It reports invalid, duplicate and unreachable documents, and index entries of documents that don't exist (orphans).
+	// It returns a core.NotFoundError if the collection doesn't exist.
+	CheckCollection(name string) (*storage.Report, error)
+	// ReindexCollection rebuilds the indices of the collection from its documents, and checks the collection afterwards.
+	// It returns a core.NotFoundError if the collection doesn't exist.
+	ReindexCollection(name string) (*storage.Report, error)
+}
+
+// CredentialNotifier notifies the application of credentials issued to DIDs managed by this node.
+type CredentialNotifier interface {
+	// SubscribeCredentialReceived registers a callback that is called when a credential issued to a DID managed by this node
+	// has been received from the network and added to the wallet. The callback is called while the transaction is processed,
+	// so it should return quickly.
+	SubscribeCredentialReceived(callback CredentialReceivedCallback)
 }
 
 // Finder is the VCR interface for searching VCs
@@ -61,7 +149,20 @@ type Validator interface {
 	// - has a valid signature if checkSignature is true
 	// if allowUntrusted == false, the issuer must also be a trusted DID
 	// May return ErrRevoked, ErrUntrusted or ErrInvalidPeriod
-	Validate(credential vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time) error
+	// The verification is recorded in the audit trail, together with the caller that validated the credential.
+	Validate(credential vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time, caller audit.Caller) error
+}
+
+// VerificationAuditor records verifications of credentials in the audit trail and searches it.
+// Nothing is recorded and the audit trail is empty when it's disabled.
+type VerificationAuditor interface {
+	// RecordVerification records the verification of the credential by the caller, with the checks that were performed.
+	// Errors are logged, so they don't change the outcome of the verification.
+	RecordVerification(credential vc.VerifiableCredential, caller audit.Caller, checks []audit.Check)
+	// SearchVerifications returns the page of recorded verifications matching the query, ordered by the moment of verification,
+	// together with the total number of matching verifications. The page starts at offset and contains at most limit verifications,
+	// all remaining verifications if limit is 0.
+	SearchVerifications(query audit.Query, offset int, limit int) ([]audit.Entry, int, error)
 }
 
 // Writer is the interface that groups al the VC write methods
@@ -83,12 +184,20 @@ type TrustManager interface {
 	Trusted(credentialType ssi.URI) ([]ssi.URI, error)
 	// Untrusted returns a list of untrusted issuers based on known credentials
 	Untrusted(credentialType ssi.URI) ([]ssi.URI, error)
+	// ExplainTrust returns whether the issuer is currently trusted for the credential type, and why.
+	ExplainTrust(credentialType ssi.URI, issuer ssi.URI) trust.Decision
+	// ImportTrustList verifies the given trust list, signed as JWS by a governance body, and imports its trust policies.
+	// The governance body must be configured as trust list signer.
+	// It returns the imported trust list.
+	ImportTrustList(signedList string) (*trust.TrustList, error)
 }
 
 // Resolver binds all read type of operations into an interface
 type Resolver interface {
 	// Registry returns the concept registry as read-only
 	Registry() concept.Reader
+	// JSONLDContexts returns the JSON-LD contexts the node can use to sign and verify credentials.
+	JSONLDContexts() []signature.ContextInfo
 	// Resolve returns a credential based on its ID.
 	// The optional resolveTime will resolve the credential at that point in time.
 	// The credential will still be returned in the case of ErrRevoked and ErrUntrusted.
@@ -100,6 +209,7 @@ type Resolver interface {
 type VCR interface {
 	Issuer() issuer.Issuer
 	Holder() holder.Holder
+	Verifier() verifier.Verifier
 
 	// Issue creates and publishes a new VC.
 	// An optional expirationDate can be given.
@@ -112,8 +222,15 @@ type VCR interface {
 
 	Finder
 	ConceptFinder
+	ConceptManager
+	CredentialNotifier
+	CredentialRefresher
+	IssuedCredentialManager
 	Resolver
+	StoreMaintainer
+	TemplateManager
 	TrustManager
 	Validator
+	VerificationAuditor
 	Writer
 }
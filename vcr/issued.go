@@ -0,0 +1,145 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"fmt"
+
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+)
+
+// IssuedCredentialState is the state of an issued credential: active or revoked.
+type IssuedCredentialState string
+
+const (
+	// ActiveCredentialState is the state of issued credentials that haven't been revoked.
+	ActiveCredentialState IssuedCredentialState = "active"
+	// RevokedCredentialState is the state of issued credentials that have been revoked.
+	RevokedCredentialState IssuedCredentialState = "revoked"
+)
+
+// IssuedCredentialQuery selects credentials issued by this node.
+type IssuedCredentialQuery struct {
+	issuer.CredentialFilter
+	// State selects the credentials in the given state. If empty, credentials in any state are selected.
+	State IssuedCredentialState
+}
+
+// IssuedCredential is a credential issued by this node together with its state.
+type IssuedCredential struct {
+	Credential vc.VerifiableCredential
+	State      IssuedCredentialState
+}
+
+// RevocationResult contains the result of revoking a single credential of a bulk revocation.
+type RevocationResult struct {
+	// Credential is the credential that is revoked.
+	Credential vc.VerifiableCredential
+	// Revocation is the published revocation. It's nil for a dry run or if revoking failed.
+	Revocation *credential.Revocation
+	// Err contains the reason revoking failed.
+	Err error
+}
+
+func (c *vcr) ListIssued(query IssuedCredentialQuery, offset int, limit int) ([]IssuedCredential, int, error) {
+	if offset < 0 || limit < 0 {
+		return nil, 0, core.InvalidInputError("offset and limit must not be negative")
+	}
+	credentials, err := c.searchIssued(query)
+	if err != nil {
+		return nil, 0, err
+	}
+	total := len(credentials)
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if limit > 0 && offset+limit < total {
+		end = offset + limit
+	}
+	return credentials[offset:end], total, nil
+}
+
+func (c *vcr) RevokeIssued(query IssuedCredentialQuery, reason string, dryRun bool) ([]RevocationResult, error) {
+	if query.Issuer == "" && query.Subject == "" && query.CredentialType == "" {
+		return nil, core.InvalidInputError("an issuer, subject or credential type must be given to revoke credentials")
+	}
+	if query.State == RevokedCredentialState {
+		return nil, core.InvalidInputError("only active credentials can be revoked")
+	}
+	query.State = ActiveCredentialState
+	credentials, err := c.searchIssued(query)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]RevocationResult, len(credentials))
+	revoked := 0
+	for i, curr := range credentials {
+		results[i].Credential = curr.Credential
+		if dryRun {
+			continue
+		}
+		results[i].Revocation, results[i].Err = c.issuer.Revoke(*curr.Credential.ID, reason)
+		if results[i].Err != nil {
+			log.Logger().Errorf("Unable to revoke issued credential (id=%s): %v", curr.Credential.ID, results[i].Err)
+			continue
+		}
+		revoked++
+	}
+	if !dryRun {
+		log.Logger().Infof("Revoked issued credentials (count=%d, failed=%d, issuer=%s, subject=%s, credentialType=%s, reason=%s)",
+			revoked, len(results)-revoked, query.Issuer, query.Subject, query.CredentialType, reason)
+	}
+	return results, nil
+}
+
+// searchIssued returns the issued credentials that match the query, ordered by issuance date.
+func (c *vcr) searchIssued(query IssuedCredentialQuery) ([]IssuedCredential, error) {
+	if query.State != "" && query.State != ActiveCredentialState && query.State != RevokedCredentialState {
+		return nil, core.InvalidInputError("state must be %s or %s (state=%s)", ActiveCredentialState, RevokedCredentialState, query.State)
+	}
+	credentials, err := c.issuer.FilterCredentials(query.CredentialFilter)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]IssuedCredential, 0, len(credentials))
+	for _, curr := range credentials {
+		if curr.ID == nil {
+			continue
+		}
+		revoked, err := c.verifier.IsRevoked(*curr.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to check revocation of issued credential (id=%s): %w", curr.ID, err)
+		}
+		state := ActiveCredentialState
+		if revoked {
+			state = RevokedCredentialState
+		}
+		if query.State == "" || query.State == state {
+			result = append(result, IssuedCredential{Credential: curr, State: state})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,194 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/verifier"
+	"github.com/stretchr/testify/assert"
+)
+
+type issuedTestContext struct {
+	vcr      *vcr
+	issuer   *issuer.MockIssuer
+	verifier *verifier.MockVerifier
+}
+
+func newIssuedTestContext(t *testing.T) issuedTestContext {
+	ctrl := gomock.NewController(t)
+	ctx := issuedTestContext{
+		issuer:   issuer.NewMockIssuer(ctrl),
+		verifier: verifier.NewMockVerifier(ctrl),
+	}
+	ctx.vcr = &vcr{issuer: ctx.issuer, verifier: ctx.verifier}
+	return ctx
+}
+
+func issuedTestCredentials() []vc.VerifiableCredential {
+	var result []vc.VerifiableCredential
+	for _, id := range []string{"did:nuts:123#1", "did:nuts:123#2", "did:nuts:123#3"} {
+		credentialID := ssi.MustParseURI(id)
+		result = append(result, vc.VerifiableCredential{ID: &credentialID})
+	}
+	return result
+}
+
+func TestVcr_ListIssued(t *testing.T) {
+	filter := issuer.CredentialFilter{Subject: "did:nuts:456"}
+	credentials := issuedTestCredentials()
+	expectRevocations := func(ctx issuedTestContext) {
+		ctx.issuer.EXPECT().FilterCredentials(filter).Return(credentials, nil)
+		ctx.verifier.EXPECT().IsRevoked(*credentials[0].ID).Return(false, nil)
+		ctx.verifier.EXPECT().IsRevoked(*credentials[1].ID).Return(true, nil)
+		ctx.verifier.EXPECT().IsRevoked(*credentials[2].ID).Return(false, nil)
+	}
+
+	t.Run("ok - all states", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+		expectRevocations(ctx)
+
+		result, total, err := ctx.vcr.ListIssued(IssuedCredentialQuery{CredentialFilter: filter}, 0, 0)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 3, total)
+		assert.Equal(t, []IssuedCredential{
+			{Credential: credentials[0], State: ActiveCredentialState},
+			{Credential: credentials[1], State: RevokedCredentialState},
+			{Credential: credentials[2], State: ActiveCredentialState},
+		}, result)
+	})
+	t.Run("ok - by state", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+		expectRevocations(ctx)
+
+		result, total, err := ctx.vcr.ListIssued(IssuedCredentialQuery{CredentialFilter: filter, State: ActiveCredentialState}, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Len(t, result, 2)
+	})
+	t.Run("ok - page", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+		expectRevocations(ctx)
+
+		result, total, err := ctx.vcr.ListIssued(IssuedCredentialQuery{CredentialFilter: filter}, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Equal(t, []IssuedCredential{{Credential: credentials[1], State: RevokedCredentialState}}, result)
+	})
+	t.Run("ok - offset beyond last credential", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+		expectRevocations(ctx)
+
+		result, total, err := ctx.vcr.ListIssued(IssuedCredentialQuery{CredentialFilter: filter}, 10, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Empty(t, result)
+	})
+	t.Run("error - negative offset", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+
+		_, _, err := ctx.vcr.ListIssued(IssuedCredentialQuery{}, -1, 0)
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - invalid state", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+
+		_, _, err := ctx.vcr.ListIssued(IssuedCredentialQuery{State: "expired"}, 0, 0)
+
+		assert.EqualError(t, err, "state must be active or revoked (state=expired)")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - revocation check fails", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+		ctx.issuer.EXPECT().FilterCredentials(filter).Return(credentials, nil)
+		ctx.verifier.EXPECT().IsRevoked(*credentials[0].ID).Return(false, errors.New("b00m"))
+
+		_, _, err := ctx.vcr.ListIssued(IssuedCredentialQuery{CredentialFilter: filter}, 0, 0)
+
+		assert.EqualError(t, err, "unable to check revocation of issued credential (id=did:nuts:123#1): b00m")
+	})
+}
+
+func TestVcr_RevokeIssued(t *testing.T) {
+	filter := issuer.CredentialFilter{Subject: "did:nuts:456"}
+	credentials := issuedTestCredentials()
+	reason := "organization left the platform"
+	expectRevocations := func(ctx issuedTestContext) {
+		ctx.issuer.EXPECT().FilterCredentials(filter).Return(credentials, nil)
+		ctx.verifier.EXPECT().IsRevoked(*credentials[0].ID).Return(false, nil)
+		ctx.verifier.EXPECT().IsRevoked(*credentials[1].ID).Return(true, nil)
+		ctx.verifier.EXPECT().IsRevoked(*credentials[2].ID).Return(false, nil)
+	}
+
+	t.Run("ok - revokes active credentials", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+		expectRevocations(ctx)
+		revocation := &credential.Revocation{Subject: *credentials[0].ID, Reason: reason}
+		ctx.issuer.EXPECT().Revoke(*credentials[0].ID, reason).Return(revocation, nil)
+		ctx.issuer.EXPECT().Revoke(*credentials[2].ID, reason).Return(nil, errors.New("b00m"))
+
+		results, err := ctx.vcr.RevokeIssued(IssuedCredentialQuery{CredentialFilter: filter}, reason, false)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []RevocationResult{
+			{Credential: credentials[0], Revocation: revocation},
+			{Credential: credentials[2], Err: errors.New("b00m")},
+		}, results)
+	})
+	t.Run("ok - dry run", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+		expectRevocations(ctx)
+
+		results, err := ctx.vcr.RevokeIssued(IssuedCredentialQuery{CredentialFilter: filter}, reason, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []RevocationResult{{Credential: credentials[0]}, {Credential: credentials[2]}}, results)
+	})
+	t.Run("error - no issuer, subject or type", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+
+		_, err := ctx.vcr.RevokeIssued(IssuedCredentialQuery{}, reason, false)
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - revoked state", func(t *testing.T) {
+		ctx := newIssuedTestContext(t)
+
+		_, err := ctx.vcr.RevokeIssued(IssuedCredentialQuery{CredentialFilter: filter, State: RevokedCredentialState}, reason, false)
+
+		assert.EqualError(t, err, "only active credentials can be revoked")
+	})
+}
@@ -25,7 +25,10 @@ import (
 	"github.com/nuts-foundation/go-did/vc"
 	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
 	"io"
+	"time"
 )
 
 // Publisher publishes new credentials and revocations to a channel. Used by a credential issuer.
@@ -33,11 +36,52 @@ type Publisher interface {
 	// PublishCredential publishes the credential to the outside world.
 	// A public flag is used to indicate if everybody can see the credential, or just the involved parties.
 	PublishCredential(verifiableCredential vc.VerifiableCredential, public bool) error
+	// PublishCredentials publishes credentials of the same issuer to the outside world. If the publisher supports it,
+	// credentials with the same visibility are combined into as few transactions as possible.
+	// It returns the error for each credential that couldn't be published, or nil if all credentials were published.
+	PublishCredentials(verifiableCredentials []vc.VerifiableCredential, public bool) []error
 	// PublishRevocation publishes the revocation to the outside world.
 	// It indicates to the network a credential can no longer be used.
 	PublishRevocation(revocation credential.Revocation) error
 }
 
+// SchemaValidator validates issued credentials against the JSON Schema of their type.
+type SchemaValidator interface {
+	credential.Validator
+	// SchemaID returns the ID of the schema of the given credential type, which is set as 'credentialSchema' of credentials
+	// issued in (SD-)JWT format. It returns false if the type has no schema.
+	SchemaID(credentialType string) (string, bool)
+}
+
+// DisclosurePolicy returns the claims of the given credential type that are selectively disclosable when a credential
+// is issued in SD-JWT format. The claims are paths of object keys in the credential subject separated by dots, e.g. 'organization.city'.
+type DisclosurePolicy func(credentialType string) []string
+
+// TemplatePolicy checks whether a credential to be issued matches an issuance template of its type.
+// It returns an error wrapping ErrTemplateMismatch if it doesn't.
+type TemplatePolicy func(credential vc.VerifiableCredential) error
+
+// RefreshPolicy returns true if credentials of the given type can be refreshed by their subject.
+// Credentials of these types get a 'refreshService' if they're issued in (SD-)JWT format and the issuer has a refresh service.
+type RefreshPolicy func(credentialType string) bool
+
+// RefreshServiceType is the type of the DID service of an issuer, at which the subjects of its credentials can refresh them.
+const RefreshServiceType = "vcr-refresh"
+
+// RefreshServiceCredentialType is the type of the 'refreshService' of credentials, which refers to the refresh service of the issuer.
+const RefreshServiceCredentialType = "NutsRefreshService"
+
+// RefreshService is the 'refreshService' of a credential, see https://www.w3.org/TR/vc-data-model/#refreshing
+type RefreshService struct {
+	// ID is the reference to the refresh service in the DID document of the issuer.
+	ID ssi.URI `json:"id"`
+	// Type is the type of the refresh service, which is RefreshServiceCredentialType.
+	Type string `json:"type"`
+}
+
+// ErrTemplateMismatch is returned when a credential to be issued doesn't match an issuance template of its type.
+var ErrTemplateMismatch = errors.New("credential doesn't match an issuance template")
+
 type keyResolver interface {
 	ResolveAssertionKey(issuerDID did.DID) (crypto.Key, error)
 }
@@ -45,17 +89,31 @@ type keyResolver interface {
 // Issuer is a role in the network for a party who issues credentials about a subject to a holder.
 type Issuer interface {
 	// Issue issues a credential by signing an unsigned credential.
+	// The format param specifies whether the credential is signed with a JSON-LD proof (ldp_vc), as JWT (jwt_vc) or as SD-JWT (vc+sd-jwt).
 	// The publish param indicates if the credendential should be published to the network.
 	// The public param instructs the Publisher to publish the param with a certain visibility.
-	Issue(unsignedCredential vc.VerifiableCredential, publish, public bool) (*vc.VerifiableCredential, error)
-	// Revoke revokes a credential by the provided type.
+	Issue(unsignedCredential vc.VerifiableCredential, format types.Format, publish, public bool) (*vc.VerifiableCredential, error)
+	// IssueBatch issues multiple credentials of the same issuer, signing them in parallel.
+	// The publish and public params apply to all credentials, see Issue. The credentials are published together,
+	// so they require fewer transactions than issuing them one by one.
+	// It returns a result for each unsigned credential, in the same order. A credential that fails doesn't affect the others.
+	IssueBatch(unsignedCredentials []vc.VerifiableCredential, format types.Format, publish, public bool) []BatchResult
+	// Revoke revokes a credential by the provided type. The optional reason describes why the credential is revoked.
 	// It requires access to the private key of the issuer which will be used to sign the revocation.
 	// It returns an error when the credential is not issued by this node or is already revoked.
 	// The revocation will be published to the network by the issuers Publisher.
-	Revoke(credentialID ssi.URI) (*credential.Revocation, error)
+	Revoke(credentialID ssi.URI, reason string) (*credential.Revocation, error)
 	CredentialSearcher
 }
 
+// BatchResult contains the result of issuing a single credential of a batch.
+type BatchResult struct {
+	// Credential is the issued credential, it's nil if issuing failed.
+	Credential *vc.VerifiableCredential
+	// Err contains the reason issuing failed.
+	Err error
+}
+
 // ErrNotFound is returned when a credential or revocation can not be found based on its ID.
 var ErrNotFound = errors.New("not found")
 
@@ -68,6 +126,8 @@ type Store interface {
 	// StoreCredential writes a VC to storage.
 	StoreCredential(vc vc.VerifiableCredential) error
 	CredentialSearcher
+	// Collection returns the collection of the store with its indices, so it can be checked and reindexed.
+	Collection() storage.Collection
 	// Closer closes and frees the underlying resources the store uses.
 	io.Closer
 }
@@ -77,4 +137,31 @@ type Store interface {
 type CredentialSearcher interface {
 	// SearchCredential searches for issued credentials
 	SearchCredential(context ssi.URI, credentialType ssi.URI, issuer did.DID, subject *ssi.URI) ([]vc.VerifiableCredential, error)
+	// SearchExpiringCredentials searches for issued credentials with an expiration date before the given moment,
+	// including credentials that have already expired.
+	SearchExpiringCredentials(before time.Time) ([]vc.VerifiableCredential, error)
+	// FilterCredentials returns the issued credentials that match the filter, ordered by issuance date.
+	FilterCredentials(filter CredentialFilter) ([]vc.VerifiableCredential, error)
+}
+
+// CredentialFilter selects issued credentials. Fields that aren't set match all credentials.
+type CredentialFilter struct {
+	// Issuer is the DID of the issuer of the credentials.
+	Issuer string
+	// Subject is the ID of the credential subject (usually a DID).
+	Subject string
+	// CredentialType is the type of the credentials, besides VerifiableCredential.
+	CredentialType string
+	// IssuedFrom selects the credentials issued at or after this moment.
+	IssuedFrom *time.Time
+	// IssuedUntil selects the credentials issued before this moment.
+	IssuedUntil *time.Time
+}
+
+// matchesIssuanceDate returns true if the credential was issued within the range of the filter.
+func (f CredentialFilter) matchesIssuanceDate(credential vc.VerifiableCredential) bool {
+	if f.IssuedFrom != nil && credential.IssuanceDate.Before(*f.IssuedFrom) {
+		return false
+	}
+	return f.IssuedUntil == nil || credential.IssuanceDate.Before(*f.IssuedUntil)
 }
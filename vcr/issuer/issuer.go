@@ -31,43 +31,64 @@ import (
 	"github.com/nuts-foundation/nuts-node/vcr/log"
 	"github.com/nuts-foundation/nuts-node/vcr/signature"
 	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+	"github.com/nuts-foundation/nuts-node/vdr/doc"
 	vdr "github.com/nuts-foundation/nuts-node/vdr/types"
 	"github.com/piprate/json-gold/ld"
+	"sync"
 	"time"
 )
 
+// maxBatchWorkers is the maximum number of credentials of a batch that are built and signed concurrently.
+const maxBatchWorkers = 8
+
 // NewIssuer creates a new issuer which implements the Issuer interface.
-func NewIssuer(store Store, publisher Publisher, docResolver vdr.DocResolver, keyStore crypto.KeyStore, contextLoader ld.DocumentLoader) Issuer {
+// Issued credentials are validated against the given schemaValidator, if set.
+// The disclosurePolicy specifies the selectively disclosable claims of credentials issued as SD-JWT, if not set no claims are.
+// Issued credentials must match an issuance template according to the templatePolicy, if set.
+// The refreshPolicy specifies which credentials get a 'refreshService', if not set none do.
+func NewIssuer(store Store, publisher Publisher, docResolver vdr.DocResolver, keyStore crypto.KeyStore, contextLoader ld.DocumentLoader,
+	schemaValidator SchemaValidator, disclosurePolicy DisclosurePolicy, templatePolicy TemplatePolicy, refreshPolicy RefreshPolicy) Issuer {
 	resolver := vdrKeyResolver{docResolver: docResolver, keyResolver: keyStore}
 	return &issuer{
-		store:         store,
-		publisher:     publisher,
-		keyResolver:   resolver,
-		contextLoader: contextLoader,
+		store:            store,
+		publisher:        publisher,
+		docResolver:      docResolver,
+		keyResolver:      resolver,
+		contextLoader:    contextLoader,
+		schemaValidator:  schemaValidator,
+		disclosurePolicy: disclosurePolicy,
+		templatePolicy:   templatePolicy,
+		refreshPolicy:    refreshPolicy,
 	}
 }
 
 type issuer struct {
 	store         Store
 	publisher     Publisher
+	docResolver   vdr.DocResolver
 	keyResolver   keyResolver
 	contextLoader ld.DocumentLoader
+	// schemaValidator validates issued credentials against the JSON Schema of their type, it's optional
+	schemaValidator SchemaValidator
+	// disclosurePolicy specifies the selectively disclosable claims of SD-JWT credentials, it's optional
+	disclosurePolicy DisclosurePolicy
+	// templatePolicy checks whether issued credentials match an issuance template, it's optional
+	templatePolicy TemplatePolicy
+	// refreshPolicy specifies which credentials can be refreshed by their subject, it's optional
+	refreshPolicy RefreshPolicy
 }
 
 // Issue creates a new credential, signs, stores it.
+// The format determines whether the credential is signed with a JSON-LD proof or as JWT.
 // If publish is true, it publishes the credential to the network using the configured Publisher
 // Use the public flag to pass the visibility settings to the Publisher.
-func (i issuer) Issue(credentialOptions vc.VerifiableCredential, publish, public bool) (*vc.VerifiableCredential, error) {
-	createdVC, err := i.buildVC(credentialOptions)
+func (i issuer) Issue(credentialOptions vc.VerifiableCredential, format types.Format, publish, public bool) (*vc.VerifiableCredential, error) {
+	createdVC, err := i.buildAndValidateVC(credentialOptions, format)
 	if err != nil {
 		return nil, err
 	}
 
-	validator, _ := credential.FindValidatorAndBuilder(*createdVC)
-	if err := validator.Validate(*createdVC); err != nil {
-		return nil, err
-	}
-
 	if err = i.store.StoreCredential(*createdVC); err != nil {
 		return nil, fmt.Errorf("unable to store the issued credential: %w", err)
 	}
@@ -80,10 +101,92 @@ func (i issuer) Issue(credentialOptions vc.VerifiableCredential, publish, public
 	return createdVC, nil
 }
 
-func (i issuer) buildVC(credentialOptions vc.VerifiableCredential) (*vc.VerifiableCredential, error) {
+// IssueBatch creates multiple credentials of the same issuer, signs and stores them.
+// The credentials are built and signed in parallel, and published together using the configured Publisher.
+func (i issuer) IssueBatch(credentialOptions []vc.VerifiableCredential, format types.Format, publish, public bool) []BatchResult {
+	results := make([]BatchResult, len(credentialOptions))
+	if len(credentialOptions) == 0 {
+		return results
+	}
+	batchIssuer := credentialOptions[0].Issuer.String()
+
+	wg := sync.WaitGroup{}
+	workers := make(chan struct{}, maxBatchWorkers)
+	for idx := range credentialOptions {
+		if credentialOptions[idx].Issuer.String() != batchIssuer {
+			results[idx].Err = fmt.Errorf("credential issuer differs from batch issuer (issuer=%s)", batchIssuer)
+			continue
+		}
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(idx int) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			results[idx].Credential, results[idx].Err = i.buildAndValidateVC(credentialOptions[idx], format)
+		}(idx)
+	}
+	wg.Wait()
+
+	var (
+		toPublish        []vc.VerifiableCredential
+		toPublishResults []int
+	)
+	for idx, result := range results {
+		if result.Err != nil {
+			continue
+		}
+		if err := i.store.StoreCredential(*result.Credential); err != nil {
+			results[idx] = BatchResult{Err: fmt.Errorf("unable to store the issued credential: %w", err)}
+			continue
+		}
+		toPublish = append(toPublish, *result.Credential)
+		toPublishResults = append(toPublishResults, idx)
+	}
+
+	if publish && len(toPublish) > 0 {
+		publishErrs := i.publisher.PublishCredentials(toPublish, public)
+		for j, err := range publishErrs {
+			if err != nil {
+				results[toPublishResults[j]] = BatchResult{Err: fmt.Errorf("unable to publish the issued credential: %w", err)}
+			}
+		}
+	}
+	return results
+}
+
+// buildAndValidateVC builds and signs the credential, and validates it according to the rules of its type, its schema and the issuance templates.
+func (i issuer) buildAndValidateVC(credentialOptions vc.VerifiableCredential, format types.Format) (*vc.VerifiableCredential, error) {
+	createdVC, err := i.buildVC(credentialOptions, format)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, _ := credential.FindValidatorAndBuilder(*createdVC)
+	if err := validator.Validate(*createdVC); err != nil {
+		return nil, err
+	}
+	if i.schemaValidator != nil {
+		if err := i.schemaValidator.Validate(*createdVC); err != nil {
+			return nil, err
+		}
+	}
+	if i.templatePolicy != nil {
+		if err := i.templatePolicy(*createdVC); err != nil {
+			return nil, err
+		}
+	}
+	return createdVC, nil
+}
+
+func (i issuer) buildVC(credentialOptions vc.VerifiableCredential, format types.Format) (*vc.VerifiableCredential, error) {
 	if len(credentialOptions.Type) != 1 {
 		return nil, errors.New("can only issue credential with 1 type")
 	}
+	if format != types.JSONLDCredentialFormat && format != types.JWTCredentialFormat && format != types.SDJWTCredentialFormat {
+		return nil, fmt.Errorf("unsupported credential format: %s", format)
+	}
 
 	// find issuer
 	issuer, err := did.ParseDID(credentialOptions.Issuer.String())
@@ -112,6 +215,21 @@ func (i issuer) buildVC(credentialOptions vc.VerifiableCredential) (*vc.Verifiab
 		return nil, fmt.Errorf("failed to sign credential, could not resolve an assertionKey for issuer: %w", err)
 	}
 
+	if format == types.JWTCredentialFormat || format == types.SDJWTCredentialFormat {
+		extraClaims, err := i.extraCredentialClaims(*issuer, credentialOptions.Type[0].String())
+		if err != nil {
+			return nil, err
+		}
+		if format == types.JWTCredentialFormat {
+			return signJWTCredential(unsignedCredential, extraClaims, key)
+		}
+		var disclosable []string
+		if i.disclosurePolicy != nil {
+			disclosable = i.disclosurePolicy(credentialOptions.Type[0].String())
+		}
+		return signSDJWTCredential(unsignedCredential, extraClaims, key, disclosable)
+	}
+
 	credentialAsMap := map[string]interface{}{}
 	b, _ := json.Marshal(unsignedCredential)
 	_ = json.Unmarshal(b, &credentialAsMap)
@@ -140,14 +258,96 @@ func (i issuer) buildVC(credentialOptions vc.VerifiableCredential) (*vc.Verifiab
 	return signedCredential, nil
 }
 
-func (i issuer) Revoke(credentialID ssi.URI) (*credential.Revocation, error) {
+// extraCredentialClaims returns the properties of a credential of the given type that the go-did credential doesn't retain,
+// so they're added to the 'vc' claim of credentials issued in (SD-)JWT format: the 'credentialSchema' if the type has a schema,
+// and the 'refreshService' if the type can be refreshed and the issuer has a refresh service.
+func (i issuer) extraCredentialClaims(issuer did.DID, credentialType string) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	if i.schemaValidator != nil {
+		if schemaID, ok := i.schemaValidator.SchemaID(credentialType); ok {
+			result["credentialSchema"] = []credential.SchemaReference{{ID: schemaID, Type: credential.JSONSchemaValidator2018Type}}
+		}
+	}
+	if i.refreshPolicy != nil && i.refreshPolicy(credentialType) {
+		refreshService, err := i.resolveRefreshService(issuer)
+		if err != nil {
+			return nil, err
+		}
+		if refreshService != nil {
+			result["refreshService"] = refreshService
+		}
+	}
+	return result, nil
+}
+
+// resolveRefreshService returns the 'refreshService' of credentials of the issuer, which refers to its service of type RefreshServiceType.
+// It returns nil if the issuer doesn't have such a service.
+func (i issuer) resolveRefreshService(issuer did.DID) (*RefreshService, error) {
+	document, _, err := i.docResolver.Resolve(issuer, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve refresh service of issuer: %w", err)
+	}
+	for _, service := range document.Service {
+		if service.Type == RefreshServiceType {
+			return &RefreshService{ID: doc.MakeServiceReference(issuer, RefreshServiceType), Type: RefreshServiceCredentialType}, nil
+		}
+	}
+	return nil, nil
+}
+
+// signJWTCredential signs the credential as JWT according to https://www.w3.org/TR/vc-data-model/#jwt-encoding
+// The credential is returned in its JSON form with the JWT as proof, so it can be stored, searched and published like any other credential.
+// The extra claims are added to the credential in the JWT.
+func signJWTCredential(unsignedCredential vc.VerifiableCredential, extraClaims map[string]interface{}, key crypto.Key) (*vc.VerifiableCredential, error) {
+	claims, err := credentialClaims(unsignedCredential, extraClaims)
+	if err != nil {
+		return nil, err
+	}
+	jwtProof, err := proof.NewJWTProof(claims, key)
+	if err != nil {
+		return nil, err
+	}
+	return jwtProof.Credential()
+}
+
+// signSDJWTCredential signs the credential as SD-JWT, making the given claims of the credential subject selectively disclosable.
+func signSDJWTCredential(unsignedCredential vc.VerifiableCredential, extraClaims map[string]interface{}, key crypto.Key, disclosable []string) (*vc.VerifiableCredential, error) {
+	paths := make([]string, len(disclosable))
+	for i, claim := range disclosable {
+		paths[i] = "vc.credentialSubject." + claim
+	}
+	claims, err := credentialClaims(unsignedCredential, extraClaims)
+	if err != nil {
+		return nil, err
+	}
+	sdJWTProof, err := proof.NewSDJWTProof(claims, paths, key)
+	if err != nil {
+		return nil, err
+	}
+	return sdJWTProof.Credential()
+}
+
+// credentialClaims maps the credential to the claims of a VC-JWT, with the extra claims as properties of the credential.
+// The go-did credential doesn't retain properties like 'credentialSchema', so they're added to the 'vc' claim directly.
+func credentialClaims(unsignedCredential vc.VerifiableCredential, extraClaims map[string]interface{}) (map[string]interface{}, error) {
+	claims, err := proof.CredentialClaims(unsignedCredential)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range extraClaims {
+		claims["vc"].(map[string]interface{})[name] = value
+	}
+	return claims, nil
+}
+
+func (i issuer) Revoke(credentialID ssi.URI, reason string) (*credential.Revocation, error) {
 	// first find it using a query on id.
 	credentialToRevoke, err := i.store.GetCredential(credentialID)
 	if err != nil {
 		return nil, fmt.Errorf("could not revoke (id=%s): %w", credentialID, err)
 	}
 
-	revocation, err := i.buildRevocation(*credentialToRevoke)
+	revocation, err := i.buildRevocation(*credentialToRevoke, reason)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +361,7 @@ func (i issuer) Revoke(credentialID ssi.URI) (*credential.Revocation, error) {
 	return revocation, nil
 }
 
-func (i issuer) buildRevocation(credentialToRevoke vc.VerifiableCredential) (*credential.Revocation, error) {
+func (i issuer) buildRevocation(credentialToRevoke vc.VerifiableCredential, reason string) (*credential.Revocation, error) {
 	// find issuer
 	issuerDID, err := did.ParseDID(credentialToRevoke.Issuer.String())
 	if err != nil {
@@ -171,6 +371,7 @@ func (i issuer) buildRevocation(credentialToRevoke vc.VerifiableCredential) (*cr
 	assertionKey, err := i.keyResolver.ResolveAssertionKey(*issuerDID)
 	// set defaults
 	revocation := credential.BuildRevocation(credentialToRevoke)
+	revocation.Reason = reason
 
 	revocationAsMap := map[string]interface{}{}
 	b, _ := json.Marshal(revocation)
@@ -194,6 +395,14 @@ func (i issuer) CredentialResolver() CredentialSearcher {
 	return i.store
 }
 
+func (i issuer) SearchExpiringCredentials(before time.Time) ([]vc.VerifiableCredential, error) {
+	return i.store.SearchExpiringCredentials(before)
+}
+
 func (i issuer) SearchCredential(context ssi.URI, credentialType ssi.URI, issuer did.DID, subject *ssi.URI) ([]vc.VerifiableCredential, error) {
 	return i.store.SearchCredential(context, credentialType, issuer, subject)
 }
+
+func (i issuer) FilterCredentials(filter CredentialFilter) ([]vc.VerifiableCredential, error) {
+	return i.store.FilterCredentials(filter)
+}
@@ -29,6 +29,9 @@ import (
 	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
 	"github.com/nuts-foundation/nuts-node/vcr/signature"
+	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+	vdr "github.com/nuts-foundation/nuts-node/vdr/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
@@ -62,7 +65,7 @@ func Test_issuer_buildVC(t *testing.T) {
 				"id": "did:nuts:456",
 			}},
 		}
-		result, err := sut.buildVC(credentialOptions)
+		result, err := sut.buildVC(credentialOptions, types.JSONLDCredentialFormat)
 		if !assert.NoError(t, err) || !assert.NotNil(t, result) {
 			return
 		}
@@ -75,14 +78,229 @@ func Test_issuer_buildVC(t *testing.T) {
 		assert.Equal(t, issuance, proofs[0].Created)
 	})
 
+	t.Run("it issues a JWT VC", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		kid := "did:nuts:123#abc"
+		key := crypto.NewTestKey(kid)
+		keyResolverMock := NewMockkeyResolver(ctrl)
+		keyResolverMock.EXPECT().ResolveAssertionKey(*issuerDID).Return(key, nil)
+		sut := issuer{keyResolver: keyResolverMock}
+		expirationDate := time.Now().Add(time.Hour)
+
+		credentialOptions := vc.VerifiableCredential{
+			Type:           []ssi.URI{*credentialType},
+			Issuer:         *issuerID,
+			ExpirationDate: &expirationDate,
+			CredentialSubject: []interface{}{map[string]interface{}{
+				"id": "did:nuts:456",
+			}},
+		}
+		result, err := sut.buildVC(credentialOptions, types.JWTCredentialFormat)
+		if !assert.NoError(t, err) || !assert.NotNil(t, result) {
+			return
+		}
+		assert.Contains(t, result.Type, *credentialType)
+		var jwtProofs []proof.JWTProof
+		_ = result.UnmarshalProofValue(&jwtProofs)
+		if !assert.Len(t, jwtProofs, 1) {
+			return
+		}
+		assert.Equal(t, proof.JwtProof2020, jwtProofs[0].Type)
+		claims, err := jwtProofs[0].Verify(key.Public())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, issuerID.String(), claims["iss"])
+		assert.Equal(t, result.ID.String(), claims["jti"])
+		assert.Equal(t, "did:nuts:456", claims["sub"])
+		assert.Equal(t, float64(result.IssuanceDate.Unix()), claims["nbf"])
+		assert.Equal(t, float64(expirationDate.Unix()), claims["exp"])
+		// the registered claims aren't repeated in the 'vc' claim
+		vcClaim := claims["vc"].(map[string]interface{})
+		assert.NotContains(t, vcClaim, "id")
+		assert.NotContains(t, vcClaim, "issuer")
+		assert.NotContains(t, vcClaim, "proof")
+		assert.Equal(t, map[string]interface{}{}, vcClaim["credentialSubject"])
+		// the credential is the JSON form decoded from the JWT
+		assert.Equal(t, issuerID.String(), result.Issuer.String())
+		assert.Equal(t, expirationDate.Unix(), result.ExpirationDate.Unix())
+		assert.Equal(t, []interface{}{map[string]interface{}{"id": "did:nuts:456"}}, result.CredentialSubject)
+	})
+
+	t.Run("it sets the credentialSchema of a JWT VC", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		key := crypto.NewTestKey("did:nuts:123#abc")
+		keyResolverMock := NewMockkeyResolver(ctrl)
+		keyResolverMock.EXPECT().ResolveAssertionKey(*issuerDID).Return(key, nil)
+		sut := issuer{keyResolver: keyResolverMock, schemaValidator: failingValidator{}}
+
+		credentialOptions := vc.VerifiableCredential{
+			Type:              []ssi.URI{*credentialType},
+			Issuer:            *issuerID,
+			CredentialSubject: []interface{}{map[string]interface{}{"id": "did:nuts:456"}},
+		}
+		result, err := sut.buildVC(credentialOptions, types.JWTCredentialFormat)
+		if !assert.NoError(t, err) || !assert.NotNil(t, result) {
+			return
+		}
+		var jwtProofs []proof.JWTProof
+		_ = result.UnmarshalProofValue(&jwtProofs)
+		if !assert.Len(t, jwtProofs, 1) {
+			return
+		}
+		claims, _ := jwtProofs[0].Claims()
+		expected := []interface{}{map[string]interface{}{"id": "https://example.com/schema.json", "type": "JsonSchemaValidator2018"}}
+		assert.Equal(t, expected, claims["vc"].(map[string]interface{})["credentialSchema"])
+	})
+
+	t.Run("refreshService of a JWT VC", func(t *testing.T) {
+		refreshPolicy := func(credentialType string) bool {
+			return credentialType == "TestCredential"
+		}
+		credentialOptions := vc.VerifiableCredential{
+			Type:              []ssi.URI{*credentialType},
+			Issuer:            *issuerID,
+			CredentialSubject: []interface{}{map[string]interface{}{"id": "did:nuts:456"}},
+		}
+		vcClaim := func(t *testing.T, result *vc.VerifiableCredential) map[string]interface{} {
+			var jwtProofs []proof.JWTProof
+			_ = result.UnmarshalProofValue(&jwtProofs)
+			if !assert.Len(t, jwtProofs, 1) {
+				return nil
+			}
+			claims, _ := jwtProofs[0].Claims()
+			return claims["vc"].(map[string]interface{})
+		}
+
+		t.Run("it's set if the issuer has a refresh service", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			keyResolverMock := NewMockkeyResolver(ctrl)
+			keyResolverMock.EXPECT().ResolveAssertionKey(*issuerDID).Return(crypto.NewTestKey("did:nuts:123#abc"), nil)
+			docResolver := vdr.NewMockDocResolver(ctrl)
+			document := &did.Document{ID: *issuerDID, Service: []did.Service{{Type: RefreshServiceType, ServiceEndpoint: "https://example.com/n2n/vcr/v2/refresh"}}}
+			docResolver.EXPECT().Resolve(*issuerDID, nil).Return(document, nil, nil)
+			sut := issuer{keyResolver: keyResolverMock, docResolver: docResolver, refreshPolicy: refreshPolicy}
+
+			result, err := sut.buildVC(credentialOptions, types.JWTCredentialFormat)
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			expected := map[string]interface{}{"id": "did:nuts:123/serviceEndpoint?type=vcr-refresh", "type": "NutsRefreshService"}
+			assert.Equal(t, expected, vcClaim(t, result)["refreshService"])
+		})
+		t.Run("it's not set if the issuer has no refresh service", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			keyResolverMock := NewMockkeyResolver(ctrl)
+			keyResolverMock.EXPECT().ResolveAssertionKey(*issuerDID).Return(crypto.NewTestKey("did:nuts:123#abc"), nil)
+			docResolver := vdr.NewMockDocResolver(ctrl)
+			docResolver.EXPECT().Resolve(*issuerDID, nil).Return(&did.Document{ID: *issuerDID}, nil, nil)
+			sut := issuer{keyResolver: keyResolverMock, docResolver: docResolver, refreshPolicy: refreshPolicy}
+
+			result, err := sut.buildVC(credentialOptions, types.JWTCredentialFormat)
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NotContains(t, vcClaim(t, result), "refreshService")
+		})
+		t.Run("it's not set if the type can't be refreshed", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			keyResolverMock := NewMockkeyResolver(ctrl)
+			keyResolverMock.EXPECT().ResolveAssertionKey(*issuerDID).Return(crypto.NewTestKey("did:nuts:123#abc"), nil)
+			sut := issuer{keyResolver: keyResolverMock, refreshPolicy: func(_ string) bool {
+				return false
+			}}
+
+			result, err := sut.buildVC(credentialOptions, types.JWTCredentialFormat)
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NotContains(t, vcClaim(t, result), "refreshService")
+		})
+		t.Run("error - can't resolve issuer", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			keyResolverMock := NewMockkeyResolver(ctrl)
+			keyResolverMock.EXPECT().ResolveAssertionKey(*issuerDID).Return(crypto.NewTestKey("did:nuts:123#abc"), nil)
+			docResolver := vdr.NewMockDocResolver(ctrl)
+			docResolver.EXPECT().Resolve(*issuerDID, nil).Return(nil, nil, vdr.ErrNotFound)
+			sut := issuer{keyResolver: keyResolverMock, docResolver: docResolver, refreshPolicy: refreshPolicy}
+
+			_, err := sut.buildVC(credentialOptions, types.JWTCredentialFormat)
+
+			assert.ErrorIs(t, err, vdr.ErrNotFound)
+		})
+	})
+
+	t.Run("it issues an SD-JWT VC", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		kid := "did:nuts:123#abc"
+		key := crypto.NewTestKey(kid)
+		keyResolverMock := NewMockkeyResolver(ctrl)
+		keyResolverMock.EXPECT().ResolveAssertionKey(*issuerDID).Return(key, nil)
+		disclosurePolicy := func(credentialType string) []string {
+			assert.Equal(t, "TestCredential", credentialType)
+			return []string{"organization.city"}
+		}
+		sut := issuer{keyResolver: keyResolverMock, disclosurePolicy: disclosurePolicy}
+
+		credentialOptions := vc.VerifiableCredential{
+			Type:   []ssi.URI{*credentialType},
+			Issuer: *issuerID,
+			CredentialSubject: []interface{}{map[string]interface{}{
+				"id": "did:nuts:456",
+				"organization": map[string]interface{}{
+					"name": "Because we care B.V.",
+					"city": "IJbergen",
+				},
+			}},
+		}
+		result, err := sut.buildVC(credentialOptions, types.SDJWTCredentialFormat)
+		if !assert.NoError(t, err) || !assert.NotNil(t, result) {
+			return
+		}
+		var sdJWTProofs []proof.SDJWTProof
+		_ = result.UnmarshalProofValue(&sdJWTProofs)
+		if !assert.Len(t, sdJWTProofs, 1) {
+			return
+		}
+		assert.Equal(t, proof.SdJwtProof2022, sdJWTProofs[0].Type)
+		// the credential contains all claims, the city is only disclosed when the holder chooses to
+		assert.Equal(t, credentialOptions.CredentialSubject, result.CredentialSubject)
+		withheld, err := sdJWTProofs[0].Disclose(nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		claims, err := withheld.Verify(key.Public())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "did:nuts:456", claims["sub"])
+		credentialSubject := claims["vc"].(map[string]interface{})["credentialSubject"]
+		assert.Equal(t, map[string]interface{}{"organization": map[string]interface{}{"name": "Because we care B.V."}}, credentialSubject)
+	})
+
 	t.Run("error - invalid params", func(t *testing.T) {
+		t.Run("unsupported format", func(t *testing.T) {
+			sut := issuer{}
+
+			credentialOptions := vc.VerifiableCredential{
+				Type: []ssi.URI{*credentialType},
+			}
+			result, err := sut.buildVC(credentialOptions, "jwt_vp")
+
+			assert.EqualError(t, err, "unsupported credential format: jwt_vp")
+			assert.Nil(t, result)
+		})
+
 		t.Run("wrong amount of credential types", func(t *testing.T) {
 			sut := issuer{}
 
 			credentialOptions := vc.VerifiableCredential{
 				Type: []ssi.URI{},
 			}
-			result, err := sut.buildVC(credentialOptions)
+			result, err := sut.buildVC(credentialOptions, types.JSONLDCredentialFormat)
 
 			assert.EqualError(t, err, "can only issue credential with 1 type")
 			assert.Nil(t, result)
@@ -94,7 +312,7 @@ func Test_issuer_buildVC(t *testing.T) {
 			credentialOptions := vc.VerifiableCredential{
 				Type: []ssi.URI{*credentialType},
 			}
-			result, err := sut.buildVC(credentialOptions)
+			result, err := sut.buildVC(credentialOptions, types.JSONLDCredentialFormat)
 
 			assert.EqualError(t, err, "failed to parse issuer: invalid DID: input length is less than 7")
 			assert.Nil(t, result)
@@ -119,7 +337,7 @@ func Test_issuer_buildVC(t *testing.T) {
 					"id": "did:nuts:456",
 				}},
 			}
-			_, err := sut.buildVC(credentialOptions)
+			_, err := sut.buildVC(credentialOptions, types.JSONLDCredentialFormat)
 			assert.EqualError(t, err, "failed to sign credential, could not resolve an assertionKey for issuer: b00m!")
 		})
 
@@ -151,7 +369,7 @@ func Test_issuer_Issue(t *testing.T) {
 		mockStore.EXPECT().StoreCredential(gomock.Any())
 		sut := issuer{keyResolver: keyResolverMock, store: mockStore, contextLoader: contextLoader}
 
-		result, err := sut.Issue(credentialOptions, false, true)
+		result, err := sut.Issue(credentialOptions, types.JSONLDCredentialFormat, false, true)
 		assert.NoError(t, err)
 		assert.Contains(t, result.Type, *credentialType, "expected vc to be of right type")
 		proofs, _ := result.Proofs()
@@ -173,7 +391,7 @@ func Test_issuer_Issue(t *testing.T) {
 			mockStore.EXPECT().StoreCredential(gomock.Any()).Return(errors.New("b00m!"))
 			sut := issuer{keyResolver: keyResolverMock, store: mockStore, contextLoader: contextLoader}
 
-			result, err := sut.Issue(credentialOptions, false, true)
+			result, err := sut.Issue(credentialOptions, types.JSONLDCredentialFormat, false, true)
 			assert.EqualError(t, err, "unable to store the issued credential: b00m!")
 			assert.Nil(t, result)
 		})
@@ -191,11 +409,41 @@ func Test_issuer_Issue(t *testing.T) {
 			mockStore.EXPECT().StoreCredential(gomock.Any()).Return(nil)
 			sut := issuer{keyResolver: keyResolverMock, store: mockStore, publisher: mockPublisher, contextLoader: contextLoader}
 
-			result, err := sut.Issue(credentialOptions, true, true)
+			result, err := sut.Issue(credentialOptions, types.JSONLDCredentialFormat, true, true)
 			assert.EqualError(t, err, "unable to publish the issued credential: b00m!")
 			assert.Nil(t, result)
 		})
 
+		t.Run("schema validation fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			kid := "did:nuts:123#abc"
+
+			keyResolverMock := NewMockkeyResolver(ctrl)
+			keyResolverMock.EXPECT().ResolveAssertionKey(gomock.Any()).Return(crypto.NewTestKey(kid), nil)
+			sut := issuer{keyResolver: keyResolverMock, contextLoader: contextLoader, schemaValidator: failingValidator{}}
+
+			result, err := sut.Issue(credentialOptions, types.JSONLDCredentialFormat, true, true)
+			assert.ErrorIs(t, err, credential.ErrValidation)
+			assert.Nil(t, result)
+		})
+
+		t.Run("template policy fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			kid := "did:nuts:123#abc"
+
+			keyResolverMock := NewMockkeyResolver(ctrl)
+			keyResolverMock.EXPECT().ResolveAssertionKey(gomock.Any()).Return(crypto.NewTestKey(kid), nil)
+			sut := issuer{keyResolver: keyResolverMock, contextLoader: contextLoader, templatePolicy: func(credential vc.VerifiableCredential) error {
+				return errors.New("no issuance template")
+			}}
+
+			result, err := sut.Issue(credentialOptions, types.JSONLDCredentialFormat, true, true)
+			assert.EqualError(t, err, "no issuance template")
+			assert.Nil(t, result)
+		})
+
 		t.Run("validator fails (missing type)", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
@@ -207,7 +455,7 @@ func Test_issuer_Issue(t *testing.T) {
 				Issuer: *issuerID,
 			}
 
-			result, err := sut.Issue(credentialOptions, true, true)
+			result, err := sut.Issue(credentialOptions, types.JSONLDCredentialFormat, true, true)
 			assert.EqualError(t, err, "can only issue credential with 1 type")
 			assert.Nil(t, result)
 
@@ -215,8 +463,120 @@ func Test_issuer_Issue(t *testing.T) {
 	})
 }
 
+func Test_issuer_IssueBatch(t *testing.T) {
+	credentialType, _ := ssi.ParseURI("TestCredential")
+	issuerID, _ := ssi.ParseURI("did:nuts:123")
+	otherIssuerID, _ := ssi.ParseURI("did:nuts:456")
+	credentialOptions := func(issuer *ssi.URI) vc.VerifiableCredential {
+		return vc.VerifiableCredential{
+			Context: []ssi.URI{*credential.NutsContextURI},
+			Type:    []ssi.URI{*credentialType},
+			Issuer:  *issuer,
+			CredentialSubject: []interface{}{map[string]interface{}{
+				"id": "did:nuts:456",
+			}},
+		}
+	}
+	contextLoader, _ := signature.NewContextLoader(false)
+	kid := "did:nuts:123#abc"
+
+	t.Run("ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		keyResolverMock := NewMockkeyResolver(ctrl)
+		keyResolverMock.EXPECT().ResolveAssertionKey(gomock.Any()).Return(crypto.NewTestKey(kid), nil).Times(3)
+		mockStore := NewMockStore(ctrl)
+		mockStore.EXPECT().StoreCredential(gomock.Any()).Times(3)
+		mockPublisher := NewMockPublisher(ctrl)
+		mockPublisher.EXPECT().PublishCredentials(gomock.Len(3), false)
+		sut := issuer{keyResolver: keyResolverMock, store: mockStore, publisher: mockPublisher, contextLoader: contextLoader}
+
+		results := sut.IssueBatch([]vc.VerifiableCredential{credentialOptions(issuerID), credentialOptions(issuerID), credentialOptions(issuerID)},
+			types.JSONLDCredentialFormat, true, false)
+
+		if !assert.Len(t, results, 3) {
+			return
+		}
+		for _, result := range results {
+			assert.NoError(t, result.Err)
+			assert.Equal(t, issuerID.String(), result.Credential.Issuer.String())
+		}
+		assert.NotEqual(t, results[0].Credential.ID, results[1].Credential.ID)
+	})
+
+	t.Run("ok - empty batch", func(t *testing.T) {
+		sut := issuer{}
+
+		results := sut.IssueBatch(nil, types.JSONLDCredentialFormat, true, false)
+
+		assert.Empty(t, results)
+	})
+
+	t.Run("failed credentials don't affect others", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		keyResolverMock := NewMockkeyResolver(ctrl)
+		keyResolverMock.EXPECT().ResolveAssertionKey(gomock.Any()).Return(crypto.NewTestKey(kid), nil).Times(3)
+		mockStore := NewMockStore(ctrl)
+		gomock.InOrder(
+			mockStore.EXPECT().StoreCredential(gomock.Any()).Return(errors.New("b00m!")),
+			mockStore.EXPECT().StoreCredential(gomock.Any()).Times(2),
+		)
+		mockPublisher := NewMockPublisher(ctrl)
+		mockPublisher.EXPECT().PublishCredentials(gomock.Len(2), true).Return([]error{nil, errors.New("b00m!")})
+		sut := issuer{keyResolver: keyResolverMock, store: mockStore, publisher: mockPublisher, contextLoader: contextLoader}
+		invalidType := credentialOptions(issuerID)
+		invalidType.Type = append(invalidType.Type, *credentialType)
+
+		results := sut.IssueBatch([]vc.VerifiableCredential{
+			credentialOptions(issuerID),
+			credentialOptions(otherIssuerID),
+			invalidType,
+			credentialOptions(issuerID),
+			credentialOptions(issuerID),
+		}, types.JSONLDCredentialFormat, true, true)
+
+		if !assert.Len(t, results, 5) {
+			return
+		}
+		assert.EqualError(t, results[0].Err, "unable to store the issued credential: b00m!")
+		assert.EqualError(t, results[1].Err, "credential issuer differs from batch issuer (issuer=did:nuts:123)")
+		assert.EqualError(t, results[2].Err, "can only issue credential with 1 type")
+		assert.NoError(t, results[3].Err)
+		assert.NotNil(t, results[3].Credential)
+		assert.EqualError(t, results[4].Err, "unable to publish the issued credential: b00m!")
+		for _, idx := range []int{0, 1, 2, 4} {
+			assert.Nil(t, results[idx].Credential)
+		}
+	})
+
+	t.Run("not published", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		keyResolverMock := NewMockkeyResolver(ctrl)
+		keyResolverMock.EXPECT().ResolveAssertionKey(gomock.Any()).Return(crypto.NewTestKey(kid), nil)
+		mockStore := NewMockStore(ctrl)
+		mockStore.EXPECT().StoreCredential(gomock.Any())
+		sut := issuer{keyResolver: keyResolverMock, store: mockStore, contextLoader: contextLoader}
+
+		results := sut.IssueBatch([]vc.VerifiableCredential{credentialOptions(issuerID)}, types.JSONLDCredentialFormat, false, false)
+
+		if !assert.Len(t, results, 1) {
+			return
+		}
+		assert.NoError(t, results[0].Err)
+	})
+}
+
+type failingValidator struct{}
+
+func (f failingValidator) Validate(_ vc.VerifiableCredential) error {
+	return credential.ErrValidation
+}
+
+func (f failingValidator) SchemaID(_ string) (string, bool) {
+	return "https://example.com/schema.json", true
+}
+
 func TestNewIssuer(t *testing.T) {
-	createdIssuer := NewIssuer(nil, nil, nil, nil, nil)
+	createdIssuer := NewIssuer(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	assert.IsType(t, &issuer{}, createdIssuer)
 }
 
@@ -242,8 +602,9 @@ func Test_issuer_buildRevocation(t *testing.T) {
 			Issuer: issuerDID.URI(),
 			ID:     credentialID,
 		}
-		revocation, err := sut.buildRevocation(credentialToRevoke)
+		revocation, err := sut.buildRevocation(credentialToRevoke, "no longer a customer")
 		assert.NoError(t, err)
+		assert.Equal(t, "no longer a customer", revocation.Reason)
 		t.Logf("revocation %+v", revocation)
 	})
 
@@ -319,7 +680,7 @@ func Test_issuer_Revoke(t *testing.T) {
 				publisher:     publisher,
 			}
 
-			revocation, err := sut.Revoke(credentialURI)
+			revocation, err := sut.Revoke(credentialURI, "")
 			assert.NoError(t, err)
 			assert.NotNil(t, revocation)
 			assert.Equal(t, issuerURI, revocation.Issuer)
@@ -348,7 +709,7 @@ func Test_issuer_Revoke(t *testing.T) {
 			sut := issuer{
 				store: store,
 			}
-			revocation, err := sut.Revoke(credentialURI)
+			revocation, err := sut.Revoke(credentialURI, "")
 			assert.EqualError(t, err, "failed to extract issuer: invalid DID: input length is less than 7")
 			assert.Nil(t, revocation)
 		})
@@ -367,7 +728,7 @@ func Test_issuer_Revoke(t *testing.T) {
 				publisher:     publisher,
 			}
 
-			revocation, err := sut.Revoke(credentialURI)
+			revocation, err := sut.Revoke(credentialURI, "")
 			assert.EqualError(t, err, "failed to publish revocation: foo")
 			assert.Nil(t, revocation)
 		})
@@ -388,7 +749,7 @@ func Test_issuer_Revoke(t *testing.T) {
 				store: storeWithoutCredential(ctrl),
 			}
 
-			revocation, err := sut.Revoke(credentialURI)
+			revocation, err := sut.Revoke(credentialURI, "")
 			assert.EqualError(t, err, "could not revoke (id=did:nuts:123#abc): not found")
 			assert.Nil(t, revocation)
 		})
@@ -28,6 +28,9 @@ import (
 	"github.com/nuts-foundation/go-did/vc"
 	"github.com/nuts-foundation/go-leia/v2"
 	"github.com/nuts-foundation/nuts-node/vcr/concept"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+	"sort"
+	"time"
 )
 
 // leiaIssuerStore implements the issuer Store interface. It is a simple and fast JSON store.
@@ -86,6 +89,64 @@ func (s leiaIssuerStore) SearchCredential(jsonLDContext ssi.URI, credentialType
 	return result, nil
 }
 
+func (s leiaIssuerStore) SearchExpiringCredentials(before time.Time) ([]vc.VerifiableCredential, error) {
+	// The expiration date can't be compared in the query, since it may be formatted in different time zones.
+	// So select all credentials that have one and compare them after parsing.
+	query := leia.New(leia.Prefix("expirationDate", ""))
+
+	docs, err := s.issuedCredentials.Find(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]vc.VerifiableCredential, 0)
+	for _, doc := range docs {
+		var credential vc.VerifiableCredential
+		if err := json.Unmarshal(doc.Bytes(), &credential); err != nil {
+			return nil, err
+		}
+		if credential.ExpirationDate != nil && credential.ExpirationDate.Before(before) {
+			result = append(result, credential)
+		}
+	}
+	return result, nil
+}
+
+func (s leiaIssuerStore) FilterCredentials(filter CredentialFilter) ([]vc.VerifiableCredential, error) {
+	// every credential has an ID, so it selects all credentials if the filter doesn't narrow down the query
+	query := leia.New(leia.Prefix(concept.IDField, ""))
+	if filter.Issuer != "" {
+		query = leia.New(leia.Eq("issuer", filter.Issuer))
+	}
+	if filter.CredentialType != "" {
+		query = query.And(leia.Eq("type", filter.CredentialType))
+	}
+	if filter.Subject != "" {
+		query = query.And(leia.Eq("credentialSubject.id", filter.Subject))
+	}
+
+	docs, err := s.issuedCredentials.Find(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	// The issuance date is compared after parsing, since it may be formatted in different time zones.
+	result := make([]vc.VerifiableCredential, 0)
+	for _, doc := range docs {
+		var credential vc.VerifiableCredential
+		if err := json.Unmarshal(doc.Bytes(), &credential); err != nil {
+			return nil, err
+		}
+		if filter.matchesIssuanceDate(credential) {
+			result = append(result, credential)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].IssuanceDate.Before(result[j].IssuanceDate)
+	})
+	return result, nil
+}
+
 func (s leiaIssuerStore) GetCredential(id ssi.URI) (*vc.VerifiableCredential, error) {
 	query := leia.New(leia.Eq(concept.IDField, id.String()))
 
@@ -107,21 +168,35 @@ func (s leiaIssuerStore) GetCredential(id ssi.URI) (*vc.VerifiableCredential, er
 	return credential, nil
 }
 
+func (s leiaIssuerStore) Collection() storage.Collection {
+	return storage.Collection{
+		Name:       "issuedCredentials",
+		Collection: s.issuedCredentials,
+		Indices:    issuerIndices(),
+		IDField:    concept.IDField,
+	}
+}
+
 func (s leiaIssuerStore) Close() error {
 	return s.store.Close()
 }
 
+// issuerIndices returns the indices of the issued VC store.
+// They allow faster searching on context, type issuer and subject values.
+func issuerIndices() []storage.Index {
+	return []storage.Index{
+		storage.NewIndex("issuedVCs", "issuer", "type", "credentialSubject.id"),
+		// Index used for getting issued VCs by id
+		storage.NewIndex("issuedVCByID", "id"),
+	}
+}
+
 // createIndices creates the needed indices for the issued VC store
-// It allows faster searching on context, type issuer and subject values.
 func (s leiaIssuerStore) createIndices() error {
-	searchIndex := leia.NewIndex("issuedVCs",
-		leia.NewFieldIndexer("issuer"),
-		leia.NewFieldIndexer("type"),
-		leia.NewFieldIndexer("credentialSubject.id"),
-	)
-
-	// Index used for getting issued VCs by id
-	idIndex := leia.NewIndex("issuedVCByID",
-		leia.NewFieldIndexer("id"))
-	return s.issuedCredentials.AddIndex(searchIndex, idIndex)
+	for _, index := range issuerIndices() {
+		if err := s.issuedCredentials.AddIndex(index.Index); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"path"
 	"testing"
+	"time"
 )
 
 func TestNewLeiaStore(t *testing.T) {
@@ -57,6 +58,121 @@ func TestLeiaStore_Close(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_leiaStore_SearchExpiringCredentials(t *testing.T) {
+	newCredential := func(id string, expirationDate *time.Time) vc.VerifiableCredential {
+		credentialID := ssi.MustParseURI(id)
+		return vc.VerifiableCredential{ID: &credentialID, ExpirationDate: expirationDate}
+	}
+	now := time.Now()
+	amsterdam, _ := time.LoadLocation("Europe/Amsterdam")
+	expired := now.Add(-time.Hour)
+	expiresSoon := now.Add(time.Hour).In(amsterdam)
+	expiresLater := now.Add(48 * time.Hour)
+
+	testDir := io.TestDirectory(t)
+	sut, err := NewLeiaIssuerStore(path.Join(testDir, "vcr", "issued-credentials.db"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, curr := range []vc.VerifiableCredential{
+		newCredential("did:nuts:123#1", &expired),
+		newCredential("did:nuts:123#2", &expiresSoon),
+		newCredential("did:nuts:123#3", &expiresLater),
+		newCredential("did:nuts:123#4", nil),
+	} {
+		if !assert.NoError(t, sut.StoreCredential(curr)) {
+			return
+		}
+	}
+
+	t.Run("expired and expiring soon", func(t *testing.T) {
+		result, err := sut.SearchExpiringCredentials(now.Add(24 * time.Hour))
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		var ids []string
+		for _, curr := range result {
+			ids = append(ids, curr.ID.String())
+		}
+		assert.ElementsMatch(t, []string{"did:nuts:123#1", "did:nuts:123#2"}, ids)
+	})
+	t.Run("none", func(t *testing.T) {
+		result, err := sut.SearchExpiringCredentials(now.Add(-24 * time.Hour))
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func Test_leiaStore_FilterCredentials(t *testing.T) {
+	newCredential := func(id string, credentialType string, subject string, issuanceDate time.Time) vc.VerifiableCredential {
+		credentialID := ssi.MustParseURI(id)
+		return vc.VerifiableCredential{
+			ID:                &credentialID,
+			Issuer:            ssi.MustParseURI("did:nuts:123"),
+			Type:              []ssi.URI{vc.VerifiableCredentialTypeV1URI(), ssi.MustParseURI(credentialType)},
+			IssuanceDate:      issuanceDate,
+			CredentialSubject: []interface{}{map[string]interface{}{"id": subject}},
+		}
+	}
+	now := time.Now().Truncate(time.Second)
+	amsterdam, _ := time.LoadLocation("Europe/Amsterdam")
+
+	testDir := io.TestDirectory(t)
+	sut, err := NewLeiaIssuerStore(path.Join(testDir, "vcr", "issued-credentials.db"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, curr := range []vc.VerifiableCredential{
+		newCredential("did:nuts:123#3", "NutsOrganizationCredential", "did:nuts:456", now.Add(-time.Hour).In(amsterdam)),
+		newCredential("did:nuts:123#1", "NutsOrganizationCredential", "did:nuts:456", now.Add(-48*time.Hour)),
+		newCredential("did:nuts:123#2", "NutsAuthorizationCredential", "did:nuts:456", now.Add(-24*time.Hour)),
+		newCredential("did:nuts:123#4", "NutsOrganizationCredential", "did:nuts:789", now),
+	} {
+		if !assert.NoError(t, sut.StoreCredential(curr)) {
+			return
+		}
+	}
+	ids := func(credentials []vc.VerifiableCredential) []string {
+		result := make([]string, 0)
+		for _, curr := range credentials {
+			result = append(result, curr.ID.String())
+		}
+		return result
+	}
+
+	t.Run("all, ordered by issuance date", func(t *testing.T) {
+		result, err := sut.FilterCredentials(CredentialFilter{})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{"did:nuts:123#1", "did:nuts:123#2", "did:nuts:123#3", "did:nuts:123#4"}, ids(result))
+	})
+	t.Run("by issuer, subject and type", func(t *testing.T) {
+		result, err := sut.FilterCredentials(CredentialFilter{Issuer: "did:nuts:123", Subject: "did:nuts:456", CredentialType: "NutsOrganizationCredential"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"did:nuts:123#1", "did:nuts:123#3"}, ids(result))
+	})
+	t.Run("by issuance date", func(t *testing.T) {
+		from := now.Add(-24 * time.Hour)
+		until := now
+
+		result, err := sut.FilterCredentials(CredentialFilter{IssuedFrom: &from, IssuedUntil: &until})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"did:nuts:123#2", "did:nuts:123#3"}, ids(result))
+	})
+	t.Run("none", func(t *testing.T) {
+		result, err := sut.FilterCredentials(CredentialFilter{Subject: "did:nuts:000"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
 func Test_leiaStore_StoreAndSearchCredential(t *testing.T) {
 	vcToStore := vc.VerifiableCredential{}
 	_ = json.Unmarshal([]byte(concept.TestCredential), &vcToStore)
@@ -6,6 +6,7 @@ package issuer
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	ssi "github.com/nuts-foundation/go-did"
@@ -13,6 +14,8 @@ import (
 	vc "github.com/nuts-foundation/go-did/vc"
 	crypto "github.com/nuts-foundation/nuts-node/crypto"
 	credential "github.com/nuts-foundation/nuts-node/vcr/credential"
+	storage "github.com/nuts-foundation/nuts-node/vcr/storage"
+	types "github.com/nuts-foundation/nuts-node/vcr/types"
 )
 
 // MockPublisher is a mock of Publisher interface.
@@ -52,6 +55,20 @@ func (mr *MockPublisherMockRecorder) PublishCredential(verifiableCredential, pub
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishCredential", reflect.TypeOf((*MockPublisher)(nil).PublishCredential), verifiableCredential, public)
 }
 
+// PublishCredentials mocks base method.
+func (m *MockPublisher) PublishCredentials(verifiableCredentials []vc.VerifiableCredential, public bool) []error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishCredentials", verifiableCredentials, public)
+	ret0, _ := ret[0].([]error)
+	return ret0
+}
+
+// PublishCredentials indicates an expected call of PublishCredentials.
+func (mr *MockPublisherMockRecorder) PublishCredentials(verifiableCredentials, public interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishCredentials", reflect.TypeOf((*MockPublisher)(nil).PublishCredentials), verifiableCredentials, public)
+}
+
 // PublishRevocation mocks base method.
 func (m *MockPublisher) PublishRevocation(revocation credential.Revocation) error {
 	m.ctrl.T.Helper()
@@ -127,34 +144,63 @@ func (m *MockIssuer) EXPECT() *MockIssuerMockRecorder {
 	return m.recorder
 }
 
+// FilterCredentials mocks base method.
+func (m *MockIssuer) FilterCredentials(filter CredentialFilter) ([]vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterCredentials", filter)
+	ret0, _ := ret[0].([]vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterCredentials indicates an expected call of FilterCredentials.
+func (mr *MockIssuerMockRecorder) FilterCredentials(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterCredentials", reflect.TypeOf((*MockIssuer)(nil).FilterCredentials), filter)
+}
+
 // Issue mocks base method.
-func (m *MockIssuer) Issue(unsignedCredential vc.VerifiableCredential, publish, public bool) (*vc.VerifiableCredential, error) {
+func (m *MockIssuer) Issue(unsignedCredential vc.VerifiableCredential, format types.Format, publish, public bool) (*vc.VerifiableCredential, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Issue", unsignedCredential, publish, public)
+	ret := m.ctrl.Call(m, "Issue", unsignedCredential, format, publish, public)
 	ret0, _ := ret[0].(*vc.VerifiableCredential)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Issue indicates an expected call of Issue.
-func (mr *MockIssuerMockRecorder) Issue(unsignedCredential, publish, public interface{}) *gomock.Call {
+func (mr *MockIssuerMockRecorder) Issue(unsignedCredential, format, publish, public interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockIssuer)(nil).Issue), unsignedCredential, format, publish, public)
+}
+
+// IssueBatch mocks base method.
+func (m *MockIssuer) IssueBatch(unsignedCredentials []vc.VerifiableCredential, format types.Format, publish, public bool) []BatchResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueBatch", unsignedCredentials, format, publish, public)
+	ret0, _ := ret[0].([]BatchResult)
+	return ret0
+}
+
+// IssueBatch indicates an expected call of IssueBatch.
+func (mr *MockIssuerMockRecorder) IssueBatch(unsignedCredentials, format, publish, public interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockIssuer)(nil).Issue), unsignedCredential, publish, public)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueBatch", reflect.TypeOf((*MockIssuer)(nil).IssueBatch), unsignedCredentials, format, publish, public)
 }
 
 // Revoke mocks base method.
-func (m *MockIssuer) Revoke(credentialID ssi.URI) (*credential.Revocation, error) {
+func (m *MockIssuer) Revoke(credentialID ssi.URI, reason string) (*credential.Revocation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Revoke", credentialID)
+	ret := m.ctrl.Call(m, "Revoke", credentialID, reason)
 	ret0, _ := ret[0].(*credential.Revocation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Revoke indicates an expected call of Revoke.
-func (mr *MockIssuerMockRecorder) Revoke(credentialID interface{}) *gomock.Call {
+func (mr *MockIssuerMockRecorder) Revoke(credentialID, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIssuer)(nil).Revoke), credentialID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIssuer)(nil).Revoke), credentialID, reason)
 }
 
 // SearchCredential mocks base method.
@@ -172,6 +218,21 @@ func (mr *MockIssuerMockRecorder) SearchCredential(context, credentialType, issu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCredential", reflect.TypeOf((*MockIssuer)(nil).SearchCredential), context, credentialType, issuer, subject)
 }
 
+// SearchExpiringCredentials mocks base method.
+func (m *MockIssuer) SearchExpiringCredentials(before time.Time) ([]vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchExpiringCredentials", before)
+	ret0, _ := ret[0].([]vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchExpiringCredentials indicates an expected call of SearchExpiringCredentials.
+func (mr *MockIssuerMockRecorder) SearchExpiringCredentials(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExpiringCredentials", reflect.TypeOf((*MockIssuer)(nil).SearchExpiringCredentials), before)
+}
+
 // MockStore is a mock of Store interface.
 type MockStore struct {
 	ctrl     *gomock.Controller
@@ -209,6 +270,35 @@ func (mr *MockStoreMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStore)(nil).Close))
 }
 
+// Collection mocks base method.
+func (m *MockStore) Collection() storage.Collection {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collection")
+	ret0, _ := ret[0].(storage.Collection)
+	return ret0
+}
+
+// Collection indicates an expected call of Collection.
+func (mr *MockStoreMockRecorder) Collection() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collection", reflect.TypeOf((*MockStore)(nil).Collection))
+}
+
+// FilterCredentials mocks base method.
+func (m *MockStore) FilterCredentials(filter CredentialFilter) ([]vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterCredentials", filter)
+	ret0, _ := ret[0].([]vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterCredentials indicates an expected call of FilterCredentials.
+func (mr *MockStoreMockRecorder) FilterCredentials(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterCredentials", reflect.TypeOf((*MockStore)(nil).FilterCredentials), filter)
+}
+
 // GetCredential mocks base method.
 func (m *MockStore) GetCredential(id ssi.URI) (*vc.VerifiableCredential, error) {
 	m.ctrl.T.Helper()
@@ -239,6 +329,21 @@ func (mr *MockStoreMockRecorder) SearchCredential(context, credentialType, issue
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCredential", reflect.TypeOf((*MockStore)(nil).SearchCredential), context, credentialType, issuer, subject)
 }
 
+// SearchExpiringCredentials mocks base method.
+func (m *MockStore) SearchExpiringCredentials(before time.Time) ([]vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchExpiringCredentials", before)
+	ret0, _ := ret[0].([]vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchExpiringCredentials indicates an expected call of SearchExpiringCredentials.
+func (mr *MockStoreMockRecorder) SearchExpiringCredentials(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExpiringCredentials", reflect.TypeOf((*MockStore)(nil).SearchExpiringCredentials), before)
+}
+
 // StoreCredential mocks base method.
 func (m *MockStore) StoreCredential(vc vc.VerifiableCredential) error {
 	m.ctrl.T.Helper()
@@ -276,6 +381,21 @@ func (m *MockCredentialSearcher) EXPECT() *MockCredentialSearcherMockRecorder {
 	return m.recorder
 }
 
+// FilterCredentials mocks base method.
+func (m *MockCredentialSearcher) FilterCredentials(filter CredentialFilter) ([]vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterCredentials", filter)
+	ret0, _ := ret[0].([]vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterCredentials indicates an expected call of FilterCredentials.
+func (mr *MockCredentialSearcherMockRecorder) FilterCredentials(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterCredentials", reflect.TypeOf((*MockCredentialSearcher)(nil).FilterCredentials), filter)
+}
+
 // SearchCredential mocks base method.
 func (m *MockCredentialSearcher) SearchCredential(context, credentialType ssi.URI, issuer did.DID, subject *ssi.URI) ([]vc.VerifiableCredential, error) {
 	m.ctrl.T.Helper()
@@ -290,3 +410,18 @@ func (mr *MockCredentialSearcherMockRecorder) SearchCredential(context, credenti
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCredential", reflect.TypeOf((*MockCredentialSearcher)(nil).SearchCredential), context, credentialType, issuer, subject)
 }
+
+// SearchExpiringCredentials mocks base method.
+func (m *MockCredentialSearcher) SearchExpiringCredentials(before time.Time) ([]vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchExpiringCredentials", before)
+	ret0, _ := ret[0].([]vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchExpiringCredentials indicates an expected call of SearchExpiringCredentials.
+func (mr *MockCredentialSearcherMockRecorder) SearchExpiringCredentials(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExpiringCredentials", reflect.TypeOf((*MockCredentialSearcher)(nil).SearchExpiringCredentials), before)
+}
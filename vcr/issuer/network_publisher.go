@@ -32,6 +32,7 @@ import (
 	"github.com/nuts-foundation/nuts-node/vcr/types"
 	"github.com/nuts-foundation/nuts-node/vdr/doc"
 	vdr "github.com/nuts-foundation/nuts-node/vdr/types"
+	"time"
 )
 
 type networkPublisher struct {
@@ -39,17 +40,22 @@ type networkPublisher struct {
 	didDocResolver  vdr.DocResolver
 	serviceResolver doc.ServiceResolver
 	keyResolver     keyResolver
+	// batchTransactions specifies whether credentials published together are combined in batch transactions
+	batchTransactions bool
 }
 
 // VcDocumentType holds the content type used in network documents which contain Verifiable Credentials
-const VcDocumentType = "application/vc+json"
+// Deprecated: use types.VcDocumentType
+const VcDocumentType = types.VcDocumentType
 
 // RevocationDocumentType holds the content type used in network documents which contain a credential revocation
 const RevocationDocumentType = "application/vc+json;type=revocation"
 
 // NewNetworkPublisher creates a new networkPublisher which implements the Publisher interface.
 // It is the default implementation to use for issuers to publish credentials and revocations to the Nuts network.
-func NewNetworkPublisher(networkTx network.Transactions, docResolver vdr.DocResolver, keyResolver crypto.KeyResolver) Publisher {
+// If batchTransactions is true, credentials published together are combined in transactions of the batch payload type,
+// which nodes that don't support it ignore. Otherwise, every credential gets its own transaction.
+func NewNetworkPublisher(networkTx network.Transactions, docResolver vdr.DocResolver, keyResolver crypto.KeyResolver, batchTransactions bool) Publisher {
 	return &networkPublisher{
 		networkTx:       networkTx,
 		didDocResolver:  docResolver,
@@ -58,6 +64,7 @@ func NewNetworkPublisher(networkTx network.Transactions, docResolver vdr.DocReso
 			docResolver: docResolver,
 			keyResolver: keyResolver,
 		},
+		batchTransactions: batchTransactions,
 	}
 
 }
@@ -92,7 +99,7 @@ func (p networkPublisher) PublishCredential(verifiableCredential vc.VerifiableCr
 	}
 
 	payload, _ := json.Marshal(verifiableCredential)
-	tx := network.TransactionTemplate(VcDocumentType, payload, key).
+	tx := network.TransactionTemplate(types.VcDocumentType, payload, key).
 		WithTimestamp(verifiableCredential.IssuanceDate).
 		WithAdditionalPrevs(meta.SourceTransactions).
 		WithPrivate(participants)
@@ -106,6 +113,123 @@ func (p networkPublisher) PublishCredential(verifiableCredential vc.VerifiableCr
 	return nil
 }
 
+// maxCredentialsPerTransaction is the maximum number of credentials published in a single transaction,
+// to keep the transaction payload well within the maximum network message size.
+const maxCredentialsPerTransaction = 100
+
+func (p networkPublisher) PublishCredentials(verifiableCredentials []vc.VerifiableCredential, public bool) []error {
+	errs := make([]error, len(verifiableCredentials))
+	failed := false
+	fail := func(indices []int, err error) {
+		for _, idx := range indices {
+			errs[idx] = err
+		}
+		failed = true
+	}
+
+	// group the credentials by their participants, so every group can be published in the same transaction(s)
+	var groupKeys []string
+	groups := map[string][]int{}
+	groupParticipants := map[string][]did.DID{}
+	for idx, verifiableCredential := range verifiableCredentials {
+		if _, err := did.ParseDIDURL(verifiableCredential.Issuer.String()); err != nil {
+			fail([]int{idx}, fmt.Errorf("invalid credential issuer: %w", err))
+			continue
+		}
+		if len(verifiableCredential.CredentialSubject) == 0 {
+			fail([]int{idx}, fmt.Errorf("missing credentialSubject"))
+			continue
+		}
+		participants := []did.DID{}
+		if !public {
+			var err error
+			if participants, err = p.generateParticipants(verifiableCredential); err != nil {
+				fail([]int{idx}, err)
+				continue
+			}
+		}
+		var key string
+		for _, participant := range participants {
+			key += participant.String() + " "
+		}
+		if _, exists := groups[key]; !exists {
+			groupKeys = append(groupKeys, key)
+			groupParticipants[key] = participants
+		}
+		groups[key] = append(groups[key], idx)
+	}
+	if len(groupKeys) == 0 {
+		return errs
+	}
+
+	// all credentials have the same issuer
+	issuerDID, _ := did.ParseDIDURL(verifiableCredentials[groups[groupKeys[0]][0]].Issuer.String())
+	key, err := p.keyResolver.ResolveAssertionKey(*issuerDID)
+	if err != nil {
+		fail(allIndices(groups), fmt.Errorf("could not resolve an assertion key for issuer: %w", err))
+		return errs
+	}
+	// find did document/metadata for originating TXs
+	_, meta, err := p.didDocResolver.Resolve(*issuerDID, nil)
+	if err != nil {
+		fail(allIndices(groups), err)
+		return errs
+	}
+
+	perTransaction := 1
+	if p.batchTransactions {
+		perTransaction = maxCredentialsPerTransaction
+	}
+	for _, groupKey := range groupKeys {
+		indices := groups[groupKey]
+		for start := 0; start < len(indices); start += perTransaction {
+			end := start + perTransaction
+			if end > len(indices) {
+				end = len(indices)
+			}
+			chunk := indices[start:end]
+			batch := make([]vc.VerifiableCredential, 0, len(chunk))
+			var timestamp time.Time
+			for _, idx := range chunk {
+				batch = append(batch, verifiableCredentials[idx])
+				// the credentials must be valid at the signing time of the transaction
+				if verifiableCredentials[idx].IssuanceDate.After(timestamp) {
+					timestamp = verifiableCredentials[idx].IssuanceDate
+				}
+			}
+			// a single credential is published as a regular credential transaction
+			payloadType := types.VcBatchDocumentType
+			payload, _ := json.Marshal(batch)
+			if len(batch) == 1 {
+				payloadType = types.VcDocumentType
+				payload, _ = json.Marshal(batch[0])
+			}
+			tx := network.TransactionTemplate(payloadType, payload, key).
+				WithTimestamp(timestamp).
+				WithAdditionalPrevs(meta.SourceTransactions).
+				WithPrivate(groupParticipants[groupKey])
+			if _, err = p.networkTx.CreateTransaction(tx); err != nil {
+				fail(chunk, fmt.Errorf("failed to publish credential, error while creating transaction: %w", err))
+				continue
+			}
+			log.Logger().Infof("Verifiable Credentials published (count=%d,issuer=%s)", len(batch), issuerDID)
+		}
+	}
+
+	if !failed {
+		return nil
+	}
+	return errs
+}
+
+func allIndices(groups map[string][]int) []int {
+	var result []int
+	for _, indices := range groups {
+		result = append(result, indices...)
+	}
+	return result
+}
+
 func (p networkPublisher) generateParticipants(verifiableCredential vc.VerifiableCredential) ([]did.DID, error) {
 	issuer, _ := did.ParseDID(verifiableCredential.Issuer.String())
 	participants := make([]did.DID, 0)
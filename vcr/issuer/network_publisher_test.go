@@ -108,7 +108,7 @@ func Test_networkPublisher_PublishCredential(t *testing.T) {
 		expectedTemplate := network.Template{
 			Key:             testKey,
 			Payload:         payload,
-			Type:            VcDocumentType,
+			Type:            types.VcDocumentType,
 			AttachKey:       false,
 			Timestamp:       time.Time{},
 			AdditionalPrevs: nil,
@@ -154,7 +154,7 @@ func Test_networkPublisher_PublishCredential(t *testing.T) {
 		expectedTemplate := network.Template{
 			Key:             testKey,
 			Payload:         payload,
-			Type:            VcDocumentType,
+			Type:            types.VcDocumentType,
 			AttachKey:       false,
 			Timestamp:       time.Time{},
 			AdditionalPrevs: nil,
@@ -265,7 +265,7 @@ func Test_networkPublisher_PublishCredential(t *testing.T) {
 			expectedTemplate := network.Template{
 				Key:             testKey,
 				Payload:         payload,
-				Type:            VcDocumentType,
+				Type:            types.VcDocumentType,
 				AttachKey:       false,
 				Timestamp:       time.Time{},
 				AdditionalPrevs: nil,
@@ -281,8 +281,200 @@ func Test_networkPublisher_PublishCredential(t *testing.T) {
 
 }
 
+func Test_networkPublisher_PublishCredentials(t *testing.T) {
+	issuerID, _ := ssi.ParseURI("did:nuts:123")
+	issuerDID, _ := did.ParseDID(issuerID.String())
+	subjectAID, _ := ssi.ParseURI("did:nuts:456")
+	subjectADID, _ := did.ParseDID(subjectAID.String())
+	subjectBID, _ := ssi.ParseURI("did:nuts:789")
+	subjectBDID, _ := did.ParseDID(subjectBID.String())
+	testKey := crypto.NewTestKey(issuerID.String() + "#abc")
+	issuanceDate := time.Now().Truncate(time.Second)
+	credentialFor := func(subjectID *ssi.URI, issuanceDate time.Time) vc.VerifiableCredential {
+		return vc.VerifiableCredential{
+			Issuer:            *issuerID,
+			IssuanceDate:      issuanceDate,
+			CredentialSubject: []interface{}{credential.BaseCredentialSubject{ID: subjectID.String()}},
+		}
+	}
+
+	t.Run("ok - public credentials in a single transaction", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockKeyResolver := NewMockkeyResolver(ctrl)
+		mockDocResolver := vdrTypes.NewMockDocResolver(ctrl)
+		mockNetwork := network.NewMockTransactions(ctrl)
+		sut := networkPublisher{keyResolver: mockKeyResolver, didDocResolver: mockDocResolver, networkTx: mockNetwork, batchTransactions: true}
+		credentials := []vc.VerifiableCredential{
+			credentialFor(subjectAID, issuanceDate),
+			credentialFor(subjectBID, issuanceDate.Add(time.Second)),
+		}
+		payload, _ := json.Marshal(credentials)
+		mockKeyResolver.EXPECT().ResolveAssertionKey(*issuerDID).Return(testKey, nil)
+		mockDocResolver.EXPECT().Resolve(*issuerDID, nil).Return(&did.Document{}, &vdrTypes.DocumentMetadata{}, nil)
+		mockNetwork.EXPECT().CreateTransaction(network.Template{
+			Key:          testKey,
+			Payload:      payload,
+			Type:         types.VcBatchDocumentType,
+			Timestamp:    issuanceDate.Add(time.Second),
+			Participants: []did.DID{},
+		}).Return(nil, nil)
+
+		errs := sut.PublishCredentials(credentials, true)
+
+		assert.Nil(t, errs)
+	})
+
+	t.Run("ok - a transaction per credential if batch transactions are disabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockKeyResolver := NewMockkeyResolver(ctrl)
+		mockDocResolver := vdrTypes.NewMockDocResolver(ctrl)
+		mockNetwork := network.NewMockTransactions(ctrl)
+		sut := networkPublisher{keyResolver: mockKeyResolver, didDocResolver: mockDocResolver, networkTx: mockNetwork}
+		credentials := []vc.VerifiableCredential{
+			credentialFor(subjectAID, issuanceDate),
+			credentialFor(subjectBID, issuanceDate.Add(time.Second)),
+		}
+		mockKeyResolver.EXPECT().ResolveAssertionKey(*issuerDID).Return(testKey, nil)
+		mockDocResolver.EXPECT().Resolve(*issuerDID, nil).Return(&did.Document{}, &vdrTypes.DocumentMetadata{}, nil)
+		for _, current := range credentials {
+			payload, _ := json.Marshal(current)
+			mockNetwork.EXPECT().CreateTransaction(network.Template{
+				Key:          testKey,
+				Payload:      payload,
+				Type:         types.VcDocumentType,
+				Timestamp:    current.IssuanceDate,
+				Participants: []did.DID{},
+			}).Return(nil, nil)
+		}
+
+		errs := sut.PublishCredentials(credentials, true)
+
+		assert.Nil(t, errs)
+	})
+
+	t.Run("ok - private credentials grouped by participants", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockKeyResolver := NewMockkeyResolver(ctrl)
+		mockDocResolver := vdrTypes.NewMockDocResolver(ctrl)
+		mockNetwork := network.NewMockTransactions(ctrl)
+		mockServiceResolver := doc.NewMockServiceResolver(ctrl)
+		sut := networkPublisher{keyResolver: mockKeyResolver, didDocResolver: mockDocResolver, networkTx: mockNetwork, serviceResolver: mockServiceResolver, batchTransactions: true}
+		credentials := []vc.VerifiableCredential{
+			credentialFor(subjectAID, issuanceDate),
+			credentialFor(subjectBID, issuanceDate),
+			credentialFor(subjectAID, issuanceDate),
+		}
+		mockKeyResolver.EXPECT().ResolveAssertionKey(*issuerDID).Return(testKey, nil)
+		mockDocResolver.EXPECT().Resolve(*issuerDID, nil).Return(&did.Document{}, &vdrTypes.DocumentMetadata{}, nil)
+		for _, id := range []*ssi.URI{issuerID, subjectAID, subjectBID} {
+			serviceURI, _ := ssi.ParseURI(id.String() + "/serviceEndpoint?type=NutsComm")
+			mockServiceResolver.EXPECT().Resolve(*serviceURI, 5).Return(did.Service{ID: *id}, nil).AnyTimes()
+		}
+		payloadA, _ := json.Marshal([]vc.VerifiableCredential{credentials[0], credentials[2]})
+		mockNetwork.EXPECT().CreateTransaction(network.Template{
+			Key:          testKey,
+			Payload:      payloadA,
+			Type:         types.VcBatchDocumentType,
+			Timestamp:    issuanceDate,
+			Participants: []did.DID{*issuerDID, *subjectADID},
+		}).Return(nil, nil)
+		// a single credential is published as regular credential transaction
+		payloadB, _ := json.Marshal(credentials[1])
+		mockNetwork.EXPECT().CreateTransaction(network.Template{
+			Key:          testKey,
+			Payload:      payloadB,
+			Type:         types.VcDocumentType,
+			Timestamp:    issuanceDate,
+			Participants: []did.DID{*issuerDID, *subjectBDID},
+		}).Return(nil, nil)
+
+		errs := sut.PublishCredentials(credentials, false)
+
+		assert.Nil(t, errs)
+	})
+
+	t.Run("ok - split in multiple transactions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockKeyResolver := NewMockkeyResolver(ctrl)
+		mockDocResolver := vdrTypes.NewMockDocResolver(ctrl)
+		mockNetwork := network.NewMockTransactions(ctrl)
+		sut := networkPublisher{keyResolver: mockKeyResolver, didDocResolver: mockDocResolver, networkTx: mockNetwork, batchTransactions: true}
+		credentials := make([]vc.VerifiableCredential, maxCredentialsPerTransaction+2)
+		for i := range credentials {
+			credentials[i] = credentialFor(subjectAID, issuanceDate)
+		}
+		mockKeyResolver.EXPECT().ResolveAssertionKey(*issuerDID).Return(testKey, nil)
+		mockDocResolver.EXPECT().Resolve(*issuerDID, nil).Return(&did.Document{}, &vdrTypes.DocumentMetadata{}, nil)
+		mockNetwork.EXPECT().CreateTransaction(gomock.Any()).Return(nil, nil).Times(2)
+
+		errs := sut.PublishCredentials(credentials, true)
+
+		assert.Nil(t, errs)
+	})
+
+	t.Run("error - failed credential doesn't affect others", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockKeyResolver := NewMockkeyResolver(ctrl)
+		mockDocResolver := vdrTypes.NewMockDocResolver(ctrl)
+		mockNetwork := network.NewMockTransactions(ctrl)
+		sut := networkPublisher{keyResolver: mockKeyResolver, didDocResolver: mockDocResolver, networkTx: mockNetwork, batchTransactions: true}
+		invalidIssuerID, _ := ssi.ParseURI("abc")
+		credentials := []vc.VerifiableCredential{
+			{Issuer: *issuerID},
+			{Issuer: *invalidIssuerID},
+			credentialFor(subjectAID, issuanceDate),
+		}
+		mockKeyResolver.EXPECT().ResolveAssertionKey(*issuerDID).Return(testKey, nil)
+		mockDocResolver.EXPECT().Resolve(*issuerDID, nil).Return(&did.Document{}, &vdrTypes.DocumentMetadata{}, nil)
+		mockNetwork.EXPECT().CreateTransaction(gomock.Any()).Return(nil, nil)
+
+		errs := sut.PublishCredentials(credentials, true)
+
+		if !assert.Len(t, errs, 3) {
+			return
+		}
+		assert.EqualError(t, errs[0], "missing credentialSubject")
+		assert.EqualError(t, errs[1], "invalid credential issuer: invalid DID: input length is less than 7")
+		assert.NoError(t, errs[2])
+	})
+
+	t.Run("error - transaction failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockKeyResolver := NewMockkeyResolver(ctrl)
+		mockDocResolver := vdrTypes.NewMockDocResolver(ctrl)
+		mockNetwork := network.NewMockTransactions(ctrl)
+		sut := networkPublisher{keyResolver: mockKeyResolver, didDocResolver: mockDocResolver, networkTx: mockNetwork, batchTransactions: true}
+		credentials := []vc.VerifiableCredential{credentialFor(subjectAID, issuanceDate), credentialFor(subjectBID, issuanceDate)}
+		mockKeyResolver.EXPECT().ResolveAssertionKey(*issuerDID).Return(testKey, nil)
+		mockDocResolver.EXPECT().Resolve(*issuerDID, nil).Return(&did.Document{}, &vdrTypes.DocumentMetadata{}, nil)
+		mockNetwork.EXPECT().CreateTransaction(gomock.Any()).Return(nil, errors.New("b00m!"))
+
+		errs := sut.PublishCredentials(credentials, true)
+
+		if !assert.Len(t, errs, 2) {
+			return
+		}
+		assert.EqualError(t, errs[0], "failed to publish credential, error while creating transaction: b00m!")
+		assert.EqualError(t, errs[1], "failed to publish credential, error while creating transaction: b00m!")
+	})
+
+	t.Run("error - no assertion key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockKeyResolver := NewMockkeyResolver(ctrl)
+		sut := networkPublisher{keyResolver: mockKeyResolver}
+		mockKeyResolver.EXPECT().ResolveAssertionKey(*issuerDID).Return(nil, errors.New("b00m!"))
+
+		errs := sut.PublishCredentials([]vc.VerifiableCredential{credentialFor(subjectAID, issuanceDate)}, true)
+
+		if !assert.Len(t, errs, 1) {
+			return
+		}
+		assert.EqualError(t, errs[0], "could not resolve an assertion key for issuer: b00m!")
+	})
+}
+
 func TestNewNetworkPublisher(t *testing.T) {
-	publisher := NewNetworkPublisher(nil, nil, nil)
+	publisher := NewNetworkPublisher(nil, nil, nil, false)
 	assert.IsType(t, &networkPublisher{}, publisher)
 }
 
@@ -326,7 +518,7 @@ func Test_networkPublisher_PublishRevocation(t *testing.T) {
 
 	t.Run("params", func(t *testing.T) {
 		t.Run("it checks the issuer", func(t *testing.T) {
-			publisher := NewNetworkPublisher(nil, nil, nil)
+			publisher := NewNetworkPublisher(nil, nil, nil, false)
 			revocationToPublish := credential.Revocation{}
 			err := publisher.PublishRevocation(revocationToPublish)
 			assert.EqualError(t, err, "invalid revocation issuer: invalid DID: input length is less than 7")
@@ -0,0 +1,230 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package issuer
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+)
+
+// Visibilities of credentials issued with a template.
+const (
+	// PublicVisibility publishes credentials to all nodes.
+	PublicVisibility = "public"
+	// PrivateVisibility publishes credentials to the node of the holder only.
+	PrivateVisibility = "private"
+)
+
+// templateNamePattern matches valid template names, which are used as filename.
+var templateNamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// Template defines how credentials of a type are issued: the contexts, the default validity and visibility,
+// and the fields the credential subject must contain.
+type Template struct {
+	// Name identifies the template. Credentials are issued with a template by its name.
+	Name string `json:"name" yaml:"name"`
+	// CredentialType is the type of the credentials issued with the template.
+	CredentialType string `json:"credentialType" yaml:"credentialType"`
+	// Context contains the JSON-LD contexts of the credentials, in addition to the W3C credentials context.
+	Context []string `json:"context,omitempty" yaml:"context,omitempty"`
+	// Validity is the period credentials are valid after their issuance, such as '8760h'.
+	// It determines the expiration date of credentials that don't specify one. If empty, credentials don't expire by default.
+	Validity string `json:"validity,omitempty" yaml:"validity,omitempty"`
+	// Visibility is the visibility of credentials that are published without specifying one: public or private.
+	Visibility string `json:"visibility,omitempty" yaml:"visibility,omitempty"`
+	// SubjectFields contains the fields the credential subject must contain.
+	SubjectFields []TemplateField `json:"subjectFields,omitempty" yaml:"subjectFields,omitempty"`
+}
+
+// TemplateField is a field the credential subject must contain.
+type TemplateField struct {
+	// Path is the path of the field in the credential subject, as object keys separated by dots (e.g. 'organization.city').
+	Path string `json:"path" yaml:"path"`
+	// Pattern is the regular expression the (whole) value of the field must match. If empty, any value is accepted.
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+}
+
+// Validate checks whether the template is complete and its validity, visibility and field patterns can be parsed.
+func (t Template) Validate() error {
+	if !templateNamePattern.MatchString(t.Name) {
+		return fmt.Errorf("name must be non-empty and consist of letters, digits, '.', '_' and '-' (name=%s)", t.Name)
+	}
+	if t.CredentialType == "" {
+		return errors.New("no credential type")
+	}
+	for _, context := range t.Context {
+		if _, err := ssi.ParseURI(context); err != nil {
+			return fmt.Errorf("invalid context (context=%s): %w", context, err)
+		}
+	}
+	if _, err := t.validity(); err != nil {
+		return err
+	}
+	if t.Visibility != "" && t.Visibility != PublicVisibility && t.Visibility != PrivateVisibility {
+		return fmt.Errorf("visibility must be %s or %s (visibility=%s)", PublicVisibility, PrivateVisibility, t.Visibility)
+	}
+	paths := map[string]bool{}
+	for _, field := range t.SubjectFields {
+		if field.Path == "" || paths[field.Path] {
+			return fmt.Errorf("subject field paths must be non-empty and unique (path=%s)", field.Path)
+		}
+		paths[field.Path] = true
+		if _, err := field.pattern(); err != nil {
+			return fmt.Errorf("invalid pattern of subject field (path=%s): %w", field.Path, err)
+		}
+	}
+	return nil
+}
+
+// Apply fills in the defaults of the template in the options of a credential to be issued at the given moment:
+// the credential type, the contexts and the expiration date. It returns an error if the options don't match the template.
+func (t Template) Apply(credentialOptions vc.VerifiableCredential, now time.Time) (*vc.VerifiableCredential, error) {
+	result := credentialOptions
+	credentialType := ssi.MustParseURI(t.CredentialType)
+	switch {
+	case len(result.Type) == 0:
+		result.Type = []ssi.URI{credentialType}
+	case len(result.Type) != 1 || result.Type[0].String() != credentialType.String():
+		return nil, fmt.Errorf("credential type doesn't match template (template=%s, credentialType=%s)", t.Name, t.CredentialType)
+	}
+	result.Context = append([]ssi.URI{}, result.Context...)
+	for _, context := range t.Context {
+		contextURI := ssi.MustParseURI(context)
+		if !containsURI(result.Context, contextURI) {
+			result.Context = append(result.Context, contextURI)
+		}
+	}
+	validity, _ := t.validity()
+	if result.ExpirationDate == nil && validity > 0 {
+		expirationDate := now.Add(validity)
+		result.ExpirationDate = &expirationDate
+	}
+	if err := t.matchSubject(result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Match checks whether the issued credential matches the template: it's of the template's credential type, has the contexts of the template,
+// doesn't expire later than the validity of the template allows and its credential subject contains the fields of the template.
+func (t Template) Match(credential vc.VerifiableCredential) error {
+	if !credential.IsType(ssi.MustParseURI(t.CredentialType)) {
+		return fmt.Errorf("credential type doesn't match template (template=%s, credentialType=%s)", t.Name, t.CredentialType)
+	}
+	for _, context := range t.Context {
+		if !containsURI(credential.Context, ssi.MustParseURI(context)) {
+			return fmt.Errorf("credential doesn't contain context of template (template=%s, context=%s)", t.Name, context)
+		}
+	}
+	validity, _ := t.validity()
+	if validity > 0 && (credential.ExpirationDate == nil || credential.ExpirationDate.After(credential.IssuanceDate.Add(validity))) {
+		return fmt.Errorf("credential must expire within the validity of template (template=%s, validity=%s)", t.Name, t.Validity)
+	}
+	return t.matchSubject(credential)
+}
+
+// matchSubject checks whether every credential subject contains the fields of the template, with values that match their pattern.
+func (t Template) matchSubject(credential vc.VerifiableCredential) error {
+	var subjects []map[string]interface{}
+	if err := credential.UnmarshalCredentialSubject(&subjects); err != nil {
+		return fmt.Errorf("invalid credential subject: %w", err)
+	}
+	if len(subjects) == 0 {
+		return errors.New("missing credentialSubject")
+	}
+	for _, subject := range subjects {
+		for _, field := range t.SubjectFields {
+			value, ok := fieldValue(subject, strings.Split(field.Path, "."))
+			if !ok {
+				return fmt.Errorf("credential subject misses field of template (template=%s, path=%s)", t.Name, field.Path)
+			}
+			pattern, _ := field.pattern()
+			if pattern == nil {
+				continue
+			}
+			// the pattern applies to every item of an array
+			values, isArray := value.([]interface{})
+			if !isArray {
+				values = []interface{}{value}
+			}
+			for _, curr := range values {
+				if !pattern.MatchString(fmt.Sprintf("%v", curr)) {
+					return fmt.Errorf("credential subject field doesn't match pattern of template (template=%s, path=%s)", t.Name, field.Path)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (t Template) validity() (time.Duration, error) {
+	if t.Validity == "" {
+		return 0, nil
+	}
+	validity, err := time.ParseDuration(t.Validity)
+	if err != nil {
+		return 0, fmt.Errorf("invalid validity: %w", err)
+	}
+	if validity <= 0 {
+		return 0, fmt.Errorf("validity must be positive (validity=%s)", t.Validity)
+	}
+	return validity, nil
+}
+
+// pattern compiles the pattern of the field, so it matches whole values. It returns nil if the field has no pattern.
+func (f TemplateField) pattern() (*regexp.Regexp, error) {
+	if f.Pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile("^(?:" + f.Pattern + ")$")
+}
+
+// fieldValue returns the value at the path of object keys. Null and objects don't count as field value.
+func fieldValue(subject map[string]interface{}, path []string) (interface{}, bool) {
+	value, ok := subject[path[0]]
+	if !ok || value == nil {
+		return nil, false
+	}
+	if len(path) > 1 {
+		object, isObject := value.(map[string]interface{})
+		if !isObject {
+			return nil, false
+		}
+		return fieldValue(object, path[1:])
+	}
+	if _, isObject := value.(map[string]interface{}); isObject {
+		return nil, false
+	}
+	return value, true
+}
+
+func containsURI(uris []ssi.URI, uri ssi.URI) bool {
+	for _, curr := range uris {
+		if curr.String() == uri.String() {
+			return true
+		}
+	}
+	return false
+}
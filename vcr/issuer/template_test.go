@@ -0,0 +1,182 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package issuer
+
+import (
+	"testing"
+	"time"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/stretchr/testify/assert"
+)
+
+var testTemplate = Template{
+	Name:           "organization",
+	CredentialType: "NutsOrganizationCredential",
+	Context:        []string{"https://nuts.nl/credentials/v1"},
+	Validity:       "24h",
+	Visibility:     PublicVisibility,
+	SubjectFields: []TemplateField{
+		{Path: "organization.name"},
+		{Path: "organization.city", Pattern: "[A-Z][a-z]+"},
+	},
+}
+
+func testSubject(city interface{}) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"id":           "did:nuts:456",
+		"organization": map[string]interface{}{"name": "Zorginstelling", "city": city},
+	}}
+}
+
+func TestTemplate_Validate(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		assert.NoError(t, testTemplate.Validate())
+	})
+	t.Run("invalid name", func(t *testing.T) {
+		template := testTemplate
+		template.Name = "../organization"
+
+		assert.EqualError(t, template.Validate(), "name must be non-empty and consist of letters, digits, '.', '_' and '-' (name=../organization)")
+	})
+	t.Run("no credential type", func(t *testing.T) {
+		template := testTemplate
+		template.CredentialType = ""
+
+		assert.EqualError(t, template.Validate(), "no credential type")
+	})
+	t.Run("invalid validity", func(t *testing.T) {
+		template := testTemplate
+		template.Validity = "-1h"
+
+		assert.EqualError(t, template.Validate(), "validity must be positive (validity=-1h)")
+	})
+	t.Run("invalid visibility", func(t *testing.T) {
+		template := testTemplate
+		template.Visibility = "everyone"
+
+		assert.EqualError(t, template.Validate(), "visibility must be public or private (visibility=everyone)")
+	})
+	t.Run("duplicate field", func(t *testing.T) {
+		template := testTemplate
+		template.SubjectFields = []TemplateField{{Path: "organization.name"}, {Path: "organization.name"}}
+
+		assert.EqualError(t, template.Validate(), "subject field paths must be non-empty and unique (path=organization.name)")
+	})
+	t.Run("invalid pattern", func(t *testing.T) {
+		template := testTemplate
+		template.SubjectFields = []TemplateField{{Path: "organization.name", Pattern: "("}}
+
+		assert.Contains(t, template.Validate().Error(), "invalid pattern of subject field (path=organization.name)")
+	})
+}
+
+func TestTemplate_Apply(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("fills in defaults", func(t *testing.T) {
+		result, err := testTemplate.Apply(vc.VerifiableCredential{CredentialSubject: testSubject("Caretown")}, now)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []ssi.URI{ssi.MustParseURI("NutsOrganizationCredential")}, result.Type)
+		assert.Equal(t, []ssi.URI{ssi.MustParseURI("https://nuts.nl/credentials/v1")}, result.Context)
+		assert.Equal(t, now.Add(24*time.Hour), *result.ExpirationDate)
+	})
+	t.Run("keeps given values", func(t *testing.T) {
+		expirationDate := now.Add(time.Hour)
+		options := vc.VerifiableCredential{
+			Context:           []ssi.URI{ssi.MustParseURI("https://nuts.nl/credentials/v1")},
+			Type:              []ssi.URI{ssi.MustParseURI("NutsOrganizationCredential")},
+			ExpirationDate:    &expirationDate,
+			CredentialSubject: testSubject("Caretown"),
+		}
+
+		result, err := testTemplate.Apply(options, now)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, result.Context, 1)
+		assert.Equal(t, expirationDate, *result.ExpirationDate)
+	})
+	t.Run("other credential type", func(t *testing.T) {
+		_, err := testTemplate.Apply(vc.VerifiableCredential{Type: []ssi.URI{ssi.MustParseURI("OtherCredential")}, CredentialSubject: testSubject("Caretown")}, now)
+
+		assert.EqualError(t, err, "credential type doesn't match template (template=organization, credentialType=NutsOrganizationCredential)")
+	})
+	t.Run("missing field", func(t *testing.T) {
+		_, err := testTemplate.Apply(vc.VerifiableCredential{CredentialSubject: testSubject(nil)}, now)
+
+		assert.EqualError(t, err, "credential subject misses field of template (template=organization, path=organization.city)")
+	})
+	t.Run("field doesn't match pattern", func(t *testing.T) {
+		_, err := testTemplate.Apply(vc.VerifiableCredential{CredentialSubject: testSubject("caretown")}, now)
+
+		assert.EqualError(t, err, "credential subject field doesn't match pattern of template (template=organization, path=organization.city)")
+	})
+	t.Run("pattern applies to every item of an array", func(t *testing.T) {
+		_, err := testTemplate.Apply(vc.VerifiableCredential{CredentialSubject: testSubject([]interface{}{"Caretown", "caretown"})}, now)
+
+		assert.EqualError(t, err, "credential subject field doesn't match pattern of template (template=organization, path=organization.city)")
+	})
+}
+
+func TestTemplate_Match(t *testing.T) {
+	issuanceDate := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	expirationDate := issuanceDate.Add(24 * time.Hour)
+	credential := vc.VerifiableCredential{
+		Context:           []ssi.URI{vc.VCContextV1URI(), ssi.MustParseURI("https://nuts.nl/credentials/v1")},
+		Type:              []ssi.URI{vc.VerifiableCredentialTypeV1URI(), ssi.MustParseURI("NutsOrganizationCredential")},
+		IssuanceDate:      issuanceDate,
+		ExpirationDate:    &expirationDate,
+		CredentialSubject: testSubject("Caretown"),
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		assert.NoError(t, testTemplate.Match(credential))
+	})
+	t.Run("other credential type", func(t *testing.T) {
+		other := credential
+		other.Type = []ssi.URI{vc.VerifiableCredentialTypeV1URI(), ssi.MustParseURI("OtherCredential")}
+
+		assert.EqualError(t, testTemplate.Match(other), "credential type doesn't match template (template=organization, credentialType=NutsOrganizationCredential)")
+	})
+	t.Run("missing context", func(t *testing.T) {
+		other := credential
+		other.Context = []ssi.URI{vc.VCContextV1URI()}
+
+		assert.EqualError(t, testTemplate.Match(other), "credential doesn't contain context of template (template=organization, context=https://nuts.nl/credentials/v1)")
+	})
+	t.Run("expires too late", func(t *testing.T) {
+		other := credential
+		tooLate := expirationDate.Add(time.Second)
+		other.ExpirationDate = &tooLate
+
+		assert.EqualError(t, testTemplate.Match(other), "credential must expire within the validity of template (template=organization, validity=24h)")
+	})
+	t.Run("doesn't expire", func(t *testing.T) {
+		other := credential
+		other.ExpirationDate = nil
+
+		assert.Error(t, testTemplate.Match(other))
+	})
+}
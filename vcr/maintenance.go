@@ -0,0 +1,174 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
+	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+)
+
+// Prefixes of the collection names, indicating the store that contains the collection.
+const (
+	credentialsStorePrefix = "credentials/"
+	issuerStorePrefix      = "issuer/"
+	holderStorePrefix      = "holder/"
+	verifierStorePrefix    = "verifier/"
+	auditStorePrefix       = "audit/"
+)
+
+func (c *vcr) StoreCollections() []string {
+	c.conceptMutex.Lock()
+	defer c.conceptMutex.Unlock()
+
+	collections := c.collections()
+	result := make([]string, len(collections))
+	for i, collection := range collections {
+		result[i] = collection.Name
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (c *vcr) CheckCollection(name string) (*storage.Report, error) {
+	// concepts can't be changed while their collection is checked, since that changes the indices
+	c.conceptMutex.Lock()
+	defer c.conceptMutex.Unlock()
+
+	collection, err := c.findCollection(name)
+	if err != nil {
+		return nil, err
+	}
+	log.Logger().Infof("Checking collection %s", name)
+	report, err := storage.Check(*collection)
+	if err != nil {
+		return nil, err
+	}
+	log.Logger().Infof("Checked collection %s (documents=%d, problems=%d)", name, report.Documents, len(report.Problems))
+	return report, nil
+}
+
+func (c *vcr) ReindexCollection(name string) (*storage.Report, error) {
+	c.conceptMutex.Lock()
+	defer c.conceptMutex.Unlock()
+
+	collection, err := c.findCollection(name)
+	if err != nil {
+		return nil, err
+	}
+	log.Logger().Infof("Reindexing collection %s", name)
+	report, err := storage.Reindex(*collection)
+	if err != nil {
+		return nil, err
+	}
+	log.Logger().Infof("Reindexed collection %s (documents=%d, problems=%d)", name, report.Documents, len(report.Problems))
+	return report, nil
+}
+
+func (c *vcr) findCollection(name string) (*storage.Collection, error) {
+	for _, collection := range c.collections() {
+		if collection.Name == name {
+			return &collection, nil
+		}
+	}
+	return nil, core.NotFoundError("unknown collection: %s", name)
+}
+
+// collections returns the collections of all VCR stores. The credential store has a collection per credential type of the concepts.
+// The names of the collections are prefixed with their store.
+func (c *vcr) collections() []storage.Collection {
+	var result []storage.Collection
+	for _, config := range c.registry.Concepts() {
+		// the indices have been created from the config, so they're valid
+		indices, _ := conceptStorageIndices(config)
+		result = append(result, storage.Collection{
+			Name:       credentialsStorePrefix + config.CredentialType,
+			Collection: c.store.Collection(config.CredentialType),
+			Indices:    indices,
+			IDField:    concept.IDField,
+			Validate:   c.validateStoredCredential,
+		})
+	}
+	result = append(result, storage.Collection{
+		Name:       credentialsStorePrefix + revocationCollection,
+		Collection: c.revocationIndex(),
+		Indices:    revocationIndices(),
+		IDField:    concept.SubjectField,
+		Validate:   c.validateStoredRevocation,
+	})
+
+	issuerCollection := c.issuerStore.Collection()
+	issuerCollection.Name = issuerStorePrefix + issuerCollection.Name
+	issuerCollection.Validate = c.validateStoredCredential
+	holderCollection := c.holderStore.Collection()
+	holderCollection.Name = holderStorePrefix + holderCollection.Name
+	holderCollection.Validate = c.validateStoredCredential
+	// revocations of the verifier store are only validated on their content, since they're signed differently
+	verifierCollection := c.verifierStore.Collection()
+	verifierCollection.Name = verifierStorePrefix + verifierCollection.Name
+	verifierCollection.Validate = func(document leia.Document) error {
+		revocation := credential.Revocation{}
+		if err := json.Unmarshal(document.Bytes(), &revocation); err != nil {
+			return fmt.Errorf("unable to parse revocation: %w", err)
+		}
+		return credential.ValidateRevocation(revocation)
+	}
+	result = append(result, issuerCollection, holderCollection, verifierCollection)
+	if c.auditLog != nil {
+		auditCollection := c.auditLog.Collection()
+		auditCollection.Name = auditStorePrefix + auditCollection.Name
+		result = append(result, auditCollection)
+	}
+	return result
+}
+
+// validateStoredCredential checks whether a stored credential parses and still validates:
+// its content and signature, at the moment it was issued, and its schema.
+func (c *vcr) validateStoredCredential(document leia.Document) error {
+	storedCredential := vc.VerifiableCredential{}
+	if err := json.Unmarshal(document.Bytes(), &storedCredential); err != nil {
+		return fmt.Errorf("unable to parse credential: %w", err)
+	}
+	validator, _ := credential.FindValidatorAndBuilder(storedCredential)
+	if err := validator.Validate(storedCredential); err != nil {
+		return err
+	}
+	if err := c.verifier.Validate(storedCredential, &storedCredential.IssuanceDate); err != nil {
+		return err
+	}
+	return c.schemaValidator.Validate(storedCredential)
+}
+
+// validateStoredRevocation checks whether a stored revocation parses and still validates.
+func (c *vcr) validateStoredRevocation(document leia.Document) error {
+	revocation := credential.Revocation{}
+	if err := json.Unmarshal(document.Bytes(), &revocation); err != nil {
+		return fmt.Errorf("unable to parse revocation: %w", err)
+	}
+	return c.verifyRevocation(revocation)
+}
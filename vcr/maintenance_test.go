@@ -0,0 +1,136 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"errors"
+	"testing"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVcr_StoreCollections(t *testing.T) {
+	ctx := newMockContext(t)
+
+	collections := ctx.vcr.StoreCollections()
+
+	assert.Equal(t, []string{
+		"audit/verifications",
+		"credentials/NutsAuthorizationCredential",
+		"credentials/NutsOrganizationCredential",
+		"credentials/_revocation",
+		"holder/heldCredentials",
+		"issuer/issuedCredentials",
+		"verifier/revocations",
+	}, collections)
+}
+
+func TestVcr_CheckCollection(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.registry.Add(concept.ExampleConfig)
+		_ = ctx.vcr.initIndices()
+		// the credential has an empty proof, so it doesn't validate
+		_ = ctx.vcr.store.Collection(concept.ExampleType).Add([]leia.Document{leia.DocumentFromString(concept.TestCredential)})
+
+		report, err := ctx.vcr.CheckCollection("credentials/" + concept.ExampleType)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "credentials/"+concept.ExampleType, report.Collection)
+		assert.Equal(t, 1, report.Documents)
+		if assert.Len(t, report.Problems, 1) {
+			assert.Equal(t, storage.InvalidProblem, report.Problems[0].Type)
+			assert.Equal(t, "did:nuts:B8PUHs2AUHbFF1xLLK4eZjgErEcMXHxs68FteY7NDtCY#123", report.Problems[0].ID)
+			assert.Equal(t, "verification method is not of issuer", report.Problems[0].Message)
+		}
+	})
+	t.Run("ok - revocations", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.revocationIndex().Add([]leia.Document{leia.DocumentFromString(concept.TestRevocation)})
+
+		report, err := ctx.vcr.CheckCollection("credentials/_revocation")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, report.Documents)
+		if assert.Len(t, report.Problems, 1) {
+			assert.Equal(t, storage.InvalidProblem, report.Problems[0].Type)
+		}
+	})
+	t.Run("error - unknown collection", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		report, err := ctx.vcr.CheckCollection("credentials/unknown")
+
+		assert.EqualError(t, err, "unknown collection: credentials/unknown")
+		assert.True(t, errors.As(err, new(core.HTTPStatusCodeError)))
+		assert.Nil(t, report)
+	})
+}
+
+func TestVcr_ReindexCollection(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		report, err := ctx.vcr.ReindexCollection("issuer/issuedCredentials")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{"issuedVCs", "issuedVCByID"}, report.Reindexed)
+		assert.Empty(t, report.Problems)
+	})
+	t.Run("ok - credentials added before the indices", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.store.Collection(concept.ExampleType).Add([]leia.Document{leia.DocumentFromString(concept.TestCredential)})
+		_ = ctx.vcr.registry.Add(concept.ExampleConfig)
+		report, _ := ctx.vcr.CheckCollection("credentials/" + concept.ExampleType)
+		assert.Len(t, report.Problems, 5, "expected the credential to be unreachable through the 4 indices")
+
+		report, err := ctx.vcr.ReindexCollection("credentials/" + concept.ExampleType)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, report.Reindexed, 4)
+		if assert.Len(t, report.Problems, 1) {
+			assert.Equal(t, storage.InvalidProblem, report.Problems[0].Type)
+		}
+		credential, err := ctx.vcr.find(ssi.MustParseURI("did:nuts:B8PUHs2AUHbFF1xLLK4eZjgErEcMXHxs68FteY7NDtCY#123"))
+		assert.NoError(t, err)
+		assert.Equal(t, concept.ExampleType, credential.Type[1].String())
+	})
+	t.Run("error - unknown collection", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		report, err := ctx.vcr.ReindexCollection("unknown")
+
+		assert.EqualError(t, err, "unknown collection: unknown")
+		assert.Nil(t, report)
+	})
+}
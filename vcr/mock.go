@@ -12,10 +12,15 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	ssi "github.com/nuts-foundation/go-did"
 	vc "github.com/nuts-foundation/go-did/vc"
+	audit "github.com/nuts-foundation/nuts-node/vcr/audit"
 	concept "github.com/nuts-foundation/nuts-node/vcr/concept"
 	credential "github.com/nuts-foundation/nuts-node/vcr/credential"
 	holder "github.com/nuts-foundation/nuts-node/vcr/holder"
 	issuer "github.com/nuts-foundation/nuts-node/vcr/issuer"
+	signature "github.com/nuts-foundation/nuts-node/vcr/signature"
+	storage "github.com/nuts-foundation/nuts-node/vcr/storage"
+	trust "github.com/nuts-foundation/nuts-node/vcr/trust"
+	verifier "github.com/nuts-foundation/nuts-node/vcr/verifier"
 )
 
 // MockConceptFinder is a mock of ConceptFinder interface.
@@ -71,6 +76,372 @@ func (mr *MockConceptFinderMockRecorder) SearchConcept(ctx, conceptName, allowUn
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchConcept", reflect.TypeOf((*MockConceptFinder)(nil).SearchConcept), ctx, conceptName, allowUntrusted, query)
 }
 
+// SearchConceptClauses mocks base method.
+func (m *MockConceptFinder) SearchConceptClauses(ctx context.Context, conceptName string, allowUntrusted bool, clauses []concept.Clause) ([]concept.Concept, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchConceptClauses", ctx, conceptName, allowUntrusted, clauses)
+	ret0, _ := ret[0].([]concept.Concept)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchConceptClauses indicates an expected call of SearchConceptClauses.
+func (mr *MockConceptFinderMockRecorder) SearchConceptClauses(ctx, conceptName, allowUntrusted, clauses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchConceptClauses", reflect.TypeOf((*MockConceptFinder)(nil).SearchConceptClauses), ctx, conceptName, allowUntrusted, clauses)
+}
+
+// MockConceptManager is a mock of ConceptManager interface.
+type MockConceptManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockConceptManagerMockRecorder
+}
+
+// MockConceptManagerMockRecorder is the mock recorder for MockConceptManager.
+type MockConceptManagerMockRecorder struct {
+	mock *MockConceptManager
+}
+
+// NewMockConceptManager creates a new mock instance.
+func NewMockConceptManager(ctrl *gomock.Controller) *MockConceptManager {
+	mock := &MockConceptManager{ctrl: ctrl}
+	mock.recorder = &MockConceptManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConceptManager) EXPECT() *MockConceptManagerMockRecorder {
+	return m.recorder
+}
+
+// AddConcept mocks base method.
+func (m *MockConceptManager) AddConcept(config concept.Config) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddConcept", config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddConcept indicates an expected call of AddConcept.
+func (mr *MockConceptManagerMockRecorder) AddConcept(config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddConcept", reflect.TypeOf((*MockConceptManager)(nil).AddConcept), config)
+}
+
+// RemoveConcept mocks base method.
+func (m *MockConceptManager) RemoveConcept(credentialType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveConcept", credentialType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveConcept indicates an expected call of RemoveConcept.
+func (mr *MockConceptManagerMockRecorder) RemoveConcept(credentialType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveConcept", reflect.TypeOf((*MockConceptManager)(nil).RemoveConcept), credentialType)
+}
+
+// MockTemplateManager is a mock of TemplateManager interface.
+type MockTemplateManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateManagerMockRecorder
+}
+
+// MockTemplateManagerMockRecorder is the mock recorder for MockTemplateManager.
+type MockTemplateManagerMockRecorder struct {
+	mock *MockTemplateManager
+}
+
+// NewMockTemplateManager creates a new mock instance.
+func NewMockTemplateManager(ctrl *gomock.Controller) *MockTemplateManager {
+	mock := &MockTemplateManager{ctrl: ctrl}
+	mock.recorder = &MockTemplateManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplateManager) EXPECT() *MockTemplateManagerMockRecorder {
+	return m.recorder
+}
+
+// AddTemplate mocks base method.
+func (m *MockTemplateManager) AddTemplate(template issuer.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTemplate", template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTemplate indicates an expected call of AddTemplate.
+func (mr *MockTemplateManagerMockRecorder) AddTemplate(template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTemplate", reflect.TypeOf((*MockTemplateManager)(nil).AddTemplate), template)
+}
+
+// RemoveTemplate mocks base method.
+func (m *MockTemplateManager) RemoveTemplate(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTemplate", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTemplate indicates an expected call of RemoveTemplate.
+func (mr *MockTemplateManagerMockRecorder) RemoveTemplate(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTemplate", reflect.TypeOf((*MockTemplateManager)(nil).RemoveTemplate), name)
+}
+
+// Template mocks base method.
+func (m *MockTemplateManager) Template(name string) (*issuer.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", name)
+	ret0, _ := ret[0].(*issuer.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockTemplateManagerMockRecorder) Template(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockTemplateManager)(nil).Template), name)
+}
+
+// Templates mocks base method.
+func (m *MockTemplateManager) Templates() []issuer.Template {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Templates")
+	ret0, _ := ret[0].([]issuer.Template)
+	return ret0
+}
+
+// Templates indicates an expected call of Templates.
+func (mr *MockTemplateManagerMockRecorder) Templates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Templates", reflect.TypeOf((*MockTemplateManager)(nil).Templates))
+}
+
+// MockIssuedCredentialManager is a mock of IssuedCredentialManager interface.
+type MockIssuedCredentialManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssuedCredentialManagerMockRecorder
+}
+
+// MockIssuedCredentialManagerMockRecorder is the mock recorder for MockIssuedCredentialManager.
+type MockIssuedCredentialManagerMockRecorder struct {
+	mock *MockIssuedCredentialManager
+}
+
+// NewMockIssuedCredentialManager creates a new mock instance.
+func NewMockIssuedCredentialManager(ctrl *gomock.Controller) *MockIssuedCredentialManager {
+	mock := &MockIssuedCredentialManager{ctrl: ctrl}
+	mock.recorder = &MockIssuedCredentialManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssuedCredentialManager) EXPECT() *MockIssuedCredentialManagerMockRecorder {
+	return m.recorder
+}
+
+// ListIssued mocks base method.
+func (m *MockIssuedCredentialManager) ListIssued(query IssuedCredentialQuery, offset, limit int) ([]IssuedCredential, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssued", query, offset, limit)
+	ret0, _ := ret[0].([]IssuedCredential)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListIssued indicates an expected call of ListIssued.
+func (mr *MockIssuedCredentialManagerMockRecorder) ListIssued(query, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssued", reflect.TypeOf((*MockIssuedCredentialManager)(nil).ListIssued), query, offset, limit)
+}
+
+// RevokeIssued mocks base method.
+func (m *MockIssuedCredentialManager) RevokeIssued(query IssuedCredentialQuery, reason string, dryRun bool) ([]RevocationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeIssued", query, reason, dryRun)
+	ret0, _ := ret[0].([]RevocationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeIssued indicates an expected call of RevokeIssued.
+func (mr *MockIssuedCredentialManagerMockRecorder) RevokeIssued(query, reason, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeIssued", reflect.TypeOf((*MockIssuedCredentialManager)(nil).RevokeIssued), query, reason, dryRun)
+}
+
+// MockCredentialRefresher is a mock of CredentialRefresher interface.
+type MockCredentialRefresher struct {
+	ctrl     *gomock.Controller
+	recorder *MockCredentialRefresherMockRecorder
+}
+
+// MockCredentialRefresherMockRecorder is the mock recorder for MockCredentialRefresher.
+type MockCredentialRefresherMockRecorder struct {
+	mock *MockCredentialRefresher
+}
+
+// NewMockCredentialRefresher creates a new mock instance.
+func NewMockCredentialRefresher(ctrl *gomock.Controller) *MockCredentialRefresher {
+	mock := &MockCredentialRefresher{ctrl: ctrl}
+	mock.recorder = &MockCredentialRefresherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCredentialRefresher) EXPECT() *MockCredentialRefresherMockRecorder {
+	return m.recorder
+}
+
+// CreateRefreshNonce mocks base method.
+func (m *MockCredentialRefresher) CreateRefreshNonce() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshNonce")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CreateRefreshNonce indicates an expected call of CreateRefreshNonce.
+func (mr *MockCredentialRefresherMockRecorder) CreateRefreshNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshNonce", reflect.TypeOf((*MockCredentialRefresher)(nil).CreateRefreshNonce))
+}
+
+// RefreshCredential mocks base method.
+func (m *MockCredentialRefresher) RefreshCredential(presentation vc.VerifiablePresentation, nonce string) (*vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCredential", presentation, nonce)
+	ret0, _ := ret[0].(*vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshCredential indicates an expected call of RefreshCredential.
+func (mr *MockCredentialRefresherMockRecorder) RefreshCredential(presentation, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCredential", reflect.TypeOf((*MockCredentialRefresher)(nil).RefreshCredential), presentation, nonce)
+}
+
+// RegisterRefreshPolicy mocks base method.
+func (m *MockCredentialRefresher) RegisterRefreshPolicy(policy RefreshPolicy) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRefreshPolicy", policy)
+}
+
+// RegisterRefreshPolicy indicates an expected call of RegisterRefreshPolicy.
+func (mr *MockCredentialRefresherMockRecorder) RegisterRefreshPolicy(policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRefreshPolicy", reflect.TypeOf((*MockCredentialRefresher)(nil).RegisterRefreshPolicy), policy)
+}
+
+// MockStoreMaintainer is a mock of StoreMaintainer interface.
+type MockStoreMaintainer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMaintainerMockRecorder
+}
+
+// MockStoreMaintainerMockRecorder is the mock recorder for MockStoreMaintainer.
+type MockStoreMaintainerMockRecorder struct {
+	mock *MockStoreMaintainer
+}
+
+// NewMockStoreMaintainer creates a new mock instance.
+func NewMockStoreMaintainer(ctrl *gomock.Controller) *MockStoreMaintainer {
+	mock := &MockStoreMaintainer{ctrl: ctrl}
+	mock.recorder = &MockStoreMaintainerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStoreMaintainer) EXPECT() *MockStoreMaintainerMockRecorder {
+	return m.recorder
+}
+
+// CheckCollection mocks base method.
+func (m *MockStoreMaintainer) CheckCollection(name string) (*storage.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCollection", name)
+	ret0, _ := ret[0].(*storage.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckCollection indicates an expected call of CheckCollection.
+func (mr *MockStoreMaintainerMockRecorder) CheckCollection(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCollection", reflect.TypeOf((*MockStoreMaintainer)(nil).CheckCollection), name)
+}
+
+// ReindexCollection mocks base method.
+func (m *MockStoreMaintainer) ReindexCollection(name string) (*storage.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReindexCollection", name)
+	ret0, _ := ret[0].(*storage.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReindexCollection indicates an expected call of ReindexCollection.
+func (mr *MockStoreMaintainerMockRecorder) ReindexCollection(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexCollection", reflect.TypeOf((*MockStoreMaintainer)(nil).ReindexCollection), name)
+}
+
+// StoreCollections mocks base method.
+func (m *MockStoreMaintainer) StoreCollections() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreCollections")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// StoreCollections indicates an expected call of StoreCollections.
+func (mr *MockStoreMaintainerMockRecorder) StoreCollections() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreCollections", reflect.TypeOf((*MockStoreMaintainer)(nil).StoreCollections))
+}
+
+// MockCredentialNotifier is a mock of CredentialNotifier interface.
+type MockCredentialNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCredentialNotifierMockRecorder
+}
+
+// MockCredentialNotifierMockRecorder is the mock recorder for MockCredentialNotifier.
+type MockCredentialNotifierMockRecorder struct {
+	mock *MockCredentialNotifier
+}
+
+// NewMockCredentialNotifier creates a new mock instance.
+func NewMockCredentialNotifier(ctrl *gomock.Controller) *MockCredentialNotifier {
+	mock := &MockCredentialNotifier{ctrl: ctrl}
+	mock.recorder = &MockCredentialNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCredentialNotifier) EXPECT() *MockCredentialNotifierMockRecorder {
+	return m.recorder
+}
+
+// SubscribeCredentialReceived mocks base method.
+func (m *MockCredentialNotifier) SubscribeCredentialReceived(callback CredentialReceivedCallback) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SubscribeCredentialReceived", callback)
+}
+
+// SubscribeCredentialReceived indicates an expected call of SubscribeCredentialReceived.
+func (mr *MockCredentialNotifierMockRecorder) SubscribeCredentialReceived(callback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeCredentialReceived", reflect.TypeOf((*MockCredentialNotifier)(nil).SubscribeCredentialReceived), callback)
+}
+
 // MockFinder is a mock of Finder interface.
 type MockFinder struct {
 	ctrl     *gomock.Controller
@@ -133,17 +504,68 @@ func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
 }
 
 // Validate mocks base method.
-func (m *MockValidator) Validate(credential vc.VerifiableCredential, allowUntrusted, checkSignature bool, validAt *time.Time) error {
+func (m *MockValidator) Validate(credential vc.VerifiableCredential, allowUntrusted, checkSignature bool, validAt *time.Time, caller audit.Caller) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Validate", credential, allowUntrusted, checkSignature, validAt)
+	ret := m.ctrl.Call(m, "Validate", credential, allowUntrusted, checkSignature, validAt, caller)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Validate indicates an expected call of Validate.
-func (mr *MockValidatorMockRecorder) Validate(credential, allowUntrusted, checkSignature, validAt interface{}) *gomock.Call {
+func (mr *MockValidatorMockRecorder) Validate(credential, allowUntrusted, checkSignature, validAt, caller interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockValidator)(nil).Validate), credential, allowUntrusted, checkSignature, validAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockValidator)(nil).Validate), credential, allowUntrusted, checkSignature, validAt, caller)
+}
+
+// MockVerificationAuditor is a mock of VerificationAuditor interface.
+type MockVerificationAuditor struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerificationAuditorMockRecorder
+}
+
+// MockVerificationAuditorMockRecorder is the mock recorder for MockVerificationAuditor.
+type MockVerificationAuditorMockRecorder struct {
+	mock *MockVerificationAuditor
+}
+
+// NewMockVerificationAuditor creates a new mock instance.
+func NewMockVerificationAuditor(ctrl *gomock.Controller) *MockVerificationAuditor {
+	mock := &MockVerificationAuditor{ctrl: ctrl}
+	mock.recorder = &MockVerificationAuditorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerificationAuditor) EXPECT() *MockVerificationAuditorMockRecorder {
+	return m.recorder
+}
+
+// RecordVerification mocks base method.
+func (m *MockVerificationAuditor) RecordVerification(credential vc.VerifiableCredential, caller audit.Caller, checks []audit.Check) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordVerification", credential, caller, checks)
+}
+
+// RecordVerification indicates an expected call of RecordVerification.
+func (mr *MockVerificationAuditorMockRecorder) RecordVerification(credential, caller, checks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordVerification", reflect.TypeOf((*MockVerificationAuditor)(nil).RecordVerification), credential, caller, checks)
+}
+
+// SearchVerifications mocks base method.
+func (m *MockVerificationAuditor) SearchVerifications(query audit.Query, offset, limit int) ([]audit.Entry, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchVerifications", query, offset, limit)
+	ret0, _ := ret[0].([]audit.Entry)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchVerifications indicates an expected call of SearchVerifications.
+func (mr *MockVerificationAuditorMockRecorder) SearchVerifications(query, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchVerifications", reflect.TypeOf((*MockVerificationAuditor)(nil).SearchVerifications), query, offset, limit)
 }
 
 // MockWriter is a mock of Writer interface.
@@ -220,6 +642,35 @@ func (m *MockTrustManager) EXPECT() *MockTrustManagerMockRecorder {
 	return m.recorder
 }
 
+// ExplainTrust mocks base method.
+func (m *MockTrustManager) ExplainTrust(credentialType, issuer ssi.URI) trust.Decision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainTrust", credentialType, issuer)
+	ret0, _ := ret[0].(trust.Decision)
+	return ret0
+}
+
+// ExplainTrust indicates an expected call of ExplainTrust.
+func (mr *MockTrustManagerMockRecorder) ExplainTrust(credentialType, issuer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainTrust", reflect.TypeOf((*MockTrustManager)(nil).ExplainTrust), credentialType, issuer)
+}
+
+// ImportTrustList mocks base method.
+func (m *MockTrustManager) ImportTrustList(signedList string) (*trust.TrustList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTrustList", signedList)
+	ret0, _ := ret[0].(*trust.TrustList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportTrustList indicates an expected call of ImportTrustList.
+func (mr *MockTrustManagerMockRecorder) ImportTrustList(signedList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTrustList", reflect.TypeOf((*MockTrustManager)(nil).ImportTrustList), signedList)
+}
+
 // Trust mocks base method.
 func (m *MockTrustManager) Trust(credentialType, issuer ssi.URI) error {
 	m.ctrl.T.Helper()
@@ -301,6 +752,20 @@ func (m *MockResolver) EXPECT() *MockResolverMockRecorder {
 	return m.recorder
 }
 
+// JSONLDContexts mocks base method.
+func (m *MockResolver) JSONLDContexts() []signature.ContextInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JSONLDContexts")
+	ret0, _ := ret[0].([]signature.ContextInfo)
+	return ret0
+}
+
+// JSONLDContexts indicates an expected call of JSONLDContexts.
+func (mr *MockResolverMockRecorder) JSONLDContexts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JSONLDContexts", reflect.TypeOf((*MockResolver)(nil).JSONLDContexts))
+}
+
 // Registry mocks base method.
 func (m *MockResolver) Registry() concept.Reader {
 	m.ctrl.T.Helper()
@@ -353,6 +818,77 @@ func (m *MockVCR) EXPECT() *MockVCRMockRecorder {
 	return m.recorder
 }
 
+// AddConcept mocks base method.
+func (m *MockVCR) AddConcept(config concept.Config) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddConcept", config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddConcept indicates an expected call of AddConcept.
+func (mr *MockVCRMockRecorder) AddConcept(config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddConcept", reflect.TypeOf((*MockVCR)(nil).AddConcept), config)
+}
+
+// AddTemplate mocks base method.
+func (m *MockVCR) AddTemplate(template issuer.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTemplate", template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTemplate indicates an expected call of AddTemplate.
+func (mr *MockVCRMockRecorder) AddTemplate(template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTemplate", reflect.TypeOf((*MockVCR)(nil).AddTemplate), template)
+}
+
+// CheckCollection mocks base method.
+func (m *MockVCR) CheckCollection(name string) (*storage.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCollection", name)
+	ret0, _ := ret[0].(*storage.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckCollection indicates an expected call of CheckCollection.
+func (mr *MockVCRMockRecorder) CheckCollection(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCollection", reflect.TypeOf((*MockVCR)(nil).CheckCollection), name)
+}
+
+// CreateRefreshNonce mocks base method.
+func (m *MockVCR) CreateRefreshNonce() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshNonce")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CreateRefreshNonce indicates an expected call of CreateRefreshNonce.
+func (mr *MockVCRMockRecorder) CreateRefreshNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshNonce", reflect.TypeOf((*MockVCR)(nil).CreateRefreshNonce))
+}
+
+// ExplainTrust mocks base method.
+func (m *MockVCR) ExplainTrust(credentialType, issuer ssi.URI) trust.Decision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainTrust", credentialType, issuer)
+	ret0, _ := ret[0].(trust.Decision)
+	return ret0
+}
+
+// ExplainTrust indicates an expected call of ExplainTrust.
+func (mr *MockVCRMockRecorder) ExplainTrust(credentialType, issuer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainTrust", reflect.TypeOf((*MockVCR)(nil).ExplainTrust), credentialType, issuer)
+}
+
 // Get mocks base method.
 func (m *MockVCR) Get(conceptName string, allowUntrusted bool, subject string) (concept.Concept, error) {
 	m.ctrl.T.Helper()
@@ -382,6 +918,21 @@ func (mr *MockVCRMockRecorder) Holder() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Holder", reflect.TypeOf((*MockVCR)(nil).Holder))
 }
 
+// ImportTrustList mocks base method.
+func (m *MockVCR) ImportTrustList(signedList string) (*trust.TrustList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTrustList", signedList)
+	ret0, _ := ret[0].(*trust.TrustList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportTrustList indicates an expected call of ImportTrustList.
+func (mr *MockVCRMockRecorder) ImportTrustList(signedList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTrustList", reflect.TypeOf((*MockVCR)(nil).ImportTrustList), signedList)
+}
+
 // Issue mocks base method.
 func (m *MockVCR) Issue(vcToIssue vc.VerifiableCredential) (*vc.VerifiableCredential, error) {
 	m.ctrl.T.Helper()
@@ -411,6 +962,75 @@ func (mr *MockVCRMockRecorder) Issuer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issuer", reflect.TypeOf((*MockVCR)(nil).Issuer))
 }
 
+// JSONLDContexts mocks base method.
+func (m *MockVCR) JSONLDContexts() []signature.ContextInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JSONLDContexts")
+	ret0, _ := ret[0].([]signature.ContextInfo)
+	return ret0
+}
+
+// JSONLDContexts indicates an expected call of JSONLDContexts.
+func (mr *MockVCRMockRecorder) JSONLDContexts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JSONLDContexts", reflect.TypeOf((*MockVCR)(nil).JSONLDContexts))
+}
+
+// ListIssued mocks base method.
+func (m *MockVCR) ListIssued(query IssuedCredentialQuery, offset, limit int) ([]IssuedCredential, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssued", query, offset, limit)
+	ret0, _ := ret[0].([]IssuedCredential)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListIssued indicates an expected call of ListIssued.
+func (mr *MockVCRMockRecorder) ListIssued(query, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssued", reflect.TypeOf((*MockVCR)(nil).ListIssued), query, offset, limit)
+}
+
+// RecordVerification mocks base method.
+func (m *MockVCR) RecordVerification(credential vc.VerifiableCredential, caller audit.Caller, checks []audit.Check) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordVerification", credential, caller, checks)
+}
+
+// RecordVerification indicates an expected call of RecordVerification.
+func (mr *MockVCRMockRecorder) RecordVerification(credential, caller, checks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordVerification", reflect.TypeOf((*MockVCR)(nil).RecordVerification), credential, caller, checks)
+}
+
+// RefreshCredential mocks base method.
+func (m *MockVCR) RefreshCredential(presentation vc.VerifiablePresentation, nonce string) (*vc.VerifiableCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCredential", presentation, nonce)
+	ret0, _ := ret[0].(*vc.VerifiableCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshCredential indicates an expected call of RefreshCredential.
+func (mr *MockVCRMockRecorder) RefreshCredential(presentation, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCredential", reflect.TypeOf((*MockVCR)(nil).RefreshCredential), presentation, nonce)
+}
+
+// RegisterRefreshPolicy mocks base method.
+func (m *MockVCR) RegisterRefreshPolicy(policy RefreshPolicy) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRefreshPolicy", policy)
+}
+
+// RegisterRefreshPolicy indicates an expected call of RegisterRefreshPolicy.
+func (mr *MockVCRMockRecorder) RegisterRefreshPolicy(policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRefreshPolicy", reflect.TypeOf((*MockVCR)(nil).RegisterRefreshPolicy), policy)
+}
+
 // Registry mocks base method.
 func (m *MockVCR) Registry() concept.Reader {
 	m.ctrl.T.Helper()
@@ -425,6 +1045,49 @@ func (mr *MockVCRMockRecorder) Registry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Registry", reflect.TypeOf((*MockVCR)(nil).Registry))
 }
 
+// ReindexCollection mocks base method.
+func (m *MockVCR) ReindexCollection(name string) (*storage.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReindexCollection", name)
+	ret0, _ := ret[0].(*storage.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReindexCollection indicates an expected call of ReindexCollection.
+func (mr *MockVCRMockRecorder) ReindexCollection(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexCollection", reflect.TypeOf((*MockVCR)(nil).ReindexCollection), name)
+}
+
+// RemoveConcept mocks base method.
+func (m *MockVCR) RemoveConcept(credentialType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveConcept", credentialType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveConcept indicates an expected call of RemoveConcept.
+func (mr *MockVCRMockRecorder) RemoveConcept(credentialType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveConcept", reflect.TypeOf((*MockVCR)(nil).RemoveConcept), credentialType)
+}
+
+// RemoveTemplate mocks base method.
+func (m *MockVCR) RemoveTemplate(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTemplate", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTemplate indicates an expected call of RemoveTemplate.
+func (mr *MockVCRMockRecorder) RemoveTemplate(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTemplate", reflect.TypeOf((*MockVCR)(nil).RemoveTemplate), name)
+}
+
 // Resolve mocks base method.
 func (m *MockVCR) Resolve(ID ssi.URI, resolveTime *time.Time) (*vc.VerifiableCredential, error) {
 	m.ctrl.T.Helper()
@@ -455,6 +1118,21 @@ func (mr *MockVCRMockRecorder) Revoke(ID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockVCR)(nil).Revoke), ID)
 }
 
+// RevokeIssued mocks base method.
+func (m *MockVCR) RevokeIssued(query IssuedCredentialQuery, reason string, dryRun bool) ([]RevocationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeIssued", query, reason, dryRun)
+	ret0, _ := ret[0].([]RevocationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeIssued indicates an expected call of RevokeIssued.
+func (mr *MockVCRMockRecorder) RevokeIssued(query, reason, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeIssued", reflect.TypeOf((*MockVCR)(nil).RevokeIssued), query, reason, dryRun)
+}
+
 // Search mocks base method.
 func (m *MockVCR) Search(ctx context.Context, query concept.Query, allowUntrusted bool, resolveTime *time.Time) ([]vc.VerifiableCredential, error) {
 	m.ctrl.T.Helper()
@@ -485,6 +1163,51 @@ func (mr *MockVCRMockRecorder) SearchConcept(ctx, conceptName, allowUntrusted, q
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchConcept", reflect.TypeOf((*MockVCR)(nil).SearchConcept), ctx, conceptName, allowUntrusted, query)
 }
 
+// SearchConceptClauses mocks base method.
+func (m *MockVCR) SearchConceptClauses(ctx context.Context, conceptName string, allowUntrusted bool, clauses []concept.Clause) ([]concept.Concept, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchConceptClauses", ctx, conceptName, allowUntrusted, clauses)
+	ret0, _ := ret[0].([]concept.Concept)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchConceptClauses indicates an expected call of SearchConceptClauses.
+func (mr *MockVCRMockRecorder) SearchConceptClauses(ctx, conceptName, allowUntrusted, clauses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchConceptClauses", reflect.TypeOf((*MockVCR)(nil).SearchConceptClauses), ctx, conceptName, allowUntrusted, clauses)
+}
+
+// SearchVerifications mocks base method.
+func (m *MockVCR) SearchVerifications(query audit.Query, offset, limit int) ([]audit.Entry, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchVerifications", query, offset, limit)
+	ret0, _ := ret[0].([]audit.Entry)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchVerifications indicates an expected call of SearchVerifications.
+func (mr *MockVCRMockRecorder) SearchVerifications(query, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchVerifications", reflect.TypeOf((*MockVCR)(nil).SearchVerifications), query, offset, limit)
+}
+
+// StoreCollections mocks base method.
+func (m *MockVCR) StoreCollections() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreCollections")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// StoreCollections indicates an expected call of StoreCollections.
+func (mr *MockVCRMockRecorder) StoreCollections() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreCollections", reflect.TypeOf((*MockVCR)(nil).StoreCollections))
+}
+
 // StoreCredential mocks base method.
 func (m *MockVCR) StoreCredential(vc vc.VerifiableCredential, validAt *time.Time) error {
 	m.ctrl.T.Helper()
@@ -513,6 +1236,47 @@ func (mr *MockVCRMockRecorder) StoreRevocation(r interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRevocation", reflect.TypeOf((*MockVCR)(nil).StoreRevocation), r)
 }
 
+// SubscribeCredentialReceived mocks base method.
+func (m *MockVCR) SubscribeCredentialReceived(callback CredentialReceivedCallback) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SubscribeCredentialReceived", callback)
+}
+
+// SubscribeCredentialReceived indicates an expected call of SubscribeCredentialReceived.
+func (mr *MockVCRMockRecorder) SubscribeCredentialReceived(callback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeCredentialReceived", reflect.TypeOf((*MockVCR)(nil).SubscribeCredentialReceived), callback)
+}
+
+// Template mocks base method.
+func (m *MockVCR) Template(name string) (*issuer.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", name)
+	ret0, _ := ret[0].(*issuer.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockVCRMockRecorder) Template(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockVCR)(nil).Template), name)
+}
+
+// Templates mocks base method.
+func (m *MockVCR) Templates() []issuer.Template {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Templates")
+	ret0, _ := ret[0].([]issuer.Template)
+	return ret0
+}
+
+// Templates indicates an expected call of Templates.
+func (mr *MockVCRMockRecorder) Templates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Templates", reflect.TypeOf((*MockVCR)(nil).Templates))
+}
+
 // Trust mocks base method.
 func (m *MockVCR) Trust(credentialType, issuer ssi.URI) error {
 	m.ctrl.T.Helper()
@@ -572,15 +1336,29 @@ func (mr *MockVCRMockRecorder) Untrusted(credentialType interface{}) *gomock.Cal
 }
 
 // Validate mocks base method.
-func (m *MockVCR) Validate(credential vc.VerifiableCredential, allowUntrusted, checkSignature bool, validAt *time.Time) error {
+func (m *MockVCR) Validate(credential vc.VerifiableCredential, allowUntrusted, checkSignature bool, validAt *time.Time, caller audit.Caller) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Validate", credential, allowUntrusted, checkSignature, validAt)
+	ret := m.ctrl.Call(m, "Validate", credential, allowUntrusted, checkSignature, validAt, caller)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Validate indicates an expected call of Validate.
-func (mr *MockVCRMockRecorder) Validate(credential, allowUntrusted, checkSignature, validAt interface{}) *gomock.Call {
+func (mr *MockVCRMockRecorder) Validate(credential, allowUntrusted, checkSignature, validAt, caller interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockVCR)(nil).Validate), credential, allowUntrusted, checkSignature, validAt, caller)
+}
+
+// Verifier mocks base method.
+func (m *MockVCR) Verifier() verifier.Verifier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verifier")
+	ret0, _ := ret[0].(verifier.Verifier)
+	return ret0
+}
+
+// Verifier indicates an expected call of Verifier.
+func (mr *MockVCRMockRecorder) Verifier() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockVCR)(nil).Validate), credential, allowUntrusted, checkSignature, validAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verifier", reflect.TypeOf((*MockVCR)(nil).Verifier))
 }
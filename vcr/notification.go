@@ -0,0 +1,189 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/events"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+)
+
+// CredentialReceivedEvent is published on the VCR stream when a credential issued to a DID managed by this node
+// has been received and added to the wallet.
+type CredentialReceivedEvent struct {
+	// CredentialID contains the ID of the received credential.
+	CredentialID string `json:"credentialID"`
+	// CredentialType contains the type of the received credential.
+	// It's empty if the credential doesn't have exactly one type besides VerifiableCredential.
+	CredentialType string `json:"credentialType,omitempty"`
+	// Issuer contains the DID of the issuer of the received credential.
+	Issuer string `json:"issuer"`
+	// Subject contains the DID managed by this node the credential has been issued to.
+	Subject string `json:"subject"`
+}
+
+// CredentialReceivedCallback is called when a credential issued to a DID managed by this node has been received.
+type CredentialReceivedCallback func(event CredentialReceivedEvent)
+
+func newCredentialReceivedEvent(credential vc.VerifiableCredential, subject did.DID) CredentialReceivedEvent {
+	event := CredentialReceivedEvent{
+		Issuer:  credential.Issuer.String(),
+		Subject: subject.String(),
+	}
+	if credential.ID != nil {
+		event.CredentialID = credential.ID.String()
+	}
+	if credentialType, err := credentialTypeOf(credential); err == nil {
+		event.CredentialType = credentialType.String()
+	}
+	return event
+}
+
+// credentialNotifier notifies the application of received credentials: it publishes an event on the VCR stream,
+// calls the registered callbacks and calls the webhook if one is configured.
+type credentialNotifier struct {
+	mutex     *sync.RWMutex
+	callbacks []CredentialReceivedCallback
+	// publish publishes the event on the VCR stream.
+	publish func(event CredentialReceivedEvent) error
+	// webhook is called with every event, it's nil if no webhook is configured.
+	webhook *webhook
+}
+
+func newCredentialNotifier(publish func(event CredentialReceivedEvent) error, webhook *webhook) *credentialNotifier {
+	return &credentialNotifier{
+		mutex:   &sync.RWMutex{},
+		publish: publish,
+		webhook: webhook,
+	}
+}
+
+// subscribe registers a callback that is called for every received credential.
+func (n *credentialNotifier) subscribe(callback CredentialReceivedCallback) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.callbacks = append(n.callbacks, callback)
+}
+
+// notify notifies the application of the received credential. It's only called when the credential is added to the wallet,
+// so a credential that's received again when the network is replayed, or that has been deleted from the wallet, isn't notified again.
+// Failing to publish the event or to call the webhook is logged, since the credential has been stored anyway.
+// The webhook is called in the background, so a slow application doesn't hold up the processing of transactions.
+func (n *credentialNotifier) notify(event CredentialReceivedEvent) {
+	log.Logger().Infof("Received credential for DID managed by this node (id=%s, type=%s, issuer=%s, subject=%s)",
+		event.CredentialID, event.CredentialType, event.Issuer, event.Subject)
+	if err := n.publish(event); err != nil {
+		log.Logger().Errorf("Unable to publish credential received event (id=%s): %v", event.CredentialID, err)
+	}
+
+	n.mutex.RLock()
+	callbacks := n.callbacks
+	n.mutex.RUnlock()
+	for _, callback := range callbacks {
+		callback(event)
+	}
+
+	if n.webhook != nil {
+		go func() {
+			if err := n.webhook.send(event); err != nil {
+				log.Logger().Errorf("Unable to call webhook for received credential (id=%s): %v", event.CredentialID, err)
+			}
+		}()
+	}
+}
+
+// webhook POSTs events as JSON to the application.
+type webhook struct {
+	url    string
+	client *http.Client
+}
+
+// newWebhook creates a webhook for the configured URL. It returns nil if no URL is configured.
+// In strict mode the URL must use HTTPS.
+func newWebhook(config NotificationConfig, strictMode bool) (*webhook, error) {
+	if config.Webhook == "" {
+		return nil, nil
+	}
+	parsed, err := url.Parse(config.Webhook)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return nil, fmt.Errorf("invalid webhook URL: must be an absolute HTTP(S) URL: %s", config.Webhook)
+	}
+	if strictMode && parsed.Scheme != "https" {
+		return nil, errors.New("invalid webhook URL: must use HTTPS in strict mode")
+	}
+	return &webhook{
+		url:    config.Webhook,
+		client: &http.Client{Timeout: config.WebhookTimeout},
+	}, nil
+}
+
+// send POSTs the event to the webhook, which must respond with a 2xx status code.
+func (w webhook) send(event CredentialReceivedEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	response, err := w.client.Post(w.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	_ = response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status code %d", response.StatusCode)
+	}
+	return nil
+}
+
+func (c *vcr) SubscribeCredentialReceived(callback CredentialReceivedCallback) {
+	c.notifier.subscribe(callback)
+}
+
+// publishEvent publishes the given event as JSON on the VCR stream, under the given subject.
+func (c *vcr) publishEvent(subject string, event interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+	conn, _, err := c.eventManager.Pool().Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	return c.eventManager.GetStream(events.VCRStream).Publish(conn, subject, data)
+}
+
+// publishCredentialReceivedEvent publishes the given event on the VCR stream.
+func (c *vcr) publishCredentialReceivedEvent(event CredentialReceivedEvent) error {
+	return c.publishEvent(events.CredentialReceivedSubject, event)
+}
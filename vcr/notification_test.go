@@ -0,0 +1,189 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/stretchr/testify/assert"
+)
+
+var testReceivedEvent = CredentialReceivedEvent{
+	CredentialID:   "did:nuts:issuer#1",
+	CredentialType: "ExampleCredential",
+	Issuer:         "did:nuts:issuer",
+	Subject:        "did:nuts:subject",
+}
+
+func TestNewCredentialReceivedEvent(t *testing.T) {
+	id := ssi.MustParseURI("did:nuts:issuer#1")
+	credential := vc.VerifiableCredential{
+		ID:     &id,
+		Type:   []ssi.URI{vc.VerifiableCredentialTypeV1URI(), ssi.MustParseURI("ExampleCredential")},
+		Issuer: ssi.MustParseURI("did:nuts:issuer"),
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		event := newCredentialReceivedEvent(credential, did.MustParseDID("did:nuts:subject"))
+
+		assert.Equal(t, testReceivedEvent, event)
+	})
+	t.Run("ok - multiple types", func(t *testing.T) {
+		multipleTypes := credential
+		multipleTypes.Type = append(multipleTypes.Type, ssi.MustParseURI("OtherCredential"))
+
+		event := newCredentialReceivedEvent(multipleTypes, did.MustParseDID("did:nuts:subject"))
+
+		assert.Empty(t, event.CredentialType)
+		assert.Equal(t, "did:nuts:issuer#1", event.CredentialID)
+	})
+}
+
+func TestCredentialNotifier_notify(t *testing.T) {
+	t.Run("publishes event and calls callbacks", func(t *testing.T) {
+		var published, called []CredentialReceivedEvent
+		notifier := newCredentialNotifier(func(event CredentialReceivedEvent) error {
+			published = append(published, event)
+			return nil
+		}, nil)
+		notifier.subscribe(func(event CredentialReceivedEvent) {
+			called = append(called, event)
+		})
+
+		notifier.notify(testReceivedEvent)
+
+		assert.Equal(t, []CredentialReceivedEvent{testReceivedEvent}, published)
+		assert.Equal(t, []CredentialReceivedEvent{testReceivedEvent}, called)
+	})
+	t.Run("calls callbacks when publishing fails", func(t *testing.T) {
+		var called []CredentialReceivedEvent
+		notifier := newCredentialNotifier(func(event CredentialReceivedEvent) error {
+			return errors.New("b00m!")
+		}, nil)
+		notifier.subscribe(func(event CredentialReceivedEvent) {
+			called = append(called, event)
+		})
+
+		notifier.notify(testReceivedEvent)
+
+		assert.Len(t, called, 1)
+	})
+	t.Run("calls webhook", func(t *testing.T) {
+		received := make(chan CredentialReceivedEvent, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			event := CredentialReceivedEvent{}
+			_ = json.NewDecoder(request.Body).Decode(&event)
+			writer.WriteHeader(http.StatusNoContent)
+			received <- event
+		}))
+		defer server.Close()
+		hook, _ := newWebhook(NotificationConfig{Webhook: server.URL, WebhookTimeout: time.Second}, false)
+		notifier := newCredentialNotifier(func(event CredentialReceivedEvent) error {
+			return nil
+		}, hook)
+
+		notifier.notify(testReceivedEvent)
+
+		select {
+		case event := <-received:
+			assert.Equal(t, testReceivedEvent, event)
+		case <-time.After(5 * time.Second):
+			t.Fatal("webhook wasn't called")
+		}
+	})
+}
+
+func TestNewWebhook(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		hook, err := newWebhook(NotificationConfig{Webhook: "https://example.com/credentials", WebhookTimeout: time.Second}, true)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "https://example.com/credentials", hook.url)
+		assert.Equal(t, time.Second, hook.client.Timeout)
+	})
+	t.Run("ok - not configured", func(t *testing.T) {
+		hook, err := newWebhook(NotificationConfig{}, true)
+
+		assert.NoError(t, err)
+		assert.Nil(t, hook)
+	})
+	t.Run("error - relative URL", func(t *testing.T) {
+		_, err := newWebhook(NotificationConfig{Webhook: "/credentials"}, false)
+
+		assert.EqualError(t, err, "invalid webhook URL: must be an absolute HTTP(S) URL: /credentials")
+	})
+	t.Run("error - HTTP in strict mode", func(t *testing.T) {
+		_, err := newWebhook(NotificationConfig{Webhook: "http://example.com/credentials"}, true)
+
+		assert.EqualError(t, err, "invalid webhook URL: must use HTTPS in strict mode")
+	})
+}
+
+func TestWebhook_send(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		var contentType string
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			contentType = request.Header.Get("Content-Type")
+		}))
+		defer server.Close()
+		hook, _ := newWebhook(NotificationConfig{Webhook: server.URL, WebhookTimeout: time.Second}, false)
+
+		err := hook.send(testReceivedEvent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "application/json", contentType)
+	})
+	t.Run("error - status code", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		hook, _ := newWebhook(NotificationConfig{Webhook: server.URL, WebhookTimeout: time.Second}, false)
+
+		err := hook.send(testReceivedEvent)
+
+		assert.EqualError(t, err, "webhook returned status code 500")
+	})
+}
+
+func TestVcr_SubscribeCredentialReceived(t *testing.T) {
+	ctx := newMockContext(t)
+	ctx.vcr.notifier.publish = func(event CredentialReceivedEvent) error {
+		return nil
+	}
+	var called []CredentialReceivedEvent
+	ctx.vcr.SubscribeCredentialReceived(func(event CredentialReceivedEvent) {
+		called = append(called, event)
+	})
+
+	ctx.vcr.notifier.notify(testReceivedEvent)
+
+	assert.Equal(t, []CredentialReceivedEvent{testReceivedEvent}, called)
+}
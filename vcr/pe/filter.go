@@ -0,0 +1,187 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package pe
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+)
+
+// annotationKeywords are JSON Schema keywords that don't affect whether a value matches.
+var annotationKeywords = map[string]bool{
+	"$schema":     true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"format":      true,
+}
+
+// matchFilter checks whether the given (decoded) JSON value matches the filter of a field.
+// The filter is a JSON Schema, of which the keywords type, const, enum, pattern, minimum, maximum, exclusiveMinimum,
+// exclusiveMaximum, minLength, maxLength, contains and not are supported. Other keywords result in an error,
+// rather than silently matching values the verifier didn't ask for.
+func matchFilter(filter map[string]interface{}, value interface{}) (bool, error) {
+	for keyword, argument := range filter {
+		if annotationKeywords[keyword] {
+			continue
+		}
+		matches, err := matchKeyword(keyword, argument, value)
+		if err != nil {
+			return false, err
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func matchKeyword(keyword string, argument interface{}, value interface{}) (bool, error) {
+	switch keyword {
+	case "type":
+		return matchType(argument, value)
+	case "const":
+		return reflect.DeepEqual(argument, value), nil
+	case "enum":
+		options, ok := argument.([]interface{})
+		if !ok {
+			return false, fmt.Errorf("invalid filter, 'enum' must be an array")
+		}
+		for _, option := range options {
+			if reflect.DeepEqual(option, value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "pattern":
+		pattern, ok := argument.(string)
+		if !ok {
+			return false, fmt.Errorf("invalid filter, 'pattern' must be a string")
+		}
+		str, ok := value.(string)
+		if !ok {
+			// JSON Schema keywords only apply to values of the type they're defined for
+			return true, nil
+		}
+		expr, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, fmt.Errorf("invalid filter, invalid 'pattern': %w", err)
+		}
+		return expr.MatchString(str), nil
+	case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum":
+		limit, ok := argument.(float64)
+		if !ok {
+			return false, fmt.Errorf("invalid filter, '%s' must be a number", keyword)
+		}
+		number, ok := value.(float64)
+		if !ok {
+			return true, nil
+		}
+		switch keyword {
+		case "minimum":
+			return number >= limit, nil
+		case "maximum":
+			return number <= limit, nil
+		case "exclusiveMinimum":
+			return number > limit, nil
+		default:
+			return number < limit, nil
+		}
+	case "minLength", "maxLength":
+		limit, ok := argument.(float64)
+		if !ok {
+			return false, fmt.Errorf("invalid filter, '%s' must be a number", keyword)
+		}
+		str, ok := value.(string)
+		if !ok {
+			return true, nil
+		}
+		length := float64(utf8.RuneCountInString(str))
+		if keyword == "minLength" {
+			return length >= limit, nil
+		}
+		return length <= limit, nil
+	case "contains":
+		subFilter, ok := argument.(map[string]interface{})
+		if !ok {
+			return false, fmt.Errorf("invalid filter, 'contains' must be an object")
+		}
+		elements, ok := value.([]interface{})
+		if !ok {
+			return true, nil
+		}
+		for _, element := range elements {
+			matches, err := matchFilter(subFilter, element)
+			if err != nil {
+				return false, err
+			}
+			if matches {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "not":
+		subFilter, ok := argument.(map[string]interface{})
+		if !ok {
+			return false, fmt.Errorf("invalid filter, 'not' must be an object")
+		}
+		matches, err := matchFilter(subFilter, value)
+		return !matches, err
+	}
+	return false, fmt.Errorf("unsupported filter keyword: %s", keyword)
+}
+
+func matchType(argument interface{}, value interface{}) (bool, error) {
+	var types []interface{}
+	switch typed := argument.(type) {
+	case string:
+		types = []interface{}{typed}
+	case []interface{}:
+		types = typed
+	default:
+		return false, fmt.Errorf("invalid filter, 'type' must be a string or an array of strings")
+	}
+	for _, curr := range types {
+		if jsonType(value, curr) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func jsonType(value interface{}, expected interface{}) bool {
+	switch typed := value.(type) {
+	case string:
+		return expected == "string"
+	case float64:
+		return expected == "number" || (expected == "integer" && typed == float64(int64(typed)))
+	case bool:
+		return expected == "boolean"
+	case []interface{}:
+		return expected == "array"
+	case map[string]interface{}:
+		return expected == "object"
+	case nil:
+		return expected == "null"
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package pe
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_matchFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filter   string
+		value    interface{}
+		expected bool
+	}{
+		{"type string", `{"type": "string"}`, "foo", true},
+		{"type string mismatch", `{"type": "string"}`, float64(1), false},
+		{"type integer", `{"type": "integer"}`, float64(1), true},
+		{"type integer mismatch", `{"type": "integer"}`, 1.5, false},
+		{"type array of types", `{"type": ["number", "null"]}`, nil, true},
+		{"const", `{"const": "NutsOrganizationCredential"}`, "NutsOrganizationCredential", true},
+		{"const mismatch", `{"const": "NutsOrganizationCredential"}`, "NutsAuthorizationCredential", false},
+		{"enum", `{"enum": ["a", "b"]}`, "b", true},
+		{"enum mismatch", `{"enum": ["a", "b"]}`, "c", false},
+		{"pattern", `{"type": "string", "pattern": "^did:nuts:"}`, "did:nuts:123", true},
+		{"pattern mismatch", `{"type": "string", "pattern": "^did:nuts:"}`, "did:web:example.com", false},
+		{"pattern ignores other types", `{"pattern": "^did:nuts:"}`, float64(1), true},
+		{"minimum/maximum", `{"minimum": 1, "maximum": 2}`, float64(2), true},
+		{"exclusiveMaximum mismatch", `{"exclusiveMaximum": 2}`, float64(2), false},
+		{"exclusiveMinimum mismatch", `{"exclusiveMinimum": 2}`, float64(2), false},
+		{"minLength/maxLength", `{"minLength": 1, "maxLength": 3}`, "abc", true},
+		{"maxLength mismatch", `{"maxLength": 2}`, "abc", false},
+		{"contains", `{"type": "array", "contains": {"const": "NutsOrganizationCredential"}}`, []interface{}{"VerifiableCredential", "NutsOrganizationCredential"}, true},
+		{"contains mismatch", `{"type": "array", "contains": {"const": "NutsOrganizationCredential"}}`, []interface{}{"VerifiableCredential"}, false},
+		{"not", `{"not": {"const": "a"}}`, "b", true},
+		{"not mismatch", `{"not": {"const": "a"}}`, "a", false},
+		{"annotations are ignored", `{"$schema": "http://json-schema.org/draft-07/schema#", "title": "foo", "format": "date"}`, "bar", true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			filter := map[string]interface{}{}
+			_ = json.Unmarshal([]byte(testCase.filter), &filter)
+
+			matches, err := matchFilter(filter, testCase.value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, matches)
+		})
+	}
+
+	t.Run("error - unsupported keyword", func(t *testing.T) {
+		_, err := matchFilter(map[string]interface{}{"oneOf": []interface{}{}}, "foo")
+
+		assert.EqualError(t, err, "unsupported filter keyword: oneOf")
+	})
+	t.Run("error - invalid pattern", func(t *testing.T) {
+		_, err := matchFilter(map[string]interface{}{"pattern": "("}, "foo")
+
+		assert.Error(t, err)
+	})
+	t.Run("error - invalid argument", func(t *testing.T) {
+		_, err := matchFilter(map[string]interface{}{"minimum": "1"}, float64(1))
+
+		assert.EqualError(t, err, "invalid filter, 'minimum' must be a number")
+	})
+}
@@ -0,0 +1,135 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package pe
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// wildcard is the path segment that selects all members of an object or all elements of an array.
+const wildcard = "*"
+
+// pathSegment is a single step of a JSONPath expression: either a member name, an array index or the wildcard.
+type pathSegment struct {
+	name  string
+	index *int
+}
+
+// parseJSONPath parses the subset of JSONPath that is used in practice by presentation definitions:
+// the root ($), member access (.name and ['name']), array indices ([0]) and wildcards (.* and [*]).
+// Other expressions (e.g. recursive descent and filter expressions) are not supported.
+func parseJSONPath(path string) ([]pathSegment, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid JSONPath, must start with '$': %s", path)
+	}
+	var segments []pathSegment
+	remaining := path[1:]
+	for len(remaining) > 0 {
+		switch remaining[0] {
+		case '.':
+			remaining = remaining[1:]
+			if strings.HasPrefix(remaining, ".") {
+				return nil, fmt.Errorf("unsupported JSONPath, recursive descent is not supported: %s", path)
+			}
+			end := strings.IndexAny(remaining, ".[")
+			if end == -1 {
+				end = len(remaining)
+			}
+			name := remaining[:end]
+			if name == "" {
+				return nil, fmt.Errorf("invalid JSONPath, empty member name: %s", path)
+			}
+			segments = append(segments, pathSegment{name: name})
+			remaining = remaining[end:]
+		case '[':
+			end := strings.Index(remaining, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("invalid JSONPath, missing ']': %s", path)
+			}
+			segment, err := parseBracketSegment(remaining[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", err, path)
+			}
+			segments = append(segments, segment)
+			remaining = remaining[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid JSONPath, unexpected character '%c': %s", remaining[0], path)
+		}
+	}
+	return segments, nil
+}
+
+func parseBracketSegment(value string) (pathSegment, error) {
+	if value == wildcard {
+		return pathSegment{name: wildcard}, nil
+	}
+	if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+		return pathSegment{name: value[1 : len(value)-1]}, nil
+	}
+	index, err := strconv.Atoi(value)
+	if err != nil || index < 0 {
+		return pathSegment{}, fmt.Errorf("unsupported JSONPath, invalid array index or member '%s'", value)
+	}
+	return pathSegment{index: &index}, nil
+}
+
+// evaluateJSONPath evaluates the JSONPath expression on the given (decoded) JSON document.
+// It returns all values that were found, which is at most 1 value if the expression doesn't contain wildcards.
+func evaluateJSONPath(path string, document interface{}) ([]interface{}, error) {
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	current := []interface{}{document}
+	for _, segment := range segments {
+		var next []interface{}
+		for _, value := range current {
+			next = append(next, segment.apply(value)...)
+		}
+		current = next
+	}
+	return current, nil
+}
+
+func (s pathSegment) apply(value interface{}) []interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		if s.name == wildcard {
+			result := make([]interface{}, 0, len(typed))
+			for _, member := range typed {
+				result = append(result, member)
+			}
+			return result
+		}
+		if member, ok := typed[s.name]; ok && s.index == nil {
+			return []interface{}{member}
+		}
+	case []interface{}:
+		if s.name == wildcard {
+			return typed
+		}
+		if s.index != nil && *s.index < len(typed) {
+			return []interface{}{typed[*s.index]}
+		}
+	}
+	return nil
+}
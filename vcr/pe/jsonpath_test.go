@@ -0,0 +1,72 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package pe
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_evaluateJSONPath(t *testing.T) {
+	var document interface{}
+	_ = json.Unmarshal([]byte(`{
+		"type": ["VerifiableCredential", "NutsOrganizationCredential"],
+		"credentialSubject": {
+			"id": "did:nuts:123",
+			"organization": {"name": "Because we care B.V.", "city": "IJbergen"}
+		}
+	}`), &document)
+
+	testCases := []struct {
+		path     string
+		expected []interface{}
+	}{
+		{"$", []interface{}{document}},
+		{"$.credentialSubject.id", []interface{}{"did:nuts:123"}},
+		{"$['credentialSubject']['organization'][\"city\"]", []interface{}{"IJbergen"}},
+		{"$.credentialSubject.organization.city", []interface{}{"IJbergen"}},
+		{"$.type[1]", []interface{}{"NutsOrganizationCredential"}},
+		{"$.type[*]", []interface{}{"VerifiableCredential", "NutsOrganizationCredential"}},
+		{"$.type.*", []interface{}{"VerifiableCredential", "NutsOrganizationCredential"}},
+		{"$.credentialSubject.*.name", []interface{}{"Because we care B.V."}},
+		{"$.type[2]", nil},
+		{"$.credentialSubject.unknown", nil},
+		{"$.credentialSubject.id.unknown", nil},
+		{"$.credentialSubject[0]", nil},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.path, func(t *testing.T) {
+			values, err := evaluateJSONPath(testCase.path, document)
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, values)
+		})
+	}
+
+	t.Run("error - unsupported or invalid", func(t *testing.T) {
+		for _, path := range []string{"credentialSubject.id", "$..id", "$.type[-1]", "$.type[?(@ == 'x')]", "$.type[0", "$.", "$x"} {
+			_, err := evaluateJSONPath(path, document)
+
+			assert.Error(t, err, path)
+		}
+	})
+}
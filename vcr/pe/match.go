@@ -0,0 +1,248 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package pe
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+)
+
+// ErrNoCredentials is returned when none of the given credentials match an input descriptor of a presentation definition.
+var ErrNoCredentials = errors.New("no matching credentials for input descriptor")
+
+// verifiableCredentialPath is the JSONPath of the credentials in a presentation, which is indexed to refer to a specific credential.
+const verifiableCredentialPath = "$.verifiableCredential"
+
+// Match selects a credential from the given candidates for every input descriptor of the presentation definition.
+// It returns the selected credentials, in the order they must appear in the presentation, and the submission that maps
+// the input descriptors to them. A credential that matches multiple input descriptors is only selected once.
+// It returns ErrNoCredentials if an input descriptor can't be satisfied by any of the candidates.
+func Match(definition PresentationDefinition, candidates []vc.VerifiableCredential) ([]vc.VerifiableCredential, *PresentationSubmission, error) {
+	if err := checkSupported(definition); err != nil {
+		return nil, nil, err
+	}
+	documents := make([]interface{}, len(candidates))
+	for i, candidate := range candidates {
+		document, err := toJSONDocument(candidate)
+		if err != nil {
+			return nil, nil, err
+		}
+		documents[i] = document
+	}
+
+	var selected []vc.VerifiableCredential
+	selectedIndices := map[int]int{}
+	submission := PresentationSubmission{
+		ID:            uuid.NewString(),
+		DefinitionID:  definition.ID,
+		DescriptorMap: make([]InputDescriptorMapping, 0, len(definition.InputDescriptors)),
+	}
+	for _, descriptor := range definition.InputDescriptors {
+		match := -1
+		for i, candidate := range candidates {
+			matches, err := matchDescriptor(definition, descriptor, candidate, documents[i])
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid input descriptor (id=%s): %w", descriptor.ID, err)
+			}
+			if matches {
+				match = i
+				break
+			}
+		}
+		if match == -1 {
+			return nil, nil, fmt.Errorf("%w (id=%s)", ErrNoCredentials, descriptor.ID)
+		}
+		index, ok := selectedIndices[match]
+		if !ok {
+			index = len(selected)
+			selectedIndices[match] = index
+			selected = append(selected, candidates[match])
+		}
+		submission.DescriptorMap = append(submission.DescriptorMap, InputDescriptorMapping{
+			ID:     descriptor.ID,
+			Format: string(CredentialFormat(candidates[match])),
+			Path:   fmt.Sprintf("%s[%d]", verifiableCredentialPath, index),
+		})
+	}
+	return selected, &submission, nil
+}
+
+// Validate checks whether the presentation satisfies the presentation definition, according to the given submission.
+// Every input descriptor must be mapped to a credential in the presentation that matches its constraints.
+// It doesn't verify the presentation and its credentials, which must be done by the caller.
+func Validate(definition PresentationDefinition, submission PresentationSubmission, presentation vc.VerifiablePresentation) error {
+	if err := checkSupported(definition); err != nil {
+		return err
+	}
+	if submission.DefinitionID != definition.ID {
+		return fmt.Errorf("presentation submission is for another presentation definition (definition_id=%s)", submission.DefinitionID)
+	}
+	// The credentials are always evaluated as array, since a single credential is marshalled as object.
+	credentials := make([]interface{}, len(presentation.VerifiableCredential))
+	for i, credential := range presentation.VerifiableCredential {
+		document, err := toJSONDocument(credential)
+		if err != nil {
+			return err
+		}
+		credentials[i] = document
+	}
+	presentationDocument := map[string]interface{}{"verifiableCredential": credentials}
+
+	for _, descriptor := range definition.InputDescriptors {
+		mapping := submission.findMapping(descriptor.ID)
+		if mapping == nil {
+			return fmt.Errorf("input descriptor is not satisfied by presentation submission (id=%s)", descriptor.ID)
+		}
+		values, err := evaluateJSONPath(mapping.Path, presentationDocument)
+		if err != nil {
+			return fmt.Errorf("invalid descriptor map (id=%s): %w", descriptor.ID, err)
+		}
+		if len(values) != 1 {
+			return fmt.Errorf("descriptor map path does not refer to a single credential (id=%s)", descriptor.ID)
+		}
+		credential, err := fromJSONDocument(values[0])
+		if err != nil {
+			return fmt.Errorf("descriptor map path does not refer to a credential (id=%s): %w", descriptor.ID, err)
+		}
+		if mapping.Format != string(CredentialFormat(*credential)) {
+			return fmt.Errorf("descriptor map format does not match credential (id=%s)", descriptor.ID)
+		}
+		matches, err := matchDescriptor(definition, descriptor, *credential, values[0])
+		if err != nil {
+			return fmt.Errorf("invalid input descriptor (id=%s): %w", descriptor.ID, err)
+		}
+		if !matches {
+			return fmt.Errorf("credential does not match input descriptor (id=%s)", descriptor.ID)
+		}
+	}
+	return nil
+}
+
+// CredentialFormat returns the Presentation Exchange format of the credential: jwt_vc if it's JWT-encoded,
+// vc+sd-jwt if it's SD-JWT-encoded, ldp_vc otherwise.
+func CredentialFormat(credential vc.VerifiableCredential) types.Format {
+	for _, curr := range credential.Proof {
+		proofAsMap, ok := curr.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch proofAsMap["type"] {
+		case string(proof.JwtProof2020):
+			return types.JWTCredentialFormat
+		case string(proof.SdJwtProof2022):
+			return types.SDJWTCredentialFormat
+		}
+	}
+	return types.JSONLDCredentialFormat
+}
+
+func checkSupported(definition PresentationDefinition) error {
+	if len(definition.SubmissionRequirements) > 0 {
+		return errors.New("submission requirements are not supported")
+	}
+	return nil
+}
+
+func (s PresentationSubmission) findMapping(descriptorID string) *InputDescriptorMapping {
+	for _, mapping := range s.DescriptorMap {
+		if mapping.ID == descriptorID {
+			return &mapping
+		}
+	}
+	return nil
+}
+
+// matchDescriptor checks whether the credential (and its JSON form) matches the format and constraints of the input descriptor.
+func matchDescriptor(definition PresentationDefinition, descriptor InputDescriptor, credential vc.VerifiableCredential, document interface{}) (bool, error) {
+	formats := descriptor.Format
+	if len(formats) == 0 {
+		formats = definition.Format
+	}
+	if len(formats) > 0 {
+		if _, ok := formats[string(CredentialFormat(credential))]; !ok {
+			return false, nil
+		}
+	}
+	if descriptor.Constraints == nil {
+		return true, nil
+	}
+	for _, field := range descriptor.Constraints.Fields {
+		matches, err := matchField(field, document)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// matchField checks whether the credential contains the field. The paths are evaluated in order, the first path that
+// yields a value matching the filter satisfies the field.
+func matchField(field Field, document interface{}) (bool, error) {
+	if len(field.Path) == 0 {
+		return false, errors.New("field must contain at least 1 path")
+	}
+	for _, path := range field.Path {
+		values, err := evaluateJSONPath(path, document)
+		if err != nil {
+			return false, err
+		}
+		for _, value := range values {
+			if field.Filter == nil {
+				return true, nil
+			}
+			matches, err := matchFilter(field.Filter, value)
+			if err != nil {
+				return false, err
+			}
+			if matches {
+				return true, nil
+			}
+		}
+	}
+	return field.Optional, nil
+}
+
+func toJSONDocument(credential vc.VerifiableCredential) (interface{}, error) {
+	data, err := json.Marshal(credential)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	err = json.Unmarshal(data, &document)
+	return document, err
+}
+
+func fromJSONDocument(document interface{}) (*vc.VerifiableCredential, error) {
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	var credential vc.VerifiableCredential
+	if err = json.Unmarshal(data, &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
@@ -0,0 +1,241 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package pe
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const organizationCredentialJSON = `{
+	"@context": ["https://www.w3.org/2018/credentials/v1", "https://nuts.nl/credentials/v1"],
+	"id": "did:nuts:issuer#1",
+	"type": ["VerifiableCredential", "NutsOrganizationCredential"],
+	"issuer": "did:nuts:issuer",
+	"issuanceDate": "2022-01-01T00:00:00Z",
+	"credentialSubject": {
+		"id": "did:nuts:holder",
+		"organization": {"name": "Because we care B.V.", "city": "IJbergen"}
+	},
+	"proof": {"type": "JsonWebSignature2020", "jws": "header..signature"}
+}`
+
+const authorizationCredentialJSON = `{
+	"@context": ["https://www.w3.org/2018/credentials/v1", "https://nuts.nl/credentials/v1"],
+	"id": "did:nuts:issuer#2",
+	"type": ["VerifiableCredential", "NutsAuthorizationCredential"],
+	"issuer": "did:nuts:issuer",
+	"issuanceDate": "2022-01-01T00:00:00Z",
+	"credentialSubject": {
+		"id": "did:nuts:holder",
+		"purposeOfUse": "eTransfer"
+	},
+	"proof": {"type": "JwtProof2020", "jwt": "a.b.c"}
+}`
+
+const definitionJSON = `{
+	"id": "definition",
+	"input_descriptors": [
+		{
+			"id": "organization",
+			"constraints": {
+				"fields": [
+					{"path": ["$.type"], "filter": {"type": "array", "contains": {"const": "NutsOrganizationCredential"}}},
+					{"path": ["$.credentialSubject.organization.city", "$.credentialSubject.city"], "filter": {"type": "string", "pattern": "^IJ"}},
+					{"path": ["$.credentialSubject.organization.kvk"], "optional": true}
+				]
+			}
+		},
+		{
+			"id": "authorization",
+			"format": {"jwt_vc": {"alg": ["ES256"]}},
+			"constraints": {
+				"fields": [
+					{"path": ["$.credentialSubject.purposeOfUse"], "filter": {"const": "eTransfer"}}
+				]
+			}
+		}
+	]
+}`
+
+func testCredentials() (vc.VerifiableCredential, vc.VerifiableCredential) {
+	organizationCredential := vc.VerifiableCredential{}
+	_ = json.Unmarshal([]byte(organizationCredentialJSON), &organizationCredential)
+	authorizationCredential := vc.VerifiableCredential{}
+	_ = json.Unmarshal([]byte(authorizationCredentialJSON), &authorizationCredential)
+	return organizationCredential, authorizationCredential
+}
+
+func testDefinition() PresentationDefinition {
+	definition := PresentationDefinition{}
+	_ = json.Unmarshal([]byte(definitionJSON), &definition)
+	return definition
+}
+
+func TestMatch(t *testing.T) {
+	organizationCredential, authorizationCredential := testCredentials()
+
+	t.Run("ok", func(t *testing.T) {
+		credentials, submission, err := Match(testDefinition(), []vc.VerifiableCredential{authorizationCredential, organizationCredential})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []vc.VerifiableCredential{organizationCredential, authorizationCredential}, credentials)
+		assert.NotEmpty(t, submission.ID)
+		assert.Equal(t, "definition", submission.DefinitionID)
+		assert.Equal(t, []InputDescriptorMapping{
+			{ID: "organization", Format: "ldp_vc", Path: "$.verifiableCredential[0]"},
+			{ID: "authorization", Format: "jwt_vc", Path: "$.verifiableCredential[1]"},
+		}, submission.DescriptorMap)
+	})
+	t.Run("ok - credential matching multiple input descriptors is selected once", func(t *testing.T) {
+		definition := PresentationDefinition{
+			ID: "definition",
+			InputDescriptors: []InputDescriptor{
+				{ID: "1", Constraints: &Constraints{Fields: []Field{{Path: []string{"$.credentialSubject.organization.name"}}}}},
+				{ID: "2", Constraints: &Constraints{Fields: []Field{{Path: []string{"$.credentialSubject.organization.city"}}}}},
+			},
+		}
+
+		credentials, submission, err := Match(definition, []vc.VerifiableCredential{authorizationCredential, organizationCredential})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []vc.VerifiableCredential{organizationCredential}, credentials)
+		assert.Equal(t, "$.verifiableCredential[0]", submission.DescriptorMap[0].Path)
+		assert.Equal(t, "$.verifiableCredential[0]", submission.DescriptorMap[1].Path)
+	})
+	t.Run("error - no matching credential", func(t *testing.T) {
+		_, _, err := Match(testDefinition(), []vc.VerifiableCredential{organizationCredential})
+
+		assert.True(t, errors.Is(err, ErrNoCredentials))
+		assert.EqualError(t, err, "no matching credentials for input descriptor (id=authorization)")
+	})
+	t.Run("error - format mismatch", func(t *testing.T) {
+		definition := testDefinition()
+		definition.InputDescriptors[1].Format = map[string]interface{}{"ldp_vc": map[string]interface{}{}}
+
+		_, _, err := Match(definition, []vc.VerifiableCredential{authorizationCredential, organizationCredential})
+
+		assert.True(t, errors.Is(err, ErrNoCredentials))
+	})
+	t.Run("error - submission requirements", func(t *testing.T) {
+		definition := testDefinition()
+		definition.SubmissionRequirements = []interface{}{map[string]interface{}{"rule": "all"}}
+
+		_, _, err := Match(definition, []vc.VerifiableCredential{organizationCredential})
+
+		assert.EqualError(t, err, "submission requirements are not supported")
+	})
+	t.Run("error - invalid input descriptor", func(t *testing.T) {
+		definition := PresentationDefinition{
+			InputDescriptors: []InputDescriptor{{ID: "1", Constraints: &Constraints{Fields: []Field{{Path: []string{"$..id"}}}}}},
+		}
+
+		_, _, err := Match(definition, []vc.VerifiableCredential{organizationCredential})
+
+		assert.EqualError(t, err, "invalid input descriptor (id=1): unsupported JSONPath, recursive descent is not supported: $..id")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	organizationCredential, authorizationCredential := testCredentials()
+	presentation := vc.VerifiablePresentation{VerifiableCredential: []vc.VerifiableCredential{organizationCredential, authorizationCredential}}
+	submission := func() PresentationSubmission {
+		return PresentationSubmission{
+			ID:           "submission",
+			DefinitionID: "definition",
+			DescriptorMap: []InputDescriptorMapping{
+				{ID: "organization", Format: "ldp_vc", Path: "$.verifiableCredential[0]"},
+				{ID: "authorization", Format: "jwt_vc", Path: "$.verifiableCredential[1]"},
+			},
+		}
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		err := Validate(testDefinition(), submission(), presentation)
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - matches result of Match", func(t *testing.T) {
+		credentials, matchedSubmission, _ := Match(testDefinition(), []vc.VerifiableCredential{authorizationCredential, organizationCredential})
+
+		err := Validate(testDefinition(), *matchedSubmission, vc.VerifiablePresentation{VerifiableCredential: credentials})
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - other definition", func(t *testing.T) {
+		s := submission()
+		s.DefinitionID = "other"
+
+		err := Validate(testDefinition(), s, presentation)
+
+		assert.EqualError(t, err, "presentation submission is for another presentation definition (definition_id=other)")
+	})
+	t.Run("error - input descriptor not mapped", func(t *testing.T) {
+		s := submission()
+		s.DescriptorMap = s.DescriptorMap[:1]
+
+		err := Validate(testDefinition(), s, presentation)
+
+		assert.EqualError(t, err, "input descriptor is not satisfied by presentation submission (id=authorization)")
+	})
+	t.Run("error - path refers to nothing", func(t *testing.T) {
+		s := submission()
+		s.DescriptorMap[1].Path = "$.verifiableCredential[2]"
+
+		err := Validate(testDefinition(), s, presentation)
+
+		assert.EqualError(t, err, "descriptor map path does not refer to a single credential (id=authorization)")
+	})
+	t.Run("error - format mismatch", func(t *testing.T) {
+		s := submission()
+		s.DescriptorMap[1].Format = "ldp_vc"
+
+		err := Validate(testDefinition(), s, presentation)
+
+		assert.EqualError(t, err, "descriptor map format does not match credential (id=authorization)")
+	})
+	t.Run("error - credential does not match", func(t *testing.T) {
+		s := submission()
+		s.DescriptorMap[0].Path = "$.verifiableCredential[1]"
+		s.DescriptorMap[0].Format = "jwt_vc"
+
+		err := Validate(testDefinition(), s, presentation)
+
+		assert.EqualError(t, err, "credential does not match input descriptor (id=organization)")
+	})
+}
+
+func TestCredentialFormat(t *testing.T) {
+	organizationCredential, authorizationCredential := testCredentials()
+
+	assert.Equal(t, types.JSONLDCredentialFormat, CredentialFormat(organizationCredential))
+	assert.Equal(t, types.JWTCredentialFormat, CredentialFormat(authorizationCredential))
+	sdJWTCredential := vc.VerifiableCredential{Proof: []interface{}{map[string]interface{}{"type": "SdJwtProof2022"}}}
+	assert.Equal(t, types.SDJWTCredentialFormat, CredentialFormat(sdJWTCredential))
+}
@@ -0,0 +1,93 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+// Package pe implements the parts of DIF Presentation Exchange v2 (https://identity.foundation/presentation-exchange/spec/v2.0.0/)
+// that are needed to select credentials for a presentation definition and to validate a presentation against it.
+package pe
+
+// PresentationDefinition describes the proofs a verifier requires, as specified by Presentation Exchange.
+type PresentationDefinition struct {
+	// ID uniquely identifies the presentation definition.
+	ID string `json:"id"`
+	// Name is an optional human-friendly name of the presentation definition.
+	Name string `json:"name,omitempty"`
+	// Purpose optionally describes why the proofs are requested.
+	Purpose string `json:"purpose,omitempty"`
+	// Format optionally restricts the formats of the presented credentials, keyed by format (e.g. ldp_vc, jwt_vc).
+	Format map[string]interface{} `json:"format,omitempty"`
+	// InputDescriptors describe the credentials that must be presented.
+	InputDescriptors []InputDescriptor `json:"input_descriptors"`
+	// SubmissionRequirements are not supported: presentation definitions that contain them are rejected.
+	SubmissionRequirements []interface{} `json:"submission_requirements,omitempty"`
+}
+
+// InputDescriptor describes a single credential that must be presented.
+type InputDescriptor struct {
+	// ID uniquely identifies the input descriptor within the presentation definition.
+	ID string `json:"id"`
+	// Name is an optional human-friendly name of the input descriptor.
+	Name string `json:"name,omitempty"`
+	// Purpose optionally describes why the credential is requested.
+	Purpose string `json:"purpose,omitempty"`
+	// Format optionally restricts the format of the credential, keyed by format (e.g. ldp_vc, jwt_vc).
+	// It overrides the format of the presentation definition.
+	Format map[string]interface{} `json:"format,omitempty"`
+	// Constraints contains the constraints the credential must satisfy.
+	Constraints *Constraints `json:"constraints,omitempty"`
+}
+
+// Constraints contains the constraints a credential must satisfy to match an input descriptor.
+type Constraints struct {
+	// Fields contains the fields the credential must contain.
+	Fields []Field `json:"fields,omitempty"`
+}
+
+// Field describes a field of the credential and the value it must contain.
+type Field struct {
+	// Path contains JSONPath expressions, which are evaluated in order. The first one that yields a value is used.
+	Path []string `json:"path"`
+	// ID optionally identifies the field.
+	ID string `json:"id,omitempty"`
+	// Purpose optionally describes why the field is requested.
+	Purpose string `json:"purpose,omitempty"`
+	// Filter contains a JSON Schema the value must match. When nil, any value matches.
+	Filter map[string]interface{} `json:"filter,omitempty"`
+	// Optional indicates that a credential without the field still matches.
+	Optional bool `json:"optional,omitempty"`
+}
+
+// PresentationSubmission describes how the credentials of a presentation satisfy the input descriptors of a presentation definition.
+type PresentationSubmission struct {
+	// ID uniquely identifies the presentation submission.
+	ID string `json:"id"`
+	// DefinitionID contains the ID of the presentation definition the submission is for.
+	DefinitionID string `json:"definition_id"`
+	// DescriptorMap maps each input descriptor to a credential in the presentation.
+	DescriptorMap []InputDescriptorMapping `json:"descriptor_map"`
+}
+
+// InputDescriptorMapping maps an input descriptor to the credential in the presentation that satisfies it.
+type InputDescriptorMapping struct {
+	// ID contains the ID of the input descriptor.
+	ID string `json:"id"`
+	// Format contains the format of the credential (ldp_vc or jwt_vc).
+	Format string `json:"format"`
+	// Path contains the JSONPath of the credential within the presentation, e.g. $.verifiableCredential[0].
+	Path string `json:"path"`
+}
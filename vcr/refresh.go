@@ -0,0 +1,146 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+	"github.com/nuts-foundation/nuts-node/vcr/verifier"
+)
+
+// refreshNonceValidity specifies how long a nonce for refreshing a credential can be used.
+const refreshNonceValidity = 5 * time.Minute
+
+func (c *vcr) CreateRefreshNonce() string {
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+	now := timeFunc()
+	// remove the nonces that were never used
+	for nonce, expiry := range c.refreshNonces {
+		if !now.Before(expiry) {
+			delete(c.refreshNonces, nonce)
+		}
+	}
+	nonce := uuid.NewString()
+	c.refreshNonces[nonce] = now.Add(refreshNonceValidity)
+	return nonce
+}
+
+// useRefreshNonce removes the nonce, so it can only be used once. It returns false if the nonce is unknown or expired.
+func (c *vcr) useRefreshNonce(nonce string) bool {
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+	expiry, ok := c.refreshNonces[nonce]
+	delete(c.refreshNonces, nonce)
+	return ok && timeFunc().Before(expiry)
+}
+
+func (c *vcr) RefreshCredential(presentation vc.VerifiablePresentation, nonce string) (*vc.VerifiableCredential, error) {
+	if len(presentation.VerifiableCredential) != 1 || presentation.VerifiableCredential[0].ID == nil {
+		return nil, core.InvalidInputError("presentation must contain exactly one credential with an ID")
+	}
+	if !c.useRefreshNonce(nonce) {
+		return nil, core.InvalidInputError("unknown or expired nonce")
+	}
+	// continue with the issued credential, so the holder can't alter it
+	credential, err := c.issuerStore.GetCredential(*presentation.VerifiableCredential[0].ID)
+	if errors.Is(err, issuer.ErrNotFound) {
+		return nil, core.NotFoundError("credential is not issued by this node")
+	}
+	if err != nil {
+		return nil, err
+	}
+	credentialType, err := credentialTypeOf(*credential)
+	if err != nil {
+		return nil, err
+	}
+	if !c.config.Refresh.refreshes(credentialType.String()) {
+		return nil, core.PreconditionFailedError("credentials of this type can't be refreshed (credentialType=%s)", credentialType)
+	}
+	if credential.ExpirationDate == nil {
+		return nil, core.PreconditionFailedError("credential doesn't expire")
+	}
+
+	// the presentation must be signed by the subject, for this issuer and with the nonce, so it can't be replayed
+	subjectID, err := credentialSubjectID(*credential)
+	if err != nil {
+		return nil, core.PreconditionFailedError("credential can't be refreshed: %w", err)
+	}
+	domain := credential.Issuer.String()
+	result := c.verifier.VerifyVP(presentation, verifier.VPVerificationOptions{Challenge: &nonce, Domain: &domain, AllowUntrustedIssuer: true})
+	if result.Err != nil {
+		return nil, core.InvalidInputError("invalid presentation: %w", result.Err)
+	}
+	if result.Holder == nil || result.Holder.String() != subjectID.String() {
+		return nil, core.InvalidInputError("presentation must be signed by the credential subject")
+	}
+
+	// the credential is revoked when it's refreshed, refreshing one at a time makes sure it only gets a single successor
+	c.reissueMutex.Lock()
+	defer c.reissueMutex.Unlock()
+	revoked, err := c.verifier.IsRevoked(*credential.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check revocation of credential: %w", err)
+	}
+	if revoked {
+		return nil, core.PreconditionFailedError("credential has been revoked")
+	}
+	if err := c.checkRefreshPolicies(*credential); err != nil {
+		return nil, core.PreconditionFailedError("credential can't be refreshed: %w", err)
+	}
+
+	// the successor is valid for the same period as the credential, from now
+	validity := credential.ExpirationDate.Sub(credential.IssuanceDate)
+	successor, err := c.reissue(*credential, *credentialType, timeFunc().Add(validity))
+	if err != nil {
+		return nil, fmt.Errorf("unable to refresh credential: %w", err)
+	}
+	log.Logger().Infof("Issued credential has been refreshed at the request of its subject (id=%s, successor=%s)", credential.ID, successor.ID)
+	// the subject already received the successor, so it's returned even if the credential couldn't be revoked
+	if _, err := c.issuer.Revoke(*credential.ID, fmt.Sprintf("superseded by refreshed credential %s", successor.ID)); err != nil {
+		log.Logger().WithError(err).Errorf("Unable to revoke refreshed credential (id=%s)", credential.ID)
+	}
+	return successor, nil
+}
+
+func (c *vcr) RegisterRefreshPolicy(policy RefreshPolicy) {
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+	c.refreshPolicies = append(c.refreshPolicies, policy)
+}
+
+// checkRefreshPolicies returns the error of the first registered policy that doesn't allow the credential to be refreshed.
+func (c *vcr) checkRefreshPolicies(credential vc.VerifiableCredential) error {
+	c.refreshMutex.RLock()
+	defer c.refreshMutex.RUnlock()
+	for _, policy := range c.refreshPolicies {
+		if err := policy(credential); err != nil {
+			return err
+		}
+	}
+	return nil
+}
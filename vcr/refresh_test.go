@@ -0,0 +1,274 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
+	"github.com/nuts-foundation/nuts-node/vcr/verifier"
+	"github.com/stretchr/testify/assert"
+)
+
+type refreshTestContext struct {
+	vcr         *vcr
+	issuer      *issuer.MockIssuer
+	issuerStore *issuer.MockStore
+	verifier    *verifier.MockVerifier
+}
+
+func newRefreshTestContext(t *testing.T) refreshTestContext {
+	ctrl := gomock.NewController(t)
+	ctx := refreshTestContext{
+		issuer:      issuer.NewMockIssuer(ctrl),
+		issuerStore: issuer.NewMockStore(ctrl),
+		verifier:    verifier.NewMockVerifier(ctrl),
+	}
+	ctx.vcr = NewVCRInstance(nil, nil, nil, nil, nil).(*vcr)
+	ctx.vcr.config.OverrideIssueAllPublic = false
+	ctx.vcr.config.Refresh.CredentialTypes = []string{"TestCredential"}
+	ctx.vcr.issuer = ctx.issuer
+	ctx.vcr.issuerStore = ctx.issuerStore
+	ctx.vcr.verifier = ctx.verifier
+	ctx.vcr.refreshNonces["nonce"] = time.Now().Add(time.Hour)
+	return ctx
+}
+
+func TestVcr_CreateRefreshNonce(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	timeFunc = func() time.Time {
+		return now
+	}
+	defer func() {
+		timeFunc = time.Now
+	}()
+	ctx := newRefreshTestContext(t)
+	ctx.vcr.refreshNonces["expired"] = now
+
+	nonce := ctx.vcr.CreateRefreshNonce()
+
+	assert.NotEmpty(t, nonce)
+	assert.Equal(t, map[string]time.Time{"nonce": ctx.vcr.refreshNonces["nonce"], nonce: now.Add(refreshNonceValidity)}, ctx.vcr.refreshNonces)
+	assert.NotEqual(t, nonce, ctx.vcr.CreateRefreshNonce())
+}
+
+func TestVcr_RefreshCredential(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	timeFunc = func() time.Time {
+		return now
+	}
+	defer func() {
+		timeFunc = time.Now
+	}()
+	credentialID := ssi.MustParseURI("did:nuts:issuer#1")
+	credentialType := ssi.MustParseURI("TestCredential")
+	subjectDID := did.MustParseDID("did:nuts:subject")
+	issuanceDate := now.Add(-360 * 24 * time.Hour)
+	expirationDate := issuanceDate.Add(365 * 24 * time.Hour)
+	credential := vc.VerifiableCredential{
+		Context:           []ssi.URI{vc.VCContextV1URI()},
+		ID:                &credentialID,
+		Type:              []ssi.URI{vc.VerifiableCredentialTypeV1URI(), credentialType},
+		Issuer:            ssi.MustParseURI("did:nuts:issuer"),
+		IssuanceDate:      issuanceDate,
+		ExpirationDate:    &expirationDate,
+		CredentialSubject: []interface{}{map[string]interface{}{"id": subjectDID.String()}},
+	}
+	presentation := vc.VerifiablePresentation{VerifiableCredential: []vc.VerifiableCredential{credential}}
+	domain := "did:nuts:issuer"
+	nonce := "nonce"
+	vpOptions := verifier.VPVerificationOptions{Challenge: &nonce, Domain: &domain, AllowUntrustedIssuer: true}
+	successorExpirationDate := now.Add(365 * 24 * time.Hour)
+	successor := vc.VerifiableCredential{
+		Type:              []ssi.URI{credentialType},
+		Issuer:            credential.Issuer,
+		CredentialSubject: credential.CredentialSubject,
+		ExpirationDate:    &successorExpirationDate,
+	}
+	successorID := ssi.MustParseURI("did:nuts:issuer#2")
+	issuedSuccessor := successor
+	issuedSuccessor.ID = &successorID
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&credential, nil)
+		ctx.verifier.EXPECT().VerifyVP(presentation, vpOptions).Return(verifier.VPVerificationResult{Holder: &subjectDID})
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
+		ctx.issuer.EXPECT().Issue(successor, types.JSONLDCredentialFormat, true, false).Return(&issuedSuccessor, nil)
+		ctx.issuer.EXPECT().Revoke(credentialID, "superseded by refreshed credential did:nuts:issuer#2")
+		var policyCalled bool
+		ctx.vcr.RegisterRefreshPolicy(func(_ vc.VerifiableCredential) error {
+			policyCalled = true
+			return nil
+		})
+
+		result, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, &issuedSuccessor, result)
+		assert.True(t, policyCalled)
+		assert.Empty(t, ctx.vcr.refreshNonces, "nonce must be used")
+	})
+	t.Run("ok - revoking the credential fails", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&credential, nil)
+		ctx.verifier.EXPECT().VerifyVP(presentation, vpOptions).Return(verifier.VPVerificationResult{Holder: &subjectDID})
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
+		ctx.issuer.EXPECT().Issue(successor, types.JSONLDCredentialFormat, true, false).Return(&issuedSuccessor, nil)
+		ctx.issuer.EXPECT().Revoke(credentialID, gomock.Any()).Return(nil, errors.New("b00m"))
+
+		result, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &issuedSuccessor, result)
+	})
+	t.Run("error - unknown nonce", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+
+		_, err := ctx.vcr.RefreshCredential(presentation, "other")
+
+		assert.EqualError(t, err, "unknown or expired nonce")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - expired nonce", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.vcr.refreshNonces[nonce] = now
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "unknown or expired nonce")
+		assert.Empty(t, ctx.vcr.refreshNonces)
+	})
+	t.Run("error - nonce is used once", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&credential, nil)
+		ctx.verifier.EXPECT().VerifyVP(presentation, vpOptions).Return(verifier.VPVerificationResult{Err: errors.New("invalid proof")})
+		_, _ = ctx.vcr.RefreshCredential(presentation, nonce)
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "unknown or expired nonce")
+	})
+	t.Run("error - no credential in presentation", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+
+		_, err := ctx.vcr.RefreshCredential(vc.VerifiablePresentation{}, nonce)
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - not issued by this node", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(nil, issuer.ErrNotFound)
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "credential is not issued by this node")
+		assert.ErrorIs(t, err, core.NotFoundError(""))
+	})
+	t.Run("error - type can't be refreshed", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.vcr.config.Refresh.CredentialTypes = nil
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&credential, nil)
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "credentials of this type can't be refreshed (credentialType=TestCredential)")
+		assert.ErrorIs(t, err, core.PreconditionFailedError(""))
+	})
+	t.Run("error - credential doesn't expire", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		withoutExpiration := credential
+		withoutExpiration.ExpirationDate = nil
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&withoutExpiration, nil)
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "credential doesn't expire")
+	})
+	t.Run("error - invalid presentation", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&credential, nil)
+		ctx.verifier.EXPECT().VerifyVP(presentation, vpOptions).Return(verifier.VPVerificationResult{Holder: &subjectDID, Err: errors.New("proof domain does not match")})
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "invalid presentation: proof domain does not match")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+	t.Run("error - not signed by subject", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		otherDID := did.MustParseDID("did:nuts:other")
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&credential, nil)
+		ctx.verifier.EXPECT().VerifyVP(presentation, vpOptions).Return(verifier.VPVerificationResult{Holder: &otherDID})
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "presentation must be signed by the credential subject")
+	})
+	t.Run("error - revoked", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&credential, nil)
+		ctx.verifier.EXPECT().VerifyVP(presentation, vpOptions).Return(verifier.VPVerificationResult{Holder: &subjectDID})
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(true, nil)
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "credential has been revoked")
+		assert.ErrorIs(t, err, core.PreconditionFailedError(""))
+	})
+	t.Run("error - policy doesn't allow it", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&credential, nil)
+		ctx.verifier.EXPECT().VerifyVP(presentation, vpOptions).Return(verifier.VPVerificationResult{Holder: &subjectDID})
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
+		ctx.vcr.RegisterRefreshPolicy(func(_ vc.VerifiableCredential) error {
+			return nil
+		})
+		ctx.vcr.RegisterRefreshPolicy(func(_ vc.VerifiableCredential) error {
+			return errors.New("organization left the platform")
+		})
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "credential can't be refreshed: organization left the platform")
+		assert.ErrorIs(t, err, core.PreconditionFailedError(""))
+	})
+	t.Run("error - reissue fails", func(t *testing.T) {
+		ctx := newRefreshTestContext(t)
+		ctx.issuerStore.EXPECT().GetCredential(credentialID).Return(&credential, nil)
+		ctx.verifier.EXPECT().VerifyVP(presentation, vpOptions).Return(verifier.VPVerificationResult{Holder: &subjectDID})
+		ctx.verifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
+		ctx.issuer.EXPECT().Issue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("b00m"))
+
+		_, err := ctx.vcr.RefreshCredential(presentation, nonce)
+
+		assert.EqualError(t, err, "unable to refresh credential: b00m")
+	})
+}
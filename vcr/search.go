@@ -0,0 +1,228 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
+)
+
+// maxConjunctions limits the number of leia queries a single search may be expanded to by OR clauses.
+const maxConjunctions = 64
+
+// ErrQueryTooComplex is returned when the OR clauses of a search expand to too many leia queries.
+var ErrQueryTooComplex = errors.New("query too complex")
+
+// conjunction is a set of clauses of which all must match, while none of the negated clauses may match.
+// The clauses only contain clauses that can be mapped to a leia query part.
+type conjunction struct {
+	clauses []concept.Clause
+	negated []concept.Clause
+}
+
+func (c conjunction) and(other conjunction) conjunction {
+	return conjunction{
+		clauses: append(append([]concept.Clause{}, c.clauses...), other.clauses...),
+		negated: append(append([]concept.Clause{}, c.negated...), other.negated...),
+	}
+}
+
+// expand rewrites the clauses to conjunctions, a document matches the clauses if it matches any of the conjunctions.
+// Negated clauses are kept as is, they're evaluated by a separate search.
+func expand(clauses []concept.Clause) ([]conjunction, error) {
+	result := []conjunction{{}}
+	for _, clause := range clauses {
+		var alternatives []conjunction
+		switch clause.Type() {
+		case concept.OrType:
+			for _, member := range clause.(concept.GroupClause).Clauses() {
+				expanded, err := expand([]concept.Clause{member})
+				if err != nil {
+					return nil, err
+				}
+				alternatives = append(alternatives, expanded...)
+			}
+		case concept.NotType:
+			alternatives = []conjunction{{negated: clause.(concept.GroupClause).Clauses()}}
+		default:
+			alternatives = []conjunction{{clauses: []concept.Clause{clause}}}
+		}
+
+		next := make([]conjunction, 0, len(result)*len(alternatives))
+		for _, current := range result {
+			for _, alternative := range alternatives {
+				next = append(next, current.and(alternative))
+			}
+		}
+		if len(next) > maxConjunctions {
+			return nil, ErrQueryTooComplex
+		}
+		result = next
+	}
+	return result, nil
+}
+
+// find returns the documents of the collection that match the clauses. Every document is returned once.
+// OR clauses result in a leia query per alternative, the documents matching a NOT clause are excluded from the results.
+func find(ctx context.Context, collection leia.Collection, clauses []concept.Clause) ([]leia.Document, error) {
+	conjunctions, err := expand(clauses)
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]bool{}
+	docs := make([]leia.Document, 0)
+	for _, conj := range conjunctions {
+		excluded := map[string]bool{}
+		for _, negated := range conj.negated {
+			negatedDocs, err := find(ctx, collection, []concept.Clause{negated})
+			if err != nil {
+				return nil, err
+			}
+			for _, doc := range negatedDocs {
+				excluded[collection.Reference(doc).EncodeToString()] = true
+			}
+		}
+
+		matches, err := collection.Find(ctx, toLeiaQuery(conj))
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range matches {
+			ref := collection.Reference(doc).EncodeToString()
+			if !found[ref] && !excluded[ref] {
+				found[ref] = true
+				docs = append(docs, doc)
+			}
+		}
+	}
+	return docs, nil
+}
+
+// toLeiaQuery maps the clauses of the conjunction to a leia query.
+// A conjunction with only negated clauses matches all documents, an empty conjunction results in no query at all.
+func toLeiaQuery(conj conjunction) leia.Query {
+	var q leia.Query
+	if len(conj.clauses) == 0 && len(conj.negated) > 0 {
+		q = allDocuments{}
+	}
+	for _, clause := range conj.clauses {
+		var qp leia.QueryPart
+
+		switch clause.Type() {
+		case concept.EqType:
+			qp = leia.Eq(clause.Key(), clause.Seek())
+		case concept.PrefixType:
+			qp = leia.Prefix(clause.Key(), clause.Seek())
+		case concept.ExistsType:
+			qp = rangePart{name: clause.Key()}
+		case concept.RangeType:
+			qp = rangePart{name: clause.Key(), begin: clause.Seek(), end: clause.Match()}
+		default:
+			qp = leia.Range(clause.Key(), clause.Seek(), clause.Match())
+		}
+
+		if q == nil {
+			q = leia.New(qp)
+		} else {
+			q = q.And(qp)
+		}
+	}
+	return q
+}
+
+// allDocuments is a leia.Query that starts without query parts, so it matches all documents of a collection.
+type allDocuments struct {
+	parts []leia.QueryPart
+}
+
+func (a allDocuments) And(part leia.QueryPart) leia.Query {
+	a.parts = append(a.parts, part)
+	return a
+}
+
+func (a allDocuments) Parts() []leia.QueryPart {
+	return a.parts
+}
+
+// rangePart is a leia.QueryPart for a range of which both bounds are optional.
+// Unlike leia.Range, it doesn't match documents without a value, which leia indexes with an empty key.
+type rangePart struct {
+	name  string
+	begin string
+	end   string
+}
+
+func (r rangePart) Name() string {
+	return r.name
+}
+
+func (r rangePart) Seek() (leia.Key, error) {
+	if r.begin == "" {
+		// skip the empty key of documents without a value, the index scan stops at the first key that doesn't match
+		return leia.Key{0}, nil
+	}
+	return leia.KeyOf(r.begin), nil
+}
+
+func (r rangePart) Condition(key leia.Key, transform leia.Transform) (bool, error) {
+	if len(key) == 0 {
+		return false, nil
+	}
+	if r.begin != "" {
+		begin, err := transformedKey(r.begin, transform)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Compare(key, begin) < 0 {
+			return false, nil
+		}
+	}
+	if r.end != "" {
+		end, err := transformedKey(r.end, transform)
+		if err != nil {
+			return false, err
+		}
+		return bytes.Compare(key, end) <= 0, nil
+	}
+	return true, nil
+}
+
+func transformedKey(value string, transform leia.Transform) (leia.Key, error) {
+	var transformed interface{} = value
+	if transform != nil {
+		transformed = transform(transformed)
+	}
+	switch t := transformed.(type) {
+	case string:
+		return leia.Key(t), nil
+	case []byte:
+		return t, nil
+	case leia.Key:
+		return t, nil
+	default:
+		return nil, fmt.Errorf("unsupported range value type: %T", transformed)
+	}
+}
@@ -0,0 +1,201 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/vcr/concept"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpand(t *testing.T) {
+	a := concept.Eq("key", "a")
+	b := concept.Eq("key", "b")
+	c := concept.Eq("other", "c")
+
+	t.Run("clauses are a single conjunction", func(t *testing.T) {
+		conjunctions, err := expand([]concept.Clause{a, c})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []conjunction{{clauses: []concept.Clause{a, c}, negated: []concept.Clause{}}}, conjunctions)
+	})
+
+	t.Run("or clause results in a conjunction per alternative", func(t *testing.T) {
+		conjunctions, err := expand([]concept.Clause{concept.Or(a, b), c})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.Len(t, conjunctions, 2) {
+			return
+		}
+		assert.Equal(t, []concept.Clause{a, c}, conjunctions[0].clauses)
+		assert.Equal(t, []concept.Clause{b, c}, conjunctions[1].clauses)
+	})
+
+	t.Run("not clause is negated", func(t *testing.T) {
+		conjunctions, err := expand([]concept.Clause{concept.Not(concept.Or(a, b)), c})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.Len(t, conjunctions, 1) {
+			return
+		}
+		assert.Equal(t, []concept.Clause{c}, conjunctions[0].clauses)
+		assert.Equal(t, []concept.Clause{concept.Or(a, b)}, conjunctions[0].negated)
+	})
+
+	t.Run("error - too many alternatives", func(t *testing.T) {
+		var clauses []concept.Clause
+		for i := 0; i < 7; i++ {
+			clauses = append(clauses, concept.Or(a, b))
+		}
+
+		_, err := expand(clauses)
+
+		assert.ErrorIs(t, err, ErrQueryTooComplex)
+	})
+}
+
+func TestFind(t *testing.T) {
+	ctx := newMockContext(t)
+	_ = ctx.vcr.registry.Add(concept.ExampleConfig)
+	if err := ctx.vcr.initIndices(); err != nil {
+		t.Fatal(err)
+	}
+	collection := ctx.vcr.store.Collection(concept.ExampleType)
+	_ = collection.Add([]leia.Document{
+		testHumanDocument("#1", "blue/grey"),
+		testHumanDocument("#2", "brown"),
+		testHumanDocument("#3", ""),
+	})
+
+	search := func(t *testing.T, clauses ...concept.Clause) []string {
+		docs, err := find(context.Background(), collection, clauses)
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		var ids []string
+		for _, doc := range docs {
+			values, _ := doc.ValuesAtPath("id")
+			ids = append(ids, values[0].(string)[strings.Index(values[0].(string), "#"):])
+		}
+		return ids
+	}
+
+	t.Run("range", func(t *testing.T) {
+		assert.Equal(t, []string{"#1", "#2"}, search(t, concept.Range("human.eyeColour", "b", "bz")))
+	})
+
+	t.Run("range without upper bound", func(t *testing.T) {
+		assert.Equal(t, []string{"#2"}, search(t, concept.Range("human.eyeColour", "br", "")))
+	})
+
+	t.Run("range without lower bound", func(t *testing.T) {
+		assert.Equal(t, []string{"#1"}, search(t, concept.Range("human.eyeColour", "", "blue/zzz")))
+	})
+
+	t.Run("or", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"#1", "#3"}, search(t, concept.Or(concept.Prefix("id", "did:nuts:123#1"), concept.Prefix("id", "did:nuts:123#3"))))
+	})
+
+	t.Run("or with overlapping alternatives returns documents once", func(t *testing.T) {
+		assert.Equal(t, []string{"#1"}, search(t, concept.Or(concept.Prefix("human.eyeColour", "blue"), concept.Eq("human.eyeColour", "blue/grey"))))
+	})
+
+	t.Run("not", func(t *testing.T) {
+		assert.Equal(t, []string{"#2"}, search(t, concept.Prefix("human.eyeColour", "b"), concept.Not(concept.Prefix("human.eyeColour", "blue"))))
+	})
+
+	t.Run("not without other clauses", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"#2", "#3"}, search(t, concept.Not(concept.Prefix("human.eyeColour", "blue"))))
+	})
+
+	t.Run("exists", func(t *testing.T) {
+		assert.Equal(t, []string{"#1", "#2"}, search(t, concept.Exists("human.eyeColour")))
+	})
+
+	t.Run("not exists", func(t *testing.T) {
+		assert.Equal(t, []string{"#3"}, search(t, concept.Not(concept.Exists("human.eyeColour"))))
+	})
+}
+
+func TestVcr_SearchConceptClauses(t *testing.T) {
+	vc := concept.TestVC()
+	ctx := newMockContext(t)
+	_ = ctx.vcr.registry.Add(concept.ExampleConfig)
+	if err := ctx.vcr.initIndices(); err != nil {
+		t.Fatal(err)
+	}
+	ctx.vcr.Trust(vc.Type[1], vc.Issuer)
+	_ = ctx.vcr.store.Collection(concept.ExampleType).Add([]leia.Document{leia.DocumentFromString(concept.TestCredential)})
+
+	t.Run("ok", func(t *testing.T) {
+		results, err := ctx.vcr.SearchConceptClauses(context.Background(), concept.ExampleConcept, false, []concept.Clause{
+			concept.Or(concept.Eq("human.eyeColour", "blue/grey"), concept.Eq("human.eyeColour", "green")),
+			concept.Not(concept.Prefix("human.eyeColour", "brown")),
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("ok - no match", func(t *testing.T) {
+		results, err := ctx.vcr.SearchConceptClauses(context.Background(), concept.ExampleConcept, false, []concept.Clause{
+			concept.Not(concept.Exists("human.eyeColour")),
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, results)
+	})
+
+	t.Run("error - unknown concept", func(t *testing.T) {
+		_, err := ctx.vcr.SearchConceptClauses(context.Background(), "unknown", false, []concept.Clause{concept.Exists("human.eyeColour")})
+
+		assert.ErrorIs(t, err, concept.ErrUnknownConcept)
+	})
+}
+
+// testHumanDocument returns a HumanCredential with the given ID fragment and eye colour, the human is omitted without eye colour.
+func testHumanDocument(fragment string, eyeColour string) leia.Document {
+	subject := map[string]interface{}{"id": "did:nuts:456"}
+	if eyeColour != "" {
+		subject["human"] = map[string]interface{}{"eyeColour": eyeColour, "hairColour": "fair"}
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"id":                "did:nuts:123" + fragment,
+		"issuer":            "did:nuts:123",
+		"type":              []string{"VerifiableCredential", concept.ExampleType},
+		"credentialSubject": subject,
+	})
+	return leia.DocumentFromBytes(data)
+}
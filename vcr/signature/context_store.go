@@ -0,0 +1,293 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package signature
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nuts-foundation/nuts-node/vcr/assets"
+	"github.com/piprate/json-gold/ld"
+	"golang.org/x/sync/singleflight"
+	"gopkg.in/yaml.v2"
+)
+
+// ContextsFile is the name of the file in the contexts directory that lists the JSON-LD contexts that may be loaded.
+const ContextsFile = "contexts.yaml"
+
+// maxContextSize is the maximum size of a remote JSON-LD context.
+const maxContextSize = 1024 * 1024
+
+// Sources of JSON-LD contexts.
+const (
+	// EmbeddedContextSource indicates the context is embedded in the node.
+	EmbeddedContextSource = "embedded"
+	// LocalContextSource indicates the context is loaded from the contexts directory.
+	LocalContextSource = "local"
+	// RemoteContextSource indicates the context is pinned and fetched from its URL on first use.
+	RemoteContextSource = "remote"
+)
+
+// embeddedContexts maps the URLs of the contexts embedded in the node to their file in the assets.
+var embeddedContexts = map[string]string{
+	"https://nuts.nl/credentials/v1":                                     "assets/contexts/nuts.ldjson",
+	"https://www.w3.org/2018/credentials/v1":                             "assets/contexts/w3c-credentials-v1.ldjson",
+	"https://w3c-ccg.github.io/lds-jws2020/contexts/lds-jws2020-v1.json": "assets/contexts/lds-jws2020-v1.ldjson",
+	"https://schema.org":                                                 "assets/contexts/schema-org-v13.ldjson",
+}
+
+// ContextEntry allows a JSON-LD context to be loaded. It's an entry of the contexts file.
+type ContextEntry struct {
+	// URL is the URL of the context as used in the @context of documents.
+	URL string `yaml:"url"`
+	// File is the file in the contexts directory that contains the context.
+	// If not set, the context is fetched from its URL on first use.
+	File string `yaml:"file,omitempty"`
+	// SHA256 is the hex encoded SHA-256 hash of the context. The context is refused if it doesn't match.
+	SHA256 string `yaml:"sha256"`
+}
+
+// ContextInfo describes a JSON-LD context known to the ContextStore.
+type ContextInfo struct {
+	// URL is the URL of the context.
+	URL string `json:"url"`
+	// Source indicates where the context is loaded from: embedded, local or remote.
+	Source string `json:"source"`
+	// SHA256 is the hex encoded SHA-256 hash of the context.
+	SHA256 string `json:"sha256"`
+	// Loaded indicates whether the context is loaded. Remote contexts are loaded on first use.
+	Loaded bool `json:"loaded"`
+}
+
+// ContextStore is a JSON-LD document loader that loads contexts from the node itself, a local directory or pinned remote URLs.
+type ContextStore interface {
+	ld.DocumentLoader
+	// Contexts returns the contexts embedded in the node and listed in the contexts file, sorted by URL.
+	Contexts() []ContextInfo
+}
+
+// contextsFile is the content of the contexts file.
+type contextsFile struct {
+	Contexts []ContextEntry `yaml:"contexts"`
+}
+
+type contextStore struct {
+	// contexts contains the known contexts by URL
+	contexts map[string]*ContextInfo
+	// documents contains the loaded known contexts by URL
+	documents map[string]*ld.RemoteDocument
+	// mutex protects the contexts and documents, since remote contexts are loaded on first use.
+	// It's not held while fetching a remote context, so loading other contexts isn't blocked by a slow server.
+	mutex *sync.Mutex
+	// fetches makes concurrent loads of the same remote context share a single fetch
+	fetches    *singleflight.Group
+	httpClient *http.Client
+	// nextLoader loads documents that are not known contexts, it's nil if no other documents may be loaded
+	nextLoader ld.DocumentLoader
+}
+
+// NewContextStore creates a ContextStore with the contexts embedded in the node and the contexts listed in the contexts file
+// of the given directory. If the contexts file doesn't exist, only the embedded contexts are known.
+// Local contexts are verified against their SHA-256 hash when the store is created, remote contexts when they're fetched.
+// If allowExternalCalls is set to true, unknown contexts are loaded from the internet. Otherwise, they're refused.
+func NewContextStore(contextsDir string, allowExternalCalls bool) (ContextStore, error) {
+	store := &contextStore{
+		contexts:   map[string]*ContextInfo{},
+		documents:  map[string]*ld.RemoteDocument{},
+		mutex:      &sync.Mutex{},
+		fetches:    &singleflight.Group{},
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+	if allowExternalCalls {
+		store.nextLoader = ld.NewCachingDocumentLoader(ld.NewDefaultDocumentLoader(nil))
+	}
+
+	for contextURL, file := range embeddedContexts {
+		data, err := assets.Assets.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load embedded context (url=%s): %w", contextURL, err)
+		}
+		if err = store.add(contextURL, file, EmbeddedContextSource, data); err != nil {
+			return nil, err
+		}
+	}
+
+	if contextsDir == "" {
+		return store, nil
+	}
+	data, err := os.ReadFile(path.Join(contextsDir, ContextsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	} else if err != nil {
+		return nil, err
+	}
+	entries := contextsFile{}
+	if err = yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", ContextsFile, err)
+	}
+	for _, entry := range entries.Contexts {
+		if err = store.addEntry(contextsDir, entry); err != nil {
+			return nil, fmt.Errorf("invalid context (url=%s): %w", entry.URL, err)
+		}
+	}
+	return store, nil
+}
+
+func (s *contextStore) addEntry(contextsDir string, entry ContextEntry) error {
+	if _, err := url.ParseRequestURI(entry.URL); err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	if _, exists := s.contexts[entry.URL]; exists {
+		return errors.New("context already exists")
+	}
+	if len(entry.SHA256) != sha256.Size*2 {
+		return errors.New("sha256 must be a hex encoded SHA-256 hash")
+	}
+	if entry.File == "" {
+		s.contexts[entry.URL] = &ContextInfo{URL: entry.URL, Source: RemoteContextSource, SHA256: strings.ToLower(entry.SHA256)}
+		return nil
+	}
+	data, err := os.ReadFile(path.Join(contextsDir, entry.File))
+	if err != nil {
+		return err
+	}
+	if err = verifyContextHash(data, entry.SHA256); err != nil {
+		return err
+	}
+	return s.add(entry.URL, entry.URL, LocalContextSource, data)
+}
+
+// add adds a loaded context. The documentURL is used as base for relative references in the context.
+func (s *contextStore) add(contextURL string, documentURL string, source string, data []byte) error {
+	document, err := ld.DocumentFromReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("unable to parse context (url=%s): %w", contextURL, err)
+	}
+	hash := sha256.Sum256(data)
+	s.contexts[contextURL] = &ContextInfo{URL: contextURL, Source: source, SHA256: hex.EncodeToString(hash[:]), Loaded: true}
+	s.documents[contextURL] = &ld.RemoteDocument{DocumentURL: documentURL, Document: document}
+	return nil
+}
+
+// LoadDocument returns the known context for the URL, fetching it first if it's a remote context that isn't loaded yet.
+// Unknown documents are loaded from the embedded filesystem or, if external calls are allowed, from the internet.
+func (s *contextStore) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	s.mutex.Lock()
+	info, known := s.contexts[u]
+	var expectedHash string
+	if known {
+		expectedHash = info.SHA256
+	}
+	document, loaded := s.documents[u]
+	s.mutex.Unlock()
+
+	if !known {
+		return s.loadUnknown(u)
+	}
+	if loaded {
+		return document, nil
+	}
+	result, err, _ := s.fetches.Do(u, func() (interface{}, error) {
+		return s.loadRemote(u, expectedHash)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ld.RemoteDocument), nil
+}
+
+// loadRemote fetches the remote context, verifies it against the expected hash and adds it to the loaded contexts.
+func (s *contextStore) loadRemote(u string, expectedHash string) (*ld.RemoteDocument, error) {
+	data, err := s.fetch(u)
+	if err != nil {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, fmt.Sprintf("unable to load remote context (url=%s): %s", u, err))
+	}
+	if err = verifyContextHash(data, expectedHash); err != nil {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, fmt.Sprintf("refused remote context (url=%s): %s", u, err))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err = s.add(u, u, RemoteContextSource, data); err != nil {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, err.Error())
+	}
+	return s.documents[u], nil
+}
+
+func (s *contextStore) loadUnknown(u string) (*ld.RemoteDocument, error) {
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, fmt.Sprintf("error parsing URL: %s", u))
+	}
+	if (parsedURL.Scheme == "http" || parsedURL.Scheme == "https") && s.nextLoader == nil {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, fmt.Sprintf("unknown remote context refused in strict mode: %s", u))
+	}
+	return NewEmbeddedFSDocumentLoader(assets.Assets, s.nextLoader).LoadDocument(u)
+}
+
+func (s *contextStore) fetch(u string) ([]byte, error) {
+	request, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/ld+json, application/json")
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(response.Body, maxContextSize))
+}
+
+func (s *contextStore) Contexts() []ContextInfo {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	result := make([]ContextInfo, 0, len(s.contexts))
+	for _, info := range s.contexts {
+		result = append(result, *info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].URL < result[j].URL
+	})
+	return result
+}
+
+func verifyContextHash(data []byte, expected string) error {
+	hash := sha256.Sum256(data)
+	if actual := hex.EncodeToString(hash[:]); actual != strings.ToLower(expected) {
+		return fmt.Errorf("SHA-256 hash mismatch (expected=%s, actual=%s)", expected, actual)
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package signature
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/stretchr/testify/assert"
+)
+
+const testContext = `{"@context": {"name": "https://example.com/vocab#name"}}`
+
+var testContextHash = func() string {
+	hash := sha256.Sum256([]byte(testContext))
+	return hex.EncodeToString(hash[:])
+}()
+
+func TestNewContextStore(t *testing.T) {
+	t.Run("ok - only embedded contexts without contexts file", func(t *testing.T) {
+		store, err := NewContextStore(io.TestDirectory(t), false)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		contexts := store.Contexts()
+		assert.Len(t, contexts, len(embeddedContexts))
+		for _, context := range contexts {
+			assert.Equal(t, EmbeddedContextSource, context.Source)
+			assert.True(t, context.Loaded)
+			assert.NotEmpty(t, context.SHA256)
+		}
+	})
+
+	t.Run("ok - local context", func(t *testing.T) {
+		dir := writeContextsFile(t, fmt.Sprintf("contexts:\n  - url: https://example.com/v1\n    file: example.ldjson\n    sha256: %s\n", testContextHash))
+		_ = os.WriteFile(path.Join(dir, "example.ldjson"), []byte(testContext), 0644)
+
+		store, err := NewContextStore(dir, false)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		doc, err := store.LoadDocument("https://example.com/v1")
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "https://example.com/v1", doc.DocumentURL)
+		assert.Contains(t, store.Contexts(), ContextInfo{URL: "https://example.com/v1", Source: LocalContextSource, SHA256: testContextHash, Loaded: true})
+	})
+
+	t.Run("error - local context doesn't match pin", func(t *testing.T) {
+		dir := writeContextsFile(t, fmt.Sprintf("contexts:\n  - url: https://example.com/v1\n    file: example.ldjson\n    sha256: %s\n", testContextHash))
+		_ = os.WriteFile(path.Join(dir, "example.ldjson"), []byte(`{"@context": {}}`), 0644)
+
+		_, err := NewContextStore(dir, false)
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "invalid context (url=https://example.com/v1): SHA-256 hash mismatch")
+	})
+
+	t.Run("error - local context file doesn't exist", func(t *testing.T) {
+		dir := writeContextsFile(t, fmt.Sprintf("contexts:\n  - url: https://example.com/v1\n    file: example.ldjson\n    sha256: %s\n", testContextHash))
+
+		_, err := NewContextStore(dir, false)
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("error - invalid pin", func(t *testing.T) {
+		dir := writeContextsFile(t, "contexts:\n  - url: https://example.com/v1\n    sha256: abc\n")
+
+		_, err := NewContextStore(dir, false)
+
+		assert.EqualError(t, err, "invalid context (url=https://example.com/v1): sha256 must be a hex encoded SHA-256 hash")
+	})
+
+	t.Run("error - replaces embedded context", func(t *testing.T) {
+		dir := writeContextsFile(t, fmt.Sprintf("contexts:\n  - url: https://schema.org\n    sha256: %s\n", testContextHash))
+
+		_, err := NewContextStore(dir, false)
+
+		assert.EqualError(t, err, "invalid context (url=https://schema.org): context already exists")
+	})
+
+	t.Run("error - invalid contexts file", func(t *testing.T) {
+		dir := writeContextsFile(t, "contexts: {")
+
+		_, err := NewContextStore(dir, false)
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "unable to parse contexts.yaml")
+	})
+}
+
+func TestContextStore_LoadDocument(t *testing.T) {
+	serve := func(t *testing.T, body string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if request.URL.Path != "/v1" {
+				writer.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = writer.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	remoteStore := func(t *testing.T, contextURL string) ContextStore {
+		dir := writeContextsFile(t, fmt.Sprintf("contexts:\n  - url: %s\n    sha256: %s\n", contextURL, testContextHash))
+		store, err := NewContextStore(dir, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return store
+	}
+
+	t.Run("ok - embedded context", func(t *testing.T) {
+		store, _ := NewContextStore("", false)
+
+		doc, err := store.LoadDocument("https://www.w3.org/2018/credentials/v1")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "assets/contexts/w3c-credentials-v1.ldjson", doc.DocumentURL)
+	})
+
+	t.Run("ok - remote context is fetched and pinned", func(t *testing.T) {
+		server := serve(t, testContext)
+		contextURL := server.URL + "/v1"
+		store := remoteStore(t, contextURL)
+		assert.Contains(t, store.Contexts(), ContextInfo{URL: contextURL, Source: RemoteContextSource, SHA256: testContextHash})
+
+		doc, err := store.LoadDocument(contextURL)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NotNil(t, doc.Document)
+		assert.Contains(t, store.Contexts(), ContextInfo{URL: contextURL, Source: RemoteContextSource, SHA256: testContextHash, Loaded: true})
+	})
+
+	t.Run("ok - fetching a remote context doesn't block other contexts", func(t *testing.T) {
+		var requests int32
+		fetching := make(chan struct{}, 1)
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				fetching <- struct{}{}
+			}
+			<-release
+			_, _ = writer.Write([]byte(testContext))
+		}))
+		t.Cleanup(server.Close)
+		contextURL := server.URL + "/v1"
+		store := remoteStore(t, contextURL)
+
+		const loaders = 5
+		results := make(chan error, loaders)
+		for i := 0; i < loaders; i++ {
+			go func() {
+				_, err := store.LoadDocument(contextURL)
+				results <- err
+			}()
+		}
+		// while the remote context is being fetched, other contexts can be loaded
+		<-fetching
+		_, err := store.LoadDocument("https://www.w3.org/2018/credentials/v1")
+		assert.NoError(t, err)
+		assert.Len(t, store.Contexts(), len(embeddedContexts)+1)
+
+		close(release)
+		for i := 0; i < loaders; i++ {
+			assert.NoError(t, <-results)
+		}
+		assert.LessOrEqual(t, atomic.LoadInt32(&requests), int32(loaders))
+		_, err = store.LoadDocument(contextURL)
+		assert.NoError(t, err)
+		assert.Contains(t, store.Contexts(), ContextInfo{URL: contextURL, Source: RemoteContextSource, SHA256: testContextHash, Loaded: true})
+	})
+
+	t.Run("error - remote context doesn't match pin", func(t *testing.T) {
+		server := serve(t, `{"@context": {}}`)
+		contextURL := server.URL + "/v1"
+		store := remoteStore(t, contextURL)
+
+		_, err := store.LoadDocument(contextURL)
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "refused remote context")
+		assert.Contains(t, store.Contexts(), ContextInfo{URL: contextURL, Source: RemoteContextSource, SHA256: testContextHash})
+	})
+
+	t.Run("error - remote context can't be fetched", func(t *testing.T) {
+		server := serve(t, testContext)
+		contextURL := server.URL + "/unknown"
+		store := remoteStore(t, contextURL)
+
+		_, err := store.LoadDocument(contextURL)
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "unexpected status code: 404")
+	})
+
+	t.Run("error - unknown remote context in strict mode", func(t *testing.T) {
+		server := serve(t, testContext)
+		store, _ := NewContextStore("", false)
+
+		_, err := store.LoadDocument(server.URL + "/v1")
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "unknown remote context refused in strict mode")
+	})
+
+	t.Run("ok - unknown remote context when external calls are allowed", func(t *testing.T) {
+		server := serve(t, testContext)
+		store, _ := NewContextStore("", true)
+
+		doc, err := store.LoadDocument(server.URL + "/v1")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NotNil(t, doc.Document)
+	})
+}
+
+func writeContextsFile(t *testing.T, contents string) string {
+	dir := io.TestDirectory(t)
+	if err := os.WriteFile(path.Join(dir, ContextsFile), []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
@@ -111,7 +111,7 @@ func TestNewContextLoader(t *testing.T) {
 		loader, err := NewContextLoader(false)
 		assert.NoError(t, err)
 		_, err = loader.LoadDocument("http://example.org")
-		assert.EqualError(t, err, "loading document failed")
+		assert.EqualError(t, err, "loading document failed: unknown remote context refused in strict mode: http://example.org")
 	})
 
 	t.Run("it resolves an external doc when allowingExternalCalls is true", func(t *testing.T) {
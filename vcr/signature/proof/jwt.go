@@ -0,0 +1,404 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package proof
+
+import (
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jws"
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	nutsCrypto "github.com/nuts-foundation/nuts-node/crypto"
+)
+
+// JwtProof2020 contains the string value for the proof type of credentials and presentations in JWT format.
+// Credentials and presentations in JWT format are exchanged as compact JWT. Within the node they're kept in their JSON form,
+// which is decoded from the JWT as specified by https://www.w3.org/TR/vc-data-model/#jwt-decoding and has the JWT as proof.
+// Other implementations (e.g. Veramo) use the same JSON form. It allows storing and searching them like any other credential.
+const JwtProof2020 = ssi.ProofType("JwtProof2020")
+
+const (
+	// vcClaim contains the credential in a VC-JWT
+	vcClaim = "vc"
+	// vpClaim contains the presentation in a VP-JWT
+	vpClaim = "vp"
+)
+
+// JWTProof contains a credential or presentation encoded as JWT, as specified by https://www.w3.org/TR/vc-data-model/#json-web-token
+type JWTProof struct {
+	// Type contains the proof type, which is always JwtProof2020
+	Type ssi.ProofType `json:"type"`
+	// JWT contains the compact serialization of the JWT
+	JWT string `json:"jwt"`
+}
+
+// NewJWTProof signs the given claims as JWT using the given key.
+// The 'kid' header of the JWT is set to the KID of the key, so verifiers can resolve the public key.
+func NewJWTProof(claims map[string]interface{}, key nutsCrypto.Key) (*JWTProof, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]interface{}{
+		jws.KeyIDKey: key.KID(),
+		jws.TypeKey:  "JWT",
+	}
+	token, err := nutsCrypto.SignJWS(payload, headers, key.Signer())
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign JWT: %w", err)
+	}
+	return &JWTProof{Type: JwtProof2020, JWT: token}, nil
+}
+
+// ParseJWTCredential decodes a credential in compact JWT form to its JSON form. It does not verify the JWT.
+func ParseJWTCredential(token string) (*vc.VerifiableCredential, error) {
+	return JWTProof{Type: JwtProof2020, JWT: token}.Credential()
+}
+
+// ParseJWTPresentation decodes a presentation in compact JWT form to its JSON form. It does not verify the JWT.
+// Contained credentials in compact JWT or SD-JWT form are decoded as well.
+func ParseJWTPresentation(token string) (*vc.VerifiablePresentation, error) {
+	return JWTProof{Type: JwtProof2020, JWT: token}.Presentation()
+}
+
+// KID returns the value of the 'kid' header of the JWT. It does not verify the JWT.
+func (p JWTProof) KID() (string, error) {
+	kid, _, err := nutsCrypto.JWTKidAlg(p.JWT)
+	if err != nil {
+		return "", fmt.Errorf("invalid JWT: %w", err)
+	}
+	return kid, nil
+}
+
+// Verify verifies the signature of the JWT with the provided public key. If the signature is valid, it returns the claims of the JWT.
+// It does not validate the claims themselves.
+func (p JWTProof) Verify(key crypto.PublicKey) (map[string]interface{}, error) {
+	alg, err := nutsCrypto.SignatureAlgorithm(key)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := jws.Verify([]byte(p.JWT), alg, key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT signature: %w", err)
+	}
+	return unmarshalClaims(payload)
+}
+
+// Claims returns the claims of the JWT, like Verify, but without verifying the signature.
+func (p JWTProof) Claims() (map[string]interface{}, error) {
+	message, err := jws.ParseString(p.JWT)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT: %w", err)
+	}
+	return unmarshalClaims(message.Payload())
+}
+
+// Credential decodes the credential from the JWT, with the JWT as proof. It does not verify the JWT.
+func (p JWTProof) Credential() (*vc.VerifiableCredential, error) {
+	claims, err := p.Claims()
+	if err != nil {
+		return nil, err
+	}
+	return CredentialFromClaims(claims, p)
+}
+
+// Presentation decodes the presentation from the JWT, with the JWT as proof. It does not verify the JWT.
+func (p JWTProof) Presentation() (*vc.VerifiablePresentation, error) {
+	claims, err := p.Claims()
+	if err != nil {
+		return nil, err
+	}
+	return PresentationFromClaims(claims, p)
+}
+
+// CompactJWT returns the compact JWT of a credential or presentation in JWT format, or the compact SD-JWT of a credential in SD-JWT format.
+// It returns false if the document isn't in either format.
+func (d SignedDocument) CompactJWT() (string, bool) {
+	if !d.HasJWTProof() && !d.HasSDJWTProof() {
+		return "", false
+	}
+	// both proofs hold the compact form in the 'jwt' property
+	jwtProof := JWTProof{}
+	if err := d.UnmarshalProofValue(&jwtProof); err != nil || jwtProof.JWT == "" {
+		return "", false
+	}
+	return jwtProof.JWT, true
+}
+
+// HasJWTProof returns true if the proof of the document is a JWT proof.
+func (d SignedDocument) HasJWTProof() bool {
+	proofAsMap, ok := d["proof"].(map[string]interface{})
+	return ok && proofAsMap["type"] == string(JwtProof2020)
+}
+
+// CredentialClaims maps a credential to the claims of a VC-JWT, as specified by https://www.w3.org/TR/vc-data-model/#jwt-encoding
+// The issuer, issuance date, ID, expiration date and the ID of a single credential subject are mapped to the registered JWT claims
+// and left out of the 'vc' claim. The proof of the credential is left out.
+func CredentialClaims(credential vc.VerifiableCredential) (map[string]interface{}, error) {
+	document, err := NewSignedDocument(credential)
+	if err != nil {
+		return nil, err
+	}
+	document = SignedDocument(document.DocumentWithoutProof())
+	claims := map[string]interface{}{
+		"iss": credential.Issuer.String(),
+		"nbf": credential.IssuanceDate.Unix(),
+	}
+	delete(document, "issuer")
+	delete(document, "issuanceDate")
+	if credential.ID != nil {
+		claims["jti"] = credential.ID.String()
+		delete(document, "id")
+	}
+	if credential.ExpirationDate != nil {
+		claims["exp"] = credential.ExpirationDate.Unix()
+		delete(document, "expirationDate")
+	}
+	if subject, ok := singleSubject(document); ok {
+		if id, ok := subject["id"].(string); ok && id != "" {
+			claims["sub"] = id
+			delete(subject, "id")
+		}
+	}
+	claims[vcClaim] = map[string]interface{}(document)
+	return claims, nil
+}
+
+// PresentationClaims maps a presentation to the claims of a VP-JWT, as specified by https://www.w3.org/TR/vc-data-model/#jwt-encoding
+// The holder and ID are mapped to the 'iss' and 'jti' claims. The creation and expiration date of the proof options are mapped to
+// the 'nbf' and 'exp' claims, the domain and challenge to the 'aud' and 'nonce' claims.
+// Contained credentials in JWT or SD-JWT format are included in their compact form.
+func PresentationClaims(presentation vc.VerifiablePresentation, options ProofOptions) (map[string]interface{}, error) {
+	document, err := NewSignedDocument(presentation)
+	if err != nil {
+		return nil, err
+	}
+	document = SignedDocument(document.DocumentWithoutProof())
+	claims := map[string]interface{}{}
+	if presentation.Holder != nil {
+		claims["iss"] = presentation.Holder.String()
+		delete(document, "holder")
+	}
+	if presentation.ID != nil {
+		claims["jti"] = presentation.ID.String()
+		delete(document, "id")
+	}
+	created := options.Created
+	if created.IsZero() {
+		created = time.Now()
+	}
+	claims["nbf"] = created.Unix()
+	if options.ExpirationDate != nil {
+		claims["exp"] = options.ExpirationDate.Unix()
+	}
+	if options.Domain != nil {
+		claims["aud"] = *options.Domain
+	}
+	if options.Challenge != nil {
+		claims["nonce"] = *options.Challenge
+	}
+	if len(presentation.VerifiableCredential) > 0 {
+		credentials := make([]interface{}, len(presentation.VerifiableCredential))
+		for i, credential := range presentation.VerifiableCredential {
+			credentials[i], err = encodeCredential(credential)
+			if err != nil {
+				return nil, err
+			}
+		}
+		document["verifiableCredential"] = credentials
+	}
+	claims[vpClaim] = map[string]interface{}(document)
+	return claims, nil
+}
+
+// CredentialFromClaims decodes the credential from the claims of a VC-JWT, as specified by https://www.w3.org/TR/vc-data-model/#jwt-decoding
+// The given proof is set as proof of the credential, if not nil.
+func CredentialFromClaims(claims map[string]interface{}, proof interface{}) (*vc.VerifiableCredential, error) {
+	document, ok := claims[vcClaim].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("JWT doesn't contain a credential ('vc' claim)")
+	}
+	if err := setFromClaim(document, "issuer", claims["iss"]); err != nil {
+		return nil, err
+	}
+	if err := setFromClaim(document, "id", claims["jti"]); err != nil {
+		return nil, err
+	}
+	if err := setDateFromClaim(document, "issuanceDate", claims["nbf"]); err != nil {
+		return nil, err
+	}
+	if err := setDateFromClaim(document, "expirationDate", claims["exp"]); err != nil {
+		return nil, err
+	}
+	if sub, ok := claims["sub"]; ok {
+		subject, ok := singleSubject(document)
+		if !ok {
+			return nil, errors.New("JWT 'sub' claim requires a single credential subject")
+		}
+		if err := setFromClaim(subject, "id", sub); err != nil {
+			return nil, err
+		}
+	}
+	if proof != nil {
+		document["proof"] = proof
+	}
+	result := vc.VerifiableCredential{}
+	if err := remarshal(document, &result); err != nil {
+		return nil, fmt.Errorf("invalid credential in JWT: %w", err)
+	}
+	return &result, nil
+}
+
+// PresentationFromClaims decodes the presentation from the claims of a VP-JWT, as specified by https://www.w3.org/TR/vc-data-model/#jwt-decoding
+// Contained credentials in compact JWT or SD-JWT form are decoded as well. The given proof is set as proof of the presentation, if not nil.
+func PresentationFromClaims(claims map[string]interface{}, proof interface{}) (*vc.VerifiablePresentation, error) {
+	document, ok := claims[vpClaim].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("JWT doesn't contain a presentation ('vp' claim)")
+	}
+	if err := setFromClaim(document, "holder", claims["iss"]); err != nil {
+		return nil, err
+	}
+	if err := setFromClaim(document, "id", claims["jti"]); err != nil {
+		return nil, err
+	}
+	if credentials, ok := document["verifiableCredential"].([]interface{}); ok {
+		for i, credential := range credentials {
+			compact, ok := credential.(string)
+			if !ok {
+				continue
+			}
+			decoded, err := decodeCredential(compact)
+			if err != nil {
+				return nil, fmt.Errorf("invalid credential in presentation: %w", err)
+			}
+			credentials[i] = decoded
+		}
+	}
+	if proof != nil {
+		document["proof"] = proof
+	}
+	result := vc.VerifiablePresentation{}
+	if err := remarshal(document, &result); err != nil {
+		return nil, fmt.Errorf("invalid presentation in JWT: %w", err)
+	}
+	return &result, nil
+}
+
+// encodeCredential returns the compact form of a credential in JWT format, or the credential itself in other cases.
+func encodeCredential(credential vc.VerifiableCredential) (interface{}, error) {
+	document, err := NewSignedDocument(credential)
+	if err != nil {
+		return nil, err
+	}
+	if compact, ok := document.CompactJWT(); ok {
+		return compact, nil
+	}
+	return credential, nil
+}
+
+// decodeCredential decodes a credential in compact JWT or SD-JWT form to its JSON form.
+func decodeCredential(compact string) (*vc.VerifiableCredential, error) {
+	return ParseCompactCredential(compact)
+}
+
+// ParseCompactCredential decodes a credential in compact JWT or SD-JWT form to its JSON form. It does not verify the (SD-)JWT.
+// The compact form of an SD-JWT is recognized by the '~' separating the JWT and the disclosures.
+func ParseCompactCredential(compact string) (*vc.VerifiableCredential, error) {
+	if strings.Contains(compact, sdSeparator) {
+		return ParseSDJWTCredential(compact)
+	}
+	return ParseJWTCredential(compact)
+}
+
+// singleSubject returns the credential subject of the credential document, if it has exactly one.
+func singleSubject(document map[string]interface{}) (map[string]interface{}, bool) {
+	switch subject := document["credentialSubject"].(type) {
+	case map[string]interface{}:
+		return subject, true
+	case []interface{}:
+		if len(subject) == 1 {
+			asMap, ok := subject[0].(map[string]interface{})
+			return asMap, ok
+		}
+	}
+	return nil, false
+}
+
+// setFromClaim sets the property of the document to the value of a claim. It fails if the property has a different value.
+func setFromClaim(document map[string]interface{}, property string, claim interface{}) error {
+	if claim == nil {
+		return nil
+	}
+	value, ok := claim.(string)
+	if !ok {
+		return fmt.Errorf("JWT claim for '%s' must be a string", property)
+	}
+	if current, exists := document[property]; exists && current != value {
+		return fmt.Errorf("JWT claim for '%s' does not match the value in the document", property)
+	}
+	document[property] = value
+	return nil
+}
+
+// setDateFromClaim sets the property of the document to the date of a NumericDate claim.
+// The claim takes precedence over the property, since the property may contain a more precise date.
+func setDateFromClaim(document map[string]interface{}, property string, claim interface{}) error {
+	if claim == nil {
+		return nil
+	}
+	var seconds int64
+	switch value := claim.(type) {
+	case float64:
+		seconds = int64(value)
+	case int64:
+		seconds = value
+	case json.Number:
+		parsed, err := value.Int64()
+		if err != nil {
+			return fmt.Errorf("JWT claim for '%s' must be a NumericDate: %w", property, err)
+		}
+		seconds = parsed
+	default:
+		return fmt.Errorf("JWT claim for '%s' must be a NumericDate", property)
+	}
+	document[property] = time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+	return nil
+}
+
+func unmarshalClaims(payload []byte) (map[string]interface{}, error) {
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("invalid JWT claims: %w", err)
+	}
+	return claims, nil
+}
+
+func remarshal(source interface{}, target interface{}) error {
+	data, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
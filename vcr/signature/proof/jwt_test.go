@@ -0,0 +1,190 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package proof
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWTProof(t *testing.T) {
+	kid := "did:nuts:123#abc"
+	testKey := crypto.NewTestKey(kid)
+	claims := map[string]interface{}{"iss": "did:nuts:123", "vc": map[string]interface{}{"id": "did:nuts:123#1"}}
+
+	t.Run("sign and verify", func(t *testing.T) {
+		jwtProof, err := NewJWTProof(claims, testKey)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, JwtProof2020, jwtProof.Type)
+
+		actualKID, err := jwtProof.KID()
+		assert.NoError(t, err)
+		assert.Equal(t, kid, actualKID)
+
+		actualClaims, err := jwtProof.Verify(testKey.Public())
+		assert.NoError(t, err)
+		assert.Equal(t, claims, actualClaims)
+	})
+	t.Run("error - invalid signature", func(t *testing.T) {
+		jwtProof, _ := NewJWTProof(claims, testKey)
+		otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+		actualClaims, err := jwtProof.Verify(otherKey.Public())
+
+		assert.Contains(t, err.Error(), "invalid JWT signature")
+		assert.Nil(t, actualClaims)
+	})
+	t.Run("error - invalid JWT", func(t *testing.T) {
+		jwtProof := JWTProof{Type: JwtProof2020, JWT: "not a JWT"}
+
+		_, err := jwtProof.KID()
+
+		assert.Contains(t, err.Error(), "invalid JWT")
+	})
+}
+
+func TestSignedDocument_HasJWTProof(t *testing.T) {
+	assert.True(t, SignedDocument{"proof": map[string]interface{}{"type": "JwtProof2020"}}.HasJWTProof())
+	assert.False(t, SignedDocument{"proof": map[string]interface{}{"type": "JsonWebSignature2020"}}.HasJWTProof())
+	assert.False(t, SignedDocument{}.HasJWTProof())
+}
+
+func TestCredentialClaims(t *testing.T) {
+	testKey := crypto.NewTestKey("did:nuts:issuer#1")
+	issuanceDate := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	expirationDate := issuanceDate.Add(time.Hour)
+	credentialID := ssi.MustParseURI("did:nuts:issuer#credential")
+	credential := vc.VerifiableCredential{
+		Context:           []ssi.URI{vc.VCContextV1URI()},
+		Type:              []ssi.URI{vc.VerifiableCredentialTypeV1URI()},
+		ID:                &credentialID,
+		Issuer:            ssi.MustParseURI("did:nuts:issuer"),
+		IssuanceDate:      issuanceDate,
+		ExpirationDate:    &expirationDate,
+		CredentialSubject: []interface{}{map[string]interface{}{"id": "did:nuts:subject", "name": "Jane"}},
+	}
+
+	t.Run("maps to registered claims", func(t *testing.T) {
+		claims, err := CredentialClaims(credential)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "did:nuts:issuer", claims["iss"])
+		assert.Equal(t, "did:nuts:subject", claims["sub"])
+		assert.Equal(t, credentialID.String(), claims["jti"])
+		assert.Equal(t, issuanceDate.Unix(), claims["nbf"])
+		assert.Equal(t, expirationDate.Unix(), claims["exp"])
+		document := claims["vc"].(map[string]interface{})
+		assert.NotContains(t, document, "issuer")
+		assert.NotContains(t, document, "id")
+		assert.NotContains(t, document, "issuanceDate")
+		assert.NotContains(t, document, "expirationDate")
+		assert.NotContains(t, document["credentialSubject"], "id")
+	})
+	t.Run("round trip through compact JWT", func(t *testing.T) {
+		claims, _ := CredentialClaims(credential)
+		jwtProof, _ := NewJWTProof(claims, testKey)
+
+		decoded, err := ParseJWTCredential(jwtProof.JWT)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, credential.ID.String(), decoded.ID.String())
+		assert.Equal(t, credential.Issuer.String(), decoded.Issuer.String())
+		assert.True(t, credential.IssuanceDate.Equal(decoded.IssuanceDate))
+		assert.True(t, credential.ExpirationDate.Equal(*decoded.ExpirationDate))
+		assert.Equal(t, "did:nuts:subject", decoded.CredentialSubject[0].(map[string]interface{})["id"])
+		document, _ := NewSignedDocument(decoded)
+		compact, ok := document.CompactJWT()
+		assert.True(t, ok)
+		assert.Equal(t, jwtProof.JWT, compact)
+	})
+	t.Run("error - claim conflicts with document", func(t *testing.T) {
+		claims, _ := CredentialClaims(credential)
+		claims["vc"].(map[string]interface{})["issuer"] = "did:nuts:other"
+
+		_, err := CredentialFromClaims(claims, nil)
+
+		assert.EqualError(t, err, "JWT claim for 'issuer' does not match the value in the document")
+	})
+	t.Run("error - no vc claim", func(t *testing.T) {
+		_, err := CredentialFromClaims(map[string]interface{}{"iss": "did:nuts:issuer"}, nil)
+
+		assert.EqualError(t, err, "JWT doesn't contain a credential ('vc' claim)")
+	})
+}
+
+func TestPresentationClaims(t *testing.T) {
+	issuerKey := crypto.NewTestKey("did:nuts:issuer#1")
+	holderKey := crypto.NewTestKey("did:nuts:holder#1")
+	credentialClaims := map[string]interface{}{
+		"iss": "did:nuts:issuer",
+		"nbf": time.Now().Unix(),
+		"vc": map[string]interface{}{
+			"@context":          []interface{}{vc.VCContextV1},
+			"type":              []interface{}{vc.VerifiableCredentialType},
+			"credentialSubject": map[string]interface{}{"name": "Jane"},
+		},
+	}
+	credentialProof, _ := NewJWTProof(credentialClaims, issuerKey)
+	credential, _ := credentialProof.Credential()
+	holder := ssi.MustParseURI("did:nuts:holder")
+	presentation := vc.VerifiablePresentation{
+		Context:              []ssi.URI{vc.VCContextV1URI()},
+		Type:                 []ssi.URI{vc.VerifiablePresentationTypeV1URI()},
+		Holder:               &holder,
+		VerifiableCredential: []vc.VerifiableCredential{*credential},
+	}
+	challenge := "nonce"
+	domain := "domain"
+
+	claims, err := PresentationClaims(presentation, ProofOptions{Challenge: &challenge, Domain: &domain})
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "did:nuts:holder", claims["iss"])
+	assert.Equal(t, "nonce", claims["nonce"])
+	assert.Equal(t, "domain", claims["aud"])
+	document := claims["vp"].(map[string]interface{})
+	assert.NotContains(t, document, "holder")
+	assert.Equal(t, []interface{}{credentialProof.JWT}, document["verifiableCredential"])
+
+	t.Run("round trip through compact JWT", func(t *testing.T) {
+		jwtProof, _ := NewJWTProof(claims, holderKey)
+
+		decoded, err := ParseJWTPresentation(jwtProof.JWT)
+
+		if !assert.NoError(t, err) || !assert.Len(t, decoded.VerifiableCredential, 1) {
+			return
+		}
+		assert.Equal(t, "did:nuts:holder", decoded.Holder.String())
+		assert.Equal(t, "did:nuts:issuer", decoded.VerifiableCredential[0].Issuer.String())
+	})
+}
@@ -0,0 +1,528 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package proof
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jws"
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	nutsCrypto "github.com/nuts-foundation/nuts-node/crypto"
+)
+
+// SdJwtProof2022 contains the string value for the proof type of credentials in SD-JWT format.
+// Credentials in SD-JWT format are exchanged in the compact form of the SD-JWT. Within the node they're kept in their JSON form,
+// which is decoded from the disclosed claims and has the SD-JWT as proof, so it can be stored and searched like any other.
+const SdJwtProof2022 = ssi.ProofType("SdJwtProof2022")
+
+const (
+	// sdClaim contains the digests of the disclosures of an object
+	sdClaim = "_sd"
+	// sdAlgClaim contains the hash algorithm used for the digests
+	sdAlgClaim = "_sd_alg"
+	// sdAlg is the only supported hash algorithm
+	sdAlg = "sha-256"
+	// sdSeparator separates the JWT, the disclosures and the key binding JWT in the compact form
+	sdSeparator = "~"
+	// sdHashClaim contains the digest of the presented SD-JWT in the key binding JWT
+	sdHashClaim = "sd_hash"
+	// keyBindingType is the 'typ' header of the key binding JWT
+	keyBindingType = "kb+jwt"
+	// saltSize is the number of random bytes of the salt of a disclosure
+	saltSize = 16
+)
+
+// SDJWTProof contains a credential encoded as Selective Disclosure JWT, as specified by
+// https://datatracker.ietf.org/doc/draft-ietf-oauth-selective-disclosure-jwt/
+// Selectively disclosable claims are replaced by the digests of their disclosures in the signed JWT,
+// so a holder can leave out disclosures to withhold the claims. When presenting the SD-JWT, the holder binds it to the
+// presentation with a key binding JWT.
+type SDJWTProof struct {
+	// Type contains the proof type, which is always SdJwtProof2022
+	Type ssi.ProofType `json:"type"`
+	// JWT contains the SD-JWT in compact form: the JWT signed by the issuer, followed by the disclosures and the optional
+	// key binding JWT of the holder, separated by '~' (<issuer-jwt>~<disclosure>~...~<kb-jwt>).
+	JWT string `json:"jwt"`
+}
+
+// ParseSDJWTCredential decodes a credential in compact SD-JWT form to its JSON form, containing the disclosed claims.
+// It does not verify the SD-JWT.
+func ParseSDJWTCredential(token string) (*vc.VerifiableCredential, error) {
+	return SDJWTProof{Type: SdJwtProof2022, JWT: token}.Credential()
+}
+
+// disclosure contains a selectively disclosable claim
+type disclosure struct {
+	encoded string
+	name    string
+	value   interface{}
+}
+
+// NewSDJWTProof signs the given claims as SD-JWT using the given key.
+// The disclosable claims are given as paths of object keys separated by dots, e.g. 'vc.credentialSubject.name'.
+// Arrays on the path apply the remainder of the path to every object in the array. Claims that don't exist are ignored.
+// Nested claims are disclosable within their disclosable parent claim.
+func NewSDJWTProof(claims map[string]interface{}, disclosable []string, key nutsCrypto.Key) (*SDJWTProof, error) {
+	// copy the claims, so they can be modified
+	var payload map[string]interface{}
+	claimsAsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+	_ = json.Unmarshal(claimsAsJSON, &payload)
+
+	// the deepest claims are made disclosable first, so they end up in the disclosures of their parent claims
+	paths := make([]string, len(disclosable))
+	copy(paths, disclosable)
+	sort.SliceStable(paths, func(i, j int) bool {
+		return strings.Count(paths[i], ".") > strings.Count(paths[j], ".")
+	})
+	var disclosures []string
+	for _, path := range paths {
+		if err = makeDisclosable(payload, strings.Split(path, "."), &disclosures); err != nil {
+			return nil, err
+		}
+	}
+	payload[sdAlgClaim] = sdAlg
+
+	payloadAsJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]interface{}{
+		jws.KeyIDKey: key.KID(),
+		jws.TypeKey:  "vc+sd-jwt",
+	}
+	token, err := nutsCrypto.SignJWS(payloadAsJSON, headers, key.Signer())
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign SD-JWT: %w", err)
+	}
+	return &SDJWTProof{Type: SdJwtProof2022, JWT: combine(token, disclosures)}, nil
+}
+
+func makeDisclosable(object map[string]interface{}, path []string, disclosures *[]string) error {
+	value, exists := object[path[0]]
+	if !exists {
+		return nil
+	}
+	if len(path) > 1 {
+		switch child := value.(type) {
+		case map[string]interface{}:
+			return makeDisclosable(child, path[1:], disclosures)
+		case []interface{}:
+			for _, element := range child {
+				if elementAsMap, ok := element.(map[string]interface{}); ok {
+					if err := makeDisclosable(elementAsMap, path[1:], disclosures); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal([]interface{}{base64.RawURLEncoding.EncodeToString(salt), path[0], value})
+	if err != nil {
+		return err
+	}
+	d := base64.RawURLEncoding.EncodeToString(encoded)
+	*disclosures = append(*disclosures, d)
+
+	digests, _ := object[sdClaim].([]interface{})
+	digests = append(digests, digest(d))
+	// the digests are sorted, so their order doesn't reveal the order of the claims
+	sort.Slice(digests, func(i, j int) bool {
+		return digests[i].(string) < digests[j].(string)
+	})
+	object[sdClaim] = digests
+	delete(object, path[0])
+	return nil
+}
+
+// KID returns the value of the 'kid' header of the JWT. It does not verify the JWT.
+func (p SDJWTProof) KID() (string, error) {
+	token, _, _, err := p.split()
+	if err != nil {
+		return "", err
+	}
+	kid, _, err := nutsCrypto.JWTKidAlg(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid JWT: %w", err)
+	}
+	return kid, nil
+}
+
+// Verify verifies the signature of the JWT with the provided public key and the disclosures against their digests.
+// If both are valid, it returns the claims of the JWT with the disclosed claims. Claims that aren't disclosed are left out.
+// It does not validate the claims themselves.
+func (p SDJWTProof) Verify(key crypto.PublicKey) (map[string]interface{}, error) {
+	token, disclosures, _, err := p.split()
+	if err != nil {
+		return nil, err
+	}
+	alg, err := nutsCrypto.SignatureAlgorithm(key)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := jws.Verify([]byte(token), alg, key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT signature: %w", err)
+	}
+	return disclose(payload, disclosures)
+}
+
+// Claims returns the claims of the JWT with the disclosed claims, like Verify, but without verifying the signature.
+func (p SDJWTProof) Claims() (map[string]interface{}, error) {
+	token, disclosures, _, err := p.split()
+	if err != nil {
+		return nil, err
+	}
+	message, err := jws.ParseString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT: %w", err)
+	}
+	return disclose(message.Payload(), disclosures)
+}
+
+// Credential decodes the credential from the disclosed claims of the SD-JWT, with the SD-JWT as proof. It does not verify the SD-JWT.
+func (p SDJWTProof) Credential() (*vc.VerifiableCredential, error) {
+	claims, err := p.Claims()
+	if err != nil {
+		return nil, err
+	}
+	return CredentialFromClaims(claims, p)
+}
+
+// Disclose returns a copy of the proof that only contains the disclosures of the given claims, to present it to a verifier.
+// The claims are given as paths of object keys separated by dots, like for NewSDJWTProof.
+// Nested claims are only disclosed if their parent claim is disclosed as well. The key binding JWT is left out.
+func (p SDJWTProof) Disclose(claims []string) (*SDJWTProof, error) {
+	token, disclosures, _, err := p.split()
+	if err != nil {
+		return nil, err
+	}
+	message, err := jws.ParseString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT: %w", err)
+	}
+	var payload map[string]interface{}
+	if err = json.Unmarshal(message.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("invalid JWT claims: %w", err)
+	}
+
+	requested := map[string]bool{}
+	for _, claim := range claims {
+		requested[claim] = true
+	}
+	byDigest := map[string]disclosure{}
+	for _, d := range disclosures {
+		byDigest[digest(d.encoded)] = d
+	}
+	var disclosed []string
+	walkDisclosures(payload, "", byDigest, func(d disclosure, path string) bool {
+		if !requested[path] {
+			return false
+		}
+		disclosed = append(disclosed, d.encoded)
+		return true
+	})
+	return &SDJWTProof{Type: p.Type, JWT: combine(token, disclosed)}, nil
+}
+
+// WithKeyBinding returns a copy of the proof with a key binding JWT signed by the holder with the given key, replacing an existing one.
+// The key binding JWT contains the digest of the SD-JWT with its disclosures ('sd_hash'), so disclosures can't be added or left out
+// afterwards. The nonce and audience are set as 'nonce' and 'aud' claims when not empty, to bind it to a single verifier and request.
+func (p SDJWTProof) WithKeyBinding(key nutsCrypto.Key, nonce string, audience string, issuedAt time.Time) (*SDJWTProof, error) {
+	token, disclosures, _, err := p.split()
+	if err != nil {
+		return nil, err
+	}
+	presented := combineDisclosures(token, disclosures)
+	claims := map[string]interface{}{
+		"iat":       issuedAt.Unix(),
+		sdHashClaim: digest(presented),
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	if audience != "" {
+		claims["aud"] = audience
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]interface{}{
+		jws.KeyIDKey: key.KID(),
+		jws.TypeKey:  keyBindingType,
+	}
+	keyBinding, err := nutsCrypto.SignJWS(payload, headers, key.Signer())
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign key binding JWT: %w", err)
+	}
+	return &SDJWTProof{Type: p.Type, JWT: presented + keyBinding}, nil
+}
+
+// KeyBindingKID returns the value of the 'kid' header of the key binding JWT. It does not verify the key binding JWT.
+// It returns an error if the SD-JWT doesn't contain a key binding JWT.
+func (p SDJWTProof) KeyBindingKID() (string, error) {
+	_, _, keyBinding, err := p.split()
+	if err != nil {
+		return "", err
+	}
+	if keyBinding == "" {
+		return "", errors.New("SD-JWT doesn't contain a key binding JWT")
+	}
+	kid, _, err := nutsCrypto.JWTKidAlg(keyBinding)
+	if err != nil {
+		return "", fmt.Errorf("invalid key binding JWT: %w", err)
+	}
+	return kid, nil
+}
+
+// VerifyKeyBinding verifies the signature of the key binding JWT with the provided public key of the holder, and whether it's bound
+// to the SD-JWT with the presented disclosures. If both are valid, it returns the claims of the key binding JWT.
+// It does not validate the 'nonce', 'aud' and 'iat' claims.
+func (p SDJWTProof) VerifyKeyBinding(key crypto.PublicKey) (map[string]interface{}, error) {
+	token, disclosures, keyBinding, err := p.split()
+	if err != nil {
+		return nil, err
+	}
+	if keyBinding == "" {
+		return nil, errors.New("SD-JWT doesn't contain a key binding JWT")
+	}
+	alg, err := nutsCrypto.SignatureAlgorithm(key)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := jws.Verify([]byte(keyBinding), alg, key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key binding JWT signature: %w", err)
+	}
+	message, _ := jws.ParseString(keyBinding)
+	if len(message.Signatures()) != 1 || message.Signatures()[0].ProtectedHeaders().Type() != keyBindingType {
+		return nil, fmt.Errorf("invalid key binding JWT: 'typ' header must be '%s'", keyBindingType)
+	}
+	claims, err := unmarshalClaims(payload)
+	if err != nil {
+		return nil, err
+	}
+	if claims[sdHashClaim] != digest(combineDisclosures(token, disclosures)) {
+		return nil, errors.New("key binding JWT isn't bound to the presented SD-JWT")
+	}
+	return claims, nil
+}
+
+// walkDisclosures calls visit for every disclosure referenced by the given value, with the path of the disclosed claim.
+// Disclosures referenced by a disclosed value are only visited when visit returns true.
+func walkDisclosures(value interface{}, path string, byDigest map[string]disclosure, visit func(d disclosure, path string) bool) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			if key == sdClaim {
+				digests, _ := child.([]interface{})
+				for _, curr := range digests {
+					digestAsString, _ := curr.(string)
+					d, ok := byDigest[digestAsString]
+					if !ok {
+						continue
+					}
+					claimPath := joinPath(path, d.name)
+					if visit(d, claimPath) {
+						walkDisclosures(d.value, claimPath, byDigest, visit)
+					}
+				}
+				continue
+			}
+			walkDisclosures(child, joinPath(path, key), byDigest, visit)
+		}
+	case []interface{}:
+		for _, element := range typed {
+			walkDisclosures(element, path, byDigest, visit)
+		}
+	}
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// disclose replaces the digests in the JWT payload by the claims of the disclosures.
+func disclose(payload []byte, disclosures []disclosure) (map[string]interface{}, error) {
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("invalid JWT claims: %w", err)
+	}
+	if alg, ok := claims[sdAlgClaim]; ok && alg != sdAlg {
+		return nil, fmt.Errorf("unsupported SD-JWT hash algorithm: %v", alg)
+	}
+	delete(claims, sdAlgClaim)
+
+	byDigest := map[string]disclosure{}
+	for _, d := range disclosures {
+		key := digest(d.encoded)
+		if _, exists := byDigest[key]; exists {
+			return nil, errors.New("duplicate disclosure")
+		}
+		byDigest[key] = d
+	}
+	used := map[string]bool{}
+	result, err := resolveDisclosures(claims, byDigest, used)
+	if err != nil {
+		return nil, err
+	}
+	if len(used) != len(byDigest) {
+		return nil, errors.New("disclosure isn't referenced by the SD-JWT")
+	}
+	return result.(map[string]interface{}), nil
+}
+
+func resolveDisclosures(value interface{}, byDigest map[string]disclosure, used map[string]bool) (interface{}, error) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		result := map[string]interface{}{}
+		for key, child := range typed {
+			if key == sdClaim {
+				continue
+			}
+			resolved, err := resolveDisclosures(child, byDigest, used)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = resolved
+		}
+		digests, _ := typed[sdClaim].([]interface{})
+		for _, curr := range digests {
+			digestAsString, _ := curr.(string)
+			d, ok := byDigest[digestAsString]
+			if !ok {
+				// claim isn't disclosed
+				continue
+			}
+			if used[digestAsString] {
+				return nil, errors.New("digest is referenced more than once")
+			}
+			used[digestAsString] = true
+			if _, exists := result[d.name]; exists {
+				return nil, fmt.Errorf("disclosed claim already exists: %s", d.name)
+			}
+			resolved, err := resolveDisclosures(d.value, byDigest, used)
+			if err != nil {
+				return nil, err
+			}
+			result[d.name] = resolved
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, len(typed))
+		for i, element := range typed {
+			resolved, err := resolveDisclosures(element, byDigest, used)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = resolved
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+// split splits the compact form into the JWT, the disclosures and the key binding JWT, which is empty if there is none.
+func (p SDJWTProof) split() (string, []disclosure, string, error) {
+	parts := strings.Split(p.JWT, sdSeparator)
+	if len(parts) < 2 {
+		return "", nil, "", errors.New("invalid SD-JWT: expected JWT and disclosures separated by '~'")
+	}
+	var disclosures []disclosure
+	for _, encoded := range parts[1 : len(parts)-1] {
+		d, err := parseDisclosure(encoded)
+		if err != nil {
+			return "", nil, "", err
+		}
+		disclosures = append(disclosures, d)
+	}
+	return parts[0], disclosures, parts[len(parts)-1], nil
+}
+
+func parseDisclosure(encoded string) (disclosure, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return disclosure{}, fmt.Errorf("invalid disclosure: %w", err)
+	}
+	var elements []interface{}
+	if err = json.Unmarshal(data, &elements); err != nil {
+		return disclosure{}, fmt.Errorf("invalid disclosure: %w", err)
+	}
+	if len(elements) != 3 {
+		return disclosure{}, errors.New("invalid disclosure: expected salt, claim name and claim value")
+	}
+	name, ok := elements[1].(string)
+	if _, saltOk := elements[0].(string); !ok || !saltOk {
+		return disclosure{}, errors.New("invalid disclosure: salt and claim name must be strings")
+	}
+	return disclosure{encoded: encoded, name: name, value: elements[2]}, nil
+}
+
+func combine(token string, disclosures []string) string {
+	builder := strings.Builder{}
+	builder.WriteString(token)
+	builder.WriteString(sdSeparator)
+	for _, d := range disclosures {
+		builder.WriteString(d)
+		builder.WriteString(sdSeparator)
+	}
+	return builder.String()
+}
+
+func combineDisclosures(token string, disclosures []disclosure) string {
+	encoded := make([]string, len(disclosures))
+	for i, d := range disclosures {
+		encoded[i] = d.encoded
+	}
+	return combine(token, encoded)
+}
+
+func digest(encodedDisclosure string) string {
+	hash := sha256.Sum256([]byte(encodedDisclosure))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// HasSDJWTProof returns true if the proof of the document is an SD-JWT proof.
+func (d SignedDocument) HasSDJWTProof() bool {
+	proofAsMap, ok := d["proof"].(map[string]interface{})
+	return ok && proofAsMap["type"] == string(SdJwtProof2022)
+}
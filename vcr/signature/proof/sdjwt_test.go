@@ -0,0 +1,316 @@
+/*
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package proof
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSDJWTProof(t *testing.T) {
+	kid := "did:nuts:123#abc"
+	testKey := crypto.NewTestKey(kid)
+	claims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss": "did:nuts:123",
+			"vc": map[string]interface{}{
+				"id": "did:nuts:123#1",
+				"credentialSubject": map[string]interface{}{
+					"id": "did:nuts:456",
+					"organization": map[string]interface{}{
+						"name": "Because we care B.V.",
+						"city": "IJbergen",
+					},
+					"resources": []interface{}{"/composition/1"},
+				},
+			},
+		}
+	}
+	disclosable := []string{"vc.credentialSubject.organization", "vc.credentialSubject.organization.city", "vc.credentialSubject.resources", "vc.credentialSubject.unknown"}
+
+	t.Run("sign and verify", func(t *testing.T) {
+		sdJWTProof, err := NewSDJWTProof(claims(), disclosable, testKey)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, SdJwtProof2022, sdJWTProof.Type)
+		assert.Equal(t, 4, strings.Count(sdJWTProof.JWT, "~"), "expected 3 disclosures")
+
+		actualKID, err := sdJWTProof.KID()
+		assert.NoError(t, err)
+		assert.Equal(t, kid, actualKID)
+
+		actualClaims, err := sdJWTProof.Verify(testKey.Public())
+		assert.NoError(t, err)
+		assert.Equal(t, claims(), actualClaims)
+	})
+	t.Run("disclosable claims are not in the JWT", func(t *testing.T) {
+		sdJWTProof, _ := NewSDJWTProof(claims(), disclosable, testKey)
+
+		withoutDisclosures := SDJWTProof{Type: SdJwtProof2022, JWT: strings.Split(sdJWTProof.JWT, "~")[0] + "~"}
+		actualClaims, err := withoutDisclosures.Verify(testKey.Public())
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, map[string]interface{}{"id": "did:nuts:456"}, actualClaims["vc"].(map[string]interface{})["credentialSubject"])
+	})
+	t.Run("disclose", func(t *testing.T) {
+		sdJWTProof, _ := NewSDJWTProof(claims(), disclosable, testKey)
+
+		t.Run("parent claim only", func(t *testing.T) {
+			disclosed, err := sdJWTProof.Disclose([]string{"vc.credentialSubject.organization"})
+			if !assert.NoError(t, err) {
+				return
+			}
+			actualClaims, err := disclosed.Verify(testKey.Public())
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, map[string]interface{}{
+				"id":           "did:nuts:456",
+				"organization": map[string]interface{}{"name": "Because we care B.V."},
+			}, actualClaims["vc"].(map[string]interface{})["credentialSubject"])
+			claimsWithoutVerification, err := disclosed.Claims()
+			assert.NoError(t, err)
+			assert.Equal(t, actualClaims, claimsWithoutVerification)
+		})
+		t.Run("nested claim without parent claim", func(t *testing.T) {
+			disclosed, err := sdJWTProof.Disclose([]string{"vc.credentialSubject.organization.city", "vc.credentialSubject.resources"})
+			if !assert.NoError(t, err) {
+				return
+			}
+			actualClaims, err := disclosed.Verify(testKey.Public())
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, map[string]interface{}{
+				"id":        "did:nuts:456",
+				"resources": []interface{}{"/composition/1"},
+			}, actualClaims["vc"].(map[string]interface{})["credentialSubject"])
+		})
+		t.Run("nothing", func(t *testing.T) {
+			disclosed, err := sdJWTProof.Disclose(nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, strings.Split(sdJWTProof.JWT, "~")[0]+"~", disclosed.JWT)
+		})
+	})
+	t.Run("array of objects", func(t *testing.T) {
+		input := map[string]interface{}{"subjects": []interface{}{
+			map[string]interface{}{"name": "a"},
+			map[string]interface{}{"name": "b"},
+		}}
+
+		sdJWTProof, err := NewSDJWTProof(input, []string{"subjects.name"}, testKey)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 3, strings.Count(sdJWTProof.JWT, "~"))
+		actualClaims, err := sdJWTProof.Verify(testKey.Public())
+		assert.NoError(t, err)
+		assert.Equal(t, input, actualClaims)
+	})
+	t.Run("error - invalid signature", func(t *testing.T) {
+		sdJWTProof, _ := NewSDJWTProof(claims(), disclosable, testKey)
+		otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+		actualClaims, err := sdJWTProof.Verify(otherKey.Public())
+
+		assert.Contains(t, err.Error(), "invalid JWT signature")
+		assert.Nil(t, actualClaims)
+	})
+	t.Run("error - disclosure not referenced", func(t *testing.T) {
+		sdJWTProof, _ := NewSDJWTProof(claims(), disclosable, testKey)
+		forged := base64.RawURLEncoding.EncodeToString([]byte(`["salt", "name", "Evil Corp"]`))
+		sdJWTProof.JWT += forged + "~"
+
+		_, err := sdJWTProof.Verify(testKey.Public())
+
+		assert.EqualError(t, err, "disclosure isn't referenced by the SD-JWT")
+	})
+	t.Run("error - duplicate disclosure", func(t *testing.T) {
+		sdJWTProof, _ := NewSDJWTProof(claims(), disclosable, testKey)
+		sdJWTProof.JWT += strings.Split(sdJWTProof.JWT, "~")[1] + "~"
+
+		_, err := sdJWTProof.Verify(testKey.Public())
+
+		assert.EqualError(t, err, "duplicate disclosure")
+	})
+	t.Run("error - invalid disclosure", func(t *testing.T) {
+		sdJWTProof, _ := NewSDJWTProof(claims(), disclosable, testKey)
+		sdJWTProof.JWT += base64.RawURLEncoding.EncodeToString([]byte(`["salt", "name"]`)) + "~"
+
+		_, err := sdJWTProof.Verify(testKey.Public())
+
+		assert.EqualError(t, err, "invalid disclosure: expected salt, claim name and claim value")
+	})
+	t.Run("error - not in compact form", func(t *testing.T) {
+		sdJWTProof, _ := NewSDJWTProof(claims(), disclosable, testKey)
+		sdJWTProof.JWT = strings.Split(sdJWTProof.JWT, "~")[0]
+
+		_, err := sdJWTProof.Verify(testKey.Public())
+
+		assert.Contains(t, err.Error(), "invalid SD-JWT")
+	})
+	t.Run("error - invalid JWT", func(t *testing.T) {
+		sdJWTProof := SDJWTProof{Type: SdJwtProof2022, JWT: "not a JWT~"}
+
+		_, err := sdJWTProof.KID()
+		assert.Contains(t, err.Error(), "invalid JWT")
+		_, err = sdJWTProof.Claims()
+		assert.Contains(t, err.Error(), "invalid JWT")
+		_, err = sdJWTProof.Disclose(nil)
+		assert.Contains(t, err.Error(), "invalid JWT")
+	})
+}
+
+func TestSDJWTProof_KeyBinding(t *testing.T) {
+	issuerKey := crypto.NewTestKey("did:nuts:123#abc")
+	holderKey := crypto.NewTestKey("did:nuts:456#def")
+	claims := map[string]interface{}{
+		"iss": "did:nuts:123",
+		"vc": map[string]interface{}{
+			"credentialSubject": map[string]interface{}{"id": "did:nuts:456", "name": "Jane", "city": "IJbergen"},
+		},
+	}
+	sdJWTProof, _ := NewSDJWTProof(claims, []string{"vc.credentialSubject.name", "vc.credentialSubject.city"}, issuerKey)
+	issuedAt := time.Now().Truncate(time.Second)
+
+	t.Run("bind and verify", func(t *testing.T) {
+		bound, err := sdJWTProof.WithKeyBinding(holderKey, "nonce", "verifier", issuedAt)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, strings.HasPrefix(bound.JWT, sdJWTProof.JWT))
+		assert.False(t, strings.HasSuffix(bound.JWT, "~"))
+
+		kid, err := bound.KeyBindingKID()
+		assert.NoError(t, err)
+		assert.Equal(t, "did:nuts:456#def", kid)
+		keyBindingClaims, err := bound.VerifyKeyBinding(holderKey.Public())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "nonce", keyBindingClaims["nonce"])
+		assert.Equal(t, "verifier", keyBindingClaims["aud"])
+		assert.Equal(t, float64(issuedAt.Unix()), keyBindingClaims["iat"])
+		// the issuer's JWT and the disclosures are unaffected
+		disclosedClaims, err := bound.Verify(issuerKey.Public())
+		assert.NoError(t, err)
+		assert.Equal(t, claims, disclosedClaims)
+	})
+	t.Run("replaces existing key binding", func(t *testing.T) {
+		bound, _ := sdJWTProof.WithKeyBinding(holderKey, "first", "", issuedAt)
+
+		rebound, err := bound.WithKeyBinding(holderKey, "second", "", issuedAt)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, strings.Count(bound.JWT, "~"), strings.Count(rebound.JWT, "~"))
+		keyBindingClaims, err := rebound.VerifyKeyBinding(holderKey.Public())
+		assert.NoError(t, err)
+		assert.Equal(t, "second", keyBindingClaims["nonce"])
+	})
+	t.Run("disclose leaves out key binding", func(t *testing.T) {
+		bound, _ := sdJWTProof.WithKeyBinding(holderKey, "nonce", "verifier", issuedAt)
+
+		disclosed, err := bound.Disclose([]string{"vc.credentialSubject.name"})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, strings.HasSuffix(disclosed.JWT, "~"))
+	})
+	t.Run("error - no key binding", func(t *testing.T) {
+		_, err := sdJWTProof.KeyBindingKID()
+		assert.EqualError(t, err, "SD-JWT doesn't contain a key binding JWT")
+		_, err = sdJWTProof.VerifyKeyBinding(holderKey.Public())
+		assert.EqualError(t, err, "SD-JWT doesn't contain a key binding JWT")
+	})
+	t.Run("error - disclosure withheld after binding", func(t *testing.T) {
+		bound, _ := sdJWTProof.WithKeyBinding(holderKey, "nonce", "verifier", issuedAt)
+		parts := strings.Split(bound.JWT, "~")
+		// leave out the first disclosure
+		tampered := SDJWTProof{Type: SdJwtProof2022, JWT: strings.Join(append(parts[:1], parts[2:]...), "~")}
+
+		_, err := tampered.VerifyKeyBinding(holderKey.Public())
+
+		assert.EqualError(t, err, "key binding JWT isn't bound to the presented SD-JWT")
+	})
+	t.Run("error - invalid signature", func(t *testing.T) {
+		bound, _ := sdJWTProof.WithKeyBinding(holderKey, "nonce", "verifier", issuedAt)
+
+		_, err := bound.VerifyKeyBinding(issuerKey.Public())
+
+		assert.Contains(t, err.Error(), "invalid key binding JWT signature")
+	})
+	t.Run("error - not a key binding JWT", func(t *testing.T) {
+		// a plain JWT of the holder can't be used as key binding JWT
+		jwtProof, _ := NewJWTProof(map[string]interface{}{"nonce": "nonce"}, holderKey)
+		bound := SDJWTProof{Type: SdJwtProof2022, JWT: sdJWTProof.JWT + jwtProof.JWT}
+
+		_, err := bound.VerifyKeyBinding(holderKey.Public())
+
+		assert.EqualError(t, err, "invalid key binding JWT: 'typ' header must be 'kb+jwt'")
+	})
+}
+
+func TestParseSDJWTCredential(t *testing.T) {
+	issuerKey := crypto.NewTestKey("did:nuts:123#abc")
+	claims := map[string]interface{}{
+		"iss": "did:nuts:123",
+		"jti": "did:nuts:123#1",
+		"nbf": time.Now().Unix(),
+		"vc": map[string]interface{}{
+			"@context":          []interface{}{"https://www.w3.org/2018/credentials/v1"},
+			"type":              []interface{}{"VerifiableCredential"},
+			"credentialSubject": map[string]interface{}{"id": "did:nuts:456", "name": "Jane"},
+		},
+	}
+	sdJWTProof, _ := NewSDJWTProof(claims, []string{"vc.credentialSubject.name"}, issuerKey)
+
+	credential, err := ParseSDJWTCredential(sdJWTProof.JWT)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "did:nuts:123#1", credential.ID.String())
+	assert.Equal(t, "Jane", credential.CredentialSubject[0].(map[string]interface{})["name"])
+	document, _ := NewSignedDocument(credential)
+	compact, ok := document.CompactJWT()
+	assert.True(t, ok)
+	assert.Equal(t, sdJWTProof.JWT, compact)
+}
+
+func TestSignedDocument_HasSDJWTProof(t *testing.T) {
+	assert.True(t, SignedDocument{"proof": map[string]interface{}{"type": "SdJwtProof2022"}}.HasSDJWTProof())
+	assert.False(t, SignedDocument{"proof": map[string]interface{}{"type": "JwtProof2020"}}.HasSDJWTProof())
+	assert.False(t, SignedDocument{}.HasSDJWTProof())
+}
@@ -0,0 +1,286 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+)
+
+// progressInterval is the number of documents after which the progress of a check is logged.
+const progressInterval = 1000
+
+// Types of problems found in a collection.
+const (
+	// InvalidProblem indicates a document doesn't parse or doesn't validate.
+	InvalidProblem = "invalid"
+	// DuplicateProblem indicates a document has the same ID as another document.
+	DuplicateProblem = "duplicate"
+	// UnreachableProblem indicates a document can't be found through an index it should be in.
+	UnreachableProblem = "unreachable"
+	// OrphanProblem indicates an index refers to a document that doesn't exist.
+	OrphanProblem = "orphan"
+)
+
+// IndexedField is a field of an index.
+type IndexedField struct {
+	// Path is the JSON path of the field in the documents.
+	Path string
+	// QueryName is the name by which the field is queried: its alias, or its path if it has no alias.
+	QueryName string
+}
+
+// Index describes an index of a collection, so it can be rebuilt and its entries can be checked against the documents.
+type Index struct {
+	leia.Index
+	// Fields contains the fields of the index, in the order of the index.
+	Fields []IndexedField
+}
+
+// NewIndex creates an Index on the given JSON paths, without aliases, tokenizers or transformers.
+func NewIndex(name string, paths ...string) Index {
+	fields := make([]IndexedField, len(paths))
+	fieldIndexers := make([]leia.FieldIndexer, len(paths))
+	for i, path := range paths {
+		fields[i] = IndexedField{Path: path, QueryName: path}
+		fieldIndexers[i] = leia.NewFieldIndexer(path)
+	}
+	return Index{Index: leia.NewIndex(name, fieldIndexers...), Fields: fields}
+}
+
+// Collection is a leia collection together with the indices it should have.
+type Collection struct {
+	// Name identifies the collection.
+	Name string
+	// Collection is the leia collection that holds the documents.
+	Collection leia.Collection
+	// Indices are the indices of the collection.
+	Indices []Index
+	// IDField is the JSON path of the field that identifies the documents of the collection.
+	IDField string
+	// Validate validates a document, it's optional.
+	Validate func(document leia.Document) error
+}
+
+// Problem is an inconsistency found in a collection.
+type Problem struct {
+	// Type is the type of the problem: invalid, duplicate, unreachable or orphan.
+	Type string `json:"type"`
+	// Reference is the hex encoded reference of the document in the collection.
+	Reference string `json:"reference"`
+	// ID is the ID of the document, if it has one.
+	ID string `json:"id,omitempty"`
+	// Index is the name of the index the problem applies to, if any.
+	Index string `json:"index,omitempty"`
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// Report is the result of checking a collection.
+type Report struct {
+	// Collection is the name of the collection.
+	Collection string `json:"collection"`
+	// Documents is the number of documents in the collection.
+	Documents int `json:"documents"`
+	// Reindexed contains the names of the indices that were rebuilt, if the collection was reindexed.
+	Reindexed []string `json:"reindexed,omitempty"`
+	// Problems contains the problems found in the collection.
+	Problems []Problem `json:"problems"`
+}
+
+// Check checks whether every document of the collection parses, validates and is in the indices it should be in.
+// A document should be in an index if it has a value for every field of the index.
+// It reports invalid, duplicate and unreachable documents, and index entries of documents that don't exist (orphans).
+func Check(collection Collection) (*Report, error) {
+	report := &Report{Collection: collection.Name, Problems: []Problem{}}
+
+	// references of all documents, and the references per ID to detect duplicates
+	documents := map[string]bool{}
+	ids := map[string][]string{}
+	// expected contains the references of the documents that should be in the index, per index
+	expected := make([]map[string]string, len(collection.Indices))
+	for i := range expected {
+		expected[i] = map[string]string{}
+	}
+
+	err := collection.Collection.Iterate(leia.New(everyDocument{}), func(key leia.Reference, value []byte) error {
+		ref := key.EncodeToString()
+		document := leia.DocumentFromBytes(value)
+		documents[ref] = true
+		report.Documents++
+		if report.Documents%progressInterval == 0 {
+			log.Logger().Infof("Checked %d documents of %s", report.Documents, collection.Name)
+		}
+
+		id := documentID(document, collection.IDField)
+		if id == "" {
+			report.Problems = append(report.Problems, Problem{Type: InvalidProblem, Reference: ref, Message: fmt.Sprintf("document has no %s", collection.IDField)})
+		} else {
+			ids[id] = append(ids[id], ref)
+		}
+		if collection.Validate != nil {
+			if err := collection.Validate(document); err != nil {
+				report.Problems = append(report.Problems, Problem{Type: InvalidProblem, Reference: ref, ID: id, Message: err.Error()})
+			}
+		}
+		for i, index := range collection.Indices {
+			if hasValues(document, index) {
+				expected[i][ref] = id
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate documents of %s: %w", collection.Name, err)
+	}
+
+	for id, refs := range ids {
+		if len(refs) < 2 {
+			continue
+		}
+		for _, ref := range refs {
+			report.Problems = append(report.Problems, Problem{Type: DuplicateProblem, Reference: ref, ID: id, Message: fmt.Sprintf("%d documents have the same ID", len(refs))})
+		}
+	}
+
+	for i, index := range collection.Indices {
+		indexed, err := indexedReferences(collection, i)
+		if err != nil {
+			return nil, fmt.Errorf("unable to iterate index %s of %s: %w", index.Name(), collection.Name, err)
+		}
+		for ref := range indexed {
+			if !documents[ref] {
+				report.Problems = append(report.Problems, Problem{Type: OrphanProblem, Reference: ref, Index: index.Name(), Message: "index refers to a document that doesn't exist"})
+			}
+		}
+		for ref, id := range expected[i] {
+			if !indexed[ref] {
+				report.Problems = append(report.Problems, Problem{Type: UnreachableProblem, Reference: ref, ID: id, Index: index.Name(), Message: "document is not in the index"})
+			}
+		}
+	}
+
+	sort.Slice(report.Problems, func(i, j int) bool {
+		a, b := report.Problems[i], report.Problems[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Reference != b.Reference {
+			return a.Reference < b.Reference
+		}
+		return a.Index < b.Index
+	})
+	return report, nil
+}
+
+// Reindex rebuilds the indices of the collection from its documents and checks the collection afterwards.
+// While an index is rebuilt, queries that would use it fall back to other indices or a full scan.
+func Reindex(collection Collection) (*Report, error) {
+	var reindexed []string
+	for i, index := range collection.Indices {
+		log.Logger().Infof("Rebuilding index %s of %s (%d/%d)", index.Name(), collection.Name, i+1, len(collection.Indices))
+		if err := collection.Collection.DropIndex(index.Name()); err != nil {
+			return nil, fmt.Errorf("unable to drop index %s of %s: %w", index.Name(), collection.Name, err)
+		}
+		if err := collection.Collection.AddIndex(index.Index); err != nil {
+			return nil, fmt.Errorf("unable to rebuild index %s of %s: %w", index.Name(), collection.Name, err)
+		}
+		reindexed = append(reindexed, index.Name())
+	}
+	report, err := Check(collection)
+	if err != nil {
+		return nil, err
+	}
+	report.Reindexed = reindexed
+	return report, nil
+}
+
+// indexedReferences returns the references of all documents in the index at the given position of the collection's indices.
+// leia can't iterate a specific index: it iterates the index that matches the query best, which is the first added index of which
+// the most leading fields are in the query. The query contains all fields of the index, so the index matches it completely.
+// An index added before it matches it completely as well if all of its fields are in the query; that index would be iterated
+// instead, so it's rejected. The indices are added to leia in the order of the collection, also when they're rebuilt.
+func indexedReferences(collection Collection, position int) (map[string]bool, error) {
+	index := collection.Indices[position]
+	if len(index.Fields) == 0 {
+		return nil, errors.New("index has no fields")
+	}
+	// matching every value of all fields includes all entries of the index
+	query := leia.New(leia.Prefix(index.Fields[0].QueryName, ""))
+	for _, field := range index.Fields[1:] {
+		query = query.And(leia.Prefix(field.QueryName, ""))
+	}
+	for _, other := range collection.Indices[:position] {
+		if other.IsMatch(query) >= index.IsMatch(query) {
+			return nil, fmt.Errorf("index can't be iterated separately from index %s", other.Name())
+		}
+	}
+	result := map[string]bool{}
+	err := collection.Collection.IndexIterate(query, func(_ []byte, ref []byte) error {
+		result[leia.Reference(ref).EncodeToString()] = true
+		return nil
+	})
+	if errors.Is(err, leia.ErrNoIndex) {
+		// the index doesn't exist (anymore), so every document that should be in it is unreachable
+		return result, nil
+	}
+	return result, err
+}
+
+// hasValues returns whether the document has a value for every field of the index, which means it should be in the index.
+func hasValues(document leia.Document, index Index) bool {
+	for _, field := range index.Fields {
+		values, err := document.ValuesAtPath(field.Path)
+		if err != nil || len(values) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// documentID returns the ID of the document, or an empty string if it doesn't have exactly one.
+func documentID(document leia.Document, idField string) string {
+	values, err := document.ValuesAtPath(idField)
+	if err != nil || len(values) != 1 {
+		return ""
+	}
+	id, _ := values[0].(string)
+	return id
+}
+
+// everyDocument is a leia.QueryPart that matches every document, so all documents of a collection can be iterated.
+// leia evaluates it against the number of keys of the document, which every JSON document has.
+type everyDocument struct{}
+
+func (e everyDocument) Name() string {
+	return "@keys|#"
+}
+
+func (e everyDocument) Seek() (leia.Key, error) {
+	return leia.Key{}, nil
+}
+
+func (e everyDocument) Condition(_ leia.Key, _ leia.Transform) (bool, error) {
+	return true, nil
+}
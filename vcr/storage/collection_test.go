@@ -0,0 +1,215 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/nuts-foundation/go-leia/v2"
+	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	documentA          = `{"id": "a", "issuer": "did:nuts:1"}`
+	documentB          = `{"id": "b", "issuer": "did:nuts:1", "expirationDate": "2022-01-01T00:00:00Z"}`
+	documentADuplicate = `{"id": "a", "issuer": "did:nuts:2"}`
+)
+
+// newTestCollection creates a collection with the given documents. The optional tamper func is called with the collection
+// while its indices aren't loaded, so the changes it makes aren't reflected in the indices.
+func newTestCollection(t *testing.T, documents []string, tamper func(collection leia.Collection)) Collection {
+	dbPath := path.Join(io.TestDirectory(t), "test.db")
+	indices := []Index{NewIndex("byID", "id"), NewIndex("byIssuerExpiration", "issuer", "expirationDate")}
+	store := openTestStore(t, dbPath)
+	for _, index := range indices {
+		if err := store.Collection("test").AddIndex(index.Index); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, document := range documents {
+		if err := store.Collection("test").Add([]leia.Document{leia.DocumentFromString(document)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if tamper != nil {
+		_ = store.Close()
+		store = openTestStore(t, dbPath)
+		tamper(store.Collection("test"))
+		// existing indices are loaded, not rebuilt
+		for _, index := range indices {
+			if err := store.Collection("test").AddIndex(index.Index); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return Collection{Name: "test", Collection: store.Collection("test"), Indices: indices, IDField: "id"}
+}
+
+func openTestStore(t *testing.T, dbPath string) leia.Store {
+	store, err := leia.NewStore(dbPath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+	return store
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		collection := newTestCollection(t, []string{documentA, documentB}, nil)
+
+		report, err := Check(collection)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "test", report.Collection)
+		assert.Equal(t, 2, report.Documents)
+		assert.Empty(t, report.Problems)
+		assert.Empty(t, report.Reindexed)
+	})
+	t.Run("ok - empty collection", func(t *testing.T) {
+		report, err := Check(newTestCollection(t, nil, nil))
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 0, report.Documents)
+		assert.Empty(t, report.Problems)
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		collection := newTestCollection(t, []string{documentA, `{"issuer": "did:nuts:1"}`}, nil)
+		collection.Validate = func(document leia.Document) error {
+			if string(document.Bytes()) == documentA {
+				return errors.New("invalid")
+			}
+			return nil
+		}
+
+		report, err := Check(collection)
+
+		if !assert.NoError(t, err) || !assert.Len(t, report.Problems, 2) {
+			return
+		}
+		for _, problem := range report.Problems {
+			assert.Equal(t, InvalidProblem, problem.Type)
+		}
+		assert.ElementsMatch(t, []string{"invalid", "document has no id"}, []string{report.Problems[0].Message, report.Problems[1].Message})
+	})
+	t.Run("duplicate documents", func(t *testing.T) {
+		collection := newTestCollection(t, []string{documentA, documentADuplicate, documentB}, nil)
+
+		report, err := Check(collection)
+
+		if !assert.NoError(t, err) || !assert.Len(t, report.Problems, 2) {
+			return
+		}
+		assert.Equal(t, DuplicateProblem, report.Problems[0].Type)
+		assert.Equal(t, "a", report.Problems[0].ID)
+		assert.Equal(t, "2 documents have the same ID", report.Problems[0].Message)
+		assert.Equal(t, DuplicateProblem, report.Problems[1].Type)
+		assert.NotEqual(t, report.Problems[0].Reference, report.Problems[1].Reference)
+	})
+	t.Run("unreachable document", func(t *testing.T) {
+		collection := newTestCollection(t, []string{documentA}, func(collection leia.Collection) {
+			_ = collection.Add([]leia.Document{leia.DocumentFromString(documentB)})
+		})
+		ref := collection.Collection.Reference(leia.DocumentFromString(documentB)).EncodeToString()
+
+		report, err := Check(collection)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []Problem{
+			{Type: UnreachableProblem, Reference: ref, ID: "b", Index: "byID", Message: "document is not in the index"},
+			{Type: UnreachableProblem, Reference: ref, ID: "b", Index: "byIssuerExpiration", Message: "document is not in the index"},
+		}, report.Problems)
+	})
+	t.Run("orphan", func(t *testing.T) {
+		collection := newTestCollection(t, []string{documentA, documentB}, func(collection leia.Collection) {
+			_ = collection.Delete(leia.DocumentFromString(documentB))
+		})
+		ref := collection.Collection.Reference(leia.DocumentFromString(documentB)).EncodeToString()
+
+		report, err := Check(collection)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, report.Documents)
+		assert.Equal(t, []Problem{
+			{Type: OrphanProblem, Reference: ref, Index: "byID", Message: "index refers to a document that doesn't exist"},
+			{Type: OrphanProblem, Reference: ref, Index: "byIssuerExpiration", Message: "index refers to a document that doesn't exist"},
+		}, report.Problems)
+	})
+	t.Run("missing index", func(t *testing.T) {
+		collection := newTestCollection(t, []string{documentA, documentB}, nil)
+		_ = collection.Collection.DropIndex("byIssuerExpiration")
+
+		report, err := Check(collection)
+
+		if !assert.NoError(t, err) || !assert.Len(t, report.Problems, 1) {
+			return
+		}
+		assert.Equal(t, Problem{
+			Type:      UnreachableProblem,
+			Reference: collection.Collection.Reference(leia.DocumentFromString(documentB)).EncodeToString(),
+			ID:        "b",
+			Index:     "byIssuerExpiration",
+			Message:   "document is not in the index",
+		}, report.Problems[0])
+	})
+}
+
+func TestCheck_AmbiguousIndex(t *testing.T) {
+	collection := newTestCollection(t, []string{documentA}, nil)
+	// an index on the issuer matches the query for the byIssuerExpiration index completely, so leia would iterate it instead
+	collection.Indices = []Index{NewIndex("byIssuer", "issuer"), collection.Indices[1]}
+
+	_, err := Check(collection)
+
+	assert.EqualError(t, err, "unable to iterate index byIssuerExpiration of test: index can't be iterated separately from index byIssuer")
+}
+
+func TestReindex(t *testing.T) {
+	collection := newTestCollection(t, []string{documentA}, func(collection leia.Collection) {
+		_ = collection.Add([]leia.Document{leia.DocumentFromString(documentB)})
+		_ = collection.Delete(leia.DocumentFromString(documentA))
+	})
+
+	report, err := Reindex(collection)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"byID", "byIssuerExpiration"}, report.Reindexed)
+	assert.Equal(t, 1, report.Documents)
+	assert.Empty(t, report.Problems)
+	docs, err := collection.Collection.Find(context.Background(), leia.New(leia.Eq("id", "b")))
+	assert.NoError(t, err)
+	assert.Len(t, docs, 1)
+}
@@ -21,10 +21,13 @@ package vcr
 
 import (
 	"encoding/json"
+	"errors"
 	"time"
 
+	ssi "github.com/nuts-foundation/go-did"
 	"github.com/nuts-foundation/go-did/vc"
 	"github.com/nuts-foundation/nuts-node/vcr/log"
+	"github.com/nuts-foundation/nuts-node/vcr/verifier"
 
 	"github.com/nuts-foundation/go-leia/v2"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
@@ -36,10 +39,14 @@ const revocationCollection = "_revocation"
 const maxFindExecutionTime = 1 * time.Second
 
 func (c *vcr) StoreCredential(credential vc.VerifiableCredential, validAt *time.Time) error {
-	// verify first
+	// verify first, this isn't recorded in the audit trail: credentials received over the network are stored again
+	// every time the DAG is replayed, and storing a credential doesn't grant access to anything.
 	if err := c.verifier.Validate(credential, validAt); err != nil {
 		return err
 	}
+	if err := c.schemaValidator.Validate(credential); err != nil {
+		return err
+	}
 
 	return c.writeCredential(credential)
 }
@@ -78,3 +85,19 @@ func (c *vcr) writeRevocation(r credential.Revocation) error {
 func (c *vcr) revocationIndex() leia.Collection {
 	return c.store.Collection(revocationCollection)
 }
+
+// legacyRevocationStore is the verifier.Store the verifier of the VCR uses. Besides the revocations in the verifier's own store,
+// it finds the revocations in the legacy revocation collection, so every check of the verifier takes those into account.
+type legacyRevocationStore struct {
+	verifier.Store
+	vcr *vcr
+}
+
+// GetRevocation finds the revocation in the verifier's store first, falling back to the legacy revocation collection.
+func (s legacyRevocationStore) GetRevocation(id ssi.URI) (*credential.Revocation, error) {
+	revocation, err := s.Store.GetRevocation(id)
+	if !errors.Is(err, verifier.ErrNotFound) {
+		return revocation, err
+	}
+	return s.vcr.findLegacyRevocation(id)
+}
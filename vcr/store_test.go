@@ -23,6 +23,7 @@ import (
 	"crypto/ecdsa"
 	"encoding/json"
 	"os"
+	"path"
 	"testing"
 	"time"
 
@@ -30,8 +31,13 @@ import (
 	"github.com/nuts-foundation/go-did/vc"
 	"github.com/stretchr/testify/assert"
 
+	nutsCrypto "github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/crypto/storage"
+	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/types"
 )
 
 func TestVcr_StoreCredential(t *testing.T) {
@@ -62,6 +68,8 @@ func TestVcr_StoreCredential(t *testing.T) {
 		err := ctx.vcr.StoreCredential(target, nil)
 
 		assert.NoError(t, err)
+		entries, _, _ := ctx.vcr.SearchVerifications(audit.Query{CredentialID: target.ID.String()}, 0, 0)
+		assert.Empty(t, entries, "storing a credential must not be recorded in the audit trail")
 	})
 
 	t.Run("ok - with validAt", func(t *testing.T) {
@@ -75,6 +83,28 @@ func TestVcr_StoreCredential(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("ok - JWT credential", func(t *testing.T) {
+		ctx := newMockContext(t)
+		key := nutsCrypto.NewTestKey(target.Issuer.String() + "#key-1")
+		jwtCredential := signJWTCredential(t, target, key)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(key.KID(), nil).Return(key.Public(), nil)
+
+		err := ctx.vcr.StoreCredential(jwtCredential, nil)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		stored, err := ctx.vcr.find(*target.ID)
+		if !assert.NoError(t, err) {
+			return
+		}
+		var proofs []proof.JWTProof
+		_ = stored.UnmarshalProofValue(&proofs)
+		if assert.Len(t, proofs, 1) {
+			assert.Equal(t, proof.JwtProof2020, proofs[0].Type)
+		}
+	})
+
 	t.Run("error - validation", func(t *testing.T) {
 		ctx := newMockContext(t)
 
@@ -82,6 +112,47 @@ func TestVcr_StoreCredential(t *testing.T) {
 
 		assert.Error(t, err)
 	})
+
+	t.Run("error - schema validation", func(t *testing.T) {
+		ctx := newMockContext(t)
+		schemasDir := io.TestDirectory(t)
+		_ = os.WriteFile(path.Join(schemasDir, credential.SchemasFile), []byte(`
+schemas:
+  - id: https://example.com/schemas/organization.json
+    credentialType: NutsOrganizationCredential
+    file: organization.json
+`), 0644)
+		_ = os.WriteFile(path.Join(schemasDir, "organization.json"), []byte(`{"required": ["credentialStatus"]}`), 0644)
+		var err error
+		ctx.vcr.schemaValidator, err = credential.NewSchemaValidator(schemasDir)
+		if !assert.NoError(t, err) {
+			return
+		}
+		ctx.keyResolver.EXPECT().ResolveSigningKey(gomock.Any(), nil).Return(pk, nil)
+
+		err = ctx.vcr.StoreCredential(target, nil)
+
+		assert.ErrorIs(t, err, credential.ErrValidation)
+		_, err = ctx.vcr.find(*target.ID)
+		assert.ErrorIs(t, err, types.ErrNotFound)
+	})
+}
+
+// signJWTCredential replaces the proof of the given credential with a JWT proof signed by the given key.
+func signJWTCredential(t *testing.T, source vc.VerifiableCredential, key nutsCrypto.Key) vc.VerifiableCredential {
+	claims, err := proof.CredentialClaims(source)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	jwtProof, err := proof.NewJWTProof(claims, key)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	result, err := jwtProof.Credential()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return *result
 }
 
 func TestVcr_StoreRevocation(t *testing.T) {
@@ -0,0 +1,172 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+	"gopkg.in/yaml.v2"
+)
+
+// templateFileSuffix is the suffix of issuance template files in the templates directory.
+const templateFileSuffix = ".template.yaml"
+
+// loadIssuanceTemplates loads the issuance templates from the templates directory, if it exists.
+func (c *vcr) loadIssuanceTemplates() error {
+	files, err := filepath.Glob(path.Join(c.config.Templates.Dir, "*"+templateFileSuffix))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		template := issuer.Template{}
+		if err = yaml.Unmarshal(data, &template); err != nil {
+			return fmt.Errorf("invalid issuance template (file=%s): %w", file, err)
+		}
+		if err = template.Validate(); err != nil {
+			return fmt.Errorf("invalid issuance template (file=%s): %w", file, err)
+		}
+		if _, exists := c.templates[template.Name]; exists {
+			return fmt.Errorf("duplicate issuance template (file=%s, name=%s)", file, template.Name)
+		}
+		c.templates[template.Name] = template
+		c.templateFiles[template.Name] = file
+		log.Logger().Infof("Loaded issuance template (file=%s, name=%s, credentialType=%s)", file, template.Name, template.CredentialType)
+	}
+	return nil
+}
+
+func (c *vcr) Templates() []issuer.Template {
+	c.templateMutex.RLock()
+	defer c.templateMutex.RUnlock()
+
+	result := make([]issuer.Template, 0, len(c.templates))
+	for _, template := range c.templates {
+		result = append(result, template)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func (c *vcr) Template(name string) (*issuer.Template, error) {
+	c.templateMutex.RLock()
+	defer c.templateMutex.RUnlock()
+
+	template, ok := c.templates[name]
+	if !ok {
+		return nil, core.NotFoundError("unknown issuance template (name=%s)", name)
+	}
+	return &template, nil
+}
+
+// AddTemplate adds the issuance template, or replaces the template with the same name.
+// The template is stored in the templates directory.
+func (c *vcr) AddTemplate(template issuer.Template) error {
+	if err := template.Validate(); err != nil {
+		return core.InvalidInputError("invalid issuance template: %w", err)
+	}
+
+	c.templateMutex.Lock()
+	defer c.templateMutex.Unlock()
+
+	file, ok := c.templateFiles[template.Name]
+	if !ok {
+		file = path.Join(c.config.Templates.Dir, template.Name+templateFileSuffix)
+	}
+	data, err := yaml.Marshal(template)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(c.config.Templates.Dir, os.ModePerm); err != nil {
+		return err
+	}
+	if err = os.WriteFile(file, data, 0644); err != nil {
+		return err
+	}
+	c.templates[template.Name] = template
+	c.templateFiles[template.Name] = file
+
+	log.Logger().Infof("Added issuance template (name=%s, credentialType=%s)", template.Name, template.CredentialType)
+	return nil
+}
+
+// RemoveTemplate removes the issuance template from the templates directory.
+func (c *vcr) RemoveTemplate(name string) error {
+	c.templateMutex.Lock()
+	defer c.templateMutex.Unlock()
+
+	if _, ok := c.templates[name]; !ok {
+		return core.NotFoundError("unknown issuance template (name=%s)", name)
+	}
+	if file, ok := c.templateFiles[name]; ok {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		delete(c.templateFiles, name)
+	}
+	delete(c.templates, name)
+
+	log.Logger().Infof("Removed issuance template (name=%s)", name)
+	return nil
+}
+
+// matchTemplate checks whether the credential matches one of the issuance templates of its type.
+func (c *vcr) matchTemplate(credential vc.VerifiableCredential) error {
+	credentialType, err := credentialTypeOf(credential)
+	if err != nil {
+		return err
+	}
+
+	c.templateMutex.RLock()
+	defer c.templateMutex.RUnlock()
+
+	var mismatches []string
+	for _, template := range c.templates {
+		if template.CredentialType != credentialType.String() {
+			continue
+		}
+		err := template.Match(credential)
+		if err == nil {
+			return nil
+		}
+		mismatches = append(mismatches, err.Error())
+	}
+	if len(mismatches) == 0 {
+		return fmt.Errorf("%w: no issuance template for credential type (credentialType=%s)", issuer.ErrTemplateMismatch, credentialType)
+	}
+	sort.Strings(mismatches)
+	return fmt.Errorf("%w: %s", issuer.ErrTemplateMismatch, strings.Join(mismatches, ", "))
+}
@@ -0,0 +1,211 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vcr
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/vcr/issuer"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+var testIssuanceTemplate = issuer.Template{
+	Name:           "human",
+	CredentialType: "HumanCredential",
+	Validity:       "24h",
+	SubjectFields:  []issuer.TemplateField{{Path: "human.eyeColour", Pattern: "blue|brown"}},
+}
+
+func TestVcr_loadIssuanceTemplates(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		data, _ := yaml.Marshal(testIssuanceTemplate)
+		writeTemplateFile(t, ctx.vcr.config.Templates.Dir, "human", data)
+
+		err := ctx.vcr.loadIssuanceTemplates()
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []issuer.Template{testIssuanceTemplate}, ctx.vcr.Templates())
+		assert.Contains(t, ctx.vcr.templateFiles, "human")
+	})
+	t.Run("ok - directory does not exist", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.vcr.config.Templates.Dir = path.Join(ctx.vcr.config.Templates.Dir, "unknown")
+
+		err := ctx.vcr.loadIssuanceTemplates()
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - invalid template", func(t *testing.T) {
+		ctx := newMockContext(t)
+		writeTemplateFile(t, ctx.vcr.config.Templates.Dir, "human", []byte("name: human"))
+
+		err := ctx.vcr.loadIssuanceTemplates()
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "invalid issuance template")
+	})
+	t.Run("error - duplicate name", func(t *testing.T) {
+		ctx := newMockContext(t)
+		data, _ := yaml.Marshal(testIssuanceTemplate)
+		writeTemplateFile(t, ctx.vcr.config.Templates.Dir, "human", data)
+		writeTemplateFile(t, ctx.vcr.config.Templates.Dir, "other", data)
+
+		err := ctx.vcr.loadIssuanceTemplates()
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "duplicate issuance template")
+	})
+}
+
+func TestVcr_AddTemplate(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		err := ctx.vcr.AddTemplate(testIssuanceTemplate)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		template, err := ctx.vcr.Template("human")
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, testIssuanceTemplate, *template)
+		// the template is loaded again after a restart
+		ctx.vcr.templates = map[string]issuer.Template{}
+		_ = ctx.vcr.loadIssuanceTemplates()
+		assert.Len(t, ctx.vcr.Templates(), 1)
+	})
+	t.Run("ok - replaces template", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.AddTemplate(testIssuanceTemplate)
+		replacement := testIssuanceTemplate
+		replacement.Validity = "48h"
+
+		err := ctx.vcr.AddTemplate(replacement)
+
+		assert.NoError(t, err)
+		template, _ := ctx.vcr.Template("human")
+		assert.Equal(t, "48h", template.Validity)
+	})
+	t.Run("error - invalid template", func(t *testing.T) {
+		ctx := newMockContext(t)
+		template := testIssuanceTemplate
+		template.CredentialType = ""
+
+		err := ctx.vcr.AddTemplate(template)
+
+		assert.EqualError(t, err, "invalid issuance template: no credential type")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+}
+
+func TestVcr_RemoveTemplate(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.AddTemplate(testIssuanceTemplate)
+		file := ctx.vcr.templateFiles["human"]
+
+		err := ctx.vcr.RemoveTemplate("human")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, ctx.vcr.Templates())
+		assert.NoFileExists(t, file)
+	})
+	t.Run("error - unknown template", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		err := ctx.vcr.RemoveTemplate("human")
+
+		assert.ErrorIs(t, err, core.NotFoundError(""))
+	})
+}
+
+func TestVcr_matchTemplate(t *testing.T) {
+	issuanceDate := time.Now()
+	expirationDate := issuanceDate.Add(time.Hour)
+	credential := vc.VerifiableCredential{
+		Type:              []ssi.URI{vc.VerifiableCredentialTypeV1URI(), ssi.MustParseURI("HumanCredential")},
+		IssuanceDate:      issuanceDate,
+		ExpirationDate:    &expirationDate,
+		CredentialSubject: []interface{}{map[string]interface{}{"human": map[string]interface{}{"eyeColour": "blue"}}},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.AddTemplate(testIssuanceTemplate)
+
+		assert.NoError(t, ctx.vcr.matchTemplate(credential))
+	})
+	t.Run("ok - matches one of the templates of the type", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.AddTemplate(testIssuanceTemplate)
+		other := testIssuanceTemplate
+		other.Name = "human-green"
+		other.SubjectFields = []issuer.TemplateField{{Path: "human.eyeColour", Pattern: "green"}}
+		_ = ctx.vcr.AddTemplate(other)
+
+		assert.NoError(t, ctx.vcr.matchTemplate(credential))
+	})
+	t.Run("error - no template for type", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		err := ctx.vcr.matchTemplate(credential)
+
+		assert.EqualError(t, err, "credential doesn't match an issuance template: no issuance template for credential type (credentialType=HumanCredential)")
+		assert.ErrorIs(t, err, issuer.ErrTemplateMismatch)
+	})
+	t.Run("error - doesn't match template", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.vcr.AddTemplate(testIssuanceTemplate)
+		mismatch := credential
+		mismatch.ExpirationDate = nil
+
+		err := ctx.vcr.matchTemplate(mismatch)
+
+		assert.EqualError(t, err, "credential doesn't match an issuance template: credential must expire within the validity of template (template=human, validity=24h)")
+		assert.ErrorIs(t, err, issuer.ErrTemplateMismatch)
+	})
+}
+
+func writeTemplateFile(t *testing.T, dir string, name string, data []byte) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(dir, name+templateFileSuffix), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
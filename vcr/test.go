@@ -49,7 +49,10 @@ func NewTestVCRInstance(t *testing.T) *vcr {
 		nil,
 		nil,
 		network.NewTestNetworkInstance(path.Join(testDirectory, "network")),
+		nil,
 	).(*vcr)
+	// don't check issued credentials for expiry in the background
+	newInstance.config.Expiry.Interval = 0
 
 	if err := newInstance.Configure(core.ServerConfig{Datadir: testDirectory}); err != nil {
 		t.Fatal(err)
@@ -71,7 +74,8 @@ type mockContext struct {
 	serviceResolver *doc.MockServiceResolver
 }
 
-func newMockContext(t *testing.T) mockContext {
+// newMockContext creates a VCR instance with mocked dependencies, the given options are applied to it before it's configured.
+func newMockContext(t *testing.T, options ...func(instance *vcr)) mockContext {
 	// speedup tests
 	noSync = true
 
@@ -83,10 +87,16 @@ func newMockContext(t *testing.T) mockContext {
 	keyResolver := types.NewMockKeyResolver(ctrl)
 	docResolver := types.NewMockDocResolver(ctrl)
 	serviceResolver := doc.NewMockServiceResolver(ctrl)
-	vcr := NewVCRInstance(crypto, docResolver, keyResolver, tx).(*vcr)
+	vcr := NewVCRInstance(crypto, docResolver, keyResolver, tx, nil).(*vcr)
 	vcr.serviceResolver = serviceResolver
 	vcr.trustConfig = trust.NewConfig(path.Join(testDir, "trust.yaml"))
 	vcr.config.OverrideIssueAllPublic = false
+	vcr.config.Expiry.Interval = 0
+	vcr.config.Audit.Enabled = true
+	vcr.config.Audit.Retention = 0
+	for _, option := range options {
+		option(vcr)
+	}
 
 	if err := vcr.Configure(core.ServerConfig{Datadir: testDir}); err != nil {
 		t.Fatal(err)
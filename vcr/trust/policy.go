@@ -0,0 +1,108 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package trust
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nuts-foundation/go-did/did"
+)
+
+// maxAnchorDepth is the maximum number of controller levels between an issuer and a trust anchor.
+const maxAnchorDepth = 5
+
+// ErrOutdatedTrustList is returned when a trust list is imported that isn't newer than the already imported version.
+var ErrOutdatedTrustList = errors.New("trust list is not newer than the imported version")
+
+// Policy makes an issuer trusted for a credential type.
+type Policy struct {
+	// CredentialType is the credential type the issuer is trusted for.
+	CredentialType string `yaml:"credentialType" json:"credentialType"`
+	// Issuer is the DID of the trusted issuer.
+	Issuer string `yaml:"issuer" json:"issuer"`
+	// Anchor makes every DID controlled (directly or indirectly) by the issuer trusted as well.
+	// Only control that has been exercised counts: the controller must have signed a version of the controlled DID document.
+	Anchor bool `yaml:"anchor,omitempty" json:"anchor,omitempty"`
+	// NotBefore is the moment from which the policy applies. If not set, it applies from the beginning.
+	NotBefore *time.Time `yaml:"notBefore,omitempty" json:"notBefore,omitempty"`
+	// NotAfter is the moment after which the policy no longer applies. If not set, it applies indefinitely.
+	NotAfter *time.Time `yaml:"notAfter,omitempty" json:"notAfter,omitempty"`
+}
+
+// appliesAt returns true if the policy applies at the given moment.
+func (p Policy) appliesAt(at time.Time) bool {
+	if p.NotBefore != nil && at.Before(*p.NotBefore) {
+		return false
+	}
+	return p.NotAfter == nil || !at.After(*p.NotAfter)
+}
+
+// TrustList is a set of trust policies published by a governance body.
+type TrustList struct {
+	// ID identifies the trust list. A newer version of a trust list replaces the imported version with the same ID.
+	ID string `yaml:"id" json:"id"`
+	// Issuer is the DID of the governance body that published the trust list.
+	Issuer string `yaml:"issuer" json:"issuer"`
+	// IssuedAt is the moment the trust list was published.
+	IssuedAt time.Time `yaml:"issuedAt" json:"issuedAt"`
+	// Policies contains the trust policies of the trust list.
+	Policies []Policy `yaml:"policies" json:"policies"`
+}
+
+// Validate checks whether the trust list contains the required fields and valid policies.
+func (l TrustList) Validate() error {
+	if l.ID == "" {
+		return errors.New("trust list must have an ID")
+	}
+	if l.IssuedAt.IsZero() {
+		return errors.New("trust list must have an issuance date")
+	}
+	return validatePolicies(l.Policies)
+}
+
+// policySet is the content of the trust policies file.
+type policySet struct {
+	Policies []Policy `yaml:"policies"`
+}
+
+// Decision explains whether an issuer is trusted for a credential type.
+type Decision struct {
+	// Trusted indicates whether the issuer is trusted.
+	Trusted bool
+	// Reason explains why the issuer is (not) trusted.
+	Reason string
+}
+
+func validatePolicies(policies []Policy) error {
+	for i, policy := range policies {
+		if policy.CredentialType == "" {
+			return fmt.Errorf("invalid policy (index=%d): missing credential type", i)
+		}
+		if _, err := did.ParseDID(policy.Issuer); err != nil {
+			return fmt.Errorf("invalid policy (index=%d): invalid issuer: %w", i, err)
+		}
+		if policy.NotBefore != nil && policy.NotAfter != nil && policy.NotAfter.Before(*policy.NotBefore) {
+			return fmt.Errorf("invalid policy (index=%d): notAfter is before notBefore", i)
+		}
+	}
+	return nil
+}
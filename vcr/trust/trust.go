@@ -21,21 +21,31 @@ package trust
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/nuts-node/vcr/log"
+	vdr "github.com/nuts-foundation/nuts-node/vdr/types"
 	"gopkg.in/yaml.v2"
 )
 
 // ErrNoFilename is returned when trust actions are performed but no file for storing those is specified.
 var ErrNoFilename = errors.New("no filename specified")
 
-// Config holds the trusted issuers per credential type
+// Config holds the trusted issuers per credential type, the trust policies and the imported trust lists
 type Config struct {
-	filename       string
-	issuersPerType map[string][]string
-	mutex          sync.Mutex
+	filename           string
+	issuersPerType     map[string][]string
+	policiesFilename   string
+	policies           []Policy
+	trustListsFilename string
+	trustLists         []TrustList
+	docResolver        vdr.DocResolver
+	mutex              sync.Mutex
 }
 
 // NewConfig returns a fully configured Config
@@ -47,6 +57,16 @@ func NewConfig(filename string) *Config {
 	}
 }
 
+// NewPolicyConfig returns a Config that besides the trusted issuers in filename, evaluates the trust policies in policiesFilename
+// and the trust lists imported into trustListsFilename. The docResolver is used to resolve the controllers of issuers for trust anchors.
+func NewPolicyConfig(filename string, policiesFilename string, trustListsFilename string, docResolver vdr.DocResolver) *Config {
+	config := NewConfig(filename)
+	config.policiesFilename = policiesFilename
+	config.trustListsFilename = trustListsFilename
+	config.docResolver = docResolver
+	return config
+}
+
 // Load the trusted issuers per credential type from file
 func (tc *Config) Load() error {
 	tc.mutex.Lock()
@@ -56,18 +76,42 @@ func (tc *Config) Load() error {
 		return ErrNoFilename
 	}
 
+	if err := readYAML(tc.filename, &tc.issuersPerType); err != nil {
+		return err
+	}
+
+	if tc.policiesFilename != "" {
+		policies := policySet{}
+		if err := readYAML(tc.policiesFilename, &policies); err != nil {
+			return err
+		}
+		if err := validatePolicies(policies.Policies); err != nil {
+			return fmt.Errorf("invalid trust policies file: %w", err)
+		}
+		tc.policies = policies.Policies
+	}
+	if tc.trustListsFilename != "" {
+		if err := readYAML(tc.trustListsFilename, &tc.trustLists); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readYAML reads the YAML file into target, it's ignored if the file doesn't exist.
+func readYAML(filename string, target interface{}) error {
 	// ignore if not exists
-	_, err := os.Stat(tc.filename)
+	_, err := os.Stat(filename)
 	if err != nil {
 		return nil
 	}
 
-	data, err := os.ReadFile(tc.filename)
+	data, err := os.ReadFile(filename)
 	if err != nil {
 		return err
 	}
 
-	return yaml.Unmarshal(data, &tc.issuersPerType)
+	return yaml.Unmarshal(data, target)
 }
 
 // Save the list of trusted issuers per credential type to file
@@ -84,9 +128,16 @@ func (tc *Config) save() error {
 	return os.WriteFile(tc.filename, data, 0644)
 }
 
-// List returns all trusted issuers for the given type
+// List returns all trusted issuers for the given type: the issuers in the trusted issuers list and the issuers of the trust
+// policies that currently apply. DIDs that are trusted because they're controlled by a trust anchor aren't listed.
 func (tc *Config) List(credentialType ssi.URI) []ssi.URI {
-	stringList := tc.issuersPerType[credentialType.String()]
+	stringList := append([]string{}, tc.issuersPerType[credentialType.String()]...)
+	now := time.Now()
+	for _, policy := range tc.policiesFor(credentialType.String()) {
+		if policy.appliesAt(now) && !contains(stringList, policy.Issuer) {
+			stringList = append(stringList, policy.Issuer)
+		}
+	}
 	uriList := make([]ssi.URI, len(stringList))
 	for i, e := range stringList {
 		u, _ := ssi.ParseURI(e)
@@ -95,8 +146,168 @@ func (tc *Config) List(credentialType ssi.URI) []ssi.URI {
 	return uriList
 }
 
-// IsTrusted returns true when the given issuer is in the trusted issuers list of the given credentialType
+// IsTrusted returns true when the given issuer is trusted for the given credentialType.
+// See Explain for how it's determined.
 func (tc *Config) IsTrusted(credentialType ssi.URI, issuer ssi.URI) bool {
+	return tc.Explain(credentialType, issuer, time.Now()).Trusted
+}
+
+// Explain determines whether the issuer is trusted for the credential type at the given moment, and why.
+// An issuer is trusted when it's in the trusted issuers list, when a trust policy for the issuer applies,
+// or when it's controlled by the issuer of an applying trust policy that is a trust anchor, which has exercised that control.
+func (tc *Config) Explain(credentialType ssi.URI, issuer ssi.URI, at time.Time) Decision {
+	if tc.isExplicitlyTrusted(credentialType, issuer) {
+		return Decision{Trusted: true, Reason: "issuer is in the list of trusted issuers"}
+	}
+
+	result := Decision{Reason: "issuer is not trusted for the credential type"}
+	var anchors []sourcedPolicy
+	for _, policy := range tc.policiesFor(credentialType.String()) {
+		if !policy.appliesAt(at) {
+			if policy.Issuer == issuer.String() {
+				result.Reason = fmt.Sprintf("policy of %s does not apply at %s", policy.source, at.Format(time.RFC3339))
+			}
+			continue
+		}
+		if policy.Issuer == issuer.String() {
+			return Decision{Trusted: true, Reason: fmt.Sprintf("issuer is trusted by policy of %s", policy.source)}
+		}
+		if policy.Anchor {
+			anchors = append(anchors, policy)
+		}
+	}
+	if len(anchors) == 0 || tc.docResolver == nil {
+		return result
+	}
+
+	controllers := tc.controllersOf(issuer, at)
+	for _, anchor := range anchors {
+		if controllers[anchor.Issuer] {
+			return Decision{Trusted: true, Reason: fmt.Sprintf("issuer is controlled by trust anchor %s (policy of %s)", anchor.Issuer, anchor.source)}
+		}
+	}
+	return result
+}
+
+// ImportTrustList adds the policies of the trust list, replacing the previously imported version of the trust list.
+// It returns ErrOutdatedTrustList if the trust list isn't newer than the imported version.
+// The caller must verify the trust list is published by a trusted governance body.
+func (tc *Config) ImportTrustList(list TrustList) error {
+	if err := list.Validate(); err != nil {
+		return err
+	}
+	tc.mutex.Lock()
+	defer tc.mutex.Unlock()
+	if tc.trustListsFilename == "" {
+		return ErrNoFilename
+	}
+
+	trustLists := make([]TrustList, 0, len(tc.trustLists)+1)
+	for _, curr := range tc.trustLists {
+		if curr.ID != list.ID {
+			trustLists = append(trustLists, curr)
+		} else if !curr.IssuedAt.Before(list.IssuedAt) {
+			return ErrOutdatedTrustList
+		}
+	}
+	trustLists = append(trustLists, list)
+
+	data, err := yaml.Marshal(trustLists)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(tc.trustListsFilename, data, 0644); err != nil {
+		return err
+	}
+	tc.trustLists = trustLists
+	return nil
+}
+
+// sourcedPolicy is a Policy with a description of where it's defined.
+type sourcedPolicy struct {
+	Policy
+	source string
+}
+
+// policiesFor returns the trust policies of the trust policies file and the imported trust lists for the credential type.
+func (tc *Config) policiesFor(credentialType string) []sourcedPolicy {
+	tc.mutex.Lock()
+	defer tc.mutex.Unlock()
+	var result []sourcedPolicy
+	for _, policy := range tc.policies {
+		if policy.CredentialType == credentialType {
+			result = append(result, sourcedPolicy{Policy: policy, source: "trust policies file"})
+		}
+	}
+	for _, list := range tc.trustLists {
+		for _, policy := range list.Policies {
+			if policy.CredentialType == credentialType {
+				result = append(result, sourcedPolicy{Policy: policy, source: fmt.Sprintf("trust list %s of %s", list.ID, list.Issuer)})
+			}
+		}
+	}
+	return result
+}
+
+// controllersOf returns the DIDs that (indirectly) control the given issuer at the given moment, up to maxAnchorDepth levels.
+// The controllers of a DID document are self-asserted, so a controller only counts when it has exercised its control:
+// it must have signed one of the versions of the controlled DID document up to the given moment.
+func (tc *Config) controllersOf(issuer ssi.URI, at time.Time) map[string]bool {
+	result := map[string]bool{}
+	issuerDID, err := did.ParseDID(issuer.String())
+	if err != nil {
+		return result
+	}
+	current := []did.DID{*issuerDID}
+	for depth := 0; depth < maxAnchorDepth && len(current) > 0; depth++ {
+		var next []did.DID
+		for _, curr := range current {
+			for _, controller := range tc.exercisedControllers(curr, at) {
+				id := controller.String()
+				if controller.Equals(curr) || result[id] {
+					continue
+				}
+				result[id] = true
+				next = append(next, controller)
+			}
+		}
+		current = next
+	}
+	return result
+}
+
+// exercisedControllers returns the controllers of the DID document at the given moment that signed one of its versions up to that moment.
+func (tc *Config) exercisedControllers(id did.DID, at time.Time) []did.DID {
+	document, metadata, err := tc.docResolver.Resolve(id, &vdr.ResolveMetadata{ResolveTime: &at})
+	if err != nil {
+		log.Logger().Debugf("Unable to resolve DID document to evaluate trust anchors (did=%s): %v", id, err)
+		return nil
+	}
+	signers := map[string]bool{}
+	for metadata != nil {
+		if metadata.SignedBy != nil {
+			signers[metadata.SignedBy.String()] = true
+		}
+		if metadata.PreviousHash == nil {
+			break
+		}
+		_, metadata, err = tc.docResolver.Resolve(id, &vdr.ResolveMetadata{Hash: metadata.PreviousHash, AllowDeactivated: true})
+		if err != nil {
+			log.Logger().Debugf("Unable to resolve previous version of DID document to evaluate trust anchors (did=%s): %v", id, err)
+			break
+		}
+	}
+	var result []did.DID
+	for _, controller := range document.Controller {
+		if signers[controller.String()] {
+			result = append(result, controller)
+		}
+	}
+	return result
+}
+
+// isExplicitlyTrusted returns true when the given issuer is in the trusted issuers list of the given credentialType
+func (tc *Config) isExplicitlyTrusted(credentialType ssi.URI, issuer ssi.URI) bool {
 	issuerString := issuer.String()
 	for _, i := range tc.issuersPerType[credentialType.String()] {
 		if i == issuerString {
@@ -115,7 +326,7 @@ func (tc *Config) AddTrust(credentialType ssi.URI, issuer ssi.URI) error {
 
 	tString := credentialType.String()
 
-	if tc.IsTrusted(credentialType, issuer) {
+	if tc.isExplicitlyTrusted(credentialType, issuer) {
 		return nil
 	}
 
@@ -131,7 +342,7 @@ func (tc *Config) RemoveTrust(credentialType ssi.URI, issuer ssi.URI) error {
 	defer tc.mutex.Unlock()
 	tString := credentialType.String()
 
-	if !tc.IsTrusted(credentialType, issuer) {
+	if !tc.isExplicitlyTrusted(credentialType, issuer) {
 		return nil
 	}
 
@@ -148,3 +359,12 @@ func (tc *Config) RemoveTrust(credentialType ssi.URI, issuer ssi.URI) error {
 
 	return tc.save()
 }
+
+func contains(values []string, value string) bool {
+	for _, curr := range values {
+		if curr == value {
+			return true
+		}
+	}
+	return false
+}
@@ -20,12 +20,18 @@
 package trust
 
 import (
+	"os"
 	"path"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/crypto/hash"
 	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/nuts-foundation/nuts-node/vdr/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -185,3 +191,223 @@ func TestConfig_RemoveTrust(t *testing.T) {
 		assert.True(t, tc.IsTrusted(vc.VerifiableCredentialTypeV1URI(), *issuer3))
 	})
 }
+
+func TestConfig_Explain(t *testing.T) {
+	credentialType := ssi.MustParseURI(nutsTestCredential)
+	anchorDID := did.MustParseDID("did:nuts:anchor")
+	intermediateDID := did.MustParseDID("did:nuts:intermediate")
+	issuerDID := did.MustParseDID("did:nuts:issuer")
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	newConfig := func(t *testing.T, policies ...Policy) (*Config, *types.MockDocResolver) {
+		ctrl := gomock.NewController(t)
+		docResolver := types.NewMockDocResolver(ctrl)
+		tc := NewPolicyConfig("", "", "", docResolver)
+		tc.policies = policies
+		return tc, docResolver
+	}
+
+	t.Run("explicitly trusted", func(t *testing.T) {
+		tc, _ := newConfig(t)
+		tc.issuersPerType[nutsTestCredential] = []string{issuerDID.String()}
+
+		decision := tc.Explain(credentialType, issuerDID.URI(), now)
+
+		assert.Equal(t, Decision{Trusted: true, Reason: "issuer is in the list of trusted issuers"}, decision)
+	})
+
+	t.Run("trusted by policy", func(t *testing.T) {
+		tc, _ := newConfig(t, Policy{CredentialType: nutsTestCredential, Issuer: issuerDID.String(), NotBefore: &past, NotAfter: &future})
+
+		decision := tc.Explain(credentialType, issuerDID.URI(), now)
+
+		assert.Equal(t, Decision{Trusted: true, Reason: "issuer is trusted by policy of trust policies file"}, decision)
+	})
+
+	t.Run("trusted by policy of trust list", func(t *testing.T) {
+		tc, _ := newConfig(t)
+		tc.trustLists = []TrustList{{ID: "list", Issuer: "did:nuts:governance", Policies: []Policy{{CredentialType: nutsTestCredential, Issuer: issuerDID.String()}}}}
+
+		decision := tc.Explain(credentialType, issuerDID.URI(), now)
+
+		assert.Equal(t, Decision{Trusted: true, Reason: "issuer is trusted by policy of trust list list of did:nuts:governance"}, decision)
+	})
+
+	t.Run("policy doesn't apply yet", func(t *testing.T) {
+		tc, _ := newConfig(t, Policy{CredentialType: nutsTestCredential, Issuer: issuerDID.String(), NotBefore: &future})
+
+		decision := tc.Explain(credentialType, issuerDID.URI(), now)
+
+		assert.False(t, decision.Trusted)
+		assert.Contains(t, decision.Reason, "policy of trust policies file does not apply at")
+	})
+
+	t.Run("policy of other credential type", func(t *testing.T) {
+		tc, _ := newConfig(t, Policy{CredentialType: "OtherCredential", Issuer: issuerDID.String()})
+
+		decision := tc.Explain(credentialType, issuerDID.URI(), now)
+
+		assert.Equal(t, Decision{Trusted: false, Reason: "issuer is not trusted for the credential type"}, decision)
+	})
+
+	signedBy := func(id did.DID, previous *hash.SHA256Hash) *types.DocumentMetadata {
+		return &types.DocumentMetadata{SignedBy: &id, PreviousHash: previous}
+	}
+	atNow := &types.ResolveMetadata{ResolveTime: &now}
+
+	t.Run("controlled by trust anchor", func(t *testing.T) {
+		tc, docResolver := newConfig(t, Policy{CredentialType: nutsTestCredential, Issuer: anchorDID.String(), Anchor: true})
+		issuerDocument := did.Document{ID: issuerDID, Controller: []did.DID{intermediateDID}}
+		intermediateDocument := did.Document{ID: intermediateDID, Controller: []did.DID{anchorDID}}
+		anchorDocument := did.Document{ID: anchorDID}
+		firstVersion := hash.SHA256Sum([]byte("first"))
+		// the intermediate DID updated a previous version of the issuer's DID document
+		docResolver.EXPECT().Resolve(issuerDID, atNow).Return(&issuerDocument, signedBy(issuerDID, &firstVersion), nil)
+		docResolver.EXPECT().Resolve(issuerDID, &types.ResolveMetadata{Hash: &firstVersion, AllowDeactivated: true}).Return(&issuerDocument, signedBy(intermediateDID, nil), nil)
+		docResolver.EXPECT().Resolve(intermediateDID, atNow).Return(&intermediateDocument, signedBy(anchorDID, nil), nil)
+		docResolver.EXPECT().Resolve(anchorDID, atNow).Return(&anchorDocument, signedBy(anchorDID, nil), nil)
+
+		decision := tc.Explain(credentialType, issuerDID.URI(), now)
+
+		assert.Equal(t, Decision{Trusted: true, Reason: "issuer is controlled by trust anchor did:nuts:anchor (policy of trust policies file)"}, decision)
+	})
+
+	t.Run("control not exercised by trust anchor", func(t *testing.T) {
+		tc, docResolver := newConfig(t, Policy{CredentialType: nutsTestCredential, Issuer: anchorDID.String(), Anchor: true})
+		// the issuer lists the anchor as controller, but the anchor never signed a version of its DID document
+		issuerDocument := did.Document{ID: issuerDID, Controller: []did.DID{anchorDID}}
+		docResolver.EXPECT().Resolve(issuerDID, atNow).Return(&issuerDocument, signedBy(issuerDID, nil), nil)
+
+		decision := tc.Explain(credentialType, issuerDID.URI(), now)
+
+		assert.False(t, decision.Trusted)
+	})
+
+	t.Run("not controlled by trust anchor", func(t *testing.T) {
+		tc, docResolver := newConfig(t, Policy{CredentialType: nutsTestCredential, Issuer: anchorDID.String(), Anchor: true})
+		issuerDocument := did.Document{ID: issuerDID}
+		docResolver.EXPECT().Resolve(issuerDID, atNow).Return(&issuerDocument, signedBy(issuerDID, nil), nil)
+
+		decision := tc.Explain(credentialType, issuerDID.URI(), now)
+
+		assert.False(t, decision.Trusted)
+	})
+
+	t.Run("issuer can't be resolved", func(t *testing.T) {
+		tc, docResolver := newConfig(t, Policy{CredentialType: nutsTestCredential, Issuer: anchorDID.String(), Anchor: true})
+		docResolver.EXPECT().Resolve(issuerDID, atNow).Return(nil, nil, types.ErrNotFound)
+
+		decision := tc.Explain(credentialType, issuerDID.URI(), now)
+
+		assert.False(t, decision.Trusted)
+	})
+}
+
+func TestConfig_ImportTrustList(t *testing.T) {
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+	list := TrustList{
+		ID:       "list",
+		Issuer:   "did:nuts:governance",
+		IssuedAt: issuedAt,
+		Policies: []Policy{{CredentialType: nutsTestCredential, Issuer: "did:nuts:issuer", Anchor: true}},
+	}
+
+	t.Run("ok - persisted", func(t *testing.T) {
+		testDir := io.TestDirectory(t)
+		trustListsFile := path.Join(testDir, "trust_lists.yaml")
+		tc := NewPolicyConfig(path.Join(testDir, "test.yaml"), "", trustListsFile, nil)
+
+		err := tc.ImportTrustList(list)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		loaded := NewPolicyConfig(path.Join(testDir, "test.yaml"), "", trustListsFile, nil)
+		if !assert.NoError(t, loaded.Load()) {
+			return
+		}
+		assert.Equal(t, []TrustList{list}, loaded.trustLists)
+		assert.Equal(t, []ssi.URI{ssi.MustParseURI("did:nuts:issuer")}, loaded.List(ssi.MustParseURI(nutsTestCredential)))
+	})
+
+	t.Run("ok - newer version replaces imported version", func(t *testing.T) {
+		tc := NewPolicyConfig("", "", path.Join(io.TestDirectory(t), "trust_lists.yaml"), nil)
+		_ = tc.ImportTrustList(list)
+		newer := list
+		newer.IssuedAt = issuedAt.Add(time.Hour)
+		newer.Policies = nil
+
+		err := tc.ImportTrustList(newer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []TrustList{newer}, tc.trustLists)
+	})
+
+	t.Run("error - outdated", func(t *testing.T) {
+		tc := NewPolicyConfig("", "", path.Join(io.TestDirectory(t), "trust_lists.yaml"), nil)
+		_ = tc.ImportTrustList(list)
+
+		err := tc.ImportTrustList(list)
+
+		assert.ErrorIs(t, err, ErrOutdatedTrustList)
+	})
+
+	t.Run("error - invalid policy", func(t *testing.T) {
+		tc := NewPolicyConfig("", "", path.Join(io.TestDirectory(t), "trust_lists.yaml"), nil)
+		invalid := list
+		invalid.Policies = []Policy{{CredentialType: nutsTestCredential, Issuer: "not a DID"}}
+
+		err := tc.ImportTrustList(invalid)
+
+		assert.Error(t, err)
+		assert.Empty(t, tc.trustLists)
+	})
+
+	t.Run("error - no filename", func(t *testing.T) {
+		err := NewConfig("").ImportTrustList(list)
+
+		assert.ErrorIs(t, err, ErrNoFilename)
+	})
+}
+
+func TestConfig_LoadPolicies(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		testDir := io.TestDirectory(t)
+		policiesFile := path.Join(testDir, "trust_policies.yaml")
+		_ = os.WriteFile(policiesFile, []byte(`
+policies:
+  - credentialType: NutsOrganizationCredential
+    issuer: did:nuts:anchor
+    anchor: true
+    notAfter: 2030-01-01T00:00:00Z
+`), 0644)
+		tc := NewPolicyConfig(path.Join(testDir, "test.yaml"), policiesFile, "", nil)
+
+		err := tc.Load()
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.Len(t, tc.policies, 1) {
+			return
+		}
+		assert.True(t, tc.policies[0].Anchor)
+		assert.Equal(t, 2030, tc.policies[0].NotAfter.Year())
+	})
+
+	t.Run("error - invalid policy", func(t *testing.T) {
+		testDir := io.TestDirectory(t)
+		policiesFile := path.Join(testDir, "trust_policies.yaml")
+		_ = os.WriteFile(policiesFile, []byte(`
+policies:
+  - issuer: did:nuts:anchor
+`), 0644)
+		tc := NewPolicyConfig(path.Join(testDir, "test.yaml"), policiesFile, "", nil)
+
+		err := tc.Load()
+
+		assert.EqualError(t, err, "invalid trust policies file: invalid policy (index=0): missing credential type")
+	})
+}
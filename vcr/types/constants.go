@@ -47,8 +47,30 @@ var ErrInvalidPeriod = errors.New("credential not valid at given time")
 // VcDocumentType holds the content type used in network documents which contain Verifiable Credentials
 const VcDocumentType = "application/vc+json"
 
+// VcBatchDocumentType holds the content type used in network documents which contain a JSON array of Verifiable Credentials
+const VcBatchDocumentType = "application/vc+json;type=batch"
+
 // RevocationDocumentType holds the content type used in network documents which contain Revocation messages of credentials
 const RevocationDocumentType = "application/vc+json;type=revocation"
 
 // RevocationLDDocumentType holds the content type used in network documents which contain Revocation messages of credentials in JSON-LD form
 const RevocationLDDocumentType = "application/ld+json;type=revocation"
+
+// Format specifies the format in which a credential or presentation is signed.
+// The values are taken from the DIF claim format registry: https://identity.foundation/claim-format-registry/
+type Format string
+
+// JSONLDCredentialFormat is the format of credentials signed with a JSON-LD proof. It is the default format.
+const JSONLDCredentialFormat Format = "ldp_vc"
+
+// JWTCredentialFormat is the format of credentials signed as JWT (VC-JWT).
+const JWTCredentialFormat Format = "jwt_vc"
+
+// SDJWTCredentialFormat is the format of credentials signed as Selective Disclosure JWT (SD-JWT).
+const SDJWTCredentialFormat Format = "vc+sd-jwt"
+
+// JSONLDPresentationFormat is the format of presentations signed with a JSON-LD proof. It is the default format.
+const JSONLDPresentationFormat Format = "ldp_vp"
+
+// JWTPresentationFormat is the format of presentations signed as JWT (VP-JWT).
+const JWTPresentationFormat Format = "jwt_vp"
@@ -29,6 +29,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/nuts-foundation/nuts-node/vcr/holder"
@@ -44,13 +45,16 @@ import (
 	"github.com/nuts-foundation/nuts-node/core"
 	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/crypto/hash"
+	"github.com/nuts-foundation/nuts-node/events"
 	"github.com/nuts-foundation/nuts-node/network"
 	"github.com/nuts-foundation/nuts-node/vcr/assets"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
 	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
 	"github.com/nuts-foundation/nuts-node/vcr/issuer"
 	"github.com/nuts-foundation/nuts-node/vcr/log"
 	"github.com/nuts-foundation/nuts-node/vcr/signature"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
 	"github.com/nuts-foundation/nuts-node/vcr/trust"
 	"github.com/nuts-foundation/nuts-node/vcr/types"
 	"github.com/nuts-foundation/nuts-node/vcr/verifier"
@@ -64,7 +68,7 @@ var timeFunc = time.Now
 var noSync bool
 
 // NewVCRInstance creates a new vcr instance with default config and empty concept registry
-func NewVCRInstance(keyStore crypto.KeyStore, docResolver vdr.DocResolver, keyResolver vdr.KeyResolver, network network.Transactions) VCR {
+func NewVCRInstance(keyStore crypto.KeyStore, docResolver vdr.DocResolver, keyResolver vdr.KeyResolver, network network.Transactions, eventManager events.Event) VCR {
 	r := &vcr{
 		config:          DefaultConfig(),
 		docResolver:     docResolver,
@@ -72,7 +76,18 @@ func NewVCRInstance(keyStore crypto.KeyStore, docResolver vdr.DocResolver, keyRe
 		keyResolver:     keyResolver,
 		serviceResolver: doc.NewServiceResolver(docResolver),
 		network:         network,
+		eventManager:    eventManager,
 		registry:        concept.NewRegistry(),
+		builtinConcepts: map[string]bool{},
+		conceptFiles:    map[string]string{},
+		conceptMutex:    &sync.Mutex{},
+		templates:       map[string]issuer.Template{},
+		templateFiles:   map[string]string{},
+		templateMutex:   &sync.RWMutex{},
+		refreshNonces:   map[string]time.Time{},
+		refreshMutex:    &sync.RWMutex{},
+		reissueMutex:    &sync.Mutex{},
+		routines:        &sync.WaitGroup{},
 	}
 
 	return r
@@ -89,17 +104,63 @@ type vcr struct {
 	ambassador      Ambassador
 	network         network.Transactions
 	trustConfig     *trust.Config
+	contextStore    signature.ContextStore
+	schemaValidator *credential.SchemaValidator
 	issuer          issuer.Issuer
 	verifier        verifier.Verifier
 	holder          holder.Holder
 	issuerStore     issuer.Store
 	verifierStore   verifier.Store
+	holderStore     holder.Store
+	eventManager    events.Event
+	notifier        *credentialNotifier
+	// auditLog records the verifications of credentials. It's nil if the audit trail is disabled.
+	auditLog audit.Log
+	// builtinConcepts contains the credential types of the concepts loaded from the embedded assets
+	builtinConcepts map[string]bool
+	// conceptFiles maps the credential types of the concepts loaded from or stored in the concepts directory to their files
+	conceptFiles map[string]string
+	// conceptMutex serializes the runtime changes to the concepts
+	conceptMutex *sync.Mutex
+	// templates contains the issuance templates by name
+	templates map[string]issuer.Template
+	// templateFiles maps the names of the issuance templates to their files in the templates directory
+	templateFiles map[string]string
+	// templateMutex guards the issuance templates
+	templateMutex *sync.RWMutex
+	// refreshPolicies contains the policies that must allow a credential to be refreshed
+	refreshPolicies []RefreshPolicy
+	// refreshNonces contains the nonces that can be used to refresh a credential, with their expiry
+	refreshNonces map[string]time.Time
+	// refreshMutex guards the refresh policies and nonces
+	refreshMutex *sync.RWMutex
+	// reissueMutex serializes refreshing credentials, so a credential is refreshed once
+	reissueMutex  *sync.Mutex
+	expiryMonitor *expiryMonitor
+	// stopExpiryMonitor stops the goroutine that checks issued credentials for expiry, if it's running.
+	stopExpiryMonitor context.CancelFunc
+	// stopAuditPruner stops the goroutine that removes expired entries from the audit trail, if it's running.
+	stopAuditPruner context.CancelFunc
+	// routines tracks the background goroutines, which must have stopped before the stores are closed.
+	routines *sync.WaitGroup
 }
 
 func (c *vcr) Registry() concept.Reader {
 	return c.registry
 }
 
+// selectivelyDisclosableClaims returns the claims of the credential type that are selectively disclosable according to its concept config.
+func (c *vcr) selectivelyDisclosableClaims(credentialType string) []string {
+	if config := c.registry.FindByType(credentialType); config != nil {
+		return config.SelectivelyDisclosable
+	}
+	return nil
+}
+
+func (c *vcr) JSONLDContexts() []signature.ContextInfo {
+	return c.contextStore.Contexts()
+}
+
 func (c vcr) Issuer() issuer.Issuer {
 	return c.issuer
 }
@@ -108,6 +169,10 @@ func (c vcr) Holder() holder.Holder {
 	return c.holder
 }
 
+func (c vcr) Verifier() verifier.Verifier {
+	return c.verifier
+}
+
 func (c *vcr) Configure(config core.ServerConfig) error {
 	var err error
 
@@ -127,26 +192,95 @@ func (c *vcr) Configure(config core.ServerConfig) error {
 		return err
 	}
 
+	holderStorePath := path.Join(c.config.datadir, "vcr", "holder-store.db")
+	c.holderStore, err = holder.NewLeiaHolderStore(holderStorePath)
+	if err != nil {
+		return err
+	}
+
+	if c.config.Audit.Enabled {
+		auditLogPath := path.Join(c.config.datadir, "vcr", "audit.db")
+		c.auditLog, err = audit.NewLeiaLog(auditLogPath)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Create the JSON-LD Context loader
 	allowExternalCalls := !config.Strictmode
-	contextLoader, err := signature.NewContextLoader(allowExternalCalls)
+	if c.config.JSONLD.ContextsDir == "" {
+		c.config.JSONLD.ContextsDir = path.Join(c.config.datadir, "vcr", "contexts")
+	}
+	c.contextStore, err = signature.NewContextStore(c.config.JSONLD.ContextsDir, allowExternalCalls)
+	if err != nil {
+		return fmt.Errorf("unable to load JSON-LD contexts: %w", err)
+	}
+	contextLoader := c.contextStore
+
+	// Load the credential schemas
+	if c.config.SchemasDir == "" {
+		c.config.SchemasDir = path.Join(c.config.datadir, "vcr", "schemas")
+	}
+	c.schemaValidator, err = credential.NewSchemaValidator(c.config.SchemasDir)
+	if err != nil {
+		return fmt.Errorf("unable to load credential schemas: %w", err)
+	}
+
+	// load trusted issuers
+	tcPath := path.Join(config.Datadir, "vcr", "trusted_issuers.yaml")
+	policiesPath := path.Join(config.Datadir, "vcr", "trust_policies.yaml")
+	trustListsPath := path.Join(config.Datadir, "vcr", "trust_lists.yaml")
+	c.trustConfig = trust.NewPolicyConfig(tcPath, policiesPath, trustListsPath, c.docResolver)
+
+	publisher := issuer.NewNetworkPublisher(c.network, c.docResolver, c.keyStore, c.config.BatchTransactions)
+	// in strict mode, issued credentials must match an issuance template
+	var templatePolicy issuer.TemplatePolicy
+	if c.config.Templates.Strict {
+		templatePolicy = c.matchTemplate
+	}
+	c.issuer = issuer.NewIssuer(c.issuerStore, publisher, c.docResolver, c.keyStore, contextLoader, c.schemaValidator, c.selectivelyDisclosableClaims, templatePolicy, c.config.Refresh.refreshes)
+	c.verifier = verifier.NewVerifier(legacyRevocationStore{Store: c.verifierStore, vcr: c}, c.keyResolver, c.docResolver, contextLoader, c.trustConfig)
 
-	publisher := issuer.NewNetworkPublisher(c.network, c.docResolver, c.keyStore)
-	c.issuer = issuer.NewIssuer(c.issuerStore, publisher, c.docResolver, c.keyStore, contextLoader)
-	c.verifier = verifier.NewVerifier(c.verifierStore, c.keyResolver, contextLoader)
+	c.holder = holder.New(c.keyResolver, c.keyStore, c.verifier, contextLoader, c.holderStore)
 
-	c.ambassador = NewAmbassador(c.network, c, c.verifier)
+	webhook, err := newWebhook(c.config.Notifications, c.config.strictMode)
+	if err != nil {
+		return err
+	}
+	c.notifier = newCredentialNotifier(c.publishCredentialReceivedEvent, webhook)
 
-	c.holder = holder.New(c.keyResolver, c.keyStore, c.verifier, contextLoader)
+	c.ambassador = NewAmbassador(c.network, c, c.verifier, c.holder, c.notifier)
+
+	c.expiryMonitor = &expiryMonitor{
+		config:   c.config.Expiry,
+		issuer:   c.issuer,
+		verifier: c.verifier,
+		reissue:  c.reissue,
+		publish:  c.publishExpiryEvent,
+		notified: map[string]bool{},
+	}
+	if err = registerExpiryMetrics(); err != nil {
+		return err
+	}
 
 	// load VC concept templates
 	if err = c.loadTemplates(); err != nil {
 		return err
 	}
+	if c.config.ConceptsDir == "" {
+		c.config.ConceptsDir = path.Join(c.config.datadir, "vcr", "concepts")
+	}
+	if err = c.loadConcepts(); err != nil {
+		return err
+	}
 
-	// load trusted issuers
-	tcPath := path.Join(config.Datadir, "vcr", "trusted_issuers.yaml")
-	c.trustConfig = trust.NewConfig(tcPath)
+	// load issuance templates
+	if c.config.Templates.Dir == "" {
+		c.config.Templates.Dir = path.Join(c.config.datadir, "vcr", "templates")
+	}
+	if err = c.loadIssuanceTemplates(); err != nil {
+		return err
+	}
 
 	return c.trustConfig.Load()
 }
@@ -183,10 +317,40 @@ func (c *vcr) Start() error {
 	// start listening for new credentials
 	c.ambassador.Configure()
 
+	// start checking issued credentials for expiry
+	if c.config.Expiry.Interval > 0 {
+		var ctx context.Context
+		ctx, c.stopExpiryMonitor = context.WithCancel(context.Background())
+		c.routines.Add(1)
+		go func() {
+			defer c.routines.Done()
+			c.expiryMonitor.run(ctx)
+		}()
+	}
+
+	// start removing entries that exceeded the retention period from the audit trail
+	if c.auditLog != nil && c.config.Audit.Retention > 0 {
+		var ctx context.Context
+		ctx, c.stopAuditPruner = context.WithCancel(context.Background())
+		c.routines.Add(1)
+		go func() {
+			defer c.routines.Done()
+			c.pruneAuditLog(ctx, auditPruneInterval)
+		}()
+	}
+
 	return nil
 }
 
 func (c *vcr) Shutdown() error {
+	if c.stopExpiryMonitor != nil {
+		c.stopExpiryMonitor()
+	}
+	if c.stopAuditPruner != nil {
+		c.stopAuditPruner()
+	}
+	// a check that's in progress still uses the stores
+	c.routines.Wait()
 	err := c.issuerStore.Close()
 	if err != nil {
 		log.Logger().Errorf("Unable to close issuer store: %v", err)
@@ -195,6 +359,16 @@ func (c *vcr) Shutdown() error {
 	if err != nil {
 		log.Logger().Errorf("Unable to close verifier store: %v", err)
 	}
+	err = c.holderStore.Close()
+	if err != nil {
+		log.Logger().Errorf("Unable to close holder store: %v", err)
+	}
+	if c.auditLog != nil {
+		err = c.auditLog.Close()
+		if err != nil {
+			log.Logger().Errorf("Unable to close audit trail: %v", err)
+		}
+	}
 	return c.store.Close()
 }
 
@@ -218,6 +392,7 @@ func (c *vcr) loadTemplates() error {
 		if err = c.registry.Add(config); err != nil {
 			return err
 		}
+		c.builtinConcepts[config.CredentialType] = true
 	}
 
 	return nil
@@ -232,53 +407,92 @@ func whitespaceOrExactTokenizer(text string) (tokens []string) {
 
 func (c *vcr) initIndices() error {
 	for _, config := range c.registry.Concepts() {
-		collection := c.store.Collection(config.CredentialType)
-		for _, index := range config.Indices {
-			var leiaParts []leia.FieldIndexer
-
-			for _, iParts := range index.Parts {
-				options := make([]leia.IndexOption, 0)
-				if iParts.Alias != nil {
-					options = append(options, leia.AliasOption(*iParts.Alias))
-				}
-				if iParts.Tokenizer != nil {
-					tokenizer := strings.ToLower(*iParts.Tokenizer)
-					switch tokenizer {
-					case "whitespaceorexact":
-						options = append(options, leia.TokenizerOption(whitespaceOrExactTokenizer))
-					case "whitespace":
-						options = append(options, leia.TokenizerOption(leia.WhiteSpaceTokenizer))
-					default:
-						return fmt.Errorf("unknown tokenizer %s for %s", *iParts.Tokenizer, config.CredentialType)
-					}
+		indices, err := conceptIndices(config)
+		if err != nil {
+			return err
+		}
+		if err = c.store.Collection(config.CredentialType).AddIndex(indices...); err != nil {
+			return err
+		}
+	}
+
+	// revocation indices
+	for _, index := range revocationIndices() {
+		if err := c.revocationIndex().AddIndex(index.Index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revocationIndices returns the indices of the revocation collection.
+func revocationIndices() []storage.Index {
+	return []storage.Index{storage.NewIndex("index_subject", concept.SubjectField)}
+}
+
+// conceptIndices creates the leia indices for the credential type of the concept config.
+func conceptIndices(config concept.Config) ([]leia.Index, error) {
+	var result []leia.Index
+	for _, index := range config.Indices {
+		var leiaParts []leia.FieldIndexer
+
+		for _, iParts := range index.Parts {
+			options := make([]leia.IndexOption, 0)
+			if iParts.Alias != nil {
+				options = append(options, leia.AliasOption(*iParts.Alias))
+			}
+			if iParts.Tokenizer != nil {
+				tokenizer := strings.ToLower(*iParts.Tokenizer)
+				switch tokenizer {
+				case "whitespaceorexact":
+					options = append(options, leia.TokenizerOption(whitespaceOrExactTokenizer))
+				case "whitespace":
+					options = append(options, leia.TokenizerOption(leia.WhiteSpaceTokenizer))
+				default:
+					return nil, fmt.Errorf("unknown tokenizer %s for %s", *iParts.Tokenizer, config.CredentialType)
 				}
-				if iParts.Transformer != nil {
-					transformer := strings.ToLower(*iParts.Transformer)
-					switch transformer {
-					case "cologne":
-						options = append(options, leia.TransformerOption(concept.CologneTransformer))
-					case "lowercase":
-						options = append(options, leia.TransformerOption(leia.ToLower))
-					default:
-						return fmt.Errorf("unknown transformer %s for %s", *iParts.Transformer, config.CredentialType)
-					}
+			}
+			if iParts.Transformer != nil {
+				transformer := strings.ToLower(*iParts.Transformer)
+				switch transformer {
+				case "cologne":
+					options = append(options, leia.TransformerOption(concept.CologneTransformer))
+				case "lowercase":
+					options = append(options, leia.TransformerOption(leia.ToLower))
+				default:
+					return nil, fmt.Errorf("unknown transformer %s for %s", *iParts.Transformer, config.CredentialType)
 				}
-
-				leiaParts = append(leiaParts, leia.NewFieldIndexer(iParts.JSONPath, options...))
 			}
 
-			leiaIndex := leia.NewIndex(index.Name, leiaParts...)
-			log.Logger().Debugf("Adding index %s to %s using: %v", index.Name, config.CredentialType, leiaIndex)
+			leiaParts = append(leiaParts, leia.NewFieldIndexer(iParts.JSONPath, options...))
+		}
 
-			if err := collection.AddIndex(leiaIndex); err != nil {
-				return err
+		leiaIndex := leia.NewIndex(index.Name, leiaParts...)
+		log.Logger().Debugf("Adding index %s to %s using: %v", index.Name, config.CredentialType, leiaIndex)
+		result = append(result, leiaIndex)
+	}
+	return result, nil
+}
+
+// conceptStorageIndices returns the indices of the credential type of the concept config together with their fields,
+// so the credentials of the type can be checked and reindexed.
+func conceptStorageIndices(config concept.Config) ([]storage.Index, error) {
+	leiaIndices, err := conceptIndices(config)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]storage.Index, len(leiaIndices))
+	for i, index := range config.Indices {
+		fields := make([]storage.IndexedField, len(index.Parts))
+		for j, part := range index.Parts {
+			fields[j] = storage.IndexedField{Path: part.JSONPath, QueryName: part.JSONPath}
+			if part.Alias != nil {
+				fields[j].QueryName = *part.Alias
 			}
 		}
+		result[i] = storage.Index{Index: leiaIndices[i], Fields: fields}
 	}
-
-	// revocation indices
-	rIndex := c.revocationIndex()
-	return rIndex.AddIndex(leia.NewIndex("index_subject", leia.NewFieldIndexer(concept.SubjectField)))
+	return result, nil
 }
 
 func (c *vcr) Name() string {
@@ -292,12 +506,10 @@ func (c *vcr) Config() interface{} {
 // Search for matching credentials based upon a query. It returns an empty list if no matches have been found.
 // The optional resolveTime will Search for credentials at that point in time.
 func (c *vcr) Search(ctx context.Context, query concept.Query, allowUntrusted bool, resolveTime *time.Time) ([]vc.VerifiableCredential, error) {
-	//transform query to leia query, for each template a query is returned
-	queries := c.convert(query)
-
 	var VCs = make([]vc.VerifiableCredential, 0)
-	for vcType, q := range queries {
-		docs, err := c.store.Collection(vcType).Find(ctx, q)
+	for _, tq := range query.Parts() {
+		// the clauses are mapped to leia queries on the collection of the credential type
+		docs, err := find(ctx, c.store.Collection(tq.CredentialType()), tq.Clauses)
 		if err != nil {
 			return nil, err
 		}
@@ -308,7 +520,7 @@ func (c *vcr) Search(ctx context.Context, query concept.Query, allowUntrusted bo
 				return nil, fmt.Errorf("unable to parse credential from db: %w", err)
 			}
 
-			if err = c.Validate(foundCredential, allowUntrusted, false, resolveTime); err == nil {
+			if _, err = c.validate(foundCredential, allowUntrusted, false, resolveTime); err == nil {
 				VCs = append(VCs, foundCredential)
 			}
 		}
@@ -337,7 +549,7 @@ func (c *vcr) Issue(template vc.VerifiableCredential) (*vc.VerifiableCredential,
 	}
 
 	template.Context = append(template.Context, *credential.NutsContextURI)
-	verifiableCredential, err := c.issuer.Issue(template, true, c.config.OverrideIssueAllPublic || conceptConfig.Public)
+	verifiableCredential, err := c.issuer.Issue(template, types.JSONLDCredentialFormat, true, c.config.OverrideIssueAllPublic || conceptConfig.Public)
 
 	if err != nil {
 		return nil, err
@@ -370,7 +582,7 @@ func (c *vcr) Resolve(ID ssi.URI, resolveTime *time.Time) (*vc.VerifiableCredent
 	}
 
 	// we don't have to check the signature, it's coming from our own store.
-	if err = c.Validate(credential, false, false, resolveTime); err != nil {
+	if _, err = c.validate(credential, false, false, resolveTime); err != nil {
 		switch err {
 		case types.ErrRevoked:
 			return &credential, types.ErrRevoked
@@ -400,9 +612,21 @@ func (c *vcr) Resolve(ID ssi.URI, resolveTime *time.Time) (*vc.VerifiableCredent
 // * The type must contain exactly one type in addition to the default `VerifiableCredential` type.
 // * The issuanceDate must be before the validAt.
 // * The expirationDate must be after the validAt.
-func (c *vcr) Validate(credential vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time) error {
+//
+// The checks performed and their outcome are recorded in the audit trail, together with the caller.
+func (c *vcr) Validate(credential vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time, caller audit.Caller) error {
+	checks, err := c.validate(credential, allowUntrusted, checkSignature, validAt)
+	if len(checks) > 0 {
+		c.RecordVerification(credential, caller, checks)
+	}
+	return err
+}
+
+// validate validates the credential like Validate, without recording the verification in the audit trail.
+// It returns the checks that were performed with their outcome, and the error of the first check that failed.
+func (c *vcr) validate(credential vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time) ([]audit.Check, error) {
 	if credential.ID == nil {
-		return errors.New("verifying a credential requires it to have a valid ID")
+		return nil, errors.New("verifying a credential requires it to have a valid ID")
 	}
 
 	if validAt == nil {
@@ -410,42 +634,12 @@ func (c *vcr) Validate(credential vc.VerifiableCredential, allowUntrusted bool,
 		validAt = &now
 	}
 
-	// check for old api
-	revoked, err := c.isRevoked(*credential.ID)
-	if revoked {
-		return types.ErrRevoked
-	}
-	if err != nil {
-		return err
-	}
-
-	// check for new api
-	revoked, err = c.verifier.IsRevoked(*credential.ID)
-	if revoked {
-		return types.ErrRevoked
-	}
-	if err != nil {
-		return err
-	}
-
-	if checkSignature {
-		// check if the issuer was valid at the given time. (not deactivated, or deactivated controller)
-		issuerDID, _ := did.ParseDID(credential.Issuer.String())
-		_, _, err = c.docResolver.Resolve(*issuerDID, &vdr.ResolveMetadata{ResolveTime: validAt, AllowDeactivated: false})
-		if err != nil {
-			return fmt.Errorf("could not check validity of signing key: %w", err)
-		}
+	// the verifier checks the legacy revocations and whether the issuer was active as well
+	result := c.verifier.Check(credential, allowUntrusted, checkSignature, validAt)
+	if !result.Valid() {
+		return result.Checks, result.Errs[0]
 	}
-
-	if !allowUntrusted {
-		trusted := c.isTrusted(credential)
-		if !trusted {
-			return types.ErrUntrusted
-		}
-	}
-
-	// perform the rest of the verification steps
-	return c.verifier.Verify(credential, allowUntrusted, checkSignature, validAt)
+	return result.Checks, nil
 }
 
 func (c *vcr) isTrusted(credential vc.VerifiableCredential) bool {
@@ -495,7 +689,7 @@ func (c *vcr) Revoke(credentialID ssi.URI) (*credential.Revocation, error) {
 		return nil, core.PreconditionFailedError("credential already revoked")
 	}
 
-	return c.issuer.Revoke(credentialID)
+	return c.issuer.Revoke(credentialID, "")
 }
 
 func (c *vcr) Trust(credentialType ssi.URI, issuer ssi.URI) error {
@@ -528,12 +722,55 @@ func (c *vcr) Trusted(credentialType ssi.URI) ([]ssi.URI, error) {
 	return nil, types.ErrInvalidCredential
 }
 
+func (c *vcr) ExplainTrust(credentialType ssi.URI, issuer ssi.URI) trust.Decision {
+	return c.trustConfig.Explain(credentialType, issuer, timeFunc())
+}
+
+func (c *vcr) ImportTrustList(signedList string) (*trust.TrustList, error) {
+	message, err := jws.ParseString(signedList)
+	if err != nil {
+		return nil, core.InvalidInputError("invalid trust list: %w", err)
+	}
+	if len(message.Signatures()) != 1 {
+		return nil, core.InvalidInputError("invalid trust list: it must have exactly 1 signature")
+	}
+	headers := message.Signatures()[0].ProtectedHeaders()
+	kid := headers.KeyID()
+	signer := strings.Split(kid, "#")[0]
+	if !c.config.TrustLists.isSigner(signer) {
+		return nil, core.InvalidInputError("trust list is not signed by a configured trust list signer (kid=%s)", kid)
+	}
+
+	list := trust.TrustList{}
+	if err = json.Unmarshal(message.Payload(), &list); err != nil {
+		return nil, core.InvalidInputError("invalid trust list: %w", err)
+	}
+	if list.Issuer != signer {
+		return nil, core.InvalidInputError("trust list issuer does not match signer (issuer=%s)", list.Issuer)
+	}
+	// the key must be valid at the moment the trust list was published
+	pk, err := c.keyResolver.ResolveSigningKey(kid, &list.IssuedAt)
+	if err != nil {
+		return nil, core.InvalidInputError("unable to resolve trust list signing key: %w", err)
+	}
+	if _, err = jws.Verify([]byte(signedList), headers.Algorithm(), pk); err != nil {
+		return nil, core.InvalidInputError("invalid trust list signature: %w", err)
+	}
+
+	if err = c.trustConfig.ImportTrustList(list); err != nil {
+		if errors.Is(err, trust.ErrOutdatedTrustList) {
+			return nil, core.PreconditionFailedError("%w", err)
+		}
+		return nil, err
+	}
+	log.Logger().Infof("Imported trust list (id=%s, issuer=%s, policies=%d)", list.ID, list.Issuer, len(list.Policies))
+	return &list, nil
+}
+
 func (c *vcr) Untrusted(credentialType ssi.URI) ([]ssi.URI, error) {
+	// contains the issuers that have been evaluated
 	trustMap := make(map[string]bool)
 	untrusted := make([]ssi.URI, 0)
-	for _, trusted := range c.trustConfig.List(credentialType) {
-		trustMap[trusted.String()] = true
-	}
 
 	// match all keys
 	query := leia.New(leia.Prefix(concept.IssuerField, ""))
@@ -551,7 +788,9 @@ func (c *vcr) Untrusted(credentialType ssi.URI) ([]ssi.URI, error) {
 				return err
 			}
 			trustMap[issuer] = true
-			untrusted = append(untrusted, *u)
+			if !c.trustConfig.IsTrusted(credentialType, *u) {
+				untrusted = append(untrusted, *u)
+			}
 		}
 		return nil
 	})
@@ -592,13 +831,21 @@ func (c *vcr) Get(conceptName string, allowUntrusted bool, subject string) (conc
 }
 
 func (c *vcr) SearchConcept(ctx context.Context, conceptName string, allowUntrusted bool, queryParams map[string]string) ([]concept.Concept, error) {
+	clauses := make([]concept.Clause, 0, len(queryParams))
+	for key, value := range queryParams {
+		clauses = append(clauses, concept.Prefix(key, value))
+	}
+	return c.SearchConceptClauses(ctx, conceptName, allowUntrusted, clauses)
+}
+
+func (c *vcr) SearchConceptClauses(ctx context.Context, conceptName string, allowUntrusted bool, clauses []concept.Clause) ([]concept.Concept, error) {
 	query, err := c.registry.QueryFor(conceptName)
 	if err != nil {
 		return nil, err
 	}
 
-	for key, value := range queryParams {
-		query.AddClause(concept.Prefix(key, value))
+	for _, clause := range clauses {
+		query.AddClause(clause)
 	}
 
 	results, err := c.Search(ctx, query, allowUntrusted, nil)
@@ -667,7 +914,9 @@ func (c *vcr) verifyRevocation(r credential.Revocation) error {
 	return nil
 }
 
-func (c *vcr) isRevoked(ID ssi.URI) (bool, error) {
+// findLegacyRevocation finds the revocation of the credential in the legacy revocation collection.
+// It returns verifier.ErrNotFound if the credential hasn't been revoked through the legacy API.
+func (c *vcr) findLegacyRevocation(ID ssi.URI) (*credential.Revocation, error) {
 	qp := leia.Eq(concept.SubjectField, ID.String())
 	q := leia.New(qp)
 
@@ -676,45 +925,17 @@ func (c *vcr) isRevoked(ID ssi.URI) (bool, error) {
 	defer cancel()
 	docs, err := gIndex.Find(ctx, q)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if len(docs) >= 1 {
-		return true, nil
+	if len(docs) == 0 {
+		return nil, verifier.ErrNotFound
 	}
-
-	return false, nil
-}
-
-// convert returns a map of credential type to query
-// credential type is then used as collection input
-func (c *vcr) convert(query concept.Query) map[string]leia.Query {
-	var qs = make(map[string]leia.Query, 0)
-
-	for _, tq := range query.Parts() {
-		var q leia.Query
-		for _, clause := range tq.Clauses {
-			var qp leia.QueryPart
-
-			switch clause.Type() {
-			case concept.EqType:
-				qp = leia.Eq(clause.Key(), clause.Seek())
-			case concept.PrefixType:
-				qp = leia.Prefix(clause.Key(), clause.Seek())
-			default:
-				qp = leia.Range(clause.Key(), clause.Seek(), clause.Match())
-			}
-
-			if q == nil {
-				q = leia.New(qp)
-			} else {
-				q = q.And(qp)
-			}
-		}
-		qs[tq.CredentialType()] = q
+	revocation := credential.Revocation{}
+	if err = json.Unmarshal(docs[0].Bytes(), &revocation); err != nil {
+		return nil, fmt.Errorf("unable to parse revocation from db: %w", err)
 	}
-
-	return qs
+	return &revocation, nil
 }
 
 func generateRevocationChallenge(r credential.Revocation) []byte {
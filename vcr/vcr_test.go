@@ -22,19 +22,25 @@ package vcr
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"os"
+	"path"
 	"reflect"
 	"runtime"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
 	"github.com/nuts-foundation/nuts-node/vcr/issuer"
 	"github.com/nuts-foundation/nuts-node/vcr/verifier"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jws"
 	ssi "github.com/nuts-foundation/go-did"
 	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/go-did/vc"
@@ -51,6 +57,7 @@ import (
 	"github.com/nuts-foundation/nuts-node/test/io"
 	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/signature"
 	"github.com/nuts-foundation/nuts-node/vcr/trust"
 	vcrTypes "github.com/nuts-foundation/nuts-node/vcr/types"
 	"github.com/nuts-foundation/nuts-node/vdr"
@@ -73,12 +80,53 @@ func TestVCR_Configure(t *testing.T) {
 		assert.Equal(t, "NutsOrganizationCredential", concepts[1].CredentialType)
 		assert.Equal(t, "organization", concepts[1].Concept)
 	})
+
+	t.Run("loads JSON-LD contexts", func(t *testing.T) {
+		instance := NewTestVCRInstance(t)
+
+		contexts := instance.JSONLDContexts()
+
+		assert.Len(t, contexts, 4)
+		assert.Equal(t, path.Join(instance.config.datadir, "vcr", "contexts"), instance.config.JSONLD.ContextsDir)
+	})
+
+	t.Run("error - invalid contexts file", func(t *testing.T) {
+		testDirectory := io.TestDirectory(t)
+		contextsDir := path.Join(testDirectory, "contexts")
+		_ = os.MkdirAll(contextsDir, os.ModePerm)
+		_ = os.WriteFile(path.Join(contextsDir, signature.ContextsFile), []byte("contexts:\n  - url: https://example.com\n    sha256: abc\n"), 0644)
+		instance := NewVCRInstance(nil, nil, nil, nil, nil).(*vcr)
+		instance.config.JSONLD.ContextsDir = contextsDir
+
+		err := instance.Configure(core.ServerConfig{Datadir: testDirectory})
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "unable to load JSON-LD contexts")
+	})
+
+	t.Run("error - invalid schemas file", func(t *testing.T) {
+		testDirectory := io.TestDirectory(t)
+		schemasDir := path.Join(testDirectory, "schemas")
+		_ = os.MkdirAll(schemasDir, os.ModePerm)
+		_ = os.WriteFile(path.Join(schemasDir, credential.SchemasFile), []byte("schemas:\n  - id: https://example.com/schema.json\n"), 0644)
+		instance := NewVCRInstance(nil, nil, nil, nil, nil).(*vcr)
+		instance.config.SchemasDir = schemasDir
+
+		err := instance.Configure(core.ServerConfig{Datadir: testDirectory})
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), "unable to load credential schemas")
+	})
 }
 
 func TestVCR_Start(t *testing.T) {
 
 	t.Run("error - creating db", func(t *testing.T) {
-		instance := NewVCRInstance(nil, nil, nil, nil).(*vcr)
+		instance := NewVCRInstance(nil, nil, nil, nil, nil).(*vcr)
 
 		_ = instance.Configure(core.ServerConfig{Datadir: "test"})
 		err := instance.Start()
@@ -94,15 +142,49 @@ func TestVCR_Start(t *testing.T) {
 }
 
 func TestVCR_Shutdown(t *testing.T) {
-	m := newMockContext(t)
+	t.Run("ok", func(t *testing.T) {
+		m := newMockContext(t)
 
-	_ = m.vcr.Configure(core.ServerConfig{Datadir: io.TestDirectory(t)})
-	err := m.vcr.Start()
-	if !assert.NoError(t, err) {
-		return
-	}
-	err = m.vcr.Shutdown()
-	assert.NoError(t, err)
+		_ = m.vcr.Configure(core.ServerConfig{Datadir: io.TestDirectory(t)})
+		err := m.vcr.Start()
+		if !assert.NoError(t, err) {
+			return
+		}
+		err = m.vcr.Shutdown()
+		assert.NoError(t, err)
+	})
+	t.Run("waits for the expiry check in progress", func(t *testing.T) {
+		m := newMockContext(t)
+		_ = m.vcr.Configure(core.ServerConfig{Datadir: io.TestDirectory(t)})
+		m.vcr.config.Expiry.Interval = time.Hour
+		m.vcr.expiryMonitor.config.Interval = time.Hour
+		mockIssuer := issuer.NewMockIssuer(m.ctrl)
+		m.vcr.expiryMonitor.issuer = mockIssuer
+		checking := make(chan struct{})
+		release := make(chan struct{})
+		mockIssuer.EXPECT().SearchExpiringCredentials(gomock.Any()).DoAndReturn(func(_ time.Time) ([]vc.VerifiableCredential, error) {
+			close(checking)
+			<-release
+			return nil, nil
+		})
+		if !assert.NoError(t, m.vcr.Start()) {
+			return
+		}
+		<-checking
+
+		stopped := make(chan error)
+		go func() {
+			stopped <- m.vcr.Shutdown()
+		}()
+
+		select {
+		case <-stopped:
+			t.Fatal("Shutdown returned while the expiry check is in progress")
+		case <-time.After(50 * time.Millisecond):
+		}
+		close(release)
+		assert.NoError(t, <-stopped)
+	})
 }
 
 func TestVCR_SearchInternal(t *testing.T) {
@@ -149,7 +231,7 @@ func TestVCR_SearchInternal(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		ctx, q := testInstance(t)
-		ctx.vcr.Trust(vc.Type[0], vc.Issuer)
+		ctx.vcr.Trust(vc.Type[1], vc.Issuer)
 
 		searchResult, err := ctx.vcr.Search(reqCtx, q, false, &now)
 
@@ -190,7 +272,7 @@ func TestVCR_SearchInternal(t *testing.T) {
 
 	t.Run("ok - revoked", func(t *testing.T) {
 		ctx, q := testInstance(t)
-		ctx.vcr.Trust(vc.Type[0], vc.Issuer)
+		ctx.vcr.Trust(vc.Type[1], vc.Issuer)
 		rev := leia.DocumentFromString(concept.TestRevocation)
 		ctx.vcr.store.Collection(revocationCollection).Add([]leia.Document{rev})
 		creds, err := ctx.vcr.Search(reqCtx, q, false, nil)
@@ -232,7 +314,7 @@ func TestVCR_Resolve(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		ctx := testInstance(t)
-		ctx.vcr.trustConfig.AddTrust(testVC.Type[0], testVC.Issuer)
+		ctx.vcr.trustConfig.AddTrust(testVC.Type[1], testVC.Issuer)
 
 		vc, err := ctx.vcr.Resolve(*testVC.ID, &now)
 		if !assert.NoError(t, err) {
@@ -244,7 +326,7 @@ func TestVCR_Resolve(t *testing.T) {
 
 	t.Run("error - not valid yet", func(t *testing.T) {
 		ctx := testInstance(t)
-		ctx.vcr.trustConfig.AddTrust(testVC.Type[0], testVC.Issuer)
+		ctx.vcr.trustConfig.AddTrust(testVC.Type[1], testVC.Issuer)
 
 		_, err := ctx.vcr.Resolve(*testVC.ID, &time.Time{})
 		assert.Equal(t, vcrTypes.ErrInvalidPeriod, err)
@@ -255,7 +337,7 @@ func TestVCR_Resolve(t *testing.T) {
 		_ = json.Unmarshal([]byte(concept.TestCredential), &testVC)
 		nextYear, _ := time.Parse(time.RFC3339, "2030-01-02T12:00:00Z")
 		ctx := testInstance(t)
-		ctx.vcr.trustConfig.AddTrust(testVC.Type[0], testVC.Issuer)
+		ctx.vcr.trustConfig.AddTrust(testVC.Type[1], testVC.Issuer)
 
 		_, err := ctx.vcr.Resolve(*testVC.ID, &nextYear)
 		assert.Equal(t, vcrTypes.ErrInvalidPeriod, err)
@@ -263,7 +345,7 @@ func TestVCR_Resolve(t *testing.T) {
 
 	t.Run("ok - revoked", func(t *testing.T) {
 		ctx := testInstance(t)
-		ctx.vcr.trustConfig.RemoveTrust(testVC.Type[0], testVC.Issuer)
+		ctx.vcr.trustConfig.RemoveTrust(testVC.Type[1], testVC.Issuer)
 		rev := leia.DocumentFromString(concept.TestRevocation)
 		ctx.vcr.store.Collection(revocationCollection).Add([]leia.Document{rev})
 
@@ -275,7 +357,7 @@ func TestVCR_Resolve(t *testing.T) {
 
 	t.Run("ok - untrusted", func(t *testing.T) {
 		ctx := testInstance(t)
-		ctx.vcr.trustConfig.RemoveTrust(testVC.Type[0], testVC.Issuer)
+		ctx.vcr.trustConfig.RemoveTrust(testVC.Type[1], testVC.Issuer)
 
 		vc, err := ctx.vcr.Resolve(*testVC.ID, nil)
 
@@ -291,6 +373,18 @@ func TestVCR_Resolve(t *testing.T) {
 	})
 }
 
+func TestVcr_selectivelyDisclosableClaims(t *testing.T) {
+	ctx := newMockContext(t)
+	config := concept.ExampleConfig
+	config.SelectivelyDisclosable = []string{"human.eyeColour"}
+	if !assert.NoError(t, ctx.vcr.registry.Add(config)) {
+		return
+	}
+
+	assert.Equal(t, []string{"human.eyeColour"}, ctx.vcr.selectivelyDisclosableClaims(concept.ExampleType))
+	assert.Nil(t, ctx.vcr.selectivelyDisclosableClaims("unknownType"))
+}
+
 func TestVcr_Instance(t *testing.T) {
 	instance := NewTestVCRInstance(t)
 
@@ -575,9 +669,21 @@ func TestVcr_Validate(t *testing.T) {
 		ctx.docResolver.EXPECT().Resolve(*issuer, &types.ResolveMetadata{ResolveTime: &now, AllowDeactivated: false})
 		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &now).Return(pk, nil)
 
-		err := instance.Validate(subject, true, true, &now)
+		err := instance.Validate(subject, true, true, &now, audit.Caller{Name: "test"})
 
 		assert.NoError(t, err)
+		entries, _, _ := instance.SearchVerifications(audit.Query{CredentialID: subject.ID.String()}, 0, 0)
+		if assert.Len(t, entries, 1) {
+			assert.True(t, entries[0].Valid)
+			assert.Equal(t, "test", entries[0].Caller.Name)
+			assert.Equal(t, []audit.Check{
+				{Name: audit.ContentCheck, Passed: true},
+				{Name: audit.RevocationCheck, Passed: true},
+				{Name: audit.IssuerCheck, Passed: true},
+				{Name: audit.ValidityCheck, Passed: true},
+				{Name: audit.SignatureCheck, Passed: true},
+			}, entries[0].Checks)
+		}
 	})
 
 	t.Run("ok - with clock one second off", func(t *testing.T) {
@@ -599,17 +705,41 @@ func TestVcr_Validate(t *testing.T) {
 		ctx := newMockContext(t)
 		instance := ctx.vcr
 
-		err := instance.Validate(subject, true, false, nil)
+		err := instance.Validate(subject, true, false, nil, audit.Caller{Name: "test"})
 
 		assert.NoError(t, err)
 	})
 
+	t.Run("err - revoked through the legacy API", func(t *testing.T) {
+		ctx := newMockContext(t)
+		instance := ctx.vcr
+		_ = instance.writeRevocation(credential.Revocation{Subject: *subject.ID, Issuer: subject.Issuer})
+
+		err := instance.Validate(subject, true, false, nil, audit.Caller{Name: "test"})
+
+		assert.ErrorIs(t, err, vcrTypes.ErrRevoked)
+		// the verifier takes legacy revocations into account as well, e.g. for credentials in presentations
+		result := instance.Verifier().Check(subject, true, false, nil)
+		assert.True(t, result.Status.Revoked())
+	})
+
+	t.Run("err - issuer deactivated", func(t *testing.T) {
+		ctx := newMockContext(t)
+		instance := ctx.vcr
+		ctx.docResolver.EXPECT().Resolve(*issuer, &types.ResolveMetadata{ResolveTime: &now, AllowDeactivated: false}).Return(nil, nil, types.ErrDeactivated)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &now).Return(pk, nil)
+
+		err := instance.Validate(subject, true, true, &now, audit.Caller{Name: "test"})
+
+		assert.ErrorIs(t, err, types.ErrDeactivated)
+	})
+
 	t.Run("err - vc without id", func(t *testing.T) {
 		ctx := newMockContext(t)
 		instance := ctx.vcr
 		subject := vc.VerifiableCredential{}
 
-		err := instance.Validate(subject, true, false, nil)
+		err := instance.Validate(subject, true, false, nil, audit.Caller{Name: "test"})
 		assert.EqualError(t, err, "verifying a credential requires it to have a valid ID")
 	})
 
@@ -632,9 +762,14 @@ func TestVcr_Validate(t *testing.T) {
 		ctx := newMockContext(t)
 		instance := ctx.vcr
 
-		err := instance.Validate(subject, true, false, nil)
+		err := instance.Validate(subject, true, false, nil, audit.Caller{Name: "test"})
 
 		assert.Error(t, err)
+		entries, _, _ := instance.SearchVerifications(audit.Query{CredentialID: subject.ID.String()}, 0, 0)
+		if assert.Len(t, entries, 1) {
+			assert.False(t, entries[0].Valid)
+			assert.Contains(t, entries[0].Checks, audit.Check{Name: audit.ValidityCheck, Passed: false, Message: err.Error()})
+		}
 	})
 }
 
@@ -668,7 +803,7 @@ func TestVcr_Find(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		ctx := testInstance(t)
-		ctx.vcr.Trust(vc.Type[0], vc.Issuer)
+		ctx.vcr.Trust(vc.Type[1], vc.Issuer)
 
 		conc, err := ctx.vcr.Get(concept.ExampleConcept, false, subject)
 		if !assert.NoError(t, err) {
@@ -711,7 +846,7 @@ func TestVCR_Search(t *testing.T) {
 			return
 		}
 
-		ctx.vcr.Trust(vc.Type[0], vc.Issuer)
+		ctx.vcr.Trust(vc.Type[1], vc.Issuer)
 		doc := leia.DocumentFromString(concept.TestCredential)
 		ctx.vcr.store.Collection(concept.ExampleType).Add([]leia.Document{doc})
 		results, _ := ctx.vcr.SearchConcept(context.Background(), "human", false, map[string]string{"human.eyeColour": "blue/grey"})
@@ -948,7 +1083,7 @@ func Test_vcr_Revoke(t *testing.T) {
 
 		mockVerifier.EXPECT().IsRevoked(credentialID).Return(false, nil)
 		expectedRevocation := &credential.Revocation{Subject: credentialID}
-		mockIssuer.EXPECT().Revoke(credentialID).Return(expectedRevocation, nil)
+		mockIssuer.EXPECT().Revoke(credentialID, "").Return(expectedRevocation, nil)
 		vcr := vcr{verifier: mockVerifier, issuer: mockIssuer}
 		revocation, err := vcr.Revoke(credentialID)
 		assert.NoError(t, err)
@@ -976,3 +1111,103 @@ func Test_vcr_Revoke(t *testing.T) {
 		assert.Nil(t, revocation)
 	})
 }
+
+func TestVcr_ImportTrustList(t *testing.T) {
+	signer := "did:nuts:governance"
+	kid := signer + "#key-1"
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+	list := trust.TrustList{
+		ID:       "list",
+		Issuer:   signer,
+		IssuedAt: issuedAt,
+		Policies: []trust.Policy{{CredentialType: "NutsOrganizationCredential", Issuer: "did:nuts:anchor", Anchor: true}},
+	}
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	sign := func(list trust.TrustList, kid string) string {
+		payload, _ := json.Marshal(list)
+		headers := jws.NewHeaders()
+		_ = headers.Set(jws.KeyIDKey, kid)
+		signed, _ := jws.Sign(payload, jwa.ES256, key, jws.WithHeaders(headers))
+		return string(signed)
+	}
+	newContext := func(t *testing.T) mockContext {
+		ctx := newMockContext(t)
+		ctx.vcr.config.TrustLists.Signers = []string{signer}
+		return ctx
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(kid, &issuedAt).Return(key.Public(), nil)
+
+		result, err := ctx.vcr.ImportTrustList(sign(list, kid))
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, list, *result)
+		assert.Equal(t, []ssi.URI{ssi.MustParseURI("did:nuts:anchor")}, ctx.vcr.trustConfig.List(ssi.MustParseURI("NutsOrganizationCredential")))
+	})
+
+	t.Run("error - not signed by trust list signer", func(t *testing.T) {
+		ctx := newContext(t)
+
+		_, err := ctx.vcr.ImportTrustList(sign(list, "did:nuts:other#key-1"))
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+		assert.Contains(t, err.Error(), "trust list is not signed by a configured trust list signer")
+	})
+
+	t.Run("error - issuer doesn't match signer", func(t *testing.T) {
+		ctx := newContext(t)
+		other := list
+		other.Issuer = "did:nuts:other"
+
+		_, err := ctx.vcr.ImportTrustList(sign(other, kid))
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+		assert.Contains(t, err.Error(), "trust list issuer does not match signer")
+	})
+
+	t.Run("error - invalid signature", func(t *testing.T) {
+		ctx := newContext(t)
+		otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(kid, &issuedAt).Return(otherKey.Public(), nil)
+
+		_, err := ctx.vcr.ImportTrustList(sign(list, kid))
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+		assert.Contains(t, err.Error(), "invalid trust list signature")
+	})
+
+	t.Run("error - outdated", func(t *testing.T) {
+		ctx := newContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(kid, &issuedAt).Return(key.Public(), nil).Times(2)
+		signed := sign(list, kid)
+		_, _ = ctx.vcr.ImportTrustList(signed)
+
+		_, err := ctx.vcr.ImportTrustList(signed)
+
+		assert.ErrorIs(t, err, core.PreconditionFailedError(""))
+	})
+
+	t.Run("error - invalid JWS", func(t *testing.T) {
+		ctx := newContext(t)
+
+		_, err := ctx.vcr.ImportTrustList("not a JWS")
+
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+}
+
+func TestVcr_ExplainTrust(t *testing.T) {
+	ctx := newMockContext(t)
+	credentialType := ssi.MustParseURI("NutsOrganizationCredential")
+	issuer := ssi.MustParseURI("did:nuts:issuer")
+	_ = ctx.vcr.Trust(credentialType, issuer)
+
+	decision := ctx.vcr.ExplainTrust(credentialType, issuer)
+
+	assert.True(t, decision.Trusted)
+	assert.Equal(t, "issuer is in the list of trusted issuers", decision.Reason)
+}
@@ -3,8 +3,12 @@ package verifier
 import (
 	"errors"
 	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/pe"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
 	"io"
 	"time"
 )
@@ -12,7 +16,7 @@ import (
 // Verifier defines the interface for verifying verifiable credentials.
 type Verifier interface {
 	// Verify checks credential on full correctness. It checks:
-	// validity of the signature
+	// validity of the signature and whether the DID document of the issuer was active at the given time
 	// if it has been revoked
 	// if the issuer is registered as trusted
 	Verify(credential vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time) error
@@ -23,6 +27,140 @@ type Verifier interface {
 	// RegisterRevocation stores the revocation in the store
 	// before storing the revocation gets validated
 	RegisterRevocation(revocation credential.Revocation) error
+	// VerifyVP checks a verifiable presentation and the credentials it contains. It checks:
+	// validity of the presentation proof, which must be created by the holder of the presentation
+	// if the challenge and domain of the proof match the ones given in the options
+	// if the proof was created before and hasn't expired at the time of verification
+	// every contained credential on full correctness, like Check does (signature, active issuer, trust, revocation and validity period)
+	// if the presentation satisfies the presentation definition according to the presentation submission, when given in the options
+	// Any reason for the presentation or its credentials being invalid is reported in the result.
+	VerifyVP(presentation vc.VerifiablePresentation, options VPVerificationOptions) VPVerificationResult
+	// Status determines the revocation, trust and validity window status of the credential at the given time.
+	// If no time is given, the current time is used.
+	// It returns an error if the status can't be determined, e.g. when the revocation can't be read from the store.
+	Status(credential vc.VerifiableCredential, at *time.Time) (*CredentialStatus, error)
+	// Check verifies the credential on the same aspects as Verify, but it doesn't stop at the first failing check:
+	// every failing check is reported in the result, together with the status of the credential.
+	Check(credential vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time) CheckResult
+}
+
+// ValidityWindowState describes whether a credential is valid at a point in time, according to its issuance and expiration date.
+type ValidityWindowState string
+
+const (
+	// NotYetValid indicates the issuance date of the credential lies after the point in time.
+	NotYetValid ValidityWindowState = "not_yet_valid"
+	// Valid indicates the point in time lies between the issuance and expiration date of the credential.
+	Valid ValidityWindowState = "valid"
+	// Expired indicates the expiration date of the credential lies before the point in time.
+	Expired ValidityWindowState = "expired"
+)
+
+// TypeTrust indicates whether the issuer of a credential is trusted for one of the credential's types.
+type TypeTrust struct {
+	// Type is the credential type.
+	Type ssi.URI
+	// Trusted indicates whether the issuer is trusted for the credential type.
+	Trusted bool
+	// Reason explains why the issuer is (not) trusted for the credential type.
+	Reason string
+}
+
+// CredentialStatus contains the revocation, trust and validity window status of a credential.
+type CredentialStatus struct {
+	// Revocation contains the revocation of the credential, or nil if it isn't revoked.
+	Revocation *credential.Revocation
+	// Trust indicates for every type of the credential whether its issuer is trusted, in the order of the credential's types.
+	Trust []TypeTrust
+	// ValidityWindow indicates whether the credential is valid according to its issuance and expiration date.
+	ValidityWindow ValidityWindowState
+}
+
+// Revoked returns true if the credential has been revoked.
+func (s CredentialStatus) Revoked() bool {
+	return s.Revocation != nil
+}
+
+// Trusted returns true if the issuer is trusted for at least one of the credential's types.
+func (s CredentialStatus) Trusted() bool {
+	for _, curr := range s.Trust {
+		if curr.Trusted {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckResult contains the result of checking a credential.
+type CheckResult struct {
+	// Status contains the status of the credential. It's nil when it couldn't be determined.
+	Status *CredentialStatus
+	// Errs contains every reason for the credential being invalid. It's empty if the credential is valid.
+	Errs []error
+	// Checks contains the checks that were performed, in order, with their outcome.
+	Checks []audit.Check
+}
+
+// Valid returns true if none of the checks failed.
+func (r CheckResult) Valid() bool {
+	return len(r.Errs) == 0
+}
+
+// add adds the outcome of a check, which failed if err is not nil.
+func (r *CheckResult) add(name string, err error) {
+	r.Checks = append(r.Checks, audit.NewCheck(name, err))
+	if err != nil {
+		r.Errs = append(r.Errs, err)
+	}
+}
+
+// VPVerificationOptions contains the options for verifying a verifiable presentation.
+type VPVerificationOptions struct {
+	// Challenge is the challenge the presentation proof must contain. When nil, the challenge isn't checked.
+	Challenge *string
+	// Domain is the domain the presentation proof must contain. When nil, the domain isn't checked.
+	Domain *string
+	// AllowUntrustedIssuer indicates whether credentials of untrusted issuers are accepted.
+	AllowUntrustedIssuer bool
+	// ValidAt is the time at which the presentation and its credentials must be valid. When nil, the current time is used.
+	ValidAt *time.Time
+	// PresentationDefinition is the presentation definition the presentation must satisfy. When nil, it isn't checked.
+	PresentationDefinition *pe.PresentationDefinition
+	// PresentationSubmission describes how the presentation satisfies the PresentationDefinition. It's required when PresentationDefinition is set.
+	PresentationSubmission *pe.PresentationSubmission
+}
+
+// VPVerificationResult contains the result of verifying a verifiable presentation.
+type VPVerificationResult struct {
+	// Holder is the DID that created the presentation proof. It's nil when the proof couldn't be read.
+	Holder *did.DID
+	// Err contains the reason the presentation itself is invalid, or nil if it's valid.
+	Err error
+	// Credentials contains the verification result for every credential in the presentation, in the same order.
+	Credentials []VCVerificationResult
+}
+
+// Valid returns true if both the presentation and all of its credentials are valid.
+func (r VPVerificationResult) Valid() bool {
+	if r.Err != nil {
+		return false
+	}
+	for _, curr := range r.Credentials {
+		if curr.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// VCVerificationResult contains the result of verifying a single credential contained in a presentation.
+type VCVerificationResult struct {
+	// Credential is the verified credential.
+	Credential vc.VerifiableCredential
+	// Err contains the reason the credential is invalid, or nil if it's valid.
+	Err error
+	// Checks contains the checks that were performed on the credential, in order, with their outcome.
+	Checks []audit.Check
 }
 
 // ErrNotFound is returned when a credential or revocation can not be found based on its ID.
@@ -37,6 +175,8 @@ type Store interface {
 	GetRevocation(id ssi.URI) (*credential.Revocation, error)
 	// StoreRevocation writes a revocation to storage.
 	StoreRevocation(r credential.Revocation) error
+	// Collection returns the collection of the store with its indices, so it can be checked and reindexed.
+	Collection() storage.Collection
 	// Closer closes and frees the underlying resources the store uses.
 	io.Closer
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/nuts-foundation/go-leia/v2"
 	"github.com/nuts-foundation/nuts-node/vcr/concept"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/storage"
 )
 
 // leiaVerifierStore implements the verifier Store interface. It is a simple and fast JSON store.
@@ -63,15 +64,33 @@ func (s leiaVerifierStore) GetRevocation(id ssi.URI) (*credential.Revocation, er
 	return revocation, nil
 }
 
+func (s leiaVerifierStore) Collection() storage.Collection {
+	return storage.Collection{
+		Name:       "revocations",
+		Collection: s.revocations,
+		Indices:    verifierIndices(),
+		IDField:    concept.SubjectField,
+	}
+}
+
 func (s leiaVerifierStore) Close() error {
 	return s.store.Close()
 }
 
-// createIndices creates the needed indices for the issued VC store
-// It allows faster searching on context, type issuer and subject values.
+// verifierIndices returns the indices of the verifier store.
+func verifierIndices() []storage.Index {
+	return []storage.Index{
+		// Index used for getting revocations by the ID of the revoked credential
+		storage.NewIndex("revocationBySubjectIDIndex", "subject"),
+	}
+}
+
+// createIndices creates the needed indices for the verifier store
 func (s leiaVerifierStore) createIndices() error {
-	// Index used for getting issued VCs by id
-	revocationBySubjectIDIndex := leia.NewIndex("revocationBySubjectIDIndex",
-		leia.NewFieldIndexer("subject"))
-	return s.revocations.AddIndex(revocationBySubjectIDIndex)
+	for _, index := range verifierIndices() {
+		if err := s.revocations.AddIndex(index.Index); err != nil {
+			return err
+		}
+	}
+	return nil
 }
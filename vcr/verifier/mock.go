@@ -12,6 +12,7 @@ import (
 	ssi "github.com/nuts-foundation/go-did"
 	vc "github.com/nuts-foundation/go-did/vc"
 	credential "github.com/nuts-foundation/nuts-node/vcr/credential"
+	storage "github.com/nuts-foundation/nuts-node/vcr/storage"
 )
 
 // MockVerifier is a mock of Verifier interface.
@@ -37,6 +38,20 @@ func (m *MockVerifier) EXPECT() *MockVerifierMockRecorder {
 	return m.recorder
 }
 
+// Check mocks base method.
+func (m *MockVerifier) Check(credential vc.VerifiableCredential, allowUntrusted, checkSignature bool, validAt *time.Time) CheckResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", credential, allowUntrusted, checkSignature, validAt)
+	ret0, _ := ret[0].(CheckResult)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockVerifierMockRecorder) Check(credential, allowUntrusted, checkSignature, validAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockVerifier)(nil).Check), credential, allowUntrusted, checkSignature, validAt)
+}
+
 // IsRevoked mocks base method.
 func (m *MockVerifier) IsRevoked(credentialID ssi.URI) (bool, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +81,21 @@ func (mr *MockVerifierMockRecorder) RegisterRevocation(revocation interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRevocation", reflect.TypeOf((*MockVerifier)(nil).RegisterRevocation), revocation)
 }
 
+// Status mocks base method.
+func (m *MockVerifier) Status(credential vc.VerifiableCredential, at *time.Time) (*CredentialStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", credential, at)
+	ret0, _ := ret[0].(*CredentialStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockVerifierMockRecorder) Status(credential, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockVerifier)(nil).Status), credential, at)
+}
+
 // Validate mocks base method.
 func (m *MockVerifier) Validate(credentialToVerify vc.VerifiableCredential, at *time.Time) error {
 	m.ctrl.T.Helper()
@@ -94,6 +124,20 @@ func (mr *MockVerifierMockRecorder) Verify(credential, allowUntrusted, checkSign
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockVerifier)(nil).Verify), credential, allowUntrusted, checkSignature, validAt)
 }
 
+// VerifyVP mocks base method.
+func (m *MockVerifier) VerifyVP(presentation vc.VerifiablePresentation, options VPVerificationOptions) VPVerificationResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyVP", presentation, options)
+	ret0, _ := ret[0].(VPVerificationResult)
+	return ret0
+}
+
+// VerifyVP indicates an expected call of VerifyVP.
+func (mr *MockVerifierMockRecorder) VerifyVP(presentation, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyVP", reflect.TypeOf((*MockVerifier)(nil).VerifyVP), presentation, options)
+}
+
 // MockStore is a mock of Store interface.
 type MockStore struct {
 	ctrl     *gomock.Controller
@@ -131,6 +175,20 @@ func (mr *MockStoreMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStore)(nil).Close))
 }
 
+// Collection mocks base method.
+func (m *MockStore) Collection() storage.Collection {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collection")
+	ret0, _ := ret[0].(storage.Collection)
+	return ret0
+}
+
+// Collection indicates an expected call of Collection.
+func (mr *MockStoreMockRecorder) Collection() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collection", reflect.TypeOf((*MockStore)(nil).Collection))
+}
+
 // GetRevocation mocks base method.
 func (m *MockStore) GetRevocation(id ssi.URI) (*credential.Revocation, error) {
 	m.ctrl.T.Helper()
@@ -19,14 +19,19 @@
 package verifier
 
 import (
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
 	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/go-did/vc"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/pe"
 	"github.com/nuts-foundation/nuts-node/vcr/signature"
 	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/trust"
 	"github.com/nuts-foundation/nuts-node/vcr/types"
 	vdr "github.com/nuts-foundation/nuts-node/vdr/types"
 	"github.com/piprate/json-gold/ld"
@@ -45,13 +50,16 @@ const (
 // It does not know anything about the semantics of a credential. It should support a wide range of types.
 type verifier struct {
 	keyResolver   vdr.KeyResolver
+	docResolver   vdr.DocResolver
 	contextLoader ld.DocumentLoader
 	store         Store
+	trustConfig   *trust.Config
 }
 
-// NewVerifier creates a new instance of the verifier. It needs a key resolver for validating signatures.
-func NewVerifier(store Store, keyResolver vdr.KeyResolver, contextLoader ld.DocumentLoader) Verifier {
-	return &verifier{store: store, keyResolver: keyResolver, contextLoader: contextLoader}
+// NewVerifier creates a new instance of the verifier. It needs a key resolver for validating signatures, a DID document resolver
+// for checking whether issuers were active and the trust config for checking whether issuers of presented credentials are trusted.
+func NewVerifier(store Store, keyResolver vdr.KeyResolver, docResolver vdr.DocResolver, contextLoader ld.DocumentLoader, trustConfig *trust.Config) Verifier {
+	return &verifier{store: store, keyResolver: keyResolver, docResolver: docResolver, contextLoader: contextLoader, trustConfig: trustConfig}
 }
 
 // validateAtTime is a helper method which checks if a credential is valid at a certain given time.
@@ -64,24 +72,37 @@ func (v *verifier) validateAtTime(credential vc.VerifiableCredential, validAt *t
 		at = *validAt
 	}
 
+	if validityWindow(credential, at) != Valid {
+		return types.ErrInvalidPeriod
+	}
+	return nil
+}
+
+// validityWindow determines whether the given time lies between the issuanceDate and expirationDate of the credential,
+// allowing for clock skew.
+func validityWindow(credential vc.VerifiableCredential, at time.Time) ValidityWindowState {
 	// check if issuanceDate is before validAt
 	if credential.IssuanceDate.After(at.Add(maxSkew)) {
-		return types.ErrInvalidPeriod
+		return NotYetValid
 	}
 
 	// check if expirationDate is after validAt
 	if credential.ExpirationDate != nil && credential.ExpirationDate.Add(maxSkew).Before(at) {
-		return types.ErrInvalidPeriod
+		return Expired
 	}
-	return nil
+	return Valid
 }
 
 // Validate implements the Proof Verification Algorithm: https://w3c-ccg.github.io/data-integrity-spec/#proof-verification-algorithm
+// Credentials in JWT format are decoded according to https://www.w3.org/TR/vc-data-model/#jwt-decoding and must match the credential.
 func (v *verifier) Validate(credentialToVerify vc.VerifiableCredential, at *time.Time) error {
 	signedDocument, err := proof.NewSignedDocument(credentialToVerify)
 	if err != nil {
 		return fmt.Errorf("unable to build signed document from verifiable credential: %w", err)
 	}
+	if signedDocument.HasJWTProof() || signedDocument.HasSDJWTProof() {
+		return v.validateJWT(credentialToVerify, signedDocument, at)
+	}
 
 	ldProof := proof.LDProof{}
 	if err := signedDocument.UnmarshalProofValue(&ldProof); err != nil {
@@ -116,33 +137,195 @@ func (v *verifier) Validate(credentialToVerify vc.VerifiableCredential, at *time
 
 }
 
-// Verify implements the verify interface.
-// It currently checks if the credential has the required fields and values, if it is valid at the given time and optional the signature.
-// For the v2 api to be complete implement the following TODOs:
-// TODO: check if issuer-type combination is trusted
-func (v verifier) Verify(credentialToVerify vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time) error {
-	// it must have valid content
-	validator, _ := credential.FindValidatorAndBuilder(credentialToVerify)
-	if err := validator.Validate(credentialToVerify); err != nil {
+// jwtBasedProof is implemented by the proofs that embed the credential as (SD-)JWT.
+type jwtBasedProof interface {
+	KID() (string, error)
+	Verify(key crypto.PublicKey) (map[string]interface{}, error)
+}
+
+// validateJWT checks the signature of a credential in JWT or SD-JWT format and whether the JWT claims match the credential.
+// For SD-JWT, the claims disclosed by the holder must match the credential.
+func (v *verifier) validateJWT(credentialToVerify vc.VerifiableCredential, signedDocument proof.SignedDocument, at *time.Time) error {
+	var jwtProof jwtBasedProof
+	if signedDocument.HasSDJWTProof() {
+		sdJWTProof := proof.SDJWTProof{}
+		if err := signedDocument.UnmarshalProofValue(&sdJWTProof); err != nil {
+			return fmt.Errorf("unable to extract SD-JWT proof from signed document: %w", err)
+		}
+		jwtProof = sdJWTProof
+	} else {
+		plainJWTProof := proof.JWTProof{}
+		if err := signedDocument.UnmarshalProofValue(&plainJWTProof); err != nil {
+			return fmt.Errorf("unable to extract JWT proof from signed document: %w", err)
+		}
+		jwtProof = plainJWTProof
+	}
+	kid, err := jwtProof.KID()
+	if err != nil {
 		return err
 	}
+	if strings.Split(kid, "#")[0] != credentialToVerify.Issuer.String() {
+		return errors.New("verification method is not of issuer")
+	}
 
-	revoked, err := v.IsRevoked(*credentialToVerify.ID)
+	pk, err := v.keyResolver.ResolveSigningKey(kid, at)
 	if err != nil {
-		return err
+		if at == nil {
+			return fmt.Errorf("unable to resolve signing key: %w", err)
+		}
+		return fmt.Errorf("unable to resolve valid signing key at given time: %w", err)
 	}
-	if revoked {
-		return types.ErrRevoked
+	claims, err := jwtProof.Verify(pk)
+	if err != nil {
+		return err
 	}
 
-	if err := v.validateAtTime(credentialToVerify, validAt); err != nil {
+	// The credential is decoded from the JWT claims, which must result in the credential that's verified.
+	decoded, err := proof.CredentialFromClaims(claims, nil)
+	if err != nil {
 		return err
 	}
+	if !sameWithoutProof(*decoded, credentialToVerify) {
+		return errors.New("credential does not match JWT claims")
+	}
+	return nil
+}
+
+// sameWithoutProof returns true if the given credentials or presentations are equal, apart from their proofs.
+// Both are compared in their JSON form, which has its keys sorted.
+func sameWithoutProof(a interface{}, b interface{}) bool {
+	documentA, err := proof.NewSignedDocument(a)
+	if err != nil {
+		return false
+	}
+	documentB, err := proof.NewSignedDocument(b)
+	if err != nil {
+		return false
+	}
+	jsonA, _ := json.Marshal(documentA.DocumentWithoutProof())
+	jsonB, _ := json.Marshal(documentB.DocumentWithoutProof())
+	return string(jsonA) == string(jsonB)
+}
+
+// Verify implements the verify interface.
+// It performs the same checks as Check, but returns the error of the first check that failed.
+func (v verifier) Verify(credentialToVerify vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time) error {
+	result := v.Check(credentialToVerify, allowUntrusted, checkSignature, validAt)
+	if !result.Valid() {
+		return result.Errs[0]
+	}
+	return nil
+}
+
+// Status implements the Verifier interface.
+// The trust status is determined for every credential type, except the generic VerifiableCredential type.
+func (v *verifier) Status(credentialToCheck vc.VerifiableCredential, at *time.Time) (*CredentialStatus, error) {
+	if credentialToCheck.ID == nil {
+		return nil, errors.New("verifying a credential requires it to have a valid ID")
+	}
+	validAt := timeFunc()
+	if at != nil {
+		validAt = *at
+	}
+
+	revocation, err := v.revocation(*credentialToCheck.ID)
+	if err != nil {
+		return nil, err
+	}
+	status := v.status(credentialToCheck, validAt)
+	status.Revocation = revocation
+	return &status, nil
+}
+
+// status determines the trust and validity window status of the credential at the given time, which don't require the store.
+func (v *verifier) status(credentialToCheck vc.VerifiableCredential, at time.Time) CredentialStatus {
+	status := CredentialStatus{
+		Trust:          make([]TypeTrust, 0, len(credentialToCheck.Type)),
+		ValidityWindow: validityWindow(credentialToCheck, at),
+	}
+	for _, credentialType := range credentialToCheck.Type {
+		if credentialType == vc.VerifiableCredentialTypeV1URI() {
+			continue
+		}
+		decision := v.trustConfig.Explain(credentialType, credentialToCheck.Issuer, at)
+		status.Trust = append(status.Trust, TypeTrust{
+			Type:    credentialType,
+			Trusted: decision.Trusted,
+			Reason:  decision.Reason,
+		})
+	}
+	return status
+}
+
+// revocation returns the revocation of the credential, or nil if it isn't revoked.
+func (v *verifier) revocation(credentialID ssi.URI) (*credential.Revocation, error) {
+	revocation, err := v.store.GetRevocation(credentialID)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("unable to determine revocation status: %w", err)
+		}
+		return nil, nil
+	}
+	return revocation, nil
+}
+
+// Check implements the Verifier interface.
+// The trust and validity window checks don't require the store, so they're performed even when the revocation status can't be determined.
+func (v *verifier) Check(credentialToCheck vc.VerifiableCredential, allowUntrusted bool, checkSignature bool, validAt *time.Time) CheckResult {
+	at := timeFunc()
+	if validAt != nil {
+		at = *validAt
+	}
+
+	result := CheckResult{}
+	validator, _ := credential.FindValidatorAndBuilder(credentialToCheck)
+	result.add(audit.ContentCheck, validator.Validate(credentialToCheck))
+
+	status := v.status(credentialToCheck, at)
+	var revocationErr error
+	if credentialToCheck.ID == nil {
+		revocationErr = errors.New("verifying a credential requires it to have a valid ID")
+	} else if status.Revocation, revocationErr = v.revocation(*credentialToCheck.ID); revocationErr == nil {
+		// without the revocation status, the status of the credential is unknown
+		result.Status = &status
+		if status.Revoked() {
+			revocationErr = types.ErrRevoked
+		}
+	}
+	result.add(audit.RevocationCheck, revocationErr)
+
+	if checkSignature {
+		result.add(audit.IssuerCheck, v.checkIssuer(credentialToCheck, at))
+	}
+	if !allowUntrusted {
+		var untrustedErr error
+		if !status.Trusted() {
+			untrustedErr = types.ErrUntrusted
+		}
+		result.add(audit.TrustCheck, untrustedErr)
+	}
+	var periodErr error
+	if status.ValidityWindow != Valid {
+		periodErr = types.ErrInvalidPeriod
+	}
+	result.add(audit.ValidityCheck, periodErr)
 
 	if checkSignature {
-		return v.Validate(credentialToVerify, validAt)
+		result.add(audit.SignatureCheck, v.Validate(credentialToCheck, validAt))
 	}
+	return result
+}
 
+// checkIssuer checks whether the DID document of the issuer was active at the given time: it must not be deactivated,
+// nor may all of its controllers be deactivated.
+func (v *verifier) checkIssuer(credentialToCheck vc.VerifiableCredential, at time.Time) error {
+	issuerDID, err := did.ParseDID(credentialToCheck.Issuer.String())
+	if err != nil {
+		return fmt.Errorf("invalid issuer: %w", err)
+	}
+	if _, _, err = v.docResolver.Resolve(*issuerDID, &vdr.ResolveMetadata{ResolveTime: &at, AllowDeactivated: false}); err != nil {
+		return fmt.Errorf("could not check validity of signing key: %w", err)
+	}
 	return nil
 }
 
@@ -204,3 +387,211 @@ func (v *verifier) RegisterRevocation(revocation credential.Revocation) error {
 	}
 	return nil
 }
+
+// VerifyVP implements the Verifier interface.
+func (v *verifier) VerifyVP(presentation vc.VerifiablePresentation, options VPVerificationOptions) VPVerificationResult {
+	at := timeFunc()
+	if options.ValidAt != nil {
+		at = *options.ValidAt
+	}
+
+	result := VPVerificationResult{}
+	result.Holder, result.Err = v.verifyPresentationProof(presentation, options, at)
+	if result.Err == nil && options.PresentationDefinition != nil {
+		result.Err = validatePresentationSubmission(presentation, options)
+	}
+	result.Credentials = make([]VCVerificationResult, len(presentation.VerifiableCredential))
+	for i, credentialToVerify := range presentation.VerifiableCredential {
+		checks, err := v.verifyPresentedCredential(credentialToVerify, options, at)
+		result.Credentials[i] = VCVerificationResult{
+			Credential: credentialToVerify,
+			Err:        err,
+			Checks:     checks,
+		}
+	}
+	return result
+}
+
+// validatePresentationSubmission checks whether the presentation satisfies the presentation definition of the options.
+func validatePresentationSubmission(presentation vc.VerifiablePresentation, options VPVerificationOptions) error {
+	if options.PresentationSubmission == nil {
+		return errors.New("presentation submission is required to validate the presentation against a presentation definition")
+	}
+	if err := pe.Validate(*options.PresentationDefinition, *options.PresentationSubmission, presentation); err != nil {
+		return fmt.Errorf("presentation does not satisfy presentation definition: %w", err)
+	}
+	return nil
+}
+
+// verifyPresentationProof checks the proof of the presentation and returns the DID of the holder that created it.
+func (v *verifier) verifyPresentationProof(presentation vc.VerifiablePresentation, options VPVerificationOptions, at time.Time) (*did.DID, error) {
+	signedDocument, err := proof.NewSignedDocument(presentation)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build signed document from verifiable presentation: %w", err)
+	}
+	if signedDocument.HasJWTProof() {
+		return v.verifyPresentationJWT(presentation, signedDocument, options, at)
+	}
+
+	ldProof := proof.LDProof{}
+	if err := signedDocument.UnmarshalProofValue(&ldProof); err != nil {
+		return nil, fmt.Errorf("unable to extract ldproof from signed document: %w", err)
+	}
+
+	holder, err := presentationHolder(presentation, ldProof.VerificationMethod.String())
+	if err != nil {
+		return holder, err
+	}
+
+	if options.Challenge != nil && (ldProof.Challenge == nil || *ldProof.Challenge != *options.Challenge) {
+		return holder, errors.New("proof challenge does not match")
+	}
+	if options.Domain != nil && (ldProof.Domain == nil || *ldProof.Domain != *options.Domain) {
+		return holder, errors.New("proof domain does not match")
+	}
+	if ldProof.Created.After(at.Add(maxSkew)) {
+		return holder, errors.New("proof is not valid yet")
+	}
+	if ldProof.ExpirationDate != nil && ldProof.ExpirationDate.Add(maxSkew).Before(at) {
+		return holder, errors.New("proof has expired")
+	}
+
+	pk, err := v.keyResolver.ResolveSigningKey(ldProof.VerificationMethod.String(), &at)
+	if err != nil {
+		return holder, fmt.Errorf("unable to resolve valid signing key at given time: %w", err)
+	}
+	if err = ldProof.Verify(signedDocument.DocumentWithoutProof(), signature.JSONWebSignature2020{ContextLoader: v.contextLoader}, pk); err != nil {
+		return holder, err
+	}
+	return holder, nil
+}
+
+// verifyPresentationJWT checks the JWT of a presentation in JWT format and returns the DID of the holder that created it.
+// The challenge and domain are checked against the 'nonce' and 'aud' claims.
+func (v *verifier) verifyPresentationJWT(presentation vc.VerifiablePresentation, signedDocument proof.SignedDocument, options VPVerificationOptions, at time.Time) (*did.DID, error) {
+	jwtProof := proof.JWTProof{}
+	if err := signedDocument.UnmarshalProofValue(&jwtProof); err != nil {
+		return nil, fmt.Errorf("unable to extract JWT proof from signed document: %w", err)
+	}
+	kid, err := jwtProof.KID()
+	if err != nil {
+		return nil, err
+	}
+
+	holder, err := presentationHolder(presentation, kid)
+	if err != nil {
+		return holder, err
+	}
+
+	pk, err := v.keyResolver.ResolveSigningKey(kid, &at)
+	if err != nil {
+		return holder, fmt.Errorf("unable to resolve valid signing key at given time: %w", err)
+	}
+	claims, err := jwtProof.Verify(pk)
+	if err != nil {
+		return holder, err
+	}
+
+	if claims["iss"] != holder.String() {
+		return holder, errors.New("JWT 'iss' claim does not match holder")
+	}
+	// The presentation is decoded from the JWT claims, which must result in the presentation that's verified.
+	decoded, err := proof.PresentationFromClaims(claims, nil)
+	if err != nil {
+		return holder, err
+	}
+	if !sameWithoutProof(*decoded, presentation) {
+		return holder, errors.New("presentation does not match JWT claims")
+	}
+	if options.Challenge != nil && claims["nonce"] != *options.Challenge {
+		return holder, errors.New("proof challenge does not match")
+	}
+	if options.Domain != nil && claims["aud"] != *options.Domain {
+		return holder, errors.New("proof domain does not match")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && time.Unix(int64(nbf), 0).After(at.Add(maxSkew)) {
+		return holder, errors.New("proof is not valid yet")
+	}
+	if exp, ok := claims["exp"].(float64); ok && time.Unix(int64(exp), 0).Add(maxSkew).Before(at) {
+		return holder, errors.New("proof has expired")
+	}
+	return holder, nil
+}
+
+// presentationHolder derives the holder of the presentation from the verification method used to sign it.
+// It returns an error if the presentation specifies a different holder.
+func presentationHolder(presentation vc.VerifiablePresentation, verificationMethod string) (*did.DID, error) {
+	vmID, err := did.ParseDIDURL(verificationMethod)
+	if err != nil {
+		return nil, fmt.Errorf("invalid verification method: %w", err)
+	}
+	holder := *vmID
+	holder.Fragment = ""
+	if presentation.Holder != nil && presentation.Holder.String() != holder.String() {
+		return &holder, errors.New("verification method is not of holder")
+	}
+	return &holder, nil
+}
+
+// verifyPresentedCredential checks a credential contained in a presentation on full correctness like Check does, including whether its issuer
+// was active and is trusted.
+// Credentials in SD-JWT format must be bound to the presentation by their subject as well.
+// It returns the checks that were performed, and the first check that failed as error.
+func (v *verifier) verifyPresentedCredential(credentialToVerify vc.VerifiableCredential, options VPVerificationOptions, at time.Time) ([]audit.Check, error) {
+	if credentialToVerify.ID == nil {
+		err := errors.New("verifying a credential requires it to have a valid ID")
+		return []audit.Check{audit.NewCheck(audit.ContentCheck, err)}, err
+	}
+	result := v.Check(credentialToVerify, options.AllowUntrustedIssuer, true, &at)
+	if !result.Valid() {
+		return result.Checks, result.Errs[0]
+	}
+	signedDocument, err := proof.NewSignedDocument(credentialToVerify)
+	if err != nil || !signedDocument.HasSDJWTProof() {
+		return result.Checks, nil
+	}
+	err = v.verifyKeyBinding(credentialToVerify, signedDocument, options, at)
+	return append(result.Checks, audit.NewCheck(audit.KeyBindingCheck, err)), err
+}
+
+// verifyKeyBinding checks the key binding JWT of a presented credential in SD-JWT format. Instead of a key in a 'cnf' claim,
+// it must be signed with a key of the credential subject's DID. Its nonce and audience must match the challenge and domain of the options.
+func (v *verifier) verifyKeyBinding(credentialToVerify vc.VerifiableCredential, signedDocument proof.SignedDocument, options VPVerificationOptions, at time.Time) error {
+	sdJWTProof := proof.SDJWTProof{}
+	if err := signedDocument.UnmarshalProofValue(&sdJWTProof); err != nil {
+		return fmt.Errorf("unable to extract SD-JWT proof from signed document: %w", err)
+	}
+	kid, err := sdJWTProof.KeyBindingKID()
+	if err != nil {
+		return err
+	}
+	var subjects []credential.BaseCredentialSubject
+	if err = credentialToVerify.UnmarshalCredentialSubject(&subjects); err != nil || len(subjects) != 1 || subjects[0].ID == "" {
+		return errors.New("key binding requires a single credential subject with an ID")
+	}
+	if strings.Split(kid, "#")[0] != subjects[0].ID {
+		return errors.New("key binding JWT is not signed by the credential subject")
+	}
+	pk, err := v.keyResolver.ResolveSigningKey(kid, &at)
+	if err != nil {
+		return fmt.Errorf("unable to resolve valid key binding key at given time: %w", err)
+	}
+	claims, err := sdJWTProof.VerifyKeyBinding(pk)
+	if err != nil {
+		return err
+	}
+	if options.Challenge != nil && claims["nonce"] != *options.Challenge {
+		return errors.New("key binding JWT nonce does not match challenge")
+	}
+	if options.Domain != nil && claims["aud"] != *options.Domain {
+		return errors.New("key binding JWT audience does not match domain")
+	}
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return errors.New("key binding JWT doesn't contain an 'iat' claim")
+	}
+	if time.Unix(int64(iat), 0).After(at.Add(maxSkew)) {
+		return errors.New("key binding JWT is not valid yet")
+	}
+	return nil
+}
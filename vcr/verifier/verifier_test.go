@@ -28,16 +28,60 @@ import (
 	"github.com/nuts-foundation/go-did/vc"
 	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/crypto/storage"
+	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/nuts-foundation/nuts-node/vcr/audit"
 	"github.com/nuts-foundation/nuts-node/vcr/credential"
+	"github.com/nuts-foundation/nuts-node/vcr/pe"
 	"github.com/nuts-foundation/nuts-node/vcr/signature"
 	"github.com/nuts-foundation/nuts-node/vcr/signature/proof"
+	"github.com/nuts-foundation/nuts-node/vcr/trust"
+	vcrTypes "github.com/nuts-foundation/nuts-node/vcr/types"
 	"github.com/nuts-foundation/nuts-node/vdr/types"
 	"github.com/stretchr/testify/assert"
 	"os"
+	"path"
 	"testing"
 	"time"
 )
 
+// signJWTCredential returns the given credential as JWT credential, signed by the given key.
+func signJWTCredential(t *testing.T, source vc.VerifiableCredential, key crypto.Key) vc.VerifiableCredential {
+	claims, err := proof.CredentialClaims(source)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	jwtProof, err := proof.NewJWTProof(claims, key)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	result, err := jwtProof.Credential()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return *result
+}
+
+// signJWTPresentation returns the given presentation as JWT presentation, signed by the given key.
+// The given claims are added to (or override) the claims derived from the presentation.
+func signJWTPresentation(t *testing.T, source vc.VerifiablePresentation, claims map[string]interface{}, key crypto.Key) vc.VerifiablePresentation {
+	presentationClaims, err := proof.PresentationClaims(source, proof.ProofOptions{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	for name, value := range claims {
+		presentationClaims[name] = value
+	}
+	jwtProof, err := proof.NewJWTProof(presentationClaims, key)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	result, err := jwtProof.Presentation()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return *result
+}
+
 func testCredential(t *testing.T) vc.VerifiableCredential {
 	subject := vc.VerifiableCredential{}
 	vcJSON, _ := os.ReadFile("../test/vc.json")
@@ -191,6 +235,113 @@ func Test_verifier_Validate(t *testing.T) {
 
 }
 
+func Test_verifier_ValidateJWT(t *testing.T) {
+	const testKID = "did:nuts:CuE3qeFGGLhEAS3gKzhMCeqd1dGa9at5JCbmCfyMU2Ey#key-1"
+	issuerKey := crypto.NewTestKey(testKID)
+	jwtCredential := func(t *testing.T, key crypto.Key) vc.VerifiableCredential {
+		return signJWTCredential(t, testCredential(t), key)
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, nil).Return(issuerKey.Public(), nil)
+
+		err := ctx.verifier.Validate(jwtCredential(t, issuerKey), nil)
+
+		assert.NoError(t, err)
+	})
+	t.Run("error - credential does not match JWT", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, nil).Return(issuerKey.Public(), nil)
+		credentialToVerify := jwtCredential(t, issuerKey)
+		credentialToVerify.CredentialSubject = []interface{}{map[string]interface{}{"id": "did:nuts:other"}}
+
+		err := ctx.verifier.Validate(credentialToVerify, nil)
+
+		assert.EqualError(t, err, "credential does not match JWT claims")
+	})
+	t.Run("error - signed with key of other DID", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		err := ctx.verifier.Validate(jwtCredential(t, crypto.NewTestKey("did:nuts:other#key-1")), nil)
+
+		assert.EqualError(t, err, "verification method is not of issuer")
+	})
+	t.Run("error - invalid signature", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, nil).Return(crypto.NewTestKey(testKID).Public(), nil)
+
+		err := ctx.verifier.Validate(jwtCredential(t, issuerKey), nil)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid JWT signature")
+	})
+	t.Run("error - resolving key", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, nil).Return(nil, types.ErrNotFound)
+
+		err := ctx.verifier.Validate(jwtCredential(t, issuerKey), nil)
+
+		assert.ErrorIs(t, err, types.ErrNotFound)
+	})
+}
+
+func Test_verifier_ValidateSDJWT(t *testing.T) {
+	const testKID = "did:nuts:CuE3qeFGGLhEAS3gKzhMCeqd1dGa9at5JCbmCfyMU2Ey#key-1"
+	issuerKey := crypto.NewTestKey(testKID)
+	// sdJWTCredential returns the test credential as SD-JWT with a selectively disclosable city, of which only the given claims are disclosed.
+	sdJWTCredential := func(t *testing.T, disclose []string) vc.VerifiableCredential {
+		claims, _ := proof.CredentialClaims(testCredential(t))
+		sdJWTProof, err := proof.NewSDJWTProof(claims, []string{"vc.credentialSubject.organization.city"}, issuerKey)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		disclosedProof, _ := sdJWTProof.Disclose(disclose)
+		result, err := disclosedProof.Credential()
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		return *result
+	}
+
+	t.Run("ok - all claims disclosed", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, nil).Return(issuerKey.Public(), nil)
+
+		err := ctx.verifier.Validate(sdJWTCredential(t, []string{"vc.credentialSubject.organization.city"}), nil)
+
+		assert.NoError(t, err)
+	})
+	t.Run("ok - claim withheld", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, nil).Return(issuerKey.Public(), nil)
+		credentialToVerify := sdJWTCredential(t, nil)
+
+		err := ctx.verifier.Validate(credentialToVerify, nil)
+
+		assert.NoError(t, err)
+		assert.NotContains(t, credentialToVerify.CredentialSubject[0].(map[string]interface{})["organization"], "city")
+	})
+	t.Run("error - credential contains claim that isn't disclosed", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, nil).Return(issuerKey.Public(), nil)
+		credentialToVerify := sdJWTCredential(t, nil)
+		credentialToVerify.CredentialSubject = testCredential(t).CredentialSubject
+
+		err := ctx.verifier.Validate(credentialToVerify, nil)
+
+		assert.EqualError(t, err, "credential does not match JWT claims")
+	})
+	t.Run("error - invalid signature", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, nil).Return(crypto.NewTestKey(testKID).Public(), nil)
+
+		err := ctx.verifier.Validate(sdJWTCredential(t, nil), nil)
+
+		assert.Contains(t, err.Error(), "invalid JWT signature")
+	})
+}
+
 func TestVerifier_Verify(t *testing.T) {
 	const testKID = "did:nuts:CuE3qeFGGLhEAS3gKzhMCeqd1dGa9at5JCbmCfyMU2Ey#sNGDQ3NlOe6Icv0E7_ufviOLG6Y25bSEyS5EbXBgp8Y"
 
@@ -245,6 +396,7 @@ func Test_verifier_Verify(t *testing.T) {
 			ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, ErrNotFound)
 			proofs, _ := vc.Proofs()
 			ctx.keyResolver.EXPECT().ResolveSigningKey(proofs[0].VerificationMethod.String(), nil).Return(nil, types.ErrKeyNotFound)
+			ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
 			sut := ctx.verifier
 			validationErr := sut.Verify(vc, true, true, nil)
 			assert.EqualError(t, validationErr, "unable to resolve signing key: key not found in DID document")
@@ -260,6 +412,15 @@ func Test_verifier_Verify(t *testing.T) {
 		assert.EqualError(t, validationErr, "credential is revoked")
 	})
 
+	t.Run("invalid when untrusted", func(t *testing.T) {
+		vc := testCredential(t)
+		ctx := newMockContext(t)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, ErrNotFound)
+		sut := ctx.verifier
+		validationErr := sut.Verify(vc, false, false, nil)
+		assert.ErrorIs(t, validationErr, vcrTypes.ErrUntrusted)
+	})
+
 	t.Run("no signature check", func(t *testing.T) {
 		t.Run("ok, the vc is valid", func(t *testing.T) {
 			vc := testCredential(t)
@@ -289,6 +450,7 @@ func Test_verifier_Verify(t *testing.T) {
 				// set the type to an empty array should make the credential invalid
 				vc.Type = []ssi.URI{}
 				ctx := newMockContext(t)
+				ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, ErrNotFound)
 				sut := ctx.verifier
 				validationErr := sut.Verify(vc, true, false, nil)
 				assert.EqualError(t, validationErr, "validation failed: type 'VerifiableCredential' is required")
@@ -309,6 +471,160 @@ func Test_verifier_Verify(t *testing.T) {
 	})
 }
 
+func Test_verifier_Status(t *testing.T) {
+	organizationCredentialType := ssi.MustParseURI(credential.NutsOrganizationCredentialType)
+
+	t.Run("ok - valid, trusted and not revoked", func(t *testing.T) {
+		vc := testCredential(t)
+		ctx := newMockContext(t)
+		_ = ctx.trustConfig.AddTrust(organizationCredentialType, vc.Issuer)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, ErrNotFound)
+
+		status, err := ctx.verifier.Status(vc, nil)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.False(t, status.Revoked())
+		assert.True(t, status.Trusted())
+		assert.Equal(t, []TypeTrust{{Type: organizationCredentialType, Trusted: true, Reason: "issuer is in the list of trusted issuers"}}, status.Trust)
+		assert.Equal(t, Valid, status.ValidityWindow)
+	})
+	t.Run("ok - expired, untrusted and revoked", func(t *testing.T) {
+		vc := testCredential(t)
+		expirationDate := time.Now().Add(-time.Hour)
+		vc.ExpirationDate = &expirationDate
+		revocation := &credential.Revocation{Reason: "no longer valid"}
+		ctx := newMockContext(t)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(revocation, nil)
+
+		status, err := ctx.verifier.Status(vc, nil)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, status.Revoked())
+		assert.Equal(t, revocation, status.Revocation)
+		assert.False(t, status.Trusted())
+		assert.Equal(t, Expired, status.ValidityWindow)
+	})
+	t.Run("ok - not yet valid at given time", func(t *testing.T) {
+		vc := testCredential(t)
+		at := vc.IssuanceDate.Add(-time.Hour)
+		ctx := newMockContext(t)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, ErrNotFound)
+
+		status, err := ctx.verifier.Status(vc, &at)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, NotYetValid, status.ValidityWindow)
+	})
+	t.Run("error - store fails", func(t *testing.T) {
+		vc := testCredential(t)
+		ctx := newMockContext(t)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, errors.New("b00m!"))
+
+		status, err := ctx.verifier.Status(vc, nil)
+
+		assert.EqualError(t, err, "unable to determine revocation status: b00m!")
+		assert.Nil(t, status)
+	})
+	t.Run("error - no ID", func(t *testing.T) {
+		vc := testCredential(t)
+		vc.ID = nil
+		ctx := newMockContext(t)
+
+		_, err := ctx.verifier.Status(vc, nil)
+
+		assert.EqualError(t, err, "verifying a credential requires it to have a valid ID")
+	})
+}
+
+func Test_verifier_Check(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		vc := testCredential(t)
+		ctx := newMockContext(t)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, ErrNotFound)
+
+		result := ctx.verifier.Check(vc, true, false, nil)
+
+		assert.True(t, result.Valid())
+		assert.NotNil(t, result.Status)
+		assert.Equal(t, []audit.Check{
+			{Name: audit.ContentCheck, Passed: true},
+			{Name: audit.RevocationCheck, Passed: true},
+			{Name: audit.ValidityCheck, Passed: true},
+		}, result.Checks)
+	})
+	t.Run("reports every failing check", func(t *testing.T) {
+		vc := testCredential(t)
+		expirationDate := time.Now().Add(-time.Hour)
+		vc.ExpirationDate = &expirationDate
+		ctx := newMockContext(t)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(&credential.Revocation{}, nil)
+		proofs, _ := vc.Proofs()
+		ctx.keyResolver.EXPECT().ResolveSigningKey(proofs[0].VerificationMethod.String(), nil).Return(nil, types.ErrKeyNotFound)
+		ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+
+		result := ctx.verifier.Check(vc, false, true, nil)
+
+		assert.False(t, result.Valid())
+		if assert.Len(t, result.Errs, 4) {
+			assert.ErrorIs(t, result.Errs[0], vcrTypes.ErrRevoked)
+			assert.ErrorIs(t, result.Errs[1], vcrTypes.ErrUntrusted)
+			assert.ErrorIs(t, result.Errs[2], vcrTypes.ErrInvalidPeriod)
+			assert.ErrorIs(t, result.Errs[3], types.ErrKeyNotFound)
+		}
+		assert.True(t, result.Status.Revoked())
+	})
+	t.Run("status can't be determined", func(t *testing.T) {
+		vc := testCredential(t)
+		vc.Type = []ssi.URI{}
+		ctx := newMockContext(t)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, errors.New("b00m!"))
+
+		result := ctx.verifier.Check(vc, true, false, nil)
+
+		assert.Nil(t, result.Status)
+		if assert.Len(t, result.Errs, 2) {
+			assert.EqualError(t, result.Errs[0], "validation failed: type 'VerifiableCredential' is required")
+			assert.EqualError(t, result.Errs[1], "unable to determine revocation status: b00m!")
+		}
+	})
+	t.Run("trust and validity are checked when the revocation status can't be determined", func(t *testing.T) {
+		vc := testCredential(t)
+		expirationDate := time.Now().Add(-time.Hour)
+		vc.ExpirationDate = &expirationDate
+		ctx := newMockContext(t)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, errors.New("b00m!"))
+
+		result := ctx.verifier.Check(vc, false, false, nil)
+
+		assert.Equal(t, []audit.Check{
+			{Name: audit.ContentCheck, Passed: true},
+			{Name: audit.RevocationCheck, Passed: false, Message: "unable to determine revocation status: b00m!"},
+			{Name: audit.TrustCheck, Passed: false, Message: vcrTypes.ErrUntrusted.Error()},
+			{Name: audit.ValidityCheck, Passed: false, Message: vcrTypes.ErrInvalidPeriod.Error()},
+		}, result.Checks)
+	})
+	t.Run("issuer deactivated", func(t *testing.T) {
+		vc := testCredential(t)
+		ctx := newMockContext(t)
+		ctx.store.EXPECT().GetRevocation(*vc.ID).Return(nil, ErrNotFound)
+		ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, types.ErrDeactivated)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(gomock.Any(), nil).Return(nil, types.ErrKeyNotFound)
+
+		result := ctx.verifier.Check(vc, true, true, nil)
+
+		if assert.Len(t, result.Errs, 2) {
+			assert.ErrorIs(t, result.Errs[0], types.ErrDeactivated)
+			assert.ErrorIs(t, result.Errs[1], types.ErrKeyNotFound)
+		}
+	})
+}
+
 func Test_verifier_validateInTime(t *testing.T) {
 	var timeToCheck *time.Time
 	t.Run("no time provided", func(t *testing.T) {
@@ -478,10 +794,392 @@ func Test_verifier_IsRevoked(t *testing.T) {
 	})
 }
 
+func Test_verifier_VerifyVP(t *testing.T) {
+	const testKID = "did:nuts:CuE3qeFGGLhEAS3gKzhMCeqd1dGa9at5JCbmCfyMU2Ey#sNGDQ3NlOe6Icv0E7_ufviOLG6Y25bSEyS5EbXBgp8Y"
+	const holderKID = "did:nuts:holder#key-1"
+
+	// load pub key of the credential issuer
+	pke := storage.PublicKeyEntry{}
+	pkeJSON, _ := os.ReadFile("../test/public.json")
+	json.Unmarshal(pkeJSON, &pke)
+	var issuerKey = new(ecdsa.PublicKey)
+	pke.JWK().Raw(issuerKey)
+
+	holderKey := crypto.NewTestKey(holderKID)
+	contextLoader, _ := signature.NewContextLoader(false)
+	challenge := "challenge"
+	domain := "domain"
+	created := testCredential(t).IssuanceDate.Add(time.Hour)
+	validAt := created.Add(time.Minute)
+	expires := created.Add(time.Hour)
+	signVP := func(t *testing.T, holder *ssi.URI, options proof.ProofOptions) vc.VerifiablePresentation {
+		unsignedVP := vc.VerifiablePresentation{
+			Context:              []ssi.URI{vc.VCContextV1URI(), signature.JSONWebSignature2020Context},
+			Type:                 []ssi.URI{vc.VerifiablePresentationTypeV1URI()},
+			Holder:               holder,
+			VerifiableCredential: []vc.VerifiableCredential{testCredential(t)},
+		}
+		documentBytes, _ := json.Marshal(unsignedVP)
+		var document proof.Document
+		_ = json.Unmarshal(documentBytes, &document)
+		signed, err := proof.NewLDProof(options).Sign(document, signature.JSONWebSignature2020{ContextLoader: contextLoader}, holderKey)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		var result vc.VerifiablePresentation
+		signedBytes, _ := json.Marshal(signed)
+		_ = json.Unmarshal(signedBytes, &result)
+		return result
+	}
+	defaultOptions := proof.ProofOptions{Created: created, Challenge: &challenge, Domain: &domain, ExpirationDate: &expires}
+	holderDID := ssi.MustParseURI("did:nuts:holder")
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		_ = ctx.trustConfig.AddTrust(ssi.MustParseURI(credential.NutsOrganizationCredentialType), testCredential(t).Issuer)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &validAt).Return(issuerKey, nil)
+		ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		ctx.store.EXPECT().GetRevocation(gomock.Any()).Return(nil, ErrNotFound)
+
+		result := ctx.verifier.VerifyVP(signVP(t, &holderDID, defaultOptions), VPVerificationOptions{Challenge: &challenge, Domain: &domain, ValidAt: &validAt})
+
+		assert.NoError(t, result.Err)
+		assert.True(t, result.Valid())
+		assert.Equal(t, "did:nuts:holder", result.Holder.String())
+		if assert.Len(t, result.Credentials, 1) {
+			assert.NoError(t, result.Credentials[0].Err)
+			assert.Equal(t, testCredential(t).ID, result.Credentials[0].Credential.ID)
+		}
+	})
+	t.Run("error - untrusted issuer", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &validAt).Return(issuerKey, nil)
+		ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		ctx.store.EXPECT().GetRevocation(gomock.Any()).Return(nil, ErrNotFound)
+
+		result := ctx.verifier.VerifyVP(signVP(t, nil, defaultOptions), VPVerificationOptions{ValidAt: &validAt})
+
+		assert.NoError(t, result.Err)
+		assert.False(t, result.Valid())
+		assert.ErrorIs(t, result.Credentials[0].Err, vcrTypes.ErrUntrusted)
+		// the other checks are performed as well
+		assert.Equal(t, []audit.Check{
+			{Name: audit.ContentCheck, Passed: true},
+			{Name: audit.RevocationCheck, Passed: true},
+			{Name: audit.IssuerCheck, Passed: true},
+			{Name: audit.TrustCheck, Passed: false, Message: vcrTypes.ErrUntrusted.Error()},
+			{Name: audit.ValidityCheck, Passed: true},
+			{Name: audit.SignatureCheck, Passed: true},
+		}, result.Credentials[0].Checks)
+	})
+	t.Run("error - issuer deactivated", func(t *testing.T) {
+		ctx := newMockContext(t)
+		issuerDID, _ := did.ParseDID(testCredential(t).Issuer.String())
+		ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &validAt).Return(issuerKey, nil)
+		ctx.docResolver.EXPECT().Resolve(*issuerDID, &types.ResolveMetadata{ResolveTime: &validAt}).Return(nil, nil, types.ErrDeactivated)
+		ctx.store.EXPECT().GetRevocation(gomock.Any()).Return(nil, ErrNotFound)
+
+		result := ctx.verifier.VerifyVP(signVP(t, nil, defaultOptions), VPVerificationOptions{ValidAt: &validAt, AllowUntrustedIssuer: true})
+
+		assert.NoError(t, result.Err)
+		assert.False(t, result.Valid())
+		assert.ErrorIs(t, result.Credentials[0].Err, types.ErrDeactivated)
+	})
+	t.Run("error - revoked credential", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &validAt).Return(issuerKey, nil)
+		ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		ctx.store.EXPECT().GetRevocation(gomock.Any()).Return(&credential.Revocation{}, nil)
+
+		result := ctx.verifier.VerifyVP(signVP(t, nil, defaultOptions), VPVerificationOptions{ValidAt: &validAt, AllowUntrustedIssuer: true})
+
+		assert.NoError(t, result.Err)
+		assert.False(t, result.Valid())
+		assert.ErrorIs(t, result.Credentials[0].Err, vcrTypes.ErrRevoked)
+	})
+	t.Run("error - not signed by holder", func(t *testing.T) {
+		ctx := newMockContext(t)
+		otherHolder := ssi.MustParseURI("did:nuts:other")
+		ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &validAt).Return(issuerKey, nil)
+		ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		ctx.store.EXPECT().GetRevocation(gomock.Any()).Return(nil, ErrNotFound)
+
+		result := ctx.verifier.VerifyVP(signVP(t, &otherHolder, defaultOptions), VPVerificationOptions{ValidAt: &validAt, AllowUntrustedIssuer: true})
+
+		assert.EqualError(t, result.Err, "verification method is not of holder")
+		assert.False(t, result.Valid())
+		assert.NoError(t, result.Credentials[0].Err)
+	})
+	t.Run("error - proof checks", func(t *testing.T) {
+		other := "other"
+		tooLate := expires.Add(time.Minute)
+		tooEarly := created.Add(-time.Minute)
+		testCases := []struct {
+			name    string
+			options VPVerificationOptions
+			err     string
+		}{
+			{"challenge mismatch", VPVerificationOptions{Challenge: &other, ValidAt: &validAt}, "proof challenge does not match"},
+			{"domain mismatch", VPVerificationOptions{Domain: &other, ValidAt: &validAt}, "proof domain does not match"},
+			{"expired", VPVerificationOptions{ValidAt: &tooLate}, "proof has expired"},
+			{"not valid yet", VPVerificationOptions{ValidAt: &tooEarly}, "proof is not valid yet"},
+		}
+		vp := signVP(t, nil, defaultOptions)
+		vp.VerifiableCredential = nil
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				ctx := newMockContext(t)
+
+				result := ctx.verifier.VerifyVP(vp, testCase.options)
+
+				assert.EqualError(t, result.Err, testCase.err)
+				assert.False(t, result.Valid())
+			})
+		}
+	})
+	t.Run("error - invalid signature", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+		vp := signVP(t, nil, defaultOptions)
+		vp.VerifiableCredential = nil
+
+		result := ctx.verifier.VerifyVP(vp, VPVerificationOptions{ValidAt: &validAt})
+
+		assert.Error(t, result.Err)
+		assert.False(t, result.Valid())
+	})
+	t.Run("presentation definition", func(t *testing.T) {
+		definition := pe.PresentationDefinition{
+			ID: "definition",
+			InputDescriptors: []pe.InputDescriptor{{
+				ID: "organization",
+				Constraints: &pe.Constraints{Fields: []pe.Field{{
+					Path:   []string{"$.credentialSubject.organization.city"},
+					Filter: map[string]interface{}{"const": "IJbergen"},
+				}}},
+			}},
+		}
+		submission := pe.PresentationSubmission{
+			ID:            "submission",
+			DefinitionID:  "definition",
+			DescriptorMap: []pe.InputDescriptorMapping{{ID: "organization", Format: "ldp_vc", Path: "$.verifiableCredential[0]"}},
+		}
+		vp := signVP(t, nil, defaultOptions)
+		verify := func(t *testing.T, options VPVerificationOptions) VPVerificationResult {
+			ctx := newMockContext(t)
+			ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+			ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &validAt).Return(issuerKey, nil)
+			ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+			ctx.store.EXPECT().GetRevocation(gomock.Any()).Return(nil, ErrNotFound)
+			options.ValidAt = &validAt
+			options.AllowUntrustedIssuer = true
+			return ctx.verifier.VerifyVP(vp, options)
+		}
+
+		t.Run("ok", func(t *testing.T) {
+			result := verify(t, VPVerificationOptions{PresentationDefinition: &definition, PresentationSubmission: &submission})
+
+			assert.NoError(t, result.Err)
+			assert.True(t, result.Valid())
+		})
+		t.Run("error - definition not satisfied", func(t *testing.T) {
+			otherDefinition := definition
+			otherDefinition.InputDescriptors = []pe.InputDescriptor{definition.InputDescriptors[0], {ID: "other"}}
+
+			result := verify(t, VPVerificationOptions{PresentationDefinition: &otherDefinition, PresentationSubmission: &submission})
+
+			assert.EqualError(t, result.Err, "presentation does not satisfy presentation definition: input descriptor is not satisfied by presentation submission (id=other)")
+			assert.False(t, result.Valid())
+		})
+		t.Run("error - submission missing", func(t *testing.T) {
+			result := verify(t, VPVerificationOptions{PresentationDefinition: &definition})
+
+			assert.EqualError(t, result.Err, "presentation submission is required to validate the presentation against a presentation definition")
+		})
+	})
+	t.Run("JWT", func(t *testing.T) {
+		signJWTVP := func(t *testing.T, claims map[string]interface{}, credentials ...vc.VerifiableCredential) vc.VerifiablePresentation {
+			unsignedVP := vc.VerifiablePresentation{
+				Context:              []ssi.URI{vc.VCContextV1URI()},
+				Type:                 []ssi.URI{vc.VerifiablePresentationTypeV1URI()},
+				Holder:               &holderDID,
+				VerifiableCredential: credentials,
+			}
+			return signJWTPresentation(t, unsignedVP, claims, holderKey)
+		}
+		defaultClaims := func() map[string]interface{} {
+			return map[string]interface{}{
+				"iss":   holderDID.String(),
+				"nbf":   created.Unix(),
+				"exp":   expires.Unix(),
+				"nonce": challenge,
+				"aud":   domain,
+			}
+		}
+
+		t.Run("ok", func(t *testing.T) {
+			ctx := newMockContext(t)
+			ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+			ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &validAt).Return(issuerKey, nil)
+			ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+			ctx.store.EXPECT().GetRevocation(gomock.Any()).Return(nil, ErrNotFound)
+
+			result := ctx.verifier.VerifyVP(signJWTVP(t, defaultClaims(), testCredential(t)), VPVerificationOptions{Challenge: &challenge, Domain: &domain, ValidAt: &validAt, AllowUntrustedIssuer: true})
+
+			assert.NoError(t, result.Err)
+			assert.True(t, result.Valid())
+			assert.Equal(t, "did:nuts:holder", result.Holder.String())
+		})
+		t.Run("error - proof checks", func(t *testing.T) {
+			other := "other"
+			tooLate := expires.Add(time.Minute)
+			tooEarly := created.Add(-time.Minute)
+			testCases := []struct {
+				name    string
+				options VPVerificationOptions
+				err     string
+			}{
+				{"challenge mismatch", VPVerificationOptions{Challenge: &other, ValidAt: &validAt}, "proof challenge does not match"},
+				{"domain mismatch", VPVerificationOptions{Domain: &other, ValidAt: &validAt}, "proof domain does not match"},
+				{"expired", VPVerificationOptions{ValidAt: &tooLate}, "proof has expired"},
+				{"not valid yet", VPVerificationOptions{ValidAt: &tooEarly}, "proof is not valid yet"},
+			}
+			vp := signJWTVP(t, defaultClaims())
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					ctx := newMockContext(t)
+					ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, testCase.options.ValidAt).Return(holderKey.Public(), nil)
+
+					result := ctx.verifier.VerifyVP(vp, testCase.options)
+
+					assert.EqualError(t, result.Err, testCase.err)
+				})
+			}
+		})
+		t.Run("error - presentation does not match JWT", func(t *testing.T) {
+			ctx := newMockContext(t)
+			ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+			vp := signJWTVP(t, defaultClaims(), testCredential(t))
+			vp.VerifiableCredential = nil
+
+			result := ctx.verifier.VerifyVP(vp, VPVerificationOptions{ValidAt: &validAt})
+
+			assert.EqualError(t, result.Err, "presentation does not match JWT claims")
+		})
+		t.Run("error - issuer does not match holder", func(t *testing.T) {
+			ctx := newMockContext(t)
+			ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+			claims := defaultClaims()
+			claims["iss"] = "did:nuts:other"
+			vp := signJWTVP(t, claims)
+			vp.Holder = &holderDID
+
+			result := ctx.verifier.VerifyVP(vp, VPVerificationOptions{ValidAt: &validAt})
+
+			assert.EqualError(t, result.Err, "JWT 'iss' claim does not match holder")
+		})
+		t.Run("SD-JWT credential", func(t *testing.T) {
+			sdJWTIssuerKey := crypto.NewTestKey(testKID)
+			// the subject of the test credential is its issuer, the key binding JWT is signed with another key of the DID
+			subjectKID := "did:nuts:CuE3qeFGGLhEAS3gKzhMCeqd1dGa9at5JCbmCfyMU2Ey#key-2"
+			subjectKey := crypto.NewTestKey(subjectKID)
+			// sdJWTCredential returns the test credential as SD-JWT, bound with the given key, nonce and audience (if key is not nil)
+			sdJWTCredential := func(t *testing.T, key crypto.Key, nonce string, audience string) vc.VerifiableCredential {
+				claims, _ := proof.CredentialClaims(testCredential(t))
+				sdJWTProof, err := proof.NewSDJWTProof(claims, []string{"vc.credentialSubject.organization.city"}, sdJWTIssuerKey)
+				if !assert.NoError(t, err) {
+					t.FailNow()
+				}
+				if key != nil {
+					sdJWTProof, err = sdJWTProof.WithKeyBinding(key, nonce, audience, created)
+					if !assert.NoError(t, err) {
+						t.FailNow()
+					}
+				}
+				result, err := sdJWTProof.Credential()
+				if !assert.NoError(t, err) {
+					t.FailNow()
+				}
+				return *result
+			}
+			options := VPVerificationOptions{Challenge: &challenge, Domain: &domain, ValidAt: &validAt, AllowUntrustedIssuer: true}
+
+			t.Run("ok", func(t *testing.T) {
+				ctx := newMockContext(t)
+				ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+				ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &validAt).Return(sdJWTIssuerKey.Public(), nil)
+				ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+				ctx.keyResolver.EXPECT().ResolveSigningKey(subjectKID, &validAt).Return(subjectKey.Public(), nil)
+				ctx.store.EXPECT().GetRevocation(gomock.Any()).Return(nil, ErrNotFound)
+
+				result := ctx.verifier.VerifyVP(signJWTVP(t, defaultClaims(), sdJWTCredential(t, subjectKey, challenge, domain)), options)
+
+				assert.True(t, result.Valid())
+				if assert.Len(t, result.Credentials, 1) {
+					assert.Contains(t, result.Credentials[0].Checks, audit.Check{Name: audit.KeyBindingCheck, Passed: true})
+				}
+			})
+			testCases := []struct {
+				name       string
+				credential func(t *testing.T) vc.VerifiableCredential
+				err        string
+			}{
+				{"no key binding", func(t *testing.T) vc.VerifiableCredential {
+					return sdJWTCredential(t, nil, "", "")
+				}, "SD-JWT doesn't contain a key binding JWT"},
+				{"not bound by subject", func(t *testing.T) vc.VerifiableCredential {
+					return sdJWTCredential(t, holderKey, challenge, domain)
+				}, "key binding JWT is not signed by the credential subject"},
+				{"nonce mismatch", func(t *testing.T) vc.VerifiableCredential {
+					return sdJWTCredential(t, subjectKey, "other", domain)
+				}, "key binding JWT nonce does not match challenge"},
+				{"audience mismatch", func(t *testing.T) vc.VerifiableCredential {
+					return sdJWTCredential(t, subjectKey, challenge, "other")
+				}, "key binding JWT audience does not match domain"},
+			}
+			for _, testCase := range testCases {
+				t.Run("error - "+testCase.name, func(t *testing.T) {
+					ctx := newMockContext(t)
+					ctx.keyResolver.EXPECT().ResolveSigningKey(holderKID, &validAt).Return(holderKey.Public(), nil)
+					ctx.keyResolver.EXPECT().ResolveSigningKey(testKID, &validAt).Return(sdJWTIssuerKey.Public(), nil)
+					ctx.docResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+					ctx.keyResolver.EXPECT().ResolveSigningKey(subjectKID, &validAt).Return(subjectKey.Public(), nil).AnyTimes()
+					ctx.store.EXPECT().GetRevocation(gomock.Any()).Return(nil, ErrNotFound)
+
+					result := ctx.verifier.VerifyVP(signJWTVP(t, defaultClaims(), testCase.credential(t)), options)
+
+					assert.NoError(t, result.Err)
+					if assert.Len(t, result.Credentials, 1) {
+						assert.EqualError(t, result.Credentials[0].Err, testCase.err)
+						assert.Contains(t, result.Credentials[0].Checks, audit.Check{Name: audit.KeyBindingCheck, Passed: false, Message: testCase.err})
+					}
+				})
+			}
+		})
+	})
+	t.Run("error - no proof", func(t *testing.T) {
+		ctx := newMockContext(t)
+		vp := signVP(t, nil, defaultOptions)
+		vp.VerifiableCredential = nil
+		vp.Proof = nil
+
+		result := ctx.verifier.VerifyVP(vp, VPVerificationOptions{ValidAt: &validAt})
+
+		assert.Error(t, result.Err)
+		assert.Nil(t, result.Holder)
+	})
+}
+
 type mockContext struct {
 	ctrl        *gomock.Controller
 	keyResolver *types.MockKeyResolver
+	docResolver *types.MockDocResolver
 	store       *MockStore
+	trustConfig *trust.Config
 	verifier    Verifier
 }
 
@@ -489,14 +1187,18 @@ func newMockContext(t *testing.T) mockContext {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	keyResolver := types.NewMockKeyResolver(ctrl)
+	docResolver := types.NewMockDocResolver(ctrl)
 	contextLoader, err := signature.NewContextLoader(false)
 	verifierStore := NewMockStore(ctrl)
 	assert.NoError(t, err)
-	verifier := NewVerifier(verifierStore, keyResolver, contextLoader)
+	trustConfig := trust.NewConfig(path.Join(io.TestDirectory(t), "trust.yaml"))
+	verifier := NewVerifier(verifierStore, keyResolver, docResolver, contextLoader, trustConfig)
 	return mockContext{
 		ctrl:        ctrl,
 		verifier:    verifier,
 		keyResolver: keyResolver,
+		docResolver: docResolver,
 		store:       verifierStore,
+		trustConfig: trustConfig,
 	}
 }
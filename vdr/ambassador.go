@@ -163,11 +163,13 @@ func (n *ambassador) handleCreateDIDDocument(transaction dag.Transaction, propos
 		updatedAtP = &updatedAt
 	}
 
+	signedBy := proposedDIDDocument.ID
 	documentMetadata := types.DocumentMetadata{
 		Created:            transaction.SigningTime(),
 		Updated:            updatedAtP,
 		Hash:               transaction.PayloadHash(),
 		SourceTransactions: sourceTransactions,
+		SignedBy:           &signedBy,
 	}
 
 	if currentDIDDocument != nil {
@@ -200,13 +202,6 @@ func (n *ambassador) handleUpdateDIDDocument(transaction dag.Transaction, propos
 		return fmt.Errorf("unable to resolve DID document's controllers: %w", err)
 	}
 
-	var controllerVerificationRelationships []did.VerificationRelationship
-	for _, didCtrl := range didControllers {
-		for _, capInv := range didCtrl.CapabilityInvocation {
-			controllerVerificationRelationships = append(controllerVerificationRelationships, capInv)
-		}
-	}
-
 	// In an update, only the keyID is provided in the network document. Resolve the key from the key store
 	// This should succeed since the signature of the network document has already been verified.
 	var pKey crypto.PublicKey
@@ -229,11 +224,19 @@ func (n *ambassador) handleUpdateDIDDocument(transaction dag.Transaction, propos
 	}
 
 	// Check if the signingKey is listed as a valid capabilityInvocation in one of the controllers
-	keyToSign, err := n.findKeyByThumbprint(signingKeyThumbprint, controllerVerificationRelationships)
-	if err != nil {
-		return fmt.Errorf("unable to find signingKey by thumprint in controllers: %w", err)
+	var signedBy *did.DID
+	for _, didCtrl := range didControllers {
+		keyToSign, err := n.findKeyByThumbprint(signingKeyThumbprint, didCtrl.CapabilityInvocation)
+		if err != nil {
+			return fmt.Errorf("unable to find signingKey by thumprint in controllers: %w", err)
+		}
+		if keyToSign != nil {
+			controllerID := didCtrl.ID
+			signedBy = &controllerID
+			break
+		}
 	}
-	if keyToSign == nil {
+	if signedBy == nil {
 		return fmt.Errorf("network document not signed by one of its controllers")
 	}
 
@@ -259,6 +262,7 @@ func (n *ambassador) handleUpdateDIDDocument(transaction dag.Transaction, propos
 		PreviousHash:       &currentDIDMeta.Hash,
 		Deactivated:        store.IsDeactivated(proposedDIDDocument),
 		SourceTransactions: sourceTransactions,
+		SignedBy:           signedBy,
 	}
 	err = n.didStore.Update(proposedDIDDocument.ID, currentDIDMeta.Hash, proposedDIDDocument, &documentMetadata)
 	if err == nil {
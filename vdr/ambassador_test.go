@@ -48,7 +48,7 @@ type mockKeyCreator struct {
 }
 
 // New creates a new valid key with the correct KID
-func (m *mockKeyCreator) New(fn crypto.KIDNamingFunc) (crypto.Key, error) {
+func (m *mockKeyCreator) New(_ crypto.KeyType, fn crypto.KIDNamingFunc) (crypto.Key, error) {
 	if m.key == nil {
 		privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 		kid, _ := fn(privateKey.Public())
@@ -235,6 +235,7 @@ func TestAmbassador_handleCreateDIDDocument(t *testing.T) {
 			Updated:            nil,
 			Hash:               payloadHash,
 			SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+			SignedBy:           &didDocument.ID,
 		}
 
 		t.Run("a new document", func(t *testing.T) {
@@ -254,6 +255,7 @@ func TestAmbassador_handleCreateDIDDocument(t *testing.T) {
 				Updated:            &signingTime,
 				Hash:               payloadHash,
 				SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+				SignedBy:           &didDocument.ID,
 			}
 			ctx.didStore.EXPECT().Resolve(didDocument.ID, gomock.Any()).Return(&expectedDocument, &expectedMetadata, nil)
 			ctx.didStore.EXPECT().Update(didDocument.ID, payloadHash, expectedDocument, &expectedMetadata).Return(nil)
@@ -282,6 +284,7 @@ func TestAmbassador_handleCreateDIDDocument(t *testing.T) {
 			Updated:            &signingTime,
 			Hash:               payloadHash,
 			SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+			SignedBy:           &didDocument.ID,
 		}
 		ctx.didStore.EXPECT().Resolve(didDocument.ID, gomock.Any()).Return(&expectedDocument, &expectedMetadata, nil)
 		ctx.didStore.EXPECT().Update(didDocument.ID, payloadHash, expectedDocument, &expectedMetadata).Return(errors.New("b00m!"))
@@ -316,6 +319,7 @@ func TestAmbassador_handleCreateDIDDocument(t *testing.T) {
 			Updated:            nil,
 			Hash:               payloadHash,
 			SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+			SignedBy:           &didDocument.ID,
 		}
 
 		ctx.didStore.EXPECT().Resolve(didDocument.ID, gomock.Any()).Return(nil, nil, types.ErrNotFound)
@@ -401,6 +405,7 @@ func TestAmbassador_handleUpdateDIDDocument(t *testing.T) {
 			Deactivated:        true,
 			PreviousHash:       &currentPayloadHash,
 			SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+			SignedBy:           &storedDocument.ID,
 		}
 		var pKey crypto2.PublicKey
 		signingKey.Raw(&pKey)
@@ -448,6 +453,7 @@ func TestAmbassador_handleUpdateDIDDocument(t *testing.T) {
 			Hash:               payloadHash,
 			PreviousHash:       &currentPayloadHash,
 			SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+			SignedBy:           &expectedDocument.ID,
 		}
 		var pKey crypto2.PublicKey
 		signingKey.Raw(&pKey)
@@ -496,6 +502,7 @@ func TestAmbassador_handleUpdateDIDDocument(t *testing.T) {
 			Hash:               payloadHash,
 			PreviousHash:       &currentPayloadHash,
 			SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+			SignedBy:           &expectedDocument.ID,
 		}
 		var pKey crypto2.PublicKey
 		signingKey.Raw(&pKey)
@@ -539,6 +546,7 @@ func TestAmbassador_handleUpdateDIDDocument(t *testing.T) {
 			Hash:               payloadHash,
 			PreviousHash:       &currentMetadata.Hash,
 			SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+			SignedBy:           &controllerDoc.ID,
 		}
 		var pKey crypto2.PublicKey
 		signingKey.Raw(&pKey)
@@ -557,7 +565,7 @@ func TestAmbassador_handleUpdateDIDDocument(t *testing.T) {
 
 		currentDoc, signingKey, _ := newDidDoc()
 		newDoc := did.Document{Context: []ssi.URI{did.DIDContextV1URI()}, ID: currentDoc.ID}
-		newCapInv, _ := doc.CreateNewVerificationMethodForDID(currentDoc.ID, &mockKeyCreator{})
+		newCapInv, _ := doc.CreateNewVerificationMethodForDID(currentDoc.ID, crypto.DefaultKeyType, &mockKeyCreator{})
 		newDoc.AddCapabilityInvocation(newCapInv)
 
 		didDocPayload, _ := json.Marshal(newDoc)
@@ -581,6 +589,7 @@ func TestAmbassador_handleUpdateDIDDocument(t *testing.T) {
 			Hash:               payloadHash,
 			PreviousHash:       &currentMetadata.Hash,
 			SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+			SignedBy:           &currentDoc.ID,
 		}
 		var pKey crypto2.PublicKey
 		signingKey.Raw(&pKey)
@@ -651,6 +660,7 @@ func TestAmbassador_handleUpdateDIDDocument(t *testing.T) {
 			Hash:               payloadHash,
 			PreviousHash:       &currentMetadata.Hash,
 			SourceTransactions: []hash.SHA256Hash{tx.Ref()},
+			SignedBy:           &didDocumentController.ID,
 		}
 
 		ctx.didStore.EXPECT().Resolve(didDocument.ID, nil).Return(&expectedDocument, currentMetadata, nil)
@@ -768,6 +778,7 @@ func TestAmbassador_handleUpdateDIDDocument(t *testing.T) {
 			Hash:               payloadHash,
 			PreviousHash:       &didMetadata.Hash,
 			SourceTransactions: []hash.SHA256Hash{hash.EmptyHash(), tx.Ref()},
+			SignedBy:           &didDocument.ID,
 		}
 
 		didStoreMock.EXPECT().Resolve(didDocument.ID, gomock.Any()).Return(&didDocument, &didMetadata, nil)
@@ -29,6 +29,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/crypto/hash"
 	"github.com/nuts-foundation/nuts-node/vdr/types"
 )
@@ -41,18 +42,21 @@ type Wrapper struct {
 	VDR            types.VDR
 	DocManipulator types.DocManipulator
 	DocResolver    types.DocResolver
+	DocFinder      types.DocFinder
 }
 
 // ResolveStatusCode maps errors returned by this API to specific HTTP status codes.
 func (a *Wrapper) ResolveStatusCode(err error) int {
 	return core.ResolveStatusCode(err, map[error]int{
-		types.ErrNotFound:                http.StatusNotFound,
-		types.ErrDIDNotManagedByThisNode: http.StatusForbidden,
-		types.ErrDeactivated:             http.StatusConflict,
-		types.ErrNoActiveController:      http.StatusConflict,
-		types.ErrDuplicateService:        http.StatusBadRequest,
-		vdrDoc.ErrInvalidOptions:         http.StatusBadRequest,
-		did.ErrInvalidDID:                http.StatusBadRequest,
+		types.ErrNotFound:                  http.StatusNotFound,
+		types.ErrDIDNotManagedByThisNode:   http.StatusForbidden,
+		types.ErrDeactivated:               http.StatusConflict,
+		types.ErrNoActiveController:        http.StatusConflict,
+		types.ErrDuplicateService:          http.StatusBadRequest,
+		vdrDoc.ErrInvalidOptions:           http.StatusBadRequest,
+		vdrDoc.ErrKeyAgreementNotSupported: http.StatusBadRequest,
+		crypto.ErrUnsupportedKeyType:       http.StatusBadRequest,
+		did.ErrInvalidDID:                  http.StatusBadRequest,
 	})
 }
 
@@ -64,8 +68,8 @@ func (a *Wrapper) Preprocess(operationID string, context echo.Context) {
 }
 
 // DeleteVerificationMethod accepts a DID and a KeyIdentifier of a verificationMethod and calls the DocManipulator
-// to remove the verificationMethod from the given document.
-func (a *Wrapper) DeleteVerificationMethod(ctx echo.Context, didStr string, kidStr string) error {
+// to remove the verificationMethod from the given document. Its private key is retired if requested.
+func (a *Wrapper) DeleteVerificationMethod(ctx echo.Context, didStr string, kidStr string, params DeleteVerificationMethodParams) error {
 	id, err := did.ParseDID(didStr)
 	if err != nil {
 		return err
@@ -76,7 +80,8 @@ func (a *Wrapper) DeleteVerificationMethod(ctx echo.Context, didStr string, kidS
 		return core.InvalidInputError("given kid could not be parsed: %w", err)
 	}
 
-	err = a.DocManipulator.RemoveVerificationMethod(*id, *kid)
+	retireKey := params.RetireKey != nil && *params.RetireKey
+	err = a.DocManipulator.RemoveVerificationMethod(*id, *kid, retireKey)
 	if err != nil {
 		return fmt.Errorf("could not remove verification method from document: %w", err)
 	}
@@ -84,13 +89,20 @@ func (a *Wrapper) DeleteVerificationMethod(ctx echo.Context, didStr string, kidS
 }
 
 // AddNewVerificationMethod accepts a DID and adds a new VerificationMethod to that corresponding document.
-func (a *Wrapper) AddNewVerificationMethod(ctx echo.Context, id string) error {
+func (a *Wrapper) AddNewVerificationMethod(ctx echo.Context, id string, params AddNewVerificationMethodParams) error {
 	d, err := did.ParseDID(id)
 	if err != nil {
 		return err
 	}
 
-	vm, err := a.DocManipulator.AddVerificationMethod(*d)
+	var keyType crypto.KeyType
+	if params.KeyType != nil {
+		if keyType, err = crypto.ParseKeyType(string(*params.KeyType)); err != nil {
+			return err
+		}
+	}
+
+	vm, err := a.DocManipulator.AddVerificationMethod(*d, keyType)
 	if err != nil {
 		return err
 	}
@@ -108,12 +120,29 @@ func (a Wrapper) CreateDID(ctx echo.Context) error {
 		return err
 	}
 
+	options, err := creationOptions(req)
+	if err != nil {
+		return err
+	}
+
+	doc, _, err := a.VDR.Create(options)
+	// if this operation leads to an error, it may return a 500
+	if err != nil {
+		return err
+	}
+
+	// this API returns a DIDDocument according to spec, so it may return the business object
+	return ctx.JSON(http.StatusOK, *doc)
+}
+
+// creationOptions converts a DIDCreateRequest into DIDCreationOptions, applying the defaults for absent values.
+func creationOptions(req DIDCreateRequest) (types.DIDCreationOptions, error) {
 	options := vdrDoc.DefaultCreationOptions()
 	if req.Controllers != nil {
 		for _, c := range *req.Controllers {
 			id, err := did.ParseDID(c)
 			if err != nil {
-				return core.InvalidInputError("controller entry (%s) could not be parsed: %w", c, err)
+				return options, core.InvalidInputError("controller entry (%s) could not be parsed: %w", c, err)
 			}
 			options.Controllers = append(options.Controllers, *id)
 		}
@@ -137,15 +166,93 @@ func (a Wrapper) CreateDID(ctx echo.Context) error {
 	if req.SelfControl != nil {
 		options.SelfControl = *req.SelfControl
 	}
+	if req.KeyType != nil {
+		keyType, err := crypto.ParseKeyType(string(*req.KeyType))
+		if err != nil {
+			return options, err
+		}
+		options.KeyType = keyType
+		// keyAgreement is enabled by default, but only EC keys support it
+		if !keyType.SupportsKeyAgreement() {
+			if req.KeyAgreement != nil && *req.KeyAgreement {
+				return options, core.InvalidInputError("keyAgreement can't be enabled for key type %s, only %s keys support key agreement", keyType, crypto.ECP256Key)
+			}
+			options.KeyAgreement = false
+		}
+	}
+	return options, nil
+}
 
-	doc, _, err := a.VDR.Create(options)
-	// if this operation leads to an error, it may return a 500
+// ProvisionDIDs creates DID Documents in bulk and returns the result of every item.
+func (a Wrapper) ProvisionDIDs(ctx echo.Context) error {
+	req := DIDProvisionRequest{}
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	createRequest := DIDCreateRequest{}
+	if req.Options != nil {
+		createRequest = *req.Options
+	}
+	options, err := creationOptions(createRequest)
 	if err != nil {
 		return err
 	}
 
-	// this API returns a DIDDocument according to spec, so it may return the business object
-	return ctx.JSON(http.StatusOK, *doc)
+	items := make([]types.ProvisioningItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i].ExternalReference = item.ExternalReference
+		if item.Services == nil {
+			continue
+		}
+		for _, service := range *item.Services {
+			items[i].Services = append(items[i].Services, did.Service{Type: service.Type, ServiceEndpoint: service.ServiceEndpoint})
+		}
+	}
+
+	results, err := a.VDR.Provision(options, items)
+	if err != nil {
+		return err
+	}
+
+	response := make([]DIDProvisionResult, len(results))
+	for i, result := range results {
+		response[i] = DIDProvisionResult{
+			ExternalReference: result.ExternalReference,
+			Status:            DIDProvisionResultStatus(result.Status),
+		}
+		if result.DID != nil {
+			id := result.DID.String()
+			response[i].Did = &id
+		}
+		if result.Error != nil {
+			errStr := result.Error.Error()
+			response[i].Error = &errStr
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// SearchDIDs returns the active DID documents that match the given search parameters.
+func (a *Wrapper) SearchDIDs(ctx echo.Context, params SearchDIDsParams) error {
+	predicates := []types.Predicate{vdrDoc.IsActive()}
+	if params.ServiceType != nil {
+		predicates = append(predicates, vdrDoc.ByServiceTypeIncludingReferences(*params.ServiceType))
+	}
+	if params.Controller != nil {
+		controller, err := did.ParseDID(*params.Controller)
+		if err != nil {
+			return core.InvalidInputError("given controller is not a valid DID: %w", err)
+		}
+		predicates = append(predicates, vdrDoc.ByController(*controller))
+	}
+
+	docs, err := a.DocFinder.Find(predicates...)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, docs)
 }
 
 // GetDID returns a DID document and DID document metadata based on a DID.
@@ -231,14 +338,41 @@ func (a Wrapper) UpdateDID(ctx echo.Context, targetDID string) error {
 	return ctx.JSON(http.StatusOK, req.Document)
 }
 
+// ValidateDID validates a proposed update of a DID Document given a DID and DID Document body, without publishing it.
+// It returns the validation report.
+func (a Wrapper) ValidateDID(ctx echo.Context, targetDID string) error {
+	d, err := did.ParseDID(targetDID)
+	if err != nil {
+		return err
+	}
+
+	req := DIDUpdateRequest{}
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	h, err := hash.ParseHex(req.CurrentHash)
+	if err != nil {
+		return core.InvalidInputError("given hash is not valid: %w", err)
+	}
+
+	report, err := a.VDR.Validate(*d, h, req.Document)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, *report)
+}
+
 // DeactivateDID deactivates a DID Document given a DID.
+// The private keys of the DID Document are retired if requested.
 // It returns a 200 and an empty body if the deactivation was successful.
-func (a *Wrapper) DeactivateDID(ctx echo.Context, targetDID string) error {
+func (a *Wrapper) DeactivateDID(ctx echo.Context, targetDID string, params DeactivateDIDParams) error {
 	id, err := did.ParseDID(targetDID)
 	if err != nil {
 		return err
 	}
-	err = a.DocManipulator.Deactivate(*id)
+	retireKeys := params.RetireKeys != nil && *params.RetireKeys
+	err = a.DocManipulator.Deactivate(*id, retireKeys)
 	if err != nil {
 		return err
 	}
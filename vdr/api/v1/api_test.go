@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/nuts-foundation/nuts-node/core"
+	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/crypto/hash"
 	"github.com/nuts-foundation/nuts-node/mock"
 	"github.com/nuts-foundation/nuts-node/vdr/types"
@@ -125,6 +126,60 @@ func TestWrapper_CreateDID(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, ctx.client.ResolveStatusCode(err))
 	})
 
+	t.Run("ok - key type", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		keyType := KeyTypeRSA
+		didCreateRequest := DIDCreateRequest{KeyType: &keyType}
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			p := f.(*DIDCreateRequest)
+			*p = didCreateRequest
+			return nil
+		})
+		ctx.echo.EXPECT().JSON(http.StatusOK, gomock.Any())
+		ctx.vdr.EXPECT().Create(gomock.Any()).DoAndReturn(func(options types.DIDCreationOptions) (*did.Document, crypto.Key, error) {
+			assert.Equal(t, crypto.RSAKey, options.KeyType)
+			assert.False(t, options.KeyAgreement, "keyAgreement should be disabled for RSA keys")
+			return didDoc, nil, nil
+		})
+		err := ctx.client.CreateDID(ctx.echo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - keyAgreement requested for Ed25519 key", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		keyType := KeyTypeEd25519
+		keyAgreement := true
+		didCreateRequest := DIDCreateRequest{KeyType: &keyType, KeyAgreement: &keyAgreement}
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			p := f.(*DIDCreateRequest)
+			*p = didCreateRequest
+			return nil
+		})
+		err := ctx.client.CreateDID(ctx.echo)
+
+		assert.EqualError(t, err, "keyAgreement can't be enabled for key type Ed25519, only ECP256 keys support key agreement")
+		assert.ErrorIs(t, err, core.InvalidInputError(""))
+	})
+
+	t.Run("error - unsupported key type", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		keyType := KeyType("DSA")
+		didCreateRequest := DIDCreateRequest{KeyType: &keyType}
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			p := f.(*DIDCreateRequest)
+			*p = didCreateRequest
+			return nil
+		})
+		err := ctx.client.CreateDID(ctx.echo)
+
+		assert.ErrorIs(t, err, crypto.ErrUnsupportedKeyType)
+		assert.Equal(t, http.StatusBadRequest, ctx.client.ResolveStatusCode(err))
+	})
+
 	t.Run("error - create fails", func(t *testing.T) {
 		ctx := newMockContext(t)
 
@@ -150,6 +205,113 @@ func TestWrapper_CreateDID(t *testing.T) {
 	})
 }
 
+func TestWrapper_ProvisionDIDs(t *testing.T) {
+	id, _ := did.ParseDID("did:nuts:1")
+	controllers := []string{"did:nuts:vendor"}
+	varFalse := false
+	services := []DIDProvisionService{{Type: "eOverdracht", ServiceEndpoint: "did:nuts:vendor/serviceEndpoint?type=eOverdracht"}}
+	request := DIDProvisionRequest{
+		Options: &DIDCreateRequest{Controllers: &controllers, SelfControl: &varFalse, CapabilityInvocation: &varFalse},
+		Items: []DIDProvisionItem{
+			{ExternalReference: "org-1", Services: &services},
+			{ExternalReference: "org-2"},
+		},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*DIDProvisionRequest) = request
+			return nil
+		})
+		ctx.vdr.EXPECT().Provision(gomock.Any(), gomock.Any()).DoAndReturn(func(options types.DIDCreationOptions, items []types.ProvisioningItem) ([]types.ProvisioningResult, error) {
+			assert.Equal(t, "did:nuts:vendor", options.Controllers[0].String())
+			assert.False(t, options.SelfControl)
+			assert.True(t, options.AssertionMethod)
+			if assert.Len(t, items, 2) {
+				assert.Equal(t, "org-1", items[0].ExternalReference)
+				assert.Equal(t, []did.Service{{Type: "eOverdracht", ServiceEndpoint: services[0].ServiceEndpoint}}, items[0].Services)
+				assert.Empty(t, items[1].Services)
+			}
+			return []types.ProvisioningResult{
+				{ExternalReference: "org-1", DID: id, Status: types.ProvisioningCreated},
+				{ExternalReference: "org-2", Status: types.ProvisioningFailed, Error: errors.New("b00m!")},
+			}, nil
+		})
+		var response []DIDProvisionResult
+		ctx.echo.EXPECT().JSON(http.StatusOK, gomock.Any()).DoAndReturn(func(_ int, body interface{}) error {
+			response = body.([]DIDProvisionResult)
+			return nil
+		})
+
+		err := ctx.client.ProvisionDIDs(ctx.echo)
+
+		if !assert.NoError(t, err) || !assert.Len(t, response, 2) {
+			return
+		}
+		assert.Equal(t, "org-1", response[0].ExternalReference)
+		assert.Equal(t, DIDProvisionResultStatusCreated, response[0].Status)
+		assert.Equal(t, id.String(), *response[0].Did)
+		assert.Nil(t, response[0].Error)
+		assert.Equal(t, DIDProvisionResultStatusFailed, response[1].Status)
+		assert.Nil(t, response[1].Did)
+		assert.Equal(t, "b00m!", *response[1].Error)
+	})
+
+	t.Run("ok - default options", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*DIDProvisionRequest) = DIDProvisionRequest{Items: []DIDProvisionItem{{ExternalReference: "org-1"}}}
+			return nil
+		})
+		ctx.vdr.EXPECT().Provision(gomock.Any(), gomock.Any()).DoAndReturn(func(options types.DIDCreationOptions, items []types.ProvisioningItem) ([]types.ProvisioningResult, error) {
+			assert.True(t, options.SelfControl)
+			return []types.ProvisioningResult{{ExternalReference: "org-1", DID: id, Status: types.ProvisioningExisting}}, nil
+		})
+		ctx.echo.EXPECT().JSON(http.StatusOK, gomock.Any())
+
+		err := ctx.client.ProvisionDIDs(ctx.echo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - invalid controller", func(t *testing.T) {
+		ctx := newMockContext(t)
+		invalidControllers := []string{"not a did"}
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*DIDProvisionRequest) = DIDProvisionRequest{Options: &DIDCreateRequest{Controllers: &invalidControllers}}
+			return nil
+		})
+
+		err := ctx.client.ProvisionDIDs(ctx.echo)
+
+		assert.ErrorIs(t, err, did.ErrInvalidDID)
+		assert.Equal(t, http.StatusBadRequest, ctx.client.ResolveStatusCode(err))
+	})
+
+	t.Run("error - provisioning fails", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*DIDProvisionRequest) = request
+			return nil
+		})
+		ctx.vdr.EXPECT().Provision(gomock.Any(), gomock.Any()).Return(nil, errors.New("b00m!"))
+
+		err := ctx.client.ProvisionDIDs(ctx.echo)
+
+		assert.EqualError(t, err, "b00m!")
+	})
+
+	t.Run("error - bind fails", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.echo.EXPECT().Bind(gomock.Any()).Return(errors.New("b00m!"))
+
+		err := ctx.client.ProvisionDIDs(ctx.echo)
+
+		assert.EqualError(t, err, "b00m!")
+	})
+}
+
 func TestWrapper_GetDID(t *testing.T) {
 	id, _ := did.ParseDID("did:nuts:1")
 	didDoc := &did.Document{
@@ -280,6 +442,115 @@ func TestWrapper_GetDID(t *testing.T) {
 	})
 }
 
+func TestWrapper_SearchDIDs(t *testing.T) {
+	id, _ := did.ParseDID("did:nuts:1")
+	didDoc := did.Document{ID: *id}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		serviceType := "NutsComm"
+		controller := "did:nuts:2"
+		ctx.docFinder.EXPECT().Find(gomock.Len(3)).Return([]did.Document{didDoc}, nil)
+		var result []did.Document
+		ctx.echo.EXPECT().JSON(http.StatusOK, gomock.Any()).DoAndReturn(func(f interface{}, f2 interface{}) error {
+			result = f2.([]did.Document)
+			return nil
+		})
+
+		err := ctx.client.SearchDIDs(ctx.echo, SearchDIDsParams{ServiceType: &serviceType, Controller: &controller})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []did.Document{didDoc}, result)
+	})
+
+	t.Run("ok - without parameters only filters on active documents", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.docFinder.EXPECT().Find(gomock.Len(1)).Return([]did.Document{}, nil)
+		ctx.echo.EXPECT().JSON(http.StatusOK, []did.Document{})
+
+		err := ctx.client.SearchDIDs(ctx.echo, SearchDIDsParams{})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - invalid controller", func(t *testing.T) {
+		ctx := newMockContext(t)
+		controller := "not a did"
+
+		err := ctx.client.SearchDIDs(ctx.echo, SearchDIDsParams{Controller: &controller})
+
+		assert.ErrorIs(t, err, core.Error(http.StatusBadRequest, ""))
+	})
+
+	t.Run("error - other", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.docFinder.EXPECT().Find(gomock.Any()).Return(nil, errors.New("b00m!"))
+
+		err := ctx.client.SearchDIDs(ctx.echo, SearchDIDsParams{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestWrapper_ValidateDID(t *testing.T) {
+	id, _ := did.ParseDID("did:nuts:1")
+	didDoc := did.Document{ID: *id}
+	currentHash := "452d9e89d5bd5d9225fb6daecd579e7388a166c7661ca04e47fd3cd8446e4620"
+	h, _ := hash.ParseHex(currentHash)
+	didUpdate := DIDUpdateRequest{Document: didDoc, CurrentHash: currentHash}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newMockContext(t)
+		report := types.DocumentValidationReport{Violations: []types.DocumentViolation{{Check: "service", Message: "invalid"}}}
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*DIDUpdateRequest) = didUpdate
+			return nil
+		})
+		ctx.vdr.EXPECT().Validate(*id, h, didDoc).Return(&report, nil)
+		ctx.echo.EXPECT().JSON(http.StatusOK, report)
+
+		err := ctx.client.ValidateDID(ctx.echo, id.String())
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - invalid DID", func(t *testing.T) {
+		ctx := newMockContext(t)
+
+		err := ctx.client.ValidateDID(ctx.echo, "not a did")
+
+		assert.ErrorIs(t, err, did.ErrInvalidDID)
+	})
+
+	t.Run("error - invalid hash", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*DIDUpdateRequest) = DIDUpdateRequest{Document: didDoc, CurrentHash: "invalid"}
+			return nil
+		})
+
+		err := ctx.client.ValidateDID(ctx.echo, id.String())
+
+		assert.ErrorIs(t, err, core.Error(http.StatusBadRequest, ""))
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.echo.EXPECT().Bind(gomock.Any()).DoAndReturn(func(f interface{}) error {
+			*f.(*DIDUpdateRequest) = didUpdate
+			return nil
+		})
+		ctx.vdr.EXPECT().Validate(*id, h, didDoc).Return(nil, types.ErrNotFound)
+
+		err := ctx.client.ValidateDID(ctx.echo, id.String())
+
+		assert.ErrorIs(t, err, types.ErrNotFound)
+		assert.Equal(t, http.StatusNotFound, ctx.client.ResolveStatusCode(err))
+	})
+}
+
 func TestWrapper_ConflictedDIDs(t *testing.T) {
 	id, _ := did.ParseDID("did:nuts:1")
 	didDoc := &did.Document{
@@ -451,17 +722,27 @@ func TestWrapper_DeactivateDID(t *testing.T) {
 	did123, _ := did.ParseDID("did:nuts:123")
 	t.Run("ok", func(t *testing.T) {
 		ctx := newMockContext(t)
-		ctx.docUpdater.EXPECT().Deactivate(*did123).Return(nil)
+		ctx.docUpdater.EXPECT().Deactivate(*did123, false).Return(nil)
 
 		ctx.echo.EXPECT().NoContent(http.StatusOK)
-		err := ctx.client.DeactivateDID(ctx.echo, did123.String())
+		err := ctx.client.DeactivateDID(ctx.echo, did123.String(), DeactivateDIDParams{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("ok - retire keys", func(t *testing.T) {
+		ctx := newMockContext(t)
+		retireKeys := true
+		ctx.docUpdater.EXPECT().Deactivate(*did123, true).Return(nil)
+
+		ctx.echo.EXPECT().NoContent(http.StatusOK)
+		err := ctx.client.DeactivateDID(ctx.echo, did123.String(), DeactivateDIDParams{RetireKeys: &retireKeys})
 		assert.NoError(t, err)
 	})
 
 	t.Run("error - invalid DID format", func(t *testing.T) {
 		ctx := newMockContext(t)
 
-		err := ctx.client.DeactivateDID(ctx.echo, "invalidFormattedDID")
+		err := ctx.client.DeactivateDID(ctx.echo, "invalidFormattedDID", DeactivateDIDParams{})
 
 		assert.ErrorIs(t, err, did.ErrInvalidDID)
 		assert.Equal(t, http.StatusBadRequest, ctx.client.ResolveStatusCode(err))
@@ -470,9 +751,9 @@ func TestWrapper_DeactivateDID(t *testing.T) {
 	t.Run("error - not found", func(t *testing.T) {
 		ctx := newMockContext(t)
 
-		ctx.docUpdater.EXPECT().Deactivate(*did123).Return(types.ErrNotFound)
+		ctx.docUpdater.EXPECT().Deactivate(*did123, false).Return(types.ErrNotFound)
 
-		err := ctx.client.DeactivateDID(ctx.echo, did123.String())
+		err := ctx.client.DeactivateDID(ctx.echo, did123.String(), DeactivateDIDParams{})
 
 		assert.ErrorIs(t, err, types.ErrNotFound)
 		assert.Equal(t, http.StatusNotFound, ctx.client.ResolveStatusCode(err))
@@ -480,9 +761,9 @@ func TestWrapper_DeactivateDID(t *testing.T) {
 
 	t.Run("error - document already deactivated", func(t *testing.T) {
 		ctx := newMockContext(t)
-		ctx.docUpdater.EXPECT().Deactivate(*did123).Return(types.ErrDeactivated)
+		ctx.docUpdater.EXPECT().Deactivate(*did123, false).Return(types.ErrDeactivated)
 
-		err := ctx.client.DeactivateDID(ctx.echo, did123.String())
+		err := ctx.client.DeactivateDID(ctx.echo, did123.String(), DeactivateDIDParams{})
 
 		assert.ErrorIs(t, err, types.ErrDeactivated)
 		assert.Equal(t, http.StatusConflict, ctx.client.ResolveStatusCode(err))
@@ -490,9 +771,9 @@ func TestWrapper_DeactivateDID(t *testing.T) {
 
 	t.Run("error - did not managed by this node", func(t *testing.T) {
 		ctx := newMockContext(t)
-		ctx.docUpdater.EXPECT().Deactivate(*did123).Return(types.ErrDIDNotManagedByThisNode)
+		ctx.docUpdater.EXPECT().Deactivate(*did123, false).Return(types.ErrDIDNotManagedByThisNode)
 
-		err := ctx.client.DeactivateDID(ctx.echo, did123.String())
+		err := ctx.client.DeactivateDID(ctx.echo, did123.String(), DeactivateDIDParams{})
 
 		assert.ErrorIs(t, err, types.ErrDIDNotManagedByThisNode)
 		assert.Equal(t, http.StatusForbidden, ctx.client.ResolveStatusCode(err))
@@ -507,22 +788,43 @@ func TestWrapper_AddNewVerificationMethod(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		ctx := newMockContext(t)
-		ctx.docUpdater.EXPECT().AddVerificationMethod(*did123).Return(newMethod, nil)
+		ctx.docUpdater.EXPECT().AddVerificationMethod(*did123, crypto.KeyType("")).Return(newMethod, nil)
 
 		var createdMethodResult did.VerificationMethod
 		ctx.echo.EXPECT().JSON(http.StatusOK, gomock.Any()).DoAndReturn(func(f interface{}, f2 interface{}) error {
 			createdMethodResult = f2.(did.VerificationMethod)
 			return nil
 		})
-		err := ctx.client.AddNewVerificationMethod(ctx.echo, did123.String())
+		err := ctx.client.AddNewVerificationMethod(ctx.echo, did123.String(), AddNewVerificationMethodParams{})
 		assert.NoError(t, err)
 		assert.Equal(t, *newMethod, createdMethodResult)
 	})
 
+	t.Run("ok - with key type", func(t *testing.T) {
+		ctx := newMockContext(t)
+		ctx.docUpdater.EXPECT().AddVerificationMethod(*did123, crypto.Ed25519Key).Return(newMethod, nil)
+		ctx.echo.EXPECT().JSON(http.StatusOK, gomock.Any())
+		keyType := KeyTypeEd25519
+
+		err := ctx.client.AddNewVerificationMethod(ctx.echo, did123.String(), AddNewVerificationMethodParams{KeyType: &keyType})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - unsupported key type", func(t *testing.T) {
+		ctx := newMockContext(t)
+		keyType := KeyType("DSA")
+
+		err := ctx.client.AddNewVerificationMethod(ctx.echo, did123.String(), AddNewVerificationMethodParams{KeyType: &keyType})
+
+		assert.ErrorIs(t, err, crypto.ErrUnsupportedKeyType)
+		assert.Equal(t, http.StatusBadRequest, ctx.client.ResolveStatusCode(err))
+	})
+
 	t.Run("error - invalid did", func(t *testing.T) {
 		ctx := newMockContext(t)
 
-		err := ctx.client.AddNewVerificationMethod(ctx.echo, "not a did")
+		err := ctx.client.AddNewVerificationMethod(ctx.echo, "not a did", AddNewVerificationMethodParams{})
 
 		assert.ErrorIs(t, err, did.ErrInvalidDID)
 		assert.Equal(t, http.StatusBadRequest, ctx.client.ResolveStatusCode(err))
@@ -530,9 +832,9 @@ func TestWrapper_AddNewVerificationMethod(t *testing.T) {
 
 	t.Run("error - internal error", func(t *testing.T) {
 		ctx := newMockContext(t)
-		ctx.docUpdater.EXPECT().AddVerificationMethod(*did123).Return(nil, errors.New("something went wrong"))
+		ctx.docUpdater.EXPECT().AddVerificationMethod(*did123, crypto.KeyType("")).Return(nil, errors.New("something went wrong"))
 
-		err := ctx.client.AddNewVerificationMethod(ctx.echo, did123.String())
+		err := ctx.client.AddNewVerificationMethod(ctx.echo, did123.String(), AddNewVerificationMethodParams{})
 
 		assert.EqualError(t, err, "something went wrong")
 	})
@@ -544,17 +846,27 @@ func TestWrapper_DeleteVerificationMethod(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		ctx := newMockContext(t)
-		ctx.docUpdater.EXPECT().RemoveVerificationMethod(*did123, *did123Method).Return(nil)
+		ctx.docUpdater.EXPECT().RemoveVerificationMethod(*did123, *did123Method, false).Return(nil)
+		ctx.echo.EXPECT().NoContent(http.StatusNoContent)
+
+		err := ctx.client.DeleteVerificationMethod(ctx.echo, did123.String(), did123Method.String(), DeleteVerificationMethodParams{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("ok - retire key", func(t *testing.T) {
+		ctx := newMockContext(t)
+		retireKey := true
+		ctx.docUpdater.EXPECT().RemoveVerificationMethod(*did123, *did123Method, true).Return(nil)
 		ctx.echo.EXPECT().NoContent(http.StatusNoContent)
 
-		err := ctx.client.DeleteVerificationMethod(ctx.echo, did123.String(), did123Method.String())
+		err := ctx.client.DeleteVerificationMethod(ctx.echo, did123.String(), did123Method.String(), DeleteVerificationMethodParams{RetireKey: &retireKey})
 		assert.NoError(t, err)
 	})
 
 	t.Run("error - invalid did", func(t *testing.T) {
 		ctx := newMockContext(t)
 
-		err := ctx.client.DeleteVerificationMethod(ctx.echo, "invalid did", did123Method.String())
+		err := ctx.client.DeleteVerificationMethod(ctx.echo, "invalid did", did123Method.String(), DeleteVerificationMethodParams{})
 
 		assert.ErrorIs(t, err, did.ErrInvalidDID)
 	})
@@ -562,16 +874,16 @@ func TestWrapper_DeleteVerificationMethod(t *testing.T) {
 	t.Run("error - invalid kid", func(t *testing.T) {
 		ctx := newMockContext(t)
 
-		err := ctx.client.DeleteVerificationMethod(ctx.echo, did123.String(), "invalid kid")
+		err := ctx.client.DeleteVerificationMethod(ctx.echo, did123.String(), "invalid kid", DeleteVerificationMethodParams{})
 
 		assert.EqualError(t, err, "given kid could not be parsed: invalid DID: input does not begin with 'did:' prefix")
 	})
 
 	t.Run("error - internal error", func(t *testing.T) {
 		ctx := newMockContext(t)
-		ctx.docUpdater.EXPECT().RemoveVerificationMethod(*did123, *did123Method).Return(errors.New("something went wrong"))
+		ctx.docUpdater.EXPECT().RemoveVerificationMethod(*did123, *did123Method, false).Return(errors.New("something went wrong"))
 
-		err := ctx.client.DeleteVerificationMethod(ctx.echo, did123.String(), did123Method.String())
+		err := ctx.client.DeleteVerificationMethod(ctx.echo, did123.String(), did123Method.String(), DeleteVerificationMethodParams{})
 
 		assert.EqualError(t, err, "could not remove verification method from document: something went wrong")
 	})
@@ -586,6 +898,7 @@ type mockContext struct {
 	echo        *mock.MockContext
 	vdr         *types.MockVDR
 	docResolver *types.MockDocResolver
+	docFinder   *types.MockDocFinder
 	docUpdater  *types.MockDocManipulator
 	client      *Wrapper
 }
@@ -596,7 +909,8 @@ func newMockContext(t *testing.T) mockContext {
 	vdr := types.NewMockVDR(ctrl)
 	docManipulator := types.NewMockDocManipulator(ctrl)
 	docResolver := types.NewMockDocResolver(ctrl)
-	client := &Wrapper{VDR: vdr, DocManipulator: docManipulator, DocResolver: docResolver}
+	docFinder := types.NewMockDocFinder(ctrl)
+	client := &Wrapper{VDR: vdr, DocManipulator: docManipulator, DocResolver: docResolver, DocFinder: docFinder}
 
 	t.Cleanup(func() {
 		ctrl.Finish()
@@ -607,6 +921,7 @@ func newMockContext(t *testing.T) mockContext {
 		vdr:         vdr,
 		client:      client,
 		docResolver: docResolver,
+		docFinder:   docFinder,
 		docUpdater:  docManipulator,
 	}
 }
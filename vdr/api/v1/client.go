@@ -121,12 +121,64 @@ func (hb HTTPClient) Update(DID string, current string, next did.Document) (*did
 	return readDIDDocument(response.Body)
 }
 
-// Deactivate a DID Document given a DID.
+// Validate validates an update of a DID Document given a DID and its current hash, without publishing it.
+func (hb HTTPClient) Validate(DID string, current string, next did.Document) (*DIDValidationReport, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	requestBody := ValidateDIDJSONRequestBody{
+		Document:    next,
+		CurrentHash: current,
+	}
+	response, err := hb.client().ValidateDID(ctx, DID, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.TestResponseCode(http.StatusOK, response); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read validation report response: %w", err)
+	}
+	report := DIDValidationReport{}
+	if err = json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal validation report response: %w", err)
+	}
+	return &report, nil
+}
+
+// Provision calls the server to create DID Documents in bulk and returns the result of every item.
+func (hb HTTPClient) Provision(request DIDProvisionRequest) ([]DIDProvisionResult, error) {
+	ctx, cancel := hb.withTimeout()
+	defer cancel()
+
+	response, err := hb.client().ProvisionDIDs(ctx, ProvisionDIDsJSONRequestBody(request))
+	if err != nil {
+		return nil, err
+	}
+	if err := core.TestResponseCode(http.StatusOK, response); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read provisioning response: %w", err)
+	}
+	var results []DIDProvisionResult
+	if err = json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal provisioning response: %w", err)
+	}
+	return results, nil
+}
+
+// Deactivate a DID Document given a DID. If retireKeys is true, the private keys of the DID Document are retired.
 // It expects a status 200 response from the server, returns an error otherwise.
-func (hb HTTPClient) Deactivate(DID string) error {
+func (hb HTTPClient) Deactivate(DID string, retireKeys bool) error {
 	ctx, cancel := hb.withTimeout()
 	defer cancel()
-	response, err := hb.client().DeactivateDID(ctx, DID)
+	response, err := hb.client().DeactivateDID(ctx, DID, &DeactivateDIDParams{RetireKeys: &retireKeys})
 	if err != nil {
 		return err
 	}
@@ -137,12 +189,18 @@ func (hb HTTPClient) Deactivate(DID string) error {
 }
 
 // AddNewVerificationMethod creates a new verificationMethod and adds it to the DID document
+// The keyType is optional, when empty the node's default key type is used.
 // It expects a status 200 response from the server, returns an error otherwise
-func (hb HTTPClient) AddNewVerificationMethod(DID string) (*did.VerificationMethod, error) {
+func (hb HTTPClient) AddNewVerificationMethod(DID string, keyType string) (*did.VerificationMethod, error) {
 	ctx, cancel := hb.withTimeout()
 	defer cancel()
 
-	response, err := hb.client().AddNewVerificationMethod(ctx, DID)
+	params := &AddNewVerificationMethodParams{}
+	if keyType != "" {
+		kt := KeyType(keyType)
+		params.KeyType = &kt
+	}
+	response, err := hb.client().AddNewVerificationMethod(ctx, DID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -154,12 +212,13 @@ func (hb HTTPClient) AddNewVerificationMethod(DID string) (*did.VerificationMeth
 }
 
 // DeleteVerificationMethod deletes a specified verificationMethod from the DID document
+// If retireKey is true, the private key of the verificationMethod is retired.
 // It expects a status 204 response from the server, returns an error otherwise
-func (hb HTTPClient) DeleteVerificationMethod(DID, kid string) error {
+func (hb HTTPClient) DeleteVerificationMethod(DID, kid string, retireKey bool) error {
 	ctx, cancel := hb.withTimeout()
 	defer cancel()
 
-	response, err := hb.client().DeleteVerificationMethod(ctx, DID, kid)
+	response, err := hb.client().DeleteVerificationMethod(ctx, DID, kid, &DeleteVerificationMethodParams{RetireKey: &retireKey})
 	if err != nil {
 		return err
 	}
@@ -180,12 +180,99 @@ func TestHTTPClient_Update(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestHTTPClient_Validate(t *testing.T) {
+	didDoc := did.Document{
+		ID: *vdr.TestDIDA,
+	}
+	hash := "0000000000000000000000000000000000000000"
+
+	t.Run("ok", func(t *testing.T) {
+		report := DIDValidationReport{Violations: []types.DocumentViolation{{Check: "service", Message: "invalid service"}}}
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: report})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		result, err := c.Validate(vdr.TestDIDA.String(), hash, didDoc)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, report, *result)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusNotFound, ResponseData: ""})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.Validate(vdr.TestDIDA.String(), hash, didDoc)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error - invalid response", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: "}"})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.Validate(vdr.TestDIDA.String(), hash, didDoc)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error - wrong address", func(t *testing.T) {
+		c := HTTPClient{ServerAddress: "not_an_address", Timeout: time.Second}
+		_, err := c.Validate(vdr.TestDIDA.String(), hash, didDoc)
+		assert.Error(t, err)
+	})
+}
+
+func TestHTTPClient_Provision(t *testing.T) {
+	request := DIDProvisionRequest{Items: []DIDProvisionItem{{ExternalReference: "org-1"}}}
+
+	t.Run("ok", func(t *testing.T) {
+		id := vdr.TestDIDA.String()
+		results := []DIDProvisionResult{{ExternalReference: "org-1", Did: &id, Status: DIDProvisionResultStatusCreated}}
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: results})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		actual, err := c.Provision(request)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, results, actual)
+	})
+
+	t.Run("error - bad request", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusBadRequest, ResponseData: ""})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.Provision(request)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error - invalid response", func(t *testing.T) {
+		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: "}"})
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.Provision(request)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error - wrong address", func(t *testing.T) {
+		c := HTTPClient{ServerAddress: "not_an_address", Timeout: time.Second}
+		_, err := c.Provision(request)
+		assert.Error(t, err)
+	})
+}
+
 func TestHTTPClient_Deactivate(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK})
 		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
-		err := c.Deactivate(vdr.TestDIDA.String())
+		err := c.Deactivate(vdr.TestDIDA.String(), false)
 		if !assert.NoError(t, err) {
 			return
 		}
@@ -193,7 +280,7 @@ func TestHTTPClient_Deactivate(t *testing.T) {
 
 	t.Run("error - server problems", func(t *testing.T) {
 		c := HTTPClient{ServerAddress: "not_an_address", Timeout: time.Second}
-		err := c.Deactivate(vdr.TestDIDA.String())
+		err := c.Deactivate(vdr.TestDIDA.String(), false)
 		assert.Error(t, err)
 	})
 }
@@ -207,7 +294,7 @@ func TestHTTPClient_AddNewVerificationMethod(t *testing.T) {
 		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusOK, ResponseData: string(methodJSON)})
 		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
 		// methodResponse, err := c.AddNewVerificationMethod(didString)
-		_, err := c.AddNewVerificationMethod(vdr.TestDIDA.String())
+		_, err := c.AddNewVerificationMethod(vdr.TestDIDA.String(), "")
 		if !assert.NoError(t, err) {
 			return
 		}
@@ -215,17 +302,31 @@ func TestHTTPClient_AddNewVerificationMethod(t *testing.T) {
 		//assert.Equal(t, method, methodResponse)
 	})
 
+	t.Run("ok - with key type", func(t *testing.T) {
+		var keyType string
+		s := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			keyType = request.URL.Query().Get("keyType")
+			http2.Handler{StatusCode: http.StatusOK, ResponseData: string(methodJSON)}.ServeHTTP(writer, request)
+		}))
+		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
+
+		_, err := c.AddNewVerificationMethod(vdr.TestDIDA.String(), "Ed25519")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Ed25519", keyType)
+	})
+
 	t.Run("error - a non 200 response", func(t *testing.T) {
 		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusForbidden})
 		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
-		_, err := c.AddNewVerificationMethod(vdr.TestDIDA.String())
+		_, err := c.AddNewVerificationMethod(vdr.TestDIDA.String(), "")
 		assert.Error(t, err)
 		assert.EqualError(t, err, "server returned HTTP 403 (expected: 200), response: null")
 	})
 
 	t.Run("error - server problems", func(t *testing.T) {
 		c := HTTPClient{ServerAddress: "not_an_address", Timeout: time.Second}
-		_, err := c.AddNewVerificationMethod(vdr.TestDIDA.String())
+		_, err := c.AddNewVerificationMethod(vdr.TestDIDA.String(), "")
 		assert.Error(t, err)
 	})
 }
@@ -234,7 +335,7 @@ func TestHTTPClient_DeleteVerificationMethod(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusNoContent})
 		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
-		err := c.DeleteVerificationMethod(vdr.TestDIDA.String(), vdr.TestMethodDIDA.String())
+		err := c.DeleteVerificationMethod(vdr.TestDIDA.String(), vdr.TestMethodDIDA.String(), false)
 		if !assert.NoError(t, err) {
 			return
 		}
@@ -243,14 +344,14 @@ func TestHTTPClient_DeleteVerificationMethod(t *testing.T) {
 	t.Run("error - a non 204 response", func(t *testing.T) {
 		s := httptest.NewServer(http2.Handler{StatusCode: http.StatusForbidden})
 		c := HTTPClient{ServerAddress: s.URL, Timeout: time.Second}
-		err := c.DeleteVerificationMethod(vdr.TestDIDA.String(), vdr.TestMethodDIDA.String())
+		err := c.DeleteVerificationMethod(vdr.TestDIDA.String(), vdr.TestMethodDIDA.String(), false)
 		assert.Error(t, err)
 		assert.EqualError(t, err, "server returned HTTP 403 (expected: 204), response: null")
 	})
 
 	t.Run("error - server problems", func(t *testing.T) {
 		c := HTTPClient{ServerAddress: "not_an_address", Timeout: time.Second}
-		err := c.DeleteVerificationMethod(vdr.TestDIDA.String(), vdr.TestMethodDIDA.String())
+		err := c.DeleteVerificationMethod(vdr.TestDIDA.String(), vdr.TestMethodDIDA.String(), false)
 		assert.Error(t, err)
 	})
 }
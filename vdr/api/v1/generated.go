@@ -18,6 +18,24 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// Defines values for DIDProvisionResultStatus.
+const (
+	DIDProvisionResultStatusCreated DIDProvisionResultStatus = "created"
+
+	DIDProvisionResultStatusExisting DIDProvisionResultStatus = "existing"
+
+	DIDProvisionResultStatusFailed DIDProvisionResultStatus = "failed"
+)
+
+// Defines values for KeyType.
+const (
+	KeyTypeECP256 KeyType = "ECP256"
+
+	KeyTypeEd25519 KeyType = "Ed25519"
+
+	KeyTypeRSA KeyType = "RSA"
+)
+
 // DIDCreateRequest defines model for DIDCreateRequest.
 type DIDCreateRequest struct {
 	// indicates if the generated key pair can be used for assertions.
@@ -42,10 +60,56 @@ type DIDCreateRequest struct {
 	// indicates if the generated key pair can be used for Key agreements.
 	KeyAgreement *bool `json:"keyAgreement,omitempty"`
 
+	// Type of the generated key pair. Defaults to ECP256 when not given.
+	// Ed25519 and RSA keys can't be used for key agreement, so keyAgreement must be false when using them.
+	KeyType *KeyType `json:"keyType,omitempty"`
+
 	// whether the generated DID Document can be altered with its own capabilityInvocation key.
 	SelfControl *bool `json:"selfControl,omitempty"`
 }
 
+// DIDProvisionItem defines model for DIDProvisionItem.
+type DIDProvisionItem struct {
+	// Identifies the subject of the DID Document in the requester's system. A DID Document is only created once for every external reference.
+	ExternalReference string `json:"externalReference"`
+
+	// Services to add to the DID Document. Their IDs are generated.
+	Services *[]DIDProvisionService `json:"services,omitempty"`
+}
+
+// DIDProvisionRequest defines model for DIDProvisionRequest.
+type DIDProvisionRequest struct {
+	Items   []DIDProvisionItem `json:"items"`
+	Options *DIDCreateRequest  `json:"options,omitempty"`
+}
+
+// DIDProvisionResult defines model for DIDProvisionResult.
+type DIDProvisionResult struct {
+	// The DID of the created or previously provisioned DID Document.
+	Did *string `json:"did,omitempty"`
+
+	// The reason the item failed.
+	Error *string `json:"error,omitempty"`
+
+	// The external reference of the item.
+	ExternalReference string `json:"externalReference"`
+
+	// Whether the DID Document was created, already existed for the external reference or failed.
+	Status DIDProvisionResultStatus `json:"status"`
+}
+
+// Whether the DID Document was created, already existed for the external reference or failed.
+type DIDProvisionResultStatus string
+
+// DIDProvisionService defines model for DIDProvisionService.
+type DIDProvisionService struct {
+	// Either a URI or a complex object, e.g. a compound service referring to services of the controller.
+	ServiceEndpoint interface{} `json:"serviceEndpoint"`
+
+	// The type of the service.
+	Type string `json:"type"`
+}
+
 // DIDResolutionResult defines model for DIDResolutionResult.
 type DIDResolutionResult struct {
 	// A DID document according to the W3C spec following the Nuts Method rules as defined in [Nuts RFC006]
@@ -64,9 +128,29 @@ type DIDUpdateRequest struct {
 	Document DIDDocument `json:"document"`
 }
 
+// Type of the generated key pair. Defaults to ECP256 when not given.
+// Ed25519 and RSA keys can't be used for key agreement, so keyAgreement must be false when using them.
+type KeyType string
+
+// SearchDIDsParams defines parameters for SearchDIDs.
+type SearchDIDsParams struct {
+	// Only return DID documents that contain a service of this type. Services that refer to another DID document's service also match.
+	ServiceType *string `json:"serviceType,omitempty"`
+
+	// Only return DID documents that are controlled by this DID.
+	Controller *string `json:"controller,omitempty"`
+}
+
 // CreateDIDJSONBody defines parameters for CreateDID.
 type CreateDIDJSONBody DIDCreateRequest
 
+// DeactivateDIDParams defines parameters for DeactivateDID.
+type DeactivateDIDParams struct {
+	// When true, the private keys of the DID document are retired: they can't be used for signing anymore
+	// and are purged from the key storage after the configured retention period.
+	RetireKeys *bool `json:"retireKeys,omitempty"`
+}
+
 // GetDIDParams defines parameters for GetDID.
 type GetDIDParams struct {
 	// If a versionId parameter is provided, the DID resolution algorithm returns a specific version of the DID document.
@@ -86,12 +170,37 @@ type GetDIDParams struct {
 // UpdateDIDJSONBody defines parameters for UpdateDID.
 type UpdateDIDJSONBody DIDUpdateRequest
 
+// ValidateDIDJSONBody defines parameters for ValidateDID.
+type ValidateDIDJSONBody DIDUpdateRequest
+
+// AddNewVerificationMethodParams defines parameters for AddNewVerificationMethod.
+type AddNewVerificationMethodParams struct {
+	// Type of the generated key pair. Defaults to ECP256 when not given.
+	KeyType *KeyType `json:"keyType,omitempty"`
+}
+
+// DeleteVerificationMethodParams defines parameters for DeleteVerificationMethod.
+type DeleteVerificationMethodParams struct {
+	// When true, the private key of the verification method is retired: it can't be used for signing anymore
+	// and is purged from the key storage after the configured retention period.
+	RetireKey *bool `json:"retireKey,omitempty"`
+}
+
+// ProvisionDIDsJSONBody defines parameters for ProvisionDIDs.
+type ProvisionDIDsJSONBody DIDProvisionRequest
+
 // CreateDIDJSONRequestBody defines body for CreateDID for application/json ContentType.
 type CreateDIDJSONRequestBody CreateDIDJSONBody
 
 // UpdateDIDJSONRequestBody defines body for UpdateDID for application/json ContentType.
 type UpdateDIDJSONRequestBody UpdateDIDJSONBody
 
+// ValidateDIDJSONRequestBody defines body for ValidateDID for application/json ContentType.
+type ValidateDIDJSONRequestBody ValidateDIDJSONBody
+
+// ProvisionDIDsJSONRequestBody defines body for ProvisionDIDs for application/json ContentType.
+type ProvisionDIDsJSONRequestBody ProvisionDIDsJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -165,6 +274,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// SearchDIDs request
+	SearchDIDs(ctx context.Context, params *SearchDIDsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateDID request with any body
 	CreateDIDWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -174,7 +286,7 @@ type ClientInterface interface {
 	ConflictedDIDs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeactivateDID request
-	DeactivateDID(ctx context.Context, did string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeactivateDID(ctx context.Context, did string, params *DeactivateDIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetDID request
 	GetDID(ctx context.Context, did string, params *GetDIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -184,11 +296,33 @@ type ClientInterface interface {
 
 	UpdateDID(ctx context.Context, did string, body UpdateDIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ValidateDID request with any body
+	ValidateDIDWithBody(ctx context.Context, did string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateDID(ctx context.Context, did string, body ValidateDIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AddNewVerificationMethod request
-	AddNewVerificationMethod(ctx context.Context, did string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	AddNewVerificationMethod(ctx context.Context, did string, params *AddNewVerificationMethodParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteVerificationMethod request
-	DeleteVerificationMethod(ctx context.Context, did string, kid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteVerificationMethod(ctx context.Context, did string, kid string, params *DeleteVerificationMethodParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProvisionDIDs request with any body
+	ProvisionDIDsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ProvisionDIDs(ctx context.Context, body ProvisionDIDsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) SearchDIDs(ctx context.Context, params *SearchDIDsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchDIDsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) CreateDIDWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -227,8 +361,8 @@ func (c *Client) ConflictedDIDs(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeactivateDID(ctx context.Context, did string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeactivateDIDRequest(c.Server, did)
+func (c *Client) DeactivateDID(ctx context.Context, did string, params *DeactivateDIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeactivateDIDRequest(c.Server, did, params)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +409,56 @@ func (c *Client) UpdateDID(ctx context.Context, did string, body UpdateDIDJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddNewVerificationMethod(ctx context.Context, did string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddNewVerificationMethodRequest(c.Server, did)
+func (c *Client) ValidateDIDWithBody(ctx context.Context, did string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateDIDRequestWithBody(c.Server, did, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateDID(ctx context.Context, did string, body ValidateDIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateDIDRequest(c.Server, did, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddNewVerificationMethod(ctx context.Context, did string, params *AddNewVerificationMethodParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNewVerificationMethodRequest(c.Server, did, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteVerificationMethod(ctx context.Context, did string, kid string, params *DeleteVerificationMethodParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVerificationMethodRequest(c.Server, did, kid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProvisionDIDsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProvisionDIDsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -287,8 +469,8 @@ func (c *Client) AddNewVerificationMethod(ctx context.Context, did string, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteVerificationMethod(ctx context.Context, did string, kid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteVerificationMethodRequest(c.Server, did, kid)
+func (c *Client) ProvisionDIDs(ctx context.Context, body ProvisionDIDsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProvisionDIDsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -299,6 +481,69 @@ func (c *Client) DeleteVerificationMethod(ctx context.Context, did string, kid s
 	return c.Client.Do(req)
 }
 
+// NewSearchDIDsRequest generates requests for SearchDIDs
+func NewSearchDIDsRequest(server string, params *SearchDIDsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vdr/v1/did")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.ServiceType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "serviceType", runtime.ParamLocationQuery, *params.ServiceType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Controller != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "controller", runtime.ParamLocationQuery, *params.Controller); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewCreateDIDRequest calls the generic CreateDID builder with application/json body
 func NewCreateDIDRequest(server string, body CreateDIDJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -367,7 +612,7 @@ func NewConflictedDIDsRequest(server string) (*http.Request, error) {
 }
 
 // NewDeactivateDIDRequest generates requests for DeactivateDID
-func NewDeactivateDIDRequest(server string, did string) (*http.Request, error) {
+func NewDeactivateDIDRequest(server string, did string, params *DeactivateDIDParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -392,6 +637,26 @@ func NewDeactivateDIDRequest(server string, did string) (*http.Request, error) {
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.RetireKeys != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "retireKeys", runtime.ParamLocationQuery, *params.RetireKeys); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -517,8 +782,55 @@ func NewUpdateDIDRequestWithBody(server string, did string, contentType string,
 	return req, nil
 }
 
+// NewValidateDIDRequest calls the generic ValidateDID builder with application/json body
+func NewValidateDIDRequest(server string, did string, body ValidateDIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateDIDRequestWithBody(server, did, "application/json", bodyReader)
+}
+
+// NewValidateDIDRequestWithBody generates requests for ValidateDID with any type of body
+func NewValidateDIDRequestWithBody(server string, did string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "did", runtime.ParamLocationPath, did)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vdr/v1/did/%s/validate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewAddNewVerificationMethodRequest generates requests for AddNewVerificationMethod
-func NewAddNewVerificationMethodRequest(server string, did string) (*http.Request, error) {
+func NewAddNewVerificationMethodRequest(server string, did string, params *AddNewVerificationMethodParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -543,6 +855,26 @@ func NewAddNewVerificationMethodRequest(server string, did string) (*http.Reques
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.KeyType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyType", runtime.ParamLocationQuery, *params.KeyType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -552,7 +884,7 @@ func NewAddNewVerificationMethodRequest(server string, did string) (*http.Reques
 }
 
 // NewDeleteVerificationMethodRequest generates requests for DeleteVerificationMethod
-func NewDeleteVerificationMethodRequest(server string, did string, kid string) (*http.Request, error) {
+func NewDeleteVerificationMethodRequest(server string, did string, kid string, params *DeleteVerificationMethodParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -584,6 +916,26 @@ func NewDeleteVerificationMethodRequest(server string, did string, kid string) (
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.RetireKey != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "retireKey", runtime.ParamLocationQuery, *params.RetireKey); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -592,6 +944,46 @@ func NewDeleteVerificationMethodRequest(server string, did string, kid string) (
 	return req, nil
 }
 
+// NewProvisionDIDsRequest calls the generic ProvisionDIDs builder with application/json body
+func NewProvisionDIDsRequest(server string, body ProvisionDIDsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProvisionDIDsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewProvisionDIDsRequestWithBody generates requests for ProvisionDIDs with any type of body
+func NewProvisionDIDsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/vdr/v1/provision")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -635,6 +1027,9 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// SearchDIDs request
+	SearchDIDsWithResponse(ctx context.Context, params *SearchDIDsParams, reqEditors ...RequestEditorFn) (*SearchDIDsResponse, error)
+
 	// CreateDID request with any body
 	CreateDIDWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDIDResponse, error)
 
@@ -644,7 +1039,7 @@ type ClientWithResponsesInterface interface {
 	ConflictedDIDsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ConflictedDIDsResponse, error)
 
 	// DeactivateDID request
-	DeactivateDIDWithResponse(ctx context.Context, did string, reqEditors ...RequestEditorFn) (*DeactivateDIDResponse, error)
+	DeactivateDIDWithResponse(ctx context.Context, did string, params *DeactivateDIDParams, reqEditors ...RequestEditorFn) (*DeactivateDIDResponse, error)
 
 	// GetDID request
 	GetDIDWithResponse(ctx context.Context, did string, params *GetDIDParams, reqEditors ...RequestEditorFn) (*GetDIDResponse, error)
@@ -654,11 +1049,43 @@ type ClientWithResponsesInterface interface {
 
 	UpdateDIDWithResponse(ctx context.Context, did string, body UpdateDIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDIDResponse, error)
 
+	// ValidateDID request with any body
+	ValidateDIDWithBodyWithResponse(ctx context.Context, did string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateDIDResponse, error)
+
+	ValidateDIDWithResponse(ctx context.Context, did string, body ValidateDIDJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateDIDResponse, error)
+
 	// AddNewVerificationMethod request
-	AddNewVerificationMethodWithResponse(ctx context.Context, did string, reqEditors ...RequestEditorFn) (*AddNewVerificationMethodResponse, error)
+	AddNewVerificationMethodWithResponse(ctx context.Context, did string, params *AddNewVerificationMethodParams, reqEditors ...RequestEditorFn) (*AddNewVerificationMethodResponse, error)
 
 	// DeleteVerificationMethod request
-	DeleteVerificationMethodWithResponse(ctx context.Context, did string, kid string, reqEditors ...RequestEditorFn) (*DeleteVerificationMethodResponse, error)
+	DeleteVerificationMethodWithResponse(ctx context.Context, did string, kid string, params *DeleteVerificationMethodParams, reqEditors ...RequestEditorFn) (*DeleteVerificationMethodResponse, error)
+
+	// ProvisionDIDs request with any body
+	ProvisionDIDsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProvisionDIDsResponse, error)
+
+	ProvisionDIDsWithResponse(ctx context.Context, body ProvisionDIDsJSONRequestBody, reqEditors ...RequestEditorFn) (*ProvisionDIDsResponse, error)
+}
+
+type SearchDIDsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DIDDocument
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchDIDsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchDIDsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type CreateDIDResponse struct {
@@ -768,6 +1195,28 @@ func (r UpdateDIDResponse) StatusCode() int {
 	return 0
 }
 
+type ValidateDIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DIDValidationReport
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateDIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateDIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type AddNewVerificationMethodResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -810,6 +1259,37 @@ func (r DeleteVerificationMethodResponse) StatusCode() int {
 	return 0
 }
 
+type ProvisionDIDsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DIDProvisionResult
+}
+
+// Status returns HTTPResponse.Status
+func (r ProvisionDIDsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProvisionDIDsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// SearchDIDsWithResponse request returning *SearchDIDsResponse
+func (c *ClientWithResponses) SearchDIDsWithResponse(ctx context.Context, params *SearchDIDsParams, reqEditors ...RequestEditorFn) (*SearchDIDsResponse, error) {
+	rsp, err := c.SearchDIDs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchDIDsResponse(rsp)
+}
+
 // CreateDIDWithBodyWithResponse request with arbitrary body returning *CreateDIDResponse
 func (c *ClientWithResponses) CreateDIDWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDIDResponse, error) {
 	rsp, err := c.CreateDIDWithBody(ctx, contentType, body, reqEditors...)
@@ -837,8 +1317,8 @@ func (c *ClientWithResponses) ConflictedDIDsWithResponse(ctx context.Context, re
 }
 
 // DeactivateDIDWithResponse request returning *DeactivateDIDResponse
-func (c *ClientWithResponses) DeactivateDIDWithResponse(ctx context.Context, did string, reqEditors ...RequestEditorFn) (*DeactivateDIDResponse, error) {
-	rsp, err := c.DeactivateDID(ctx, did, reqEditors...)
+func (c *ClientWithResponses) DeactivateDIDWithResponse(ctx context.Context, did string, params *DeactivateDIDParams, reqEditors ...RequestEditorFn) (*DeactivateDIDResponse, error) {
+	rsp, err := c.DeactivateDID(ctx, did, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -871,9 +1351,26 @@ func (c *ClientWithResponses) UpdateDIDWithResponse(ctx context.Context, did str
 	return ParseUpdateDIDResponse(rsp)
 }
 
+// ValidateDIDWithBodyWithResponse request with arbitrary body returning *ValidateDIDResponse
+func (c *ClientWithResponses) ValidateDIDWithBodyWithResponse(ctx context.Context, did string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateDIDResponse, error) {
+	rsp, err := c.ValidateDIDWithBody(ctx, did, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateDIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateDIDWithResponse(ctx context.Context, did string, body ValidateDIDJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateDIDResponse, error) {
+	rsp, err := c.ValidateDID(ctx, did, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateDIDResponse(rsp)
+}
+
 // AddNewVerificationMethodWithResponse request returning *AddNewVerificationMethodResponse
-func (c *ClientWithResponses) AddNewVerificationMethodWithResponse(ctx context.Context, did string, reqEditors ...RequestEditorFn) (*AddNewVerificationMethodResponse, error) {
-	rsp, err := c.AddNewVerificationMethod(ctx, did, reqEditors...)
+func (c *ClientWithResponses) AddNewVerificationMethodWithResponse(ctx context.Context, did string, params *AddNewVerificationMethodParams, reqEditors ...RequestEditorFn) (*AddNewVerificationMethodResponse, error) {
+	rsp, err := c.AddNewVerificationMethod(ctx, did, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -881,14 +1378,57 @@ func (c *ClientWithResponses) AddNewVerificationMethodWithResponse(ctx context.C
 }
 
 // DeleteVerificationMethodWithResponse request returning *DeleteVerificationMethodResponse
-func (c *ClientWithResponses) DeleteVerificationMethodWithResponse(ctx context.Context, did string, kid string, reqEditors ...RequestEditorFn) (*DeleteVerificationMethodResponse, error) {
-	rsp, err := c.DeleteVerificationMethod(ctx, did, kid, reqEditors...)
+func (c *ClientWithResponses) DeleteVerificationMethodWithResponse(ctx context.Context, did string, kid string, params *DeleteVerificationMethodParams, reqEditors ...RequestEditorFn) (*DeleteVerificationMethodResponse, error) {
+	rsp, err := c.DeleteVerificationMethod(ctx, did, kid, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseDeleteVerificationMethodResponse(rsp)
 }
 
+// ProvisionDIDsWithBodyWithResponse request with arbitrary body returning *ProvisionDIDsResponse
+func (c *ClientWithResponses) ProvisionDIDsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProvisionDIDsResponse, error) {
+	rsp, err := c.ProvisionDIDsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProvisionDIDsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ProvisionDIDsWithResponse(ctx context.Context, body ProvisionDIDsJSONRequestBody, reqEditors ...RequestEditorFn) (*ProvisionDIDsResponse, error) {
+	rsp, err := c.ProvisionDIDs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProvisionDIDsResponse(rsp)
+}
+
+// ParseSearchDIDsResponse parses an HTTP response from a SearchDIDsWithResponse call
+func ParseSearchDIDsResponse(rsp *http.Response) (*SearchDIDsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchDIDsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DIDDocument
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseCreateDIDResponse parses an HTTP response from a CreateDIDWithResponse call
 func ParseCreateDIDResponse(rsp *http.Response) (*CreateDIDResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -989,6 +1529,32 @@ func ParseUpdateDIDResponse(rsp *http.Response) (*UpdateDIDResponse, error) {
 	return response, nil
 }
 
+// ParseValidateDIDResponse parses an HTTP response from a ValidateDIDWithResponse call
+func ParseValidateDIDResponse(rsp *http.Response) (*ValidateDIDResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateDIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DIDValidationReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseAddNewVerificationMethodResponse parses an HTTP response from a AddNewVerificationMethodWithResponse call
 func ParseAddNewVerificationMethodResponse(rsp *http.Response) (*AddNewVerificationMethodResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -1021,8 +1587,37 @@ func ParseDeleteVerificationMethodResponse(rsp *http.Response) (*DeleteVerificat
 	return response, nil
 }
 
+// ParseProvisionDIDsResponse parses an HTTP response from a ProvisionDIDsWithResponse call
+func ParseProvisionDIDsResponse(rsp *http.Response) (*ProvisionDIDsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProvisionDIDsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DIDProvisionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Searches for DID documents
+	// (GET /internal/vdr/v1/did)
+	SearchDIDs(ctx echo.Context, params SearchDIDsParams) error
 	// Creates a new Nuts DID
 	// (POST /internal/vdr/v1/did)
 	CreateDID(ctx echo.Context) error
@@ -1031,19 +1626,25 @@ type ServerInterface interface {
 	ConflictedDIDs(ctx echo.Context) error
 	// Deactivates a Nuts DID document according to the specification.
 	// (DELETE /internal/vdr/v1/did/{did})
-	DeactivateDID(ctx echo.Context, did string) error
+	DeactivateDID(ctx echo.Context, did string, params DeactivateDIDParams) error
 	// Resolves a Nuts DID document
 	// (GET /internal/vdr/v1/did/{did})
 	GetDID(ctx echo.Context, did string, params GetDIDParams) error
 	// Updates a Nuts DID document.
 	// (PUT /internal/vdr/v1/did/{did})
 	UpdateDID(ctx echo.Context, did string) error
+	// Validates an update of a Nuts DID document without publishing it.
+	// (POST /internal/vdr/v1/did/{did}/validate)
+	ValidateDID(ctx echo.Context, did string) error
 	// Creates and adds a new verificationMethod to the DID document.
 	// (POST /internal/vdr/v1/did/{did}/verificationmethod)
-	AddNewVerificationMethod(ctx echo.Context, did string) error
+	AddNewVerificationMethod(ctx echo.Context, did string, params AddNewVerificationMethodParams) error
 	// Delete a specific verification method
 	// (DELETE /internal/vdr/v1/did/{did}/verificationmethod/{kid})
-	DeleteVerificationMethod(ctx echo.Context, did string, kid string) error
+	DeleteVerificationMethod(ctx echo.Context, did string, kid string, params DeleteVerificationMethodParams) error
+	// Creates Nuts DIDs in bulk
+	// (POST /internal/vdr/v1/provision)
+	ProvisionDIDs(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -1051,6 +1652,31 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// SearchDIDs converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchDIDs(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchDIDsParams
+	// ------------- Optional query parameter "serviceType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "serviceType", ctx.QueryParams(), &params.ServiceType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter serviceType: %s", err))
+	}
+
+	// ------------- Optional query parameter "controller" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "controller", ctx.QueryParams(), &params.Controller)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter controller: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchDIDs(ctx, params)
+	return err
+}
+
 // CreateDID converts echo context to params.
 func (w *ServerInterfaceWrapper) CreateDID(ctx echo.Context) error {
 	var err error
@@ -1080,8 +1706,17 @@ func (w *ServerInterfaceWrapper) DeactivateDID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter did: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeactivateDIDParams
+	// ------------- Optional query parameter "retireKeys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "retireKeys", ctx.QueryParams(), &params.RetireKeys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retireKeys: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.DeactivateDID(ctx, did)
+	err = w.Handler.DeactivateDID(ctx, did, params)
 	return err
 }
 
@@ -1133,6 +1768,22 @@ func (w *ServerInterfaceWrapper) UpdateDID(ctx echo.Context) error {
 	return err
 }
 
+// ValidateDID converts echo context to params.
+func (w *ServerInterfaceWrapper) ValidateDID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "did" -------------
+	var did string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "did", runtime.ParamLocationPath, ctx.Param("did"), &did)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter did: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ValidateDID(ctx, did)
+	return err
+}
+
 // AddNewVerificationMethod converts echo context to params.
 func (w *ServerInterfaceWrapper) AddNewVerificationMethod(ctx echo.Context) error {
 	var err error
@@ -1144,8 +1795,17 @@ func (w *ServerInterfaceWrapper) AddNewVerificationMethod(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter did: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddNewVerificationMethodParams
+	// ------------- Optional query parameter "keyType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keyType", ctx.QueryParams(), &params.KeyType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyType: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.AddNewVerificationMethod(ctx, did)
+	err = w.Handler.AddNewVerificationMethod(ctx, did, params)
 	return err
 }
 
@@ -1168,8 +1828,26 @@ func (w *ServerInterfaceWrapper) DeleteVerificationMethod(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kid: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteVerificationMethodParams
+	// ------------- Optional query parameter "retireKey" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "retireKey", ctx.QueryParams(), &params.RetireKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retireKey: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.DeleteVerificationMethod(ctx, did, kid)
+	err = w.Handler.DeleteVerificationMethod(ctx, did, kid, params)
+	return err
+}
+
+// ProvisionDIDs converts echo context to params.
+func (w *ServerInterfaceWrapper) ProvisionDIDs(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ProvisionDIDs(ctx)
 	return err
 }
 
@@ -1205,6 +1883,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	// PATCH: This alteration wraps the call to the implementation in a function that sets the "OperationId" context parameter,
 	// so it can be used in error reporting middleware.
+	router.Add(http.MethodGet, baseURL+"/internal/vdr/v1/did", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("SearchDIDs", context)
+		return wrapper.SearchDIDs(context)
+	})
 	router.Add(http.MethodPost, baseURL+"/internal/vdr/v1/did", func(context echo.Context) error {
 		si.(Preprocessor).Preprocess("CreateDID", context)
 		return wrapper.CreateDID(context)
@@ -1225,6 +1907,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		si.(Preprocessor).Preprocess("UpdateDID", context)
 		return wrapper.UpdateDID(context)
 	})
+	router.Add(http.MethodPost, baseURL+"/internal/vdr/v1/did/:did/validate", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ValidateDID", context)
+		return wrapper.ValidateDID(context)
+	})
 	router.Add(http.MethodPost, baseURL+"/internal/vdr/v1/did/:did/verificationmethod", func(context echo.Context) error {
 		si.(Preprocessor).Preprocess("AddNewVerificationMethod", context)
 		return wrapper.AddNewVerificationMethod(context)
@@ -1233,5 +1919,9 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		si.(Preprocessor).Preprocess("DeleteVerificationMethod", context)
 		return wrapper.DeleteVerificationMethod(context)
 	})
+	router.Add(http.MethodPost, baseURL+"/internal/vdr/v1/provision", func(context echo.Context) error {
+		si.(Preprocessor).Preprocess("ProvisionDIDs", context)
+		return wrapper.ProvisionDIDs(context)
+	})
 
 }
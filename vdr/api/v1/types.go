@@ -25,3 +25,12 @@ type DIDDocument = did.Document
 
 // DIDDocumentMetadata is an alias
 type DIDDocumentMetadata = types.DocumentMetadata
+
+// DIDValidationReport is an alias
+type DIDValidationReport = types.DocumentValidationReport
+
+// DIDDocumentViolation is an alias
+type DIDDocumentViolation = types.DocumentViolation
+
+// DIDDocumentDiff is an alias
+type DIDDocumentDiff = types.DocumentDiff
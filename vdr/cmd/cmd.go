@@ -49,6 +49,7 @@ func Cmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(createCmd())
+	cmd.AddCommand(bulkCreateCmd())
 	cmd.AddCommand(resolveCmd())
 	cmd.AddCommand(conflictedCmd())
 	cmd.AddCommand(updateCmd())
@@ -60,24 +61,52 @@ func Cmd() *cobra.Command {
 	return cmd
 }
 
-func createCmd() *cobra.Command {
+const keyTypeFlagUsage = "Type of the generated key pair: 'ECP256' (default), 'Ed25519' or 'RSA'. Ed25519 and RSA keys can't be used for keyAgreement."
+
+// newCreateRequest returns a DIDCreateRequest that can be bound to the flags registered by addCreateFlags.
+func newCreateRequest() *api.DIDCreateRequest {
 	// needs to be initialized for pflags, values will be overwritten with defaults from pflag
-	var createRequest = api.DIDCreateRequest{
+	return &api.DIDCreateRequest{
 		AssertionMethod:      new(bool),
 		Authentication:       new(bool),
 		CapabilityDelegation: new(bool),
 		CapabilityInvocation: new(bool),
 		Controllers:          new([]string),
 		KeyAgreement:         new(bool),
+		KeyType:              new(api.KeyType),
 		SelfControl:          new(bool),
 	}
+}
+
+// addCreateFlags registers the flags for the DID creation options on the given flag set, bound to the given request.
+func addCreateFlags(flagSet *pflag.FlagSet, createRequest *api.DIDCreateRequest) {
+	flagSet.BoolVar(createRequest.AssertionMethod, "assertionMethod", true, "Pass 'false' to disable assertionMethod capabilities.")
+	flagSet.BoolVar(createRequest.Authentication, "authentication", false, "Pass 'true' to enable authentication capabilities.")
+	flagSet.BoolVar(createRequest.CapabilityDelegation, "capabilityDelegation", false, "Pass 'true' to enable capabilityDelegation capabilities.")
+	flagSet.BoolVar(createRequest.CapabilityInvocation, "capabilityInvocation", true, "Pass 'false' to disable capabilityInvocation capabilities.")
+	flagSet.BoolVar(createRequest.KeyAgreement, "keyAgreement", false, "Pass 'true' to enable keyAgreement capabilities.")
+	flagSet.BoolVar(createRequest.SelfControl, "selfControl", true, "Pass 'false' to disable DID Document control.")
+	flagSet.StringSliceVar(createRequest.Controllers, "controllers", []string{}, "Comma-separated list of DIDs that can control the generated DID Document.")
+	flagSet.StringVar((*string)(createRequest.KeyType), "keyType", "", keyTypeFlagUsage)
+}
+
+// withoutEmptyKeyType returns the request without key type if none was given, so the server applies its default.
+func withoutEmptyKeyType(createRequest api.DIDCreateRequest) api.DIDCreateRequest {
+	if createRequest.KeyType != nil && *createRequest.KeyType == "" {
+		createRequest.KeyType = nil
+	}
+	return createRequest
+}
+
+func createCmd() *cobra.Command {
+	createRequest := newCreateRequest()
 
 	result := &cobra.Command{
 		Use:   "create-did",
 		Short: "Registers a new DID",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			doc, err := httpClient(core.NewClientConfig(cmd.Flags())).Create(createRequest)
+			doc, err := httpClient(core.NewClientConfig(cmd.Flags())).Create(withoutEmptyKeyType(*createRequest))
 			if err != nil {
 				return fmt.Errorf("unable to create new DID: %v", err)
 			}
@@ -86,20 +115,71 @@ func createCmd() *cobra.Command {
 			return nil
 		},
 	}
+	addCreateFlags(result.Flags(), createRequest)
+
+	return result
+}
+
+func bulkCreateCmd() *cobra.Command {
+	createRequest := newCreateRequest()
+
+	result := &cobra.Command{
+		Use:   "bulk-create [file]",
+		Short: "Registers a new DID for every item in the given file",
+		Long: "Registers a new DID for every item in the given file, using the same creation options for all of them. " +
+			"The file must contain a JSON array of items with an 'externalReference' and optional 'services' (with 'type' and 'serviceEndpoint'). " +
+			"If no file is given, a pipe is assumed. A DID is only created once for every external reference, " +
+			"so a (partially) failed batch can be safely resubmitted. It outputs the result of every item. " +
+			"Large batches may take a while, consider increasing the timeout.",
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var bytes []byte
+			var err error
+			if len(args) == 1 {
+				bytes, err = os.ReadFile(args[0])
+				if err != nil {
+					return fmt.Errorf("failed to read file %s: %w", args[0], err)
+				}
+			} else {
+				bytes, err = readFromStdin()
+				if err != nil {
+					return fmt.Errorf("failed to read from pipe: %w", err)
+				}
+			}
+
+			var items []api.DIDProvisionItem
+			if err = json.Unmarshal(bytes, &items); err != nil {
+				return fmt.Errorf("failed to parse items: %w", err)
+			}
+
+			options := withoutEmptyKeyType(*createRequest)
+			results, err := httpClient(core.NewClientConfig(cmd.Flags())).Provision(api.DIDProvisionRequest{Options: &options, Items: items})
+			if err != nil {
+				return fmt.Errorf("unable to create DIDs: %w", err)
+			}
+			bytes, _ = json.MarshalIndent(results, "", "  ")
+			cmd.Printf("%s\n", string(bytes))
 
-	result.Flags().BoolVar(createRequest.AssertionMethod, "assertionMethod", true, "Pass 'false' to disable assertionMethod capabilities.")
-	result.Flags().BoolVar(createRequest.Authentication, "authentication", false, "Pass 'true' to enable authentication capabilities.")
-	result.Flags().BoolVar(createRequest.CapabilityDelegation, "capabilityDelegation", false, "Pass 'true' to enable capabilityDelegation capabilities.")
-	result.Flags().BoolVar(createRequest.CapabilityInvocation, "capabilityInvocation", true, "Pass 'false' to disable capabilityInvocation capabilities.")
-	result.Flags().BoolVar(createRequest.KeyAgreement, "keyAgreement", false, "Pass 'true' to enable keyAgreement capabilities.")
-	result.Flags().BoolVar(createRequest.SelfControl, "selfControl", true, "Pass 'false' to disable DID Document control.")
-	result.Flags().StringSliceVar(createRequest.Controllers, "controllers", []string{}, "Comma-separated list of DIDs that can control the generated DID Document.")
+			failed := 0
+			for _, curr := range results {
+				if curr.Status == api.DIDProvisionResultStatusFailed {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d DIDs could not be created", failed, len(results))
+			}
+			return nil
+		},
+	}
+	addCreateFlags(result.Flags(), createRequest)
 
 	return result
 }
 
 func updateCmd() *cobra.Command {
-	return &cobra.Command{
+	var dryRun bool
+	result := &cobra.Command{
 		Use: "update [DID] [hash] [file]",
 		Short: "Update a DID with the given DID document, this replaces the DID document. " +
 			"If no file is given, a pipe is assumed. The hash is needed to prevent concurrent updates.",
@@ -130,6 +210,20 @@ func updateCmd() *cobra.Command {
 				return fmt.Errorf("failed to parse DID document: %w", err)
 			}
 
+			if dryRun {
+				report, err := httpClient(core.NewClientConfig(cmd.Flags())).Validate(id, hash, didDoc)
+				if err != nil {
+					return fmt.Errorf("failed to validate DID document: %w", err)
+				}
+				bytes, _ := json.MarshalIndent(report, "", "  ")
+				cmd.Printf("%s\n", string(bytes))
+				if !report.Valid() {
+					return errors.New("DID document is invalid")
+				}
+				cmd.Println("DID document is valid (dry run, not updated)")
+				return nil
+			}
+
 			if _, err = httpClient(core.NewClientConfig(cmd.Flags())).Update(id, hash, didDoc); err != nil {
 				return fmt.Errorf("failed to update DID document: %w", err)
 			}
@@ -138,6 +232,8 @@ func updateCmd() *cobra.Command {
 			return nil
 		},
 	}
+	result.Flags().BoolVar(&dryRun, "dry-run", false, "Pass 'true' to only validate the DID document and show the changes, without updating it.")
+	return result
 }
 
 func resolveCmd() *cobra.Command {
@@ -215,6 +311,7 @@ func conflictedCmd() *cobra.Command {
 }
 
 func deactivateCmd() *cobra.Command {
+	var retireKeys bool
 	result := &cobra.Command{
 		Use:   "deactivate [DID]",
 		Short: "Deactivate a DID document based on its DID",
@@ -224,7 +321,7 @@ func deactivateCmd() *cobra.Command {
 				cmd.Println("Deactivation cancelled")
 				return nil
 			}
-			err := httpClient(core.NewClientConfig(cmd.Flags())).Deactivate(args[0])
+			err := httpClient(core.NewClientConfig(cmd.Flags())).Deactivate(args[0], retireKeys)
 			if err != nil {
 				return fmt.Errorf("failed to deactivate DID document: %v", err)
 			}
@@ -233,16 +330,18 @@ func deactivateCmd() *cobra.Command {
 			return nil
 		},
 	}
+	result.Flags().BoolVar(&retireKeys, "retireKeys", false, "Pass 'true' to retire the private keys of the DID document, so they can't be used for signing anymore and are purged after the retention period.")
 	return result
 }
 
 func addVerificationMethodCmd() *cobra.Command {
+	var keyType string
 	result := &cobra.Command{
 		Use:   "addvm [DID]",
 		Short: "Add a verification method key to the DID document.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			verificationMethod, err := httpClient(core.NewClientConfig(cmd.Flags())).AddNewVerificationMethod(args[0])
+			verificationMethod, err := httpClient(core.NewClientConfig(cmd.Flags())).AddNewVerificationMethod(args[0], keyType)
 			if err != nil {
 				return fmt.Errorf("failed to add a new verification method to DID document: %s", err.Error())
 			}
@@ -251,6 +350,7 @@ func addVerificationMethodCmd() *cobra.Command {
 			return nil
 		},
 	}
+	result.Flags().StringVar(&keyType, "keyType", "", keyTypeFlagUsage)
 
 	return result
 }
@@ -311,12 +411,13 @@ func addKeyAgreementKeyCmd() *cobra.Command {
 }
 
 func deleteVerificationMethodCmd() *cobra.Command {
+	var retireKey bool
 	result := &cobra.Command{
 		Use:   "delvm [DID] [kid]",
 		Short: "Deletes a verification method from the DID document.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := httpClient(core.NewClientConfig(cmd.Flags())).DeleteVerificationMethod(args[0], args[1])
+			err := httpClient(core.NewClientConfig(cmd.Flags())).DeleteVerificationMethod(args[0], args[1], retireKey)
 			if err != nil {
 				return fmt.Errorf("failed to delete the verification method from DID document: %s", err.Error())
 			}
@@ -324,6 +425,7 @@ func deleteVerificationMethodCmd() *cobra.Command {
 			return nil
 		},
 	}
+	result.Flags().BoolVar(&retireKey, "retireKey", false, "Pass 'true' to retire the private key of the verification method, so it can't be used for signing anymore and is purged after the retention period.")
 
 	return result
 }
@@ -27,6 +27,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path"
 	"testing"
 
 	ssi "github.com/nuts-foundation/go-did"
@@ -37,6 +38,7 @@ import (
 
 	"github.com/nuts-foundation/nuts-node/core"
 	http2 "github.com/nuts-foundation/nuts-node/test/http"
+	"github.com/nuts-foundation/nuts-node/test/io"
 	"github.com/nuts-foundation/nuts-node/vdr"
 	v1 "github.com/nuts-foundation/nuts-node/vdr/api/v1"
 )
@@ -105,6 +107,16 @@ func TestEngine_Command(t *testing.T) {
 			assert.NoError(t, err)
 		})
 
+		t.Run("ok - with key type", func(t *testing.T) {
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusOK, ResponseData: exampleDIDDocument})
+			cmd.SetArgs([]string{"create-did", "--keyType", "Ed25519", "--keyAgreement=false"})
+
+			err := cmd.Execute()
+
+			assert.NoError(t, err)
+			assert.Contains(t, buf.String(), "did:nuts:")
+		})
+
 		t.Run("error - server error", func(t *testing.T) {
 			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusInternalServerError, ResponseData: "b00m!"})
 			cmd.SetArgs([]string{"create-did"})
@@ -118,6 +130,62 @@ func TestEngine_Command(t *testing.T) {
 		})
 	})
 
+	t.Run("bulk-create", func(t *testing.T) {
+		itemsFile := path.Join(io.TestDirectory(t), "items.json")
+		_ = os.WriteFile(itemsFile, []byte(`[{"externalReference": "org-1", "services": [{"type": "eOverdracht", "serviceEndpoint": "did:nuts:vendor/serviceEndpoint?type=eOverdracht"}]}]`), 0600)
+		id := exampleID.String()
+
+		t.Run("ok - write to stdout", func(t *testing.T) {
+			results := []v1.DIDProvisionResult{{ExternalReference: "org-1", Did: &id, Status: v1.DIDProvisionResultStatusCreated}}
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusOK, ResponseData: results})
+			cmd.SetArgs([]string{"bulk-create", itemsFile, "--controllers", "did:nuts:vendor", "--selfControl=false", "--capabilityInvocation=false"})
+
+			err := cmd.Execute()
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			var actual []v1.DIDProvisionResult
+			_ = json.Unmarshal(buf.Bytes(), &actual)
+			assert.Equal(t, results, actual)
+		})
+
+		t.Run("error - some items failed", func(t *testing.T) {
+			failure := "b00m!"
+			results := []v1.DIDProvisionResult{
+				{ExternalReference: "org-1", Did: &id, Status: v1.DIDProvisionResultStatusExisting},
+				{ExternalReference: "org-2", Status: v1.DIDProvisionResultStatusFailed, Error: &failure},
+			}
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusOK, ResponseData: results})
+			cmd.SetArgs([]string{"bulk-create", itemsFile})
+
+			err := cmd.Execute()
+
+			assert.EqualError(t, err, "1 of 2 DIDs could not be created")
+			assert.Contains(t, buf.String(), "b00m!")
+		})
+
+		t.Run("error - incorrect input", func(t *testing.T) {
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusOK})
+			cmd.SetArgs([]string{"bulk-create", "../test/syntax_error.json"})
+
+			err := cmd.Execute()
+
+			assert.Error(t, err)
+			assert.Contains(t, errBuf.String(), "failed to parse items")
+		})
+
+		t.Run("error - server error", func(t *testing.T) {
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusBadRequest, ResponseData: "invalid"})
+			cmd.SetArgs([]string{"bulk-create", itemsFile})
+
+			err := cmd.Execute()
+
+			assert.Error(t, err)
+			assert.Contains(t, errBuf.String(), "unable to create DIDs")
+		})
+	})
+
 	t.Run("resolve", func(t *testing.T) {
 		t.Run("ok - write to stdout", func(t *testing.T) {
 			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusOK, ResponseData: exampleDIDRsolution})
@@ -255,6 +323,45 @@ func TestEngine_Command(t *testing.T) {
 			assert.Contains(t, errBuf.String(), "failed to update DID document")
 			assert.Contains(t, errBuf.String(), "invalid")
 		})
+
+		t.Run("ok - dry run", func(t *testing.T) {
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusOK, ResponseData: v1.DIDValidationReport{}})
+			cmd.SetArgs([]string{"update", "did", "hash", "../test/diddocument.json", "--dry-run"})
+
+			err := cmd.Execute()
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Contains(t, buf.String(), "DID document is valid")
+			assert.NotContains(t, buf.String(), "DID document updated")
+		})
+
+		t.Run("error - dry run with violations", func(t *testing.T) {
+			report := v1.DIDValidationReport{Violations: []v1.DIDDocumentViolation{{Check: "service", Message: "invalid service"}}}
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusOK, ResponseData: report})
+			cmd.SetArgs([]string{"update", "did", "hash", "../test/diddocument.json", "--dry-run"})
+
+			err := cmd.Execute()
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Contains(t, buf.String(), "invalid service")
+			assert.Contains(t, errBuf.String(), "DID document is invalid")
+		})
+
+		t.Run("error - dry run server error", func(t *testing.T) {
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusNotFound, ResponseData: "not found"})
+			cmd.SetArgs([]string{"update", "did", "hash", "../test/diddocument.json", "--dry-run"})
+
+			err := cmd.Execute()
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Contains(t, errBuf.String(), "failed to validate DID document")
+		})
 	})
 
 	t.Run("deactivate", func(t *testing.T) {
@@ -272,6 +379,18 @@ func TestEngine_Command(t *testing.T) {
 			assert.Contains(t, buf.String(), "DID document deactivated\n")
 			assert.Empty(t, errBuf.Bytes())
 		})
+		t.Run("ok - retire keys", func(t *testing.T) {
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusOK})
+
+			inBuf.Write([]byte{'y', '\n'})
+			cmd.SetArgs([]string{"deactivate", "did", "--retireKeys"})
+			err := cmd.Execute()
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Contains(t, buf.String(), "DID document deactivated\n")
+		})
 		t.Run("ok - stops when the user does not confirm", func(t *testing.T) {
 			cmd := newCmd(t)
 
@@ -323,6 +442,16 @@ func TestEngine_Command(t *testing.T) {
 
 		})
 
+		t.Run("ok - with key type", func(t *testing.T) {
+			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusOK, ResponseData: verificationMethod})
+			cmd.SetArgs([]string{"addvm", vdr.TestDIDA.String(), "--keyType", "RSA"})
+
+			err := cmd.Execute()
+
+			assert.NoError(t, err)
+			assert.Contains(t, buf.String(), vdr.TestMethodDIDA.String())
+		})
+
 		t.Run("error - DID document not found", func(t *testing.T) {
 			cmd := newCmdWithServer(t, http2.Handler{StatusCode: http.StatusNotFound})
 
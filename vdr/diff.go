@@ -0,0 +1,94 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vdr
+
+import (
+	"encoding/json"
+
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/nuts-node/vdr/types"
+)
+
+// documentDiff lists the verification methods, services and controllers that were added, removed or changed in the next version of a DID Document.
+func documentDiff(current did.Document, next did.Document) types.DocumentDiff {
+	result := types.DocumentDiff{}
+
+	currentMethods := map[string]bool{}
+	for _, method := range current.VerificationMethod {
+		currentMethods[method.ID.String()] = true
+	}
+	nextMethods := map[string]bool{}
+	for _, method := range next.VerificationMethod {
+		nextMethods[method.ID.String()] = true
+		if !currentMethods[method.ID.String()] {
+			result.AddedVerificationMethods = append(result.AddedVerificationMethods, method.ID.String())
+		}
+	}
+	for _, method := range current.VerificationMethod {
+		if !nextMethods[method.ID.String()] {
+			result.RemovedVerificationMethods = append(result.RemovedVerificationMethods, method.ID.String())
+		}
+	}
+
+	currentServices := map[string]did.Service{}
+	for _, service := range current.Service {
+		currentServices[service.ID.String()] = service
+	}
+	nextServices := map[string]bool{}
+	for _, service := range next.Service {
+		nextServices[service.ID.String()] = true
+		currentService, exists := currentServices[service.ID.String()]
+		if !exists {
+			result.AddedServices = append(result.AddedServices, service.ID.String())
+		} else if !servicesEqual(currentService, service) {
+			result.ChangedServices = append(result.ChangedServices, service.ID.String())
+		}
+	}
+	for _, service := range current.Service {
+		if !nextServices[service.ID.String()] {
+			result.RemovedServices = append(result.RemovedServices, service.ID.String())
+		}
+	}
+
+	currentControllers := map[string]bool{}
+	for _, controller := range current.Controller {
+		currentControllers[controller.String()] = true
+	}
+	nextControllers := map[string]bool{}
+	for _, controller := range next.Controller {
+		nextControllers[controller.String()] = true
+		if !currentControllers[controller.String()] {
+			result.AddedControllers = append(result.AddedControllers, controller.String())
+		}
+	}
+	for _, controller := range current.Controller {
+		if !nextControllers[controller.String()] {
+			result.RemovedControllers = append(result.RemovedControllers, controller.String())
+		}
+	}
+
+	return result
+}
+
+func servicesEqual(a did.Service, b did.Service) bool {
+	aJSON, _ := json.Marshal(a)
+	bJSON, _ := json.Marshal(b)
+	return string(aJSON) == string(bJSON)
+}
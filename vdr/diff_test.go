@@ -0,0 +1,78 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vdr
+
+import (
+	"testing"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_documentDiff(t *testing.T) {
+	id, _ := did.ParseDID("did:nuts:123")
+	controllerA, _ := did.ParseDID("did:nuts:A")
+	controllerB, _ := did.ParseDID("did:nuts:B")
+	keyA, _ := did.ParseDIDURL("did:nuts:123#key-A")
+	keyB, _ := did.ParseDIDURL("did:nuts:123#key-B")
+	serviceA := did.Service{ID: ssi.MustParseURI("did:nuts:123#service-A"), Type: "A", ServiceEndpoint: "https://a"}
+	serviceB := did.Service{ID: ssi.MustParseURI("did:nuts:123#service-B"), Type: "B", ServiceEndpoint: "https://b"}
+	serviceC := did.Service{ID: ssi.MustParseURI("did:nuts:123#service-C"), Type: "C", ServiceEndpoint: "https://c"}
+	changedServiceB := serviceB
+	changedServiceB.ServiceEndpoint = "https://b2"
+
+	current := did.Document{
+		ID:                 *id,
+		Controller:         []did.DID{*controllerA},
+		VerificationMethod: did.VerificationMethods{{ID: *keyA}},
+		Service:            []did.Service{serviceA, serviceB},
+	}
+	next := did.Document{
+		ID:                 *id,
+		Controller:         []did.DID{*controllerB},
+		VerificationMethod: did.VerificationMethods{{ID: *keyB}},
+		Service:            []did.Service{changedServiceB, serviceC},
+	}
+
+	t.Run("changes", func(t *testing.T) {
+		diff := documentDiff(current, next)
+
+		assert.Equal(t, []string{keyB.String()}, diff.AddedVerificationMethods)
+		assert.Equal(t, []string{keyA.String()}, diff.RemovedVerificationMethods)
+		assert.Equal(t, []string{serviceC.ID.String()}, diff.AddedServices)
+		assert.Equal(t, []string{serviceA.ID.String()}, diff.RemovedServices)
+		assert.Equal(t, []string{serviceB.ID.String()}, diff.ChangedServices)
+		assert.Equal(t, []string{controllerB.String()}, diff.AddedControllers)
+		assert.Equal(t, []string{controllerA.String()}, diff.RemovedControllers)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		diff := documentDiff(current, current)
+
+		assert.Empty(t, diff.AddedVerificationMethods)
+		assert.Empty(t, diff.RemovedVerificationMethods)
+		assert.Empty(t, diff.AddedServices)
+		assert.Empty(t, diff.RemovedServices)
+		assert.Empty(t, diff.ChangedServices)
+		assert.Empty(t, diff.AddedControllers)
+		assert.Empty(t, diff.RemovedControllers)
+	})
+}
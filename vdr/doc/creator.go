@@ -109,6 +109,9 @@ func getKIDName(pKey crypto.PublicKey, idFunc func(key jwk.Key) (string, error))
 // ErrInvalidOptions is returned when the given options have an invalid combination
 var ErrInvalidOptions = errors.New("create request has invalid combination of options: SelfControl = true and CapabilityInvocation = false")
 
+// ErrKeyAgreementNotSupported is returned when KeyAgreement is requested for a key type that doesn't support it
+var ErrKeyAgreementNotSupported = errors.New("create request has invalid combination of options: KeyAgreement = true is not supported by the key type")
+
 // Create creates a Nuts DID Document with a valid DID id based on a freshly generated keypair.
 // The key is added to the verificationMethod list and referred to from the Authentication list
 func (n Creator) Create(options vdr.DIDCreationOptions) (*did.Document, nutsCrypto.Key, error) {
@@ -118,12 +121,20 @@ func (n Creator) Create(options vdr.DIDCreationOptions) (*did.Document, nutsCryp
 	if options.SelfControl && !options.CapabilityInvocation {
 		return nil, nil, ErrInvalidOptions
 	}
+	keyType := options.KeyType
+	if keyType == "" {
+		keyType = nutsCrypto.DefaultKeyType
+	}
+	if options.KeyAgreement && !keyType.SupportsKeyAgreement() {
+		return nil, nil, ErrKeyAgreementNotSupported
+	}
 
 	// First, generate a new keyPair with the correct kid
 	if options.SelfControl {
-		key, err = n.KeyStore.New(didKIDNamingFunc)
+		key, err = n.KeyStore.New(keyType, didKIDNamingFunc)
 	} else {
-		key, err = nutsCrypto.NewEphemeralKey(didKIDNamingFunc)
+		// The ephemeral key is only used to derive the DID, so it's always of the default type
+		key, err = nutsCrypto.NewEphemeralKey(nutsCrypto.DefaultKeyType, didKIDNamingFunc)
 	}
 	if err != nil {
 		return nil, nil, err
@@ -156,7 +167,7 @@ func (n Creator) Create(options vdr.DIDCreationOptions) (*did.Document, nutsCryp
 		}
 	} else {
 		// Generate new key for other key capabilities, store the private key
-		capKey, err := n.KeyStore.New(didSubKIDNamingFunc(didID))
+		capKey, err := n.KeyStore.New(keyType, didSubKIDNamingFunc(didID))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -115,7 +115,7 @@ func TestCreator_Create(t *testing.T) {
 			keyCreator := nutsCrypto.NewMockKeyCreator(ctrl)
 			creator := Creator{KeyStore: keyCreator}
 
-			keyCreator.EXPECT().New(gomock.Any()).DoAndReturn(func(fn nutsCrypto.KIDNamingFunc) (nutsCrypto.Key, error) {
+			keyCreator.EXPECT().New(gomock.Any(), gomock.Any()).DoAndReturn(func(_ nutsCrypto.KeyType, fn nutsCrypto.KIDNamingFunc) (nutsCrypto.Key, error) {
 				key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 				keyName, _ := fn(key.Public())
 				return nutsCrypto.TestKey{
@@ -154,6 +154,47 @@ func TestCreator_Create(t *testing.T) {
 		})
 	})
 
+	t.Run("ok - key types", func(t *testing.T) {
+		creator := Creator{KeyStore: nutsCrypto.NewTestCryptoInstance()}
+		for _, keyType := range []nutsCrypto.KeyType{nutsCrypto.Ed25519Key, nutsCrypto.RSAKey} {
+			t.Run(string(keyType), func(t *testing.T) {
+				ops := DefaultCreationOptions()
+				ops.KeyAgreement = false
+				ops.KeyType = keyType
+
+				doc, key, err := creator.Create(ops)
+
+				if !assert.NoError(t, err) {
+					return
+				}
+				publicKey, _ := doc.VerificationMethod[0].PublicKey()
+				assert.Equal(t, key.Public(), publicKey)
+				assert.Equal(t, key.KID(), doc.VerificationMethod[0].ID.String())
+			})
+		}
+	})
+
+	t.Run("error - key type does not support key agreement", func(t *testing.T) {
+		ops := DefaultCreationOptions()
+		ops.KeyType = nutsCrypto.Ed25519Key
+		creator := Creator{KeyStore: newMockKeyCreator()}
+
+		_, _, err := creator.Create(ops)
+
+		assert.Equal(t, ErrKeyAgreementNotSupported, err)
+	})
+
+	t.Run("error - unsupported key type", func(t *testing.T) {
+		ops := DefaultCreationOptions()
+		ops.KeyAgreement = false
+		ops.KeyType = "DSA"
+		creator := Creator{KeyStore: nutsCrypto.NewTestCryptoInstance()}
+
+		_, _, err := creator.Create(ops)
+
+		assert.ErrorIs(t, err, nutsCrypto.ErrUnsupportedKeyType)
+	})
+
 	t.Run("error - invalid combination", func(t *testing.T) {
 		ops := types.DIDCreationOptions{
 			CapabilityInvocation: false,
@@ -171,7 +212,7 @@ func TestCreator_Create(t *testing.T) {
 		mockKeyStore := nutsCrypto.NewMockKeyStore(ctrl)
 		defer ctrl.Finish()
 		creator := Creator{KeyStore: mockKeyStore}
-		mockKeyStore.EXPECT().New(gomock.Any()).Return(nil, errors.New("b00m!"))
+		mockKeyStore.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil, errors.New("b00m!"))
 
 		_, _, err := creator.Create(DefaultCreationOptions())
 
@@ -194,7 +235,7 @@ func TestCreator_Create(t *testing.T) {
 			KeyAgreement:         false,
 			SelfControl:          false,
 		}
-		mockKeyStore.EXPECT().New(gomock.Any()).Return(nil, errors.New("b00m!"))
+		mockKeyStore.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil, errors.New("b00m!"))
 
 		_, _, err := creator.Create(ops)
 
@@ -20,10 +20,12 @@
 package doc
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/nuts-node/vdr/store"
 	"github.com/nuts-foundation/nuts-node/vdr/types"
 )
 
@@ -49,6 +51,78 @@ func (s servicePredicate) Match(document did.Document, _ types.DocumentMetadata)
 	return false
 }
 
+func (s servicePredicate) Index() (string, string) {
+	return types.ServiceTypeIndex, s.serviceType
+}
+
+// ByServiceTypeIncludingReferences returns a predicate that matches on service type.
+// Unlike ByServiceType, it also matches services which endpoint refers to another DID Document's service.
+func ByServiceTypeIncludingReferences(serviceType string) types.Predicate {
+	return anyServicePredicate{serviceType: serviceType}
+}
+
+type anyServicePredicate struct {
+	serviceType string
+}
+
+func (s anyServicePredicate) Match(document did.Document, _ types.DocumentMetadata) bool {
+	for _, service := range document.Service {
+		if service.Type == s.serviceType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s anyServicePredicate) Index() (string, string) {
+	return types.ServiceTypeIndex, s.serviceType
+}
+
+// ByController returns a predicate that matches DID Documents which list the given DID as controller.
+func ByController(controller did.DID) types.Predicate {
+	return controllerPredicate{controller: controller}
+}
+
+type controllerPredicate struct {
+	controller did.DID
+}
+
+func (c controllerPredicate) Match(document did.Document, _ types.DocumentMetadata) bool {
+	for _, controller := range document.Controller {
+		if controller.Equals(c.controller) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c controllerPredicate) Index() (string, string) {
+	return types.ControllerIndex, c.controller.String()
+}
+
+// ByKeyThumbprint returns a predicate that matches DID Documents containing a verification method with the given
+// JWK thumbprint (RFC7638, SHA-256, base64url encoded).
+func ByKeyThumbprint(thumbprint string) types.Predicate {
+	return keyThumbprintPredicate{thumbprint: thumbprint}
+}
+
+type keyThumbprintPredicate struct {
+	thumbprint string
+}
+
+func (k keyThumbprintPredicate) Match(document did.Document, _ types.DocumentMetadata) bool {
+	for _, method := range document.VerificationMethod {
+		if thumbprint, err := store.KeyThumbprint(*method); err == nil && thumbprint == k.thumbprint {
+			return true
+		}
+	}
+	return false
+}
+
+func (k keyThumbprintPredicate) Index() (string, string) {
+	return types.KeyThumbprintIndex, k.thumbprint
+}
+
 // ValidAt returns a predicate that matches on validity period.
 func ValidAt(at time.Time) types.Predicate {
 	return validAtPredicate{validAt: at}
@@ -85,15 +159,22 @@ func (d deactivatedPredicate) Match(_ did.Document, metadata types.DocumentMetad
 	return d.deactivated == metadata.Deactivated
 }
 
+func (d deactivatedPredicate) Index() (string, string) {
+	return types.DeactivatedIndex, strconv.FormatBool(d.deactivated)
+}
+
 // Finder is a helper that implements the DocFinder interface
 type Finder struct {
 	Store types.Store
 }
 
+// Find returns all DID Documents matching all given predicates.
+// If the store maintains secondary indexes, the most selective indexed predicate is used to narrow down the documents
+// that are evaluated. Otherwise, all documents in the store are evaluated.
 func (f Finder) Find(predicate ...types.Predicate) ([]did.Document, error) {
 	matches := make([]did.Document, 0)
 
-	err := f.Store.Iterate(func(doc did.Document, metadata types.DocumentMetadata) error {
+	fn := func(doc did.Document, metadata types.DocumentMetadata) error {
 		for _, p := range predicate {
 			if !p.Match(doc, metadata) {
 				return nil
@@ -102,10 +183,36 @@ func (f Finder) Find(predicate ...types.Predicate) ([]did.Document, error) {
 		matches = append(matches, doc)
 
 		return nil
-	})
+	}
+
+	var err error
+	indexedStore, isIndexed := f.Store.(types.IndexedStore)
+	if index := selectIndex(predicate); isIndexed && index != nil {
+		name, value := index.Index()
+		err = indexedStore.IterateIndex(name, value, fn)
+	} else {
+		err = f.Store.Iterate(fn)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return matches, err
 }
+
+// selectIndex returns the indexed predicate that's expected to be most selective, or nil if none of the predicates is indexed.
+// The deactivation state index only has 2 values, so it's only used when there's no other indexed predicate.
+func selectIndex(predicates []types.Predicate) types.IndexedPredicate {
+	var result types.IndexedPredicate
+	for _, p := range predicates {
+		indexed, ok := p.(types.IndexedPredicate)
+		if !ok {
+			continue
+		}
+		if name, _ := indexed.Index(); name != types.DeactivatedIndex {
+			return indexed
+		}
+		result = indexed
+	}
+	return result
+}
@@ -99,6 +99,56 @@ func TestByServiceType(t *testing.T) {
 	})
 }
 
+func TestByServiceTypeIncludingReferences(t *testing.T) {
+	ID, _ := did.ParseDID("did:nuts:123")
+	p := ByServiceTypeIncludingReferences("NutsComm")
+
+	t.Run("ok - concrete endpoint", func(t *testing.T) {
+		doc := did.Document{ID: *ID, Service: []did.Service{{Type: "NutsComm", ServiceEndpoint: "grpc://nuts.nl:5555"}}}
+
+		assert.True(t, p.Match(doc, types.DocumentMetadata{}))
+	})
+
+	t.Run("ok - reference", func(t *testing.T) {
+		doc := did.Document{ID: *ID, Service: []did.Service{{Type: "NutsComm", ServiceEndpoint: "did:nuts:321/serviceEndpoint#1"}}}
+
+		assert.True(t, p.Match(doc, types.DocumentMetadata{}))
+	})
+
+	t.Run("other type", func(t *testing.T) {
+		doc := did.Document{ID: *ID, Service: []did.Service{{Type: "Other", ServiceEndpoint: "grpc://nuts.nl:5555"}}}
+
+		assert.False(t, p.Match(doc, types.DocumentMetadata{}))
+	})
+}
+
+func TestByController(t *testing.T) {
+	ID, _ := did.ParseDID("did:nuts:123")
+	controller, _ := did.ParseDID("did:nuts:456")
+	p := ByController(*controller)
+
+	t.Run("ok", func(t *testing.T) {
+		assert.True(t, p.Match(did.Document{ID: *ID, Controller: []did.DID{*ID, *controller}}, types.DocumentMetadata{}))
+	})
+
+	t.Run("not controlled", func(t *testing.T) {
+		assert.False(t, p.Match(did.Document{ID: *ID, Controller: []did.DID{*ID}}, types.DocumentMetadata{}))
+	})
+}
+
+func TestByKeyThumbprint(t *testing.T) {
+	doc, _, _ := Creator{KeyStore: newMockKeyCreator()}.Create(DefaultCreationOptions())
+	thumbprint, _ := store.KeyThumbprint(*doc.VerificationMethod[0])
+
+	t.Run("ok", func(t *testing.T) {
+		assert.True(t, ByKeyThumbprint(thumbprint).Match(*doc, types.DocumentMetadata{}))
+	})
+
+	t.Run("unknown thumbprint", func(t *testing.T) {
+		assert.False(t, ByKeyThumbprint("unknown").Match(*doc, types.DocumentMetadata{}))
+	})
+}
+
 func TestVDR_Find(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		didStore := store.NewMemoryStore()
@@ -124,4 +174,46 @@ func TestVDR_Find(t *testing.T) {
 
 		assert.Error(t, err)
 	})
+
+	t.Run("ok - uses most selective index", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := types.NewMockIndexedStore(ctrl)
+		finder := Finder{Store: store}
+		ID, _ := did.ParseDID("did:nuts:123")
+		store.EXPECT().IterateIndex(types.ServiceTypeIndex, "NutsComm", gomock.Any()).DoAndReturn(func(_ string, _ string, fn types.DocIterator) error {
+			_ = fn(did.Document{ID: *ID, Service: []did.Service{{Type: "NutsComm", ServiceEndpoint: "grpc://nuts.nl:5555"}}}, types.DocumentMetadata{})
+			// does not match the IsActive() predicate
+			return fn(did.Document{ID: *ID, Service: []did.Service{{Type: "NutsComm", ServiceEndpoint: "grpc://nuts.nl:5555"}}}, types.DocumentMetadata{Deactivated: true})
+		})
+
+		docs, err := finder.Find(IsActive(), ByServiceType("NutsComm"))
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, docs, 1)
+	})
+
+	t.Run("ok - falls back to iterating without indexed predicates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := types.NewMockIndexedStore(ctrl)
+		finder := Finder{Store: store}
+		store.EXPECT().Iterate(gomock.Any()).Return(nil)
+
+		docs, err := finder.Find(ValidAt(time.Now()))
+
+		assert.NoError(t, err)
+		assert.Empty(t, docs)
+	})
+
+	t.Run("error - index", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := types.NewMockIndexedStore(ctrl)
+		finder := Finder{Store: store}
+		store.EXPECT().IterateIndex(types.DeactivatedIndex, "false", gomock.Any()).Return(errors.New("b00m!"))
+
+		_, err := finder.Find(IsActive())
+
+		assert.Error(t, err)
+	})
 }
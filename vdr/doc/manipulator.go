@@ -20,6 +20,7 @@ package doc
 
 import (
 	"errors"
+	"fmt"
 
 	ssi "github.com/nuts-foundation/go-did"
 	"github.com/nuts-foundation/go-did/did"
@@ -35,24 +36,33 @@ type Manipulator struct {
 	Updater types.DocUpdater
 	// Resolver is used for resolving DID Documents
 	Resolver types.DocResolver
+	// KeyRetirer is used for retiring the private keys of removed verificationMethods
+	KeyRetirer nutsCrypto.KeyRetirer
 }
 
 // Deactivate updates the DID Document so it can no longer be updated
 // It removes key material, services and controllers.
-func (u Manipulator) Deactivate(id did.DID) error {
-	_, meta, err := u.Resolver.Resolve(id, &types.ResolveMetadata{AllowDeactivated: true})
+// If retireKeys is true, the private keys of the removed verificationMethods are retired.
+func (u Manipulator) Deactivate(id did.DID, retireKeys bool) error {
+	doc, meta, err := u.Resolver.Resolve(id, &types.ResolveMetadata{AllowDeactivated: true})
 	if err != nil {
 		return err
 	}
 	// A deactivated DID resolves to an empty DID document.
 	emptyDoc := CreateDocument()
 	emptyDoc.ID = id
-	return u.Updater.Update(id, meta.Hash, emptyDoc, nil)
+	if err = u.Updater.Update(id, meta.Hash, emptyDoc, nil); err != nil {
+		return err
+	}
+	if retireKeys {
+		return u.retireKeys(doc.VerificationMethod)
+	}
+	return nil
 }
 
-// AddVerificationMethod adds a new key as a VerificationMethod to the document.
+// AddVerificationMethod adds a new key of the given type as a VerificationMethod to the document.
 // The key is not used yet and should be manually added to one of the VerificationRelationships
-func (u Manipulator) AddVerificationMethod(id did.DID) (*did.VerificationMethod, error) {
+func (u Manipulator) AddVerificationMethod(id did.DID, keyType nutsCrypto.KeyType) (*did.VerificationMethod, error) {
 	doc, meta, err := u.Resolver.Resolve(id, &types.ResolveMetadata{AllowDeactivated: true})
 	if err != nil {
 		return nil, err
@@ -60,7 +70,10 @@ func (u Manipulator) AddVerificationMethod(id did.DID) (*did.VerificationMethod,
 	if meta.Deactivated {
 		return nil, types.ErrDeactivated
 	}
-	method, err := CreateNewVerificationMethodForDID(doc.ID, u.KeyCreator)
+	if keyType == "" {
+		keyType = nutsCrypto.DefaultKeyType
+	}
+	method, err := CreateNewVerificationMethodForDID(doc.ID, keyType, u.KeyCreator)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +87,8 @@ func (u Manipulator) AddVerificationMethod(id did.DID) (*did.VerificationMethod,
 
 // RemoveVerificationMethod is a helper function to remove a verificationMethod from a DID Document
 // When the verificationMethod is used in an assertion or authentication method, it is also removed there.
-func (u Manipulator) RemoveVerificationMethod(id, keyID did.DID) error {
+// If retireKey is true, the private key of the verificationMethod is retired.
+func (u Manipulator) RemoveVerificationMethod(id, keyID did.DID, retireKey bool) error {
 	doc, meta, err := u.Resolver.Resolve(id, &types.ResolveMetadata{AllowDeactivated: true})
 	if err != nil {
 		return err
@@ -91,13 +105,33 @@ func (u Manipulator) RemoveVerificationMethod(id, keyID did.DID) error {
 	doc.CapabilityInvocation.Remove(keyID)
 	doc.Authentication.Remove(keyID)
 	doc.AssertionMethod.Remove(keyID)
-	return u.Updater.Update(id, meta.Hash, *doc, nil)
+	if err = u.Updater.Update(id, meta.Hash, *doc, nil); err != nil {
+		return err
+	}
+	if retireKey {
+		return u.retireKeys([]*did.VerificationMethod{removedVM})
+	}
+	return nil
+}
+
+// retireKeys retires the private keys of the given verificationMethods that are present in the key store.
+// Since the DID Document has already been updated, it tries to retire all keys before returning the first error.
+func (u Manipulator) retireKeys(methods []*did.VerificationMethod) error {
+	var firstErr error
+	for _, method := range methods {
+		kid := method.ID.String()
+		err := u.KeyRetirer.Retire(kid)
+		if err != nil && !errors.Is(err, nutsCrypto.ErrKeyNotFound) && firstErr == nil {
+			firstErr = fmt.Errorf("DID document updated, but could not retire key (kid=%s): %w", kid, err)
+		}
+	}
+	return firstErr
 }
 
 // CreateNewVerificationMethodForDID creates a new VerificationMethod of type JsonWebKey2020
-// with a freshly generated key for a given DID.
-func CreateNewVerificationMethodForDID(id did.DID, keyCreator nutsCrypto.KeyCreator) (*did.VerificationMethod, error) {
-	key, err := keyCreator.New(didSubKIDNamingFunc(id))
+// with a freshly generated key of the given type for a given DID.
+func CreateNewVerificationMethodForDID(id did.DID, keyType nutsCrypto.KeyType, keyCreator nutsCrypto.KeyCreator) (*did.VerificationMethod, error) {
+	key, err := keyCreator.New(keyType, didSubKIDNamingFunc(id))
 	if err != nil {
 		return nil, err
 	}
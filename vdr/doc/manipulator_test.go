@@ -19,6 +19,7 @@
 package doc
 
 import (
+	"crypto/ed25519"
 	"errors"
 	"testing"
 
@@ -39,6 +40,7 @@ type manipulatorTestContext struct {
 	mockUpdater    *types.MockDocUpdater
 	mockResolver   *types.MockDocResolver
 	mockKeyCreator *mockKeyCreator
+	mockKeyRetirer *crypto.MockKeyRetirer
 	manipulator    *Manipulator
 }
 
@@ -51,12 +53,14 @@ func newManipulatorTestContext(t *testing.T) manipulatorTestContext {
 		ctrl.Finish()
 	})
 	keyCreator := newMockKeyCreator()
+	keyRetirer := crypto.NewMockKeyRetirer(ctrl)
 	return manipulatorTestContext{
 		ctrl:           ctrl,
 		mockUpdater:    updater,
 		mockResolver:   resolver,
 		mockKeyCreator: keyCreator,
-		manipulator:    &Manipulator{Updater: updater, KeyCreator: keyCreator, Resolver: resolver},
+		mockKeyRetirer: keyRetirer,
+		manipulator:    &Manipulator{Updater: updater, KeyCreator: keyCreator, Resolver: resolver, KeyRetirer: keyRetirer},
 	}
 }
 
@@ -75,7 +79,7 @@ func TestManipulator_RemoveVerificationMethod(t *testing.T) {
 		ctx.mockResolver.EXPECT().Resolve(*id123, &types.ResolveMetadata{AllowDeactivated: true}).Return(doc, &types.DocumentMetadata{}, nil)
 		ctx.mockUpdater.EXPECT().Update(*id123, hash.SHA256Hash{}, did.Document{ID: *id123}, nil)
 
-		err := ctx.manipulator.RemoveVerificationMethod(*id123, *id123Method)
+		err := ctx.manipulator.RemoveVerificationMethod(*id123, *id123Method, false)
 		if !assert.NoError(t, err) {
 			return
 		}
@@ -83,11 +87,24 @@ func TestManipulator_RemoveVerificationMethod(t *testing.T) {
 		assert.Empty(t, doc.VerificationMethod)
 	})
 
+	t.Run("ok - retire key", func(t *testing.T) {
+		ctx := newManipulatorTestContext(t)
+		doc := &did.Document{ID: *id123}
+		doc.AddCapabilityInvocation(vm)
+		ctx.mockResolver.EXPECT().Resolve(*id123, &types.ResolveMetadata{AllowDeactivated: true}).Return(doc, &types.DocumentMetadata{}, nil)
+		ctx.mockUpdater.EXPECT().Update(*id123, hash.SHA256Hash{}, did.Document{ID: *id123}, nil)
+		ctx.mockKeyRetirer.EXPECT().Retire(id123Method.String())
+
+		err := ctx.manipulator.RemoveVerificationMethod(*id123, *id123Method, true)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("error - verificationMethod is not part of the document", func(t *testing.T) {
 		ctx := newManipulatorTestContext(t)
 		ctx.mockResolver.EXPECT().Resolve(*id123, &types.ResolveMetadata{AllowDeactivated: true}).Return(&did.Document{ID: *id123}, &types.DocumentMetadata{}, nil)
 
-		err := ctx.manipulator.RemoveVerificationMethod(*id123, *id123Method)
+		err := ctx.manipulator.RemoveVerificationMethod(*id123, *id123Method, false)
 		assert.EqualError(t, err, "verificationMethod not found in document")
 	})
 
@@ -95,7 +112,7 @@ func TestManipulator_RemoveVerificationMethod(t *testing.T) {
 		ctx := newManipulatorTestContext(t)
 		ctx.mockResolver.EXPECT().Resolve(*id123, &types.ResolveMetadata{AllowDeactivated: true}).Return(&did.Document{ID: *id123}, &types.DocumentMetadata{Deactivated: true}, nil)
 
-		err := ctx.manipulator.RemoveVerificationMethod(*id123, *id123Method)
+		err := ctx.manipulator.RemoveVerificationMethod(*id123, *id123Method, false)
 		assert.EqualError(t, err, "the DID document has been deactivated")
 		assert.True(t, errors.Is(err, types.ErrDeactivated))
 	})
@@ -109,7 +126,7 @@ func TestManipulator_CreateNewAuthenticationMethodForDID(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		// Prepare a document with an authenticationMethod:
 		document := &did.Document{ID: *id123}
-		method, err := CreateNewVerificationMethodForDID(document.ID, kc)
+		method, err := CreateNewVerificationMethodForDID(document.ID, crypto.DefaultKeyType, kc)
 		if !assert.NoError(t, err) {
 			return
 		}
@@ -138,7 +155,7 @@ func TestManipulator_AddKey(t *testing.T) {
 			updatedDocument = doc
 		})
 
-		key, err := ctx.manipulator.AddVerificationMethod(*id)
+		key, err := ctx.manipulator.AddVerificationMethod(*id, "")
 		if !assert.NoError(t, err) {
 			return
 		}
@@ -149,6 +166,34 @@ func TestManipulator_AddKey(t *testing.T) {
 		assert.Contains(t, updatedDocument.VerificationMethod, key)
 	})
 
+	t.Run("ok - add a new Ed25519 key", func(t *testing.T) {
+		ctx := newManipulatorTestContext(t)
+		ctx.manipulator.KeyCreator = crypto.NewTestCryptoInstance()
+
+		currentDIDDocument := did.Document{ID: *id, Controller: []did.DID{*id}}
+		ctx.mockResolver.EXPECT().Resolve(*id, &types.ResolveMetadata{AllowDeactivated: true}).Return(&currentDIDDocument, &types.DocumentMetadata{Hash: currentHash}, nil)
+		ctx.mockUpdater.EXPECT().Update(*id, currentHash, gomock.Any(), nil)
+
+		key, err := ctx.manipulator.AddVerificationMethod(*id, crypto.Ed25519Key)
+		if !assert.NoError(t, err) {
+			return
+		}
+		publicKey, _ := key.PublicKey()
+		assert.IsType(t, ed25519.PublicKey{}, publicKey)
+	})
+
+	t.Run("error - unsupported key type", func(t *testing.T) {
+		ctx := newManipulatorTestContext(t)
+		ctx.manipulator.KeyCreator = crypto.NewTestCryptoInstance()
+
+		currentDIDDocument := did.Document{ID: *id, Controller: []did.DID{*id}}
+		ctx.mockResolver.EXPECT().Resolve(*id, &types.ResolveMetadata{AllowDeactivated: true}).Return(&currentDIDDocument, &types.DocumentMetadata{Hash: currentHash}, nil)
+
+		_, err := ctx.manipulator.AddVerificationMethod(*id, "DSA")
+
+		assert.ErrorIs(t, err, crypto.ErrUnsupportedKeyType)
+	})
+
 	t.Run("error - vdr.update throws an error", func(t *testing.T) {
 		ctx := newManipulatorTestContext(t)
 
@@ -157,7 +202,7 @@ func TestManipulator_AddKey(t *testing.T) {
 		ctx.mockResolver.EXPECT().Resolve(*id, &types.ResolveMetadata{AllowDeactivated: true}).Return(&currentDIDDocument, &types.DocumentMetadata{Hash: currentHash}, nil)
 		ctx.mockUpdater.EXPECT().Update(*id, currentHash, gomock.Any(), nil).Return(types.ErrNotFound)
 
-		key, err := ctx.manipulator.AddVerificationMethod(*id)
+		key, err := ctx.manipulator.AddVerificationMethod(*id, "")
 		if !assert.Error(t, err) {
 			return
 		}
@@ -171,7 +216,7 @@ func TestManipulator_AddKey(t *testing.T) {
 		currentDIDDocument := did.Document{ID: *id, Controller: []did.DID{*id}}
 		ctx.mockResolver.EXPECT().Resolve(*id, &types.ResolveMetadata{AllowDeactivated: true}).Return(&currentDIDDocument, &types.DocumentMetadata{Hash: currentHash, Deactivated: true}, nil)
 
-		key, err := ctx.manipulator.AddVerificationMethod(*id)
+		key, err := ctx.manipulator.AddVerificationMethod(*id, "")
 		if !assert.Error(t, err) {
 			return
 		}
@@ -181,24 +226,60 @@ func TestManipulator_AddKey(t *testing.T) {
 }
 
 func TestManipulator_Deactivate(t *testing.T) {
-	id, _ := did.ParseDID("did:nuts:123")
-	keyID, _ := did.ParseDIDURL("did:nuts:123#key-1")
+	id123, _ := did.ParseDID("did:nuts:123")
+	id123Method1, _ := did.ParseDIDURL("did:nuts:123#method-1")
+	id123Method2, _ := did.ParseDIDURL("did:nuts:123#method-2")
 	currentHash := hash.SHA256Sum([]byte("currentHash"))
+	newDoc := func() *did.Document {
+		doc := &did.Document{ID: *id123}
+		doc.AddCapabilityInvocation(&did.VerificationMethod{ID: *id123Method1})
+		doc.AddAssertionMethod(&did.VerificationMethod{ID: *id123Method2})
+		return doc
+	}
+	expectedDoc := CreateDocument()
+	expectedDoc.ID = *id123
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newManipulatorTestContext(t)
+		ctx.mockResolver.EXPECT().Resolve(*id123, &types.ResolveMetadata{AllowDeactivated: true}).Return(newDoc(), &types.DocumentMetadata{Hash: currentHash}, nil)
+		ctx.mockUpdater.EXPECT().Update(*id123, currentHash, expectedDoc, nil)
+
+		err := ctx.manipulator.Deactivate(*id123, false)
 
-	ctx := newManipulatorTestContext(t)
+		assert.NoError(t, err)
+	})
+	t.Run("ok - retire keys", func(t *testing.T) {
+		ctx := newManipulatorTestContext(t)
+		ctx.mockResolver.EXPECT().Resolve(*id123, gomock.Any()).Return(newDoc(), &types.DocumentMetadata{Hash: currentHash}, nil)
+		ctx.mockUpdater.EXPECT().Update(*id123, currentHash, expectedDoc, nil)
+		ctx.mockKeyRetirer.EXPECT().Retire(id123Method1.String())
+		// keys that aren't present in the key store are skipped
+		ctx.mockKeyRetirer.EXPECT().Retire(id123Method2.String()).Return(crypto.ErrKeyNotFound)
+
+		err := ctx.manipulator.Deactivate(*id123, true)
 
-	currentDIDDocument := did.Document{ID: *id, Controller: []did.DID{*id}}
-	currentDIDDocument.AddCapabilityInvocation(&did.VerificationMethod{ID: *keyID})
+		assert.NoError(t, err)
+	})
+	t.Run("error - retiring key fails", func(t *testing.T) {
+		ctx := newManipulatorTestContext(t)
+		ctx.mockResolver.EXPECT().Resolve(*id123, gomock.Any()).Return(newDoc(), &types.DocumentMetadata{Hash: currentHash}, nil)
+		ctx.mockUpdater.EXPECT().Update(*id123, currentHash, expectedDoc, nil)
+		ctx.mockKeyRetirer.EXPECT().Retire(id123Method1.String()).Return(errors.New("b00m!"))
+		ctx.mockKeyRetirer.EXPECT().Retire(id123Method2.String())
 
-	ctx.mockResolver.EXPECT().Resolve(*id, &types.ResolveMetadata{AllowDeactivated: true}).Return(&currentDIDDocument, &types.DocumentMetadata{Hash: currentHash}, nil)
-	expectedDocument := CreateDocument()
-	expectedDocument.ID = *id
-	ctx.mockUpdater.EXPECT().Update(*id, currentHash, expectedDocument, nil)
+		err := ctx.manipulator.Deactivate(*id123, true)
 
-	err := ctx.manipulator.Deactivate(*id)
-	if !assert.NoError(t, err) {
-		return
-	}
+		assert.EqualError(t, err, "DID document updated, but could not retire key (kid=did:nuts:123#method-1): b00m!")
+	})
+	t.Run("error - update fails", func(t *testing.T) {
+		ctx := newManipulatorTestContext(t)
+		ctx.mockResolver.EXPECT().Resolve(*id123, gomock.Any()).Return(newDoc(), &types.DocumentMetadata{Hash: currentHash}, nil)
+		ctx.mockUpdater.EXPECT().Update(*id123, currentHash, expectedDoc, nil).Return(types.ErrDeactivated)
+
+		err := ctx.manipulator.Deactivate(*id123, true)
+
+		assert.ErrorIs(t, err, types.ErrDeactivated)
+	})
 }
 
 func Test_getVerificationMethodDiff(t *testing.T) {
@@ -70,6 +70,6 @@ func newMockKeyCreator() *mockKeyCreator {
 }
 
 // New uses a predefined ECDSA key and calls the namingFunc to get the kid
-func (m *mockKeyCreator) New(namingFunc nutsCrypto.KIDNamingFunc) (nutsCrypto.Key, error) {
+func (m *mockKeyCreator) New(_ nutsCrypto.KeyType, namingFunc nutsCrypto.KIDNamingFunc) (nutsCrypto.Key, error) {
 	return nutsCrypto.NewTestKey(m.kid), nil
 }
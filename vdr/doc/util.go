@@ -19,10 +19,13 @@
 package doc
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"github.com/nuts-foundation/go-did"
 	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/nuts-node/vdr/types"
+	"github.com/shengdoushi/base58"
 	"strings"
 )
 
@@ -38,6 +41,15 @@ func MakeServiceReference(subjectDID did.DID, serviceType string) ssi.URI {
 	return ref
 }
 
+// GenerateIDForService derives the ID of a service from its contents, so identical services get identical IDs.
+func GenerateIDForService(id did.DID, service did.Service) ssi.URI {
+	bytes, _ := json.Marshal(service)
+	shaBytes := sha256.Sum256(bytes)
+	d := id.URI()
+	d.Fragment = base58.Encode(shaBytes[:], base58.BitcoinAlphabet)
+	return d
+}
+
 // IsServiceReference checks whether the given endpoint string looks like a service reference (e.g. did:nuts:1234/serviceType?type=HelloWorld).
 func IsServiceReference(endpoint string) bool {
 	return strings.HasPrefix(endpoint, "did:")
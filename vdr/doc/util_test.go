@@ -19,6 +19,9 @@
 package doc
 
 import (
+	"fmt"
+	"net/url"
+
 	ssi "github.com/nuts-foundation/go-did"
 	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/nuts-node/vdr/types"
@@ -31,6 +34,18 @@ func Test_MakeServiceReference(t *testing.T) {
 	assert.Equal(t, "did:nuts:abc/serviceEndpoint?type=hello", MakeServiceReference(*d, "hello").String())
 }
 
+func TestGenerateIDForService(t *testing.T) {
+	id, _ := did.ParseDID("did:nuts:GvkzxsezHvEc8nGhgz6Xo3jbqkHwswLmWw3CYtCm7hAW")
+	u, _ := url.Parse("https://api.example.com/v1")
+	expectedID, _ := ssi.ParseURI(fmt.Sprintf("%s#D4eNCVjdtGaeHYMdjsdYHpTQmiwXtQKJmE9QSwwsKKzy", id.String()))
+
+	actual := GenerateIDForService(*id, did.Service{
+		Type:            "type",
+		ServiceEndpoint: u.String(),
+	})
+	assert.Equal(t, *expectedID, actual)
+}
+
 func Test_IsServiceReference(t *testing.T) {
 	assert.True(t, IsServiceReference("did:nuts:bla"))
 	assert.False(t, IsServiceReference("nuts:did:not-a-did"))
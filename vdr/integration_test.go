@@ -201,7 +201,7 @@ func TestVDRIntegration_Test(t *testing.T) {
 
 	// deactivate document B
 	docUpdater := &doc.Manipulator{KeyCreator: cryptoInstance, Updater: *vdr, Resolver: docResolver}
-	err = docUpdater.Deactivate(docB.ID)
+	err = docUpdater.Deactivate(docB.ID, false)
 	assert.NoError(t, err,
 		"expected deactivation to succeed")
 
@@ -211,7 +211,7 @@ func TestVDRIntegration_Test(t *testing.T) {
 		"expected document B to not have any CapabilityInvocation methods after deactivation")
 
 	// try to deactivate the document again
-	err = docUpdater.Deactivate(docB.ID)
+	err = docUpdater.Deactivate(docB.ID, false)
 	assert.EqualError(t, err, "the DID document has been deactivated",
 		"expected an error when trying to deactivate an already deactivated document")
 
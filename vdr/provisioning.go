@@ -0,0 +1,177 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vdr
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/nuts-node/vdr/doc"
+	"github.com/nuts-foundation/nuts-node/vdr/log"
+	"github.com/nuts-foundation/nuts-node/vdr/types"
+	"go.etcd.io/bbolt"
+)
+
+// externalReferencesBucket is the name of the bucket that maps external references to provisioned DIDs.
+const externalReferencesBucket = "externalReferences"
+
+// errProvisioningNotConfigured is returned when Provision is called before the VDR is configured.
+var errProvisioningNotConfigured = errors.New("DID provisioning is not configured")
+
+// provisioningStore keeps track of the DIDs created through bulk provisioning, by their external reference.
+type provisioningStore struct {
+	db *bbolt.DB
+	// mux makes sure concurrent batches can't provision the same external reference twice.
+	mux sync.Mutex
+}
+
+func newProvisioningStore(dataDir string) (*provisioningStore, error) {
+	dbFile := path.Join(dataDir, "vdr", "provisioning.db")
+	if err := os.MkdirAll(filepath.Dir(dbFile), os.ModePerm); err != nil {
+		return nil, fmt.Errorf("unable to create BBolt database: %w", err)
+	}
+	db, err := bbolt.Open(dbFile, 0600, bbolt.DefaultOptions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create BBolt database: %w", err)
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(externalReferencesBucket))
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &provisioningStore{db: db}, nil
+}
+
+// get returns the DID provisioned for the given external reference, or nil if there is none.
+func (s *provisioningStore) get(externalReference string) (*did.DID, error) {
+	var result *did.DID
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		value := tx.Bucket([]byte(externalReferencesBucket)).Get([]byte(externalReference))
+		if value == nil {
+			return nil
+		}
+		id, err := did.ParseDID(string(value))
+		if err != nil {
+			return fmt.Errorf("invalid DID stored for external reference (ref=%s): %w", externalReference, err)
+		}
+		result = id
+		return nil
+	})
+	return result, err
+}
+
+// put records the DID provisioned for the given external reference.
+func (s *provisioningStore) put(externalReference string, id did.DID) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(externalReferencesBucket)).Put([]byte(externalReference), []byte(id.String()))
+	})
+}
+
+// remove deletes the record of the given external reference.
+func (s *provisioningStore) remove(externalReference string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(externalReferencesBucket)).Delete([]byte(externalReference))
+	})
+}
+
+func (s *provisioningStore) close() error {
+	return s.db.Close()
+}
+
+// Provision creates a DID Document using the given options for every item that hasn't been provisioned before.
+// See types.VDR for the details.
+func (r VDR) Provision(options types.DIDCreationOptions, items []types.ProvisioningItem) ([]types.ProvisioningResult, error) {
+	if r.provisioning == nil {
+		return nil, errProvisioningNotConfigured
+	}
+	r.provisioning.mux.Lock()
+	defer r.provisioning.mux.Unlock()
+
+	results := make([]types.ProvisioningResult, len(items))
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		result := types.ProvisioningResult{ExternalReference: item.ExternalReference}
+		if item.ExternalReference == "" {
+			result.Error = errors.New("external reference is required")
+		} else if seen[item.ExternalReference] {
+			result.Error = errors.New("duplicate external reference in request")
+		} else {
+			seen[item.ExternalReference] = true
+			result.DID, result.Status, result.Error = r.provisionItem(options, item)
+		}
+		if result.Error != nil {
+			result.Status = types.ProvisioningFailed
+			log.Logger().WithError(result.Error).Warnf("Unable to provision DID Document (ref=%s)", item.ExternalReference)
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+func (r VDR) provisionItem(options types.DIDCreationOptions, item types.ProvisioningItem) (*did.DID, types.ProvisioningStatus, error) {
+	existing, err := r.provisioning.get(item.ExternalReference)
+	if err != nil {
+		return nil, "", err
+	}
+	if existing != nil {
+		_, _, err = r.store.Resolve(*existing, &types.ResolveMetadata{AllowDeactivated: true})
+		if err == nil {
+			return existing, types.ProvisioningExisting, nil
+		}
+		if !errors.Is(err, types.ErrNotFound) {
+			return nil, "", err
+		}
+		// The reference was recorded but the DID Document was never published (e.g. the node stopped in between),
+		// so it's provisioned again.
+		log.Logger().Warnf("Provisioned DID Document not found, provisioning it again (DID=%s, ref=%s)", existing, item.ExternalReference)
+	}
+
+	document, key, err := r.didDocCreator.Create(options)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not create DID document: %w", err)
+	}
+	for _, service := range item.Services {
+		service.ID = doc.GenerateIDForService(document.ID, service)
+		document.Service = append(document.Service, service)
+	}
+	if err = createManagedDocumentValidator().Validate(*document); err != nil {
+		return nil, "", err
+	}
+	// The external reference is recorded before publishing, so a retry never publishes a second DID Document for it.
+	if err = r.provisioning.put(item.ExternalReference, document.ID); err != nil {
+		return nil, "", fmt.Errorf("could not record external reference: %w", err)
+	}
+	if err = r.publish(*document, key); err != nil {
+		if removeErr := r.provisioning.remove(item.ExternalReference); removeErr != nil {
+			log.Logger().WithError(removeErr).Errorf("Unable to remove external reference of unpublished DID Document (DID=%s, ref=%s)", document.ID, item.ExternalReference)
+		}
+		return nil, "", err
+	}
+	log.Logger().Infof("New DID Document provisioned (DID=%s, ref=%s)", document.ID, item.ExternalReference)
+	return &document.ID, types.ProvisioningCreated, nil
+}
@@ -0,0 +1,211 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package vdr
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/nuts-node/crypto"
+	"github.com/nuts-foundation/nuts-node/network"
+	"github.com/nuts-foundation/nuts-node/test/io"
+	"github.com/nuts-foundation/nuts-node/vdr/doc"
+	"github.com/nuts-foundation/nuts-node/vdr/store"
+	"github.com/nuts-foundation/nuts-node/vdr/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newProvisioningTestVDR(t *testing.T) (VDR, *network.MockTransactions) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockNetwork := network.NewMockTransactions(ctrl)
+	provisioning, err := newProvisioningStore(io.TestDirectory(t))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() {
+		_ = provisioning.close()
+	})
+	return VDR{
+		store:         store.NewMemoryStore(),
+		network:       mockNetwork,
+		didDocCreator: doc.Creator{KeyStore: crypto.NewTestCryptoInstance()},
+		provisioning:  provisioning,
+	}, mockNetwork
+}
+
+// writeToStore returns a CreateTransaction stub that writes published DID documents to the store, like the ambassador does.
+func writeToStore(didStore types.Store) func(template network.Template) (interface{}, error) {
+	return func(template network.Template) (interface{}, error) {
+		document := did.Document{}
+		_ = json.Unmarshal(template.Payload, &document)
+		return nil, didStore.Write(document, types.DocumentMetadata{})
+	}
+}
+
+func TestVDR_Provision(t *testing.T) {
+	controller, _ := did.ParseDID("did:nuts:vendor")
+	options := types.DIDCreationOptions{
+		Controllers:     []did.DID{*controller},
+		AssertionMethod: true,
+	}
+	service := did.Service{Type: "eOverdracht", ServiceEndpoint: "did:nuts:vendor/serviceEndpoint?type=eOverdracht"}
+
+	t.Run("ok", func(t *testing.T) {
+		vdr, mockNetwork := newProvisioningTestVDR(t)
+		var published []did.Document
+		mockNetwork.EXPECT().CreateTransaction(gomock.Any()).Times(2).DoAndReturn(func(template network.Template) (interface{}, error) {
+			document := did.Document{}
+			_ = json.Unmarshal(template.Payload, &document)
+			published = append(published, document)
+			return nil, nil
+		})
+
+		results, err := vdr.Provision(options, []types.ProvisioningItem{
+			{ExternalReference: "org-1", Services: []did.Service{service}},
+			{ExternalReference: "org-2"},
+		})
+
+		if !assert.NoError(t, err) || !assert.Len(t, results, 2) {
+			return
+		}
+		assert.Equal(t, "org-1", results[0].ExternalReference)
+		assert.Equal(t, types.ProvisioningCreated, results[0].Status)
+		assert.NoError(t, results[0].Error)
+		assert.Equal(t, types.ProvisioningCreated, results[1].Status)
+		assert.NotEqual(t, results[0].DID.String(), results[1].DID.String())
+		// services and controllers are published in the same transaction as the DID document
+		if !assert.Len(t, published, 2) {
+			return
+		}
+		assert.Equal(t, *results[0].DID, published[0].ID)
+		assert.Equal(t, []did.DID{*controller}, published[0].Controller)
+		if assert.Len(t, published[0].Service, 1) {
+			assert.Equal(t, "eOverdracht", published[0].Service[0].Type)
+			serviceID := published[0].Service[0].ID
+			assert.NotEmpty(t, serviceID.Fragment)
+			serviceID.Fragment = ""
+			assert.Equal(t, published[0].ID.String(), serviceID.String())
+		}
+		assert.Empty(t, published[1].Service)
+	})
+
+	t.Run("ok - resubmitted batch is idempotent", func(t *testing.T) {
+		vdr, mockNetwork := newProvisioningTestVDR(t)
+		mockNetwork.EXPECT().CreateTransaction(gomock.Any()).Times(2).DoAndReturn(writeToStore(vdr.store))
+		first, _ := vdr.Provision(options, []types.ProvisioningItem{{ExternalReference: "org-1"}})
+
+		second, err := vdr.Provision(options, []types.ProvisioningItem{{ExternalReference: "org-1"}, {ExternalReference: "org-2"}})
+
+		if !assert.NoError(t, err) || !assert.Len(t, second, 2) {
+			return
+		}
+		assert.Equal(t, types.ProvisioningExisting, second[0].Status)
+		assert.Equal(t, first[0].DID, second[0].DID)
+		assert.Equal(t, types.ProvisioningCreated, second[1].Status)
+	})
+
+	t.Run("ok - external references are persisted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockNetwork := network.NewMockTransactions(ctrl)
+		dataDir := io.TestDirectory(t)
+		vdr := VDR{store: store.NewMemoryStore(), network: mockNetwork, didDocCreator: doc.Creator{KeyStore: crypto.NewTestCryptoInstance()}}
+		mockNetwork.EXPECT().CreateTransaction(gomock.Any()).DoAndReturn(writeToStore(vdr.store))
+		vdr.provisioning, _ = newProvisioningStore(dataDir)
+		first, _ := vdr.Provision(options, []types.ProvisioningItem{{ExternalReference: "org-1"}})
+		_ = vdr.provisioning.close()
+
+		vdr.provisioning, _ = newProvisioningStore(dataDir)
+		defer vdr.provisioning.close()
+		second, _ := vdr.Provision(options, []types.ProvisioningItem{{ExternalReference: "org-1"}})
+
+		assert.Equal(t, types.ProvisioningExisting, second[0].Status)
+		assert.Equal(t, first[0].DID, second[0].DID)
+	})
+
+	t.Run("ok - reference recorded but document never published", func(t *testing.T) {
+		vdr, mockNetwork := newProvisioningTestVDR(t)
+		unpublished, _ := did.ParseDID("did:nuts:unpublished")
+		_ = vdr.provisioning.put("org-1", *unpublished)
+		mockNetwork.EXPECT().CreateTransaction(gomock.Any()).DoAndReturn(writeToStore(vdr.store))
+
+		results, err := vdr.Provision(options, []types.ProvisioningItem{{ExternalReference: "org-1"}})
+
+		if !assert.NoError(t, err) || !assert.Len(t, results, 1) {
+			return
+		}
+		assert.Equal(t, types.ProvisioningCreated, results[0].Status)
+		assert.NotEqual(t, unpublished.String(), results[0].DID.String())
+		recorded, _ := vdr.provisioning.get("org-1")
+		assert.Equal(t, results[0].DID, recorded)
+	})
+
+	t.Run("failing items don't affect others", func(t *testing.T) {
+		vdr, mockNetwork := newProvisioningTestVDR(t)
+		gomock.InOrder(
+			mockNetwork.EXPECT().CreateTransaction(gomock.Any()).Return(nil, errors.New("b00m!")),
+			mockNetwork.EXPECT().CreateTransaction(gomock.Any()),
+		)
+
+		results, err := vdr.Provision(options, []types.ProvisioningItem{
+			{},
+			{ExternalReference: "org-1"},
+			{ExternalReference: "org-2", Services: []did.Service{service, {Type: service.Type, ServiceEndpoint: "https://example.com"}}},
+			{ExternalReference: "org-3"},
+			{ExternalReference: "org-3"},
+		})
+
+		if !assert.NoError(t, err) || !assert.Len(t, results, 5) {
+			return
+		}
+		assert.Equal(t, types.ProvisioningFailed, results[0].Status)
+		assert.EqualError(t, results[0].Error, "external reference is required")
+		assert.Equal(t, types.ProvisioningFailed, results[1].Status)
+		assert.EqualError(t, results[1].Error, "could not store DID document in network: b00m!")
+		assert.Nil(t, results[1].DID)
+		assert.Equal(t, types.ProvisioningFailed, results[2].Status)
+		assert.ErrorIs(t, results[2].Error, types.ErrDuplicateService)
+		assert.Equal(t, types.ProvisioningCreated, results[3].Status)
+		assert.Equal(t, types.ProvisioningFailed, results[4].Status)
+		assert.EqualError(t, results[4].Error, "duplicate external reference in request")
+		// failed items can be provisioned in a next batch
+		existing, _ := vdr.provisioning.get("org-1")
+		assert.Nil(t, existing)
+	})
+
+	t.Run("error - creation options are invalid", func(t *testing.T) {
+		vdr, _ := newProvisioningTestVDR(t)
+
+		results, err := vdr.Provision(types.DIDCreationOptions{SelfControl: true}, []types.ProvisioningItem{{ExternalReference: "org-1"}})
+
+		assert.NoError(t, err)
+		assert.ErrorIs(t, results[0].Error, doc.ErrInvalidOptions)
+	})
+
+	t.Run("error - not configured", func(t *testing.T) {
+		results, err := VDR{}.Provision(options, []types.ProvisioningItem{{ExternalReference: "org-1"}})
+
+		assert.Nil(t, results)
+		assert.Equal(t, errProvisioningNotConfigured, err)
+	})
+}
@@ -18,6 +18,7 @@ package store
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/nuts-foundation/go-did/did"
 	"go.etcd.io/bbolt"
@@ -85,9 +86,15 @@ type bboltStore struct {
 	db *bbolt.DB
 }
 
-// NewBBoltStore returns an instance of a BBolt based VDR store
-func NewBBoltStore(db *bbolt.DB) vdr.Store {
-	return &bboltStore{db: db}
+// NewBBoltStore returns an instance of a BBolt based VDR store.
+// The store maintains secondary indexes, which are (re)built on creation when the given database doesn't contain them yet
+// or contains indexes built with other index definitions.
+func NewBBoltStore(db *bbolt.DB) (vdr.IndexedStore, error) {
+	store := &bboltStore{db: db}
+	if err := store.ensureIndexes(); err != nil {
+		return nil, fmt.Errorf("unable to build VDR store indexes: %w", err)
+	}
+	return store, nil
 }
 
 func (store *bboltStore) storeDocument(tx *bbolt.Tx, document did.Document, metadata vdr.DocumentMetadata) error {
@@ -277,12 +284,16 @@ func (store *bboltStore) Write(document did.Document, metadata vdr.DocumentMetad
 			Versions:    []hash.SHA256Hash{metadata.Hash},
 		}
 
-		if err := versions.Put([]byte(document.ID.String()), versionList.encode()); err != nil {
+		if err := versions.Put(key, versionList.encode()); err != nil {
 			return err
 		}
 
 		// Store the actual document
-		return store.storeDocument(tx, document, metadata)
+		if err := store.storeDocument(tx, document, metadata); err != nil {
+			return err
+		}
+
+		return store.updateIndexes(tx, key, nil, &documentVersion{Document: document, Metadata: metadata})
 	})
 }
 
@@ -317,6 +328,15 @@ func (store *bboltStore) Update(id did.DID, current hash.SHA256Hash, next did.Do
 			return vdr.ErrUpdateOnOutdatedData
 		}
 
+		documents, err := tx.CreateBucketIfNotExists(documentsBucket)
+		if err != nil {
+			return err
+		}
+		previous, err := store.getDocumentVersion(documents, current)
+		if err != nil {
+			return err
+		}
+
 		// Update version information
 		versionList.Versions = append(versionList.Versions, metadata.Hash)
 		versionList.Deactivated = versionList.Deactivated || IsDeactivated(next)
@@ -326,6 +346,10 @@ func (store *bboltStore) Update(id did.DID, current hash.SHA256Hash, next did.Do
 		}
 
 		// Store the document
-		return store.storeDocument(tx, next, *metadata)
+		if err := store.storeDocument(tx, next, *metadata); err != nil {
+			return err
+		}
+
+		return store.updateIndexes(tx, versionKey, previous, &documentVersion{Document: next, Metadata: *metadata})
 	})
 }
@@ -0,0 +1,156 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package store
+
+import (
+	"go.etcd.io/bbolt"
+
+	vdr "github.com/nuts-foundation/nuts-node/vdr/types"
+)
+
+// indexesBucket contains a nested bucket per index. Every index bucket contains a nested bucket per indexed value,
+// which in turn contains the DIDs (as keys) of the documents which latest version has that value.
+var indexesBucket = []byte("vdrIndexes")
+
+// indexesVersionKey is the key in the indexes bucket that holds the version of the index definitions the indexes were built with.
+var indexesVersionKey = []byte("version")
+
+// indexesVersion must be incremented whenever the indexes or the way their values are derived (see indexValues) change,
+// so the indexes of existing stores are rebuilt.
+const indexesVersion = "1"
+
+// indexNames lists all indexes maintained by the stores.
+var indexNames = []string{vdr.ServiceTypeIndex, vdr.ControllerIndex, vdr.KeyThumbprintIndex, vdr.DeactivatedIndex}
+
+func isKnownIndex(name string) bool {
+	for _, curr := range indexNames {
+		if curr == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureIndexes (re)builds the indexes for all stored documents if the store was created before indexes were introduced,
+// or when the indexes were built with another version of the index definitions.
+func (store *bboltStore) ensureIndexes() error {
+	return store.db.Update(func(tx *bbolt.Tx) error {
+		if indexes := tx.Bucket(indexesBucket); indexes != nil {
+			if string(indexes.Get(indexesVersionKey)) == indexesVersion {
+				return nil
+			}
+			if err := tx.DeleteBucket(indexesBucket); err != nil {
+				return err
+			}
+		}
+		indexes, err := tx.CreateBucket(indexesBucket)
+		if err != nil {
+			return err
+		}
+		if err := indexes.Put(indexesVersionKey, []byte(indexesVersion)); err != nil {
+			return err
+		}
+		versions := tx.Bucket(versionsBucket)
+		documents := tx.Bucket(documentsBucket)
+		if versions == nil || documents == nil {
+			return nil
+		}
+		return versions.ForEach(func(key, data []byte) error {
+			doc, err := store.getDocumentVersion(documents, parseDocumentVersionList(data).Latest())
+			if err != nil || doc == nil {
+				return err
+			}
+			return store.updateIndexes(tx, key, nil, doc)
+		})
+	})
+}
+
+// updateIndexes removes the index entries of the previous version of a document (if any) and adds the entries of the next version.
+func (store *bboltStore) updateIndexes(tx *bbolt.Tx, id []byte, previous *documentVersion, next *documentVersion) error {
+	indexes, err := tx.CreateBucketIfNotExists(indexesBucket)
+	if err != nil {
+		return err
+	}
+	if previous != nil {
+		for name, values := range indexValues(previous.Document, previous.Metadata) {
+			index := indexes.Bucket([]byte(name))
+			if index == nil {
+				continue
+			}
+			for _, value := range values {
+				if entries := index.Bucket([]byte(value)); entries != nil {
+					if err := entries.Delete(id); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	for name, values := range indexValues(next.Document, next.Metadata) {
+		index, err := indexes.CreateBucketIfNotExists([]byte(name))
+		if err != nil {
+			return err
+		}
+		for _, value := range values {
+			entries, err := index.CreateBucketIfNotExists([]byte(value))
+			if err != nil {
+				return err
+			}
+			if err := entries.Put(id, []byte{}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IterateIndex loops over the latest versions of the DID Documents that have the given value in the given index and applies fn.
+func (store *bboltStore) IterateIndex(name string, value string, fn vdr.DocIterator) error {
+	if !isKnownIndex(name) {
+		return vdr.ErrUnknownIndex
+	}
+	return store.db.View(func(tx *bbolt.Tx) error {
+		indexes := tx.Bucket(indexesBucket)
+		versions := tx.Bucket(versionsBucket)
+		documents := tx.Bucket(documentsBucket)
+		if indexes == nil || versions == nil || documents == nil {
+			return nil
+		}
+		index := indexes.Bucket([]byte(name))
+		if index == nil {
+			return nil
+		}
+		entries := index.Bucket([]byte(value))
+		if entries == nil {
+			return nil
+		}
+		return entries.ForEach(func(id, _ []byte) error {
+			data := versions.Get(id)
+			if data == nil {
+				return nil
+			}
+			doc, err := store.getDocumentVersion(documents, parseDocumentVersionList(data).Latest())
+			if err != nil || doc == nil {
+				return err
+			}
+			return fn(doc.Document, doc.Metadata)
+		})
+	})
+}
@@ -0,0 +1,159 @@
+/*
+ * Nuts node
+ * Copyright (C) 2022 Nuts community
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ *
+ */
+
+package store
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	ssi "github.com/nuts-foundation/go-did"
+	"github.com/nuts-foundation/go-did/did"
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/bbolt"
+
+	"github.com/nuts-foundation/nuts-node/crypto/hash"
+	"github.com/nuts-foundation/nuts-node/vdr/types"
+)
+
+func collectIndex(t *testing.T, store *bboltStore, name, value string) []string {
+	var result []string
+	err := store.IterateIndex(name, value, func(doc did.Document, _ types.DocumentMetadata) error {
+		result = append(result, doc.ID.String())
+		return nil
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return result
+}
+
+func TestBBoltStore_IterateIndex(t *testing.T) {
+	store := newBBoltTestStore(t)
+	did1, _ := did.ParseDID("did:nuts:1")
+	did2, _ := did.ParseDID("did:nuts:2")
+	controller, _ := did.ParseDID("did:nuts:controller")
+	firstHash := hash.SHA256Sum([]byte("1"))
+	doc1 := did.Document{
+		ID:         *did1,
+		Controller: []did.DID{*controller},
+		Service:    []did.Service{{Type: "NutsComm"}},
+	}
+	doc2 := did.Document{
+		ID:         *did2,
+		Controller: []did.DID{*controller},
+	}
+	_ = store.Write(doc1, types.DocumentMetadata{Hash: firstHash})
+	_ = store.Write(doc2, types.DocumentMetadata{Hash: hash.SHA256Sum([]byte("2"))})
+
+	t.Run("service type", func(t *testing.T) {
+		assert.Equal(t, []string{did1.String()}, collectIndex(t, store, types.ServiceTypeIndex, "NutsComm"))
+		assert.Empty(t, collectIndex(t, store, types.ServiceTypeIndex, "Other"))
+	})
+
+	t.Run("controller", func(t *testing.T) {
+		assert.Equal(t, []string{did1.String(), did2.String()}, collectIndex(t, store, types.ControllerIndex, controller.String()))
+	})
+
+	t.Run("deactivated", func(t *testing.T) {
+		assert.Len(t, collectIndex(t, store, types.DeactivatedIndex, "false"), 2)
+		assert.Empty(t, collectIndex(t, store, types.DeactivatedIndex, "true"))
+	})
+
+	t.Run("key thumbprint", func(t *testing.T) {
+		did3, _ := did.ParseDID("did:nuts:3")
+		privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		keyID := *did3
+		keyID.Fragment = "key-1"
+		method, _ := did.NewVerificationMethod(keyID, ssi.JsonWebKey2020, *did3, privateKey.Public())
+		_ = store.Write(did.Document{ID: *did3, VerificationMethod: []*did.VerificationMethod{method}}, types.DocumentMetadata{Hash: hash.SHA256Sum([]byte("3"))})
+		thumbprint, _ := KeyThumbprint(*method)
+
+		assert.Equal(t, []string{did3.String()}, collectIndex(t, store, types.KeyThumbprintIndex, thumbprint))
+	})
+
+	t.Run("unknown index", func(t *testing.T) {
+		err := store.IterateIndex("unknown", "value", func(_ did.Document, _ types.DocumentMetadata) error {
+			return nil
+		})
+
+		assert.ErrorIs(t, err, types.ErrUnknownIndex)
+	})
+
+	t.Run("entries of previous version are removed on update", func(t *testing.T) {
+		next := doc1
+		next.Service = []did.Service{{Type: "Other"}}
+		next.Controller = nil
+		nextHash := hash.SHA256Sum([]byte("1-next"))
+
+		err := store.Update(*did1, firstHash, next, &types.DocumentMetadata{Hash: nextHash, Deactivated: true})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, collectIndex(t, store, types.ServiceTypeIndex, "NutsComm"))
+		assert.Equal(t, []string{did1.String()}, collectIndex(t, store, types.ServiceTypeIndex, "Other"))
+		assert.Equal(t, []string{did2.String()}, collectIndex(t, store, types.ControllerIndex, controller.String()))
+		assert.Equal(t, []string{did1.String()}, collectIndex(t, store, types.DeactivatedIndex, "true"))
+	})
+}
+
+func TestBBoltStore_ensureIndexes(t *testing.T) {
+	store := newBBoltTestStore(t)
+	did1, _ := did.ParseDID("did:nuts:1")
+	_ = store.Write(did.Document{ID: *did1, Service: []did.Service{{Type: "NutsComm"}}}, types.DocumentMetadata{Hash: hash.SHA256Sum([]byte("1"))})
+	// Simulate a store that was created before indexes were introduced
+	_ = store.db.Update(func(tx *bbolt.Tx) error {
+		return tx.DeleteBucket(indexesBucket)
+	})
+	assert.Empty(t, collectIndex(t, store, types.ServiceTypeIndex, "NutsComm"))
+
+	err := store.ensureIndexes()
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{did1.String()}, collectIndex(t, store, types.ServiceTypeIndex, "NutsComm"))
+}
+
+func TestBBoltStore_ensureIndexes_versionChanged(t *testing.T) {
+	store := newBBoltTestStore(t)
+	did1, _ := did.ParseDID("did:nuts:1")
+	_ = store.Write(did.Document{ID: *did1, Service: []did.Service{{Type: "NutsComm"}}}, types.DocumentMetadata{Hash: hash.SHA256Sum([]byte("1"))})
+	// Simulate indexes that were built with older index definitions, which lacked the service type index
+	_ = store.db.Update(func(tx *bbolt.Tx) error {
+		indexes := tx.Bucket(indexesBucket)
+		_ = indexes.Put(indexesVersionKey, []byte("0"))
+		return indexes.DeleteBucket([]byte(types.ServiceTypeIndex))
+	})
+	assert.Empty(t, collectIndex(t, store, types.ServiceTypeIndex, "NutsComm"))
+
+	err := store.ensureIndexes()
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{did1.String()}, collectIndex(t, store, types.ServiceTypeIndex, "NutsComm"))
+	_ = store.db.View(func(tx *bbolt.Tx) error {
+		assert.Equal(t, indexesVersion, string(tx.Bucket(indexesBucket).Get(indexesVersionKey)))
+		return nil
+	})
+}
@@ -41,7 +41,11 @@ func newBBoltTestStore(t *testing.T) *bboltStore {
 	db, err := bbolt.Open(filepath.Join(dir, "bbolt.db"), 0644, &opts)
 	assert.NoError(t, err)
 
-	return NewBBoltStore(db).(*bboltStore)
+	store, err := NewBBoltStore(db)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return store.(*bboltStore)
 }
 
 func TestBBoltStore_Write(t *testing.T) {
@@ -19,9 +19,50 @@
 
 package store
 
-import "github.com/nuts-foundation/go-did/did"
+import (
+	"crypto"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/nuts-foundation/go-did/did"
+	vdr "github.com/nuts-foundation/nuts-node/vdr/types"
+)
 
 // IsDeactivated returns true is a DID Document is deactivated. The DID Document no longer has any controllers and CapabilityInvocation keys.
 func IsDeactivated(doc did.Document) bool {
 	return len(doc.Controller) == 0 && len(doc.CapabilityInvocation) == 0
 }
+
+// KeyThumbprint returns the RFC7638 JWK thumbprint (base64url encoded SHA-256) of the key of the given verification method.
+// For Nuts DID Documents this equals the fragment of the verification method ID.
+func KeyThumbprint(method did.VerificationMethod) (string, error) {
+	keyAsJWK, err := method.JWK()
+	if err != nil {
+		return "", err
+	}
+	thumbprint, err := keyAsJWK.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// indexValues derives the values of all secondary indexes for the given DID Document, mapped by index name.
+// Verification methods whose key can't be parsed are left out of the thumbprint index.
+func indexValues(document did.Document, metadata vdr.DocumentMetadata) map[string][]string {
+	result := map[string][]string{
+		vdr.DeactivatedIndex: {strconv.FormatBool(metadata.Deactivated)},
+	}
+	for _, service := range document.Service {
+		result[vdr.ServiceTypeIndex] = append(result[vdr.ServiceTypeIndex], service.Type)
+	}
+	for _, controller := range document.Controller {
+		result[vdr.ControllerIndex] = append(result[vdr.ControllerIndex], controller.String())
+	}
+	for _, method := range document.VerificationMethod {
+		if thumbprint, err := KeyThumbprint(*method); err == nil {
+			result[vdr.KeyThumbprintIndex] = append(result[vdr.KeyThumbprintIndex], thumbprint)
+		}
+	}
+	return result
+}
@@ -27,10 +27,11 @@ import (
 
 // NewMemoryStore initializes a new in-memory store
 // All actions on the store are thread safe.
-func NewMemoryStore() vdr.Store {
+func NewMemoryStore() vdr.IndexedStore {
 	return &memory{
-		store: map[string]versionedEntryList{},
-		mutex: sync.RWMutex{},
+		store:   map[string]versionedEntryList{},
+		indexes: map[string]map[string]map[string]struct{}{},
+		mutex:   sync.RWMutex{},
 	}
 }
 
@@ -58,7 +59,9 @@ func (list versionedEntryList) last() (*memoryEntry, error) {
 
 type memory struct {
 	store map[string]versionedEntryList
-	mutex sync.RWMutex
+	// indexes maps index name to indexed value to the DIDs of the documents which latest version has that value.
+	indexes map[string]map[string]map[string]struct{}
+	mutex   sync.RWMutex
 }
 
 type memoryEntry struct {
@@ -191,6 +194,7 @@ func (m *memory) Write(document did.Document, metadata vdr.DocumentMetadata) err
 			metadata: metadata,
 		},
 	}
+	m.updateIndexes(document.ID.String(), nil, &document, metadata)
 
 	return nil
 }
@@ -229,6 +233,7 @@ func (m *memory) Update(id did.DID, current hash.SHA256Hash, next did.Document,
 	entry.next = newEntry
 
 	m.store[id.String()] = append(entries, newEntry)
+	m.updateIndexes(id.String(), entry, &next, *metadata)
 
 	return nil
 }
@@ -249,3 +254,52 @@ func (m *memory) Iterate(fn vdr.DocIterator) error {
 	}
 	return nil
 }
+
+// updateIndexes removes the index entries of the previous version of a document (if any) and adds the entries of the next version.
+func (m *memory) updateIndexes(id string, previous *memoryEntry, next *did.Document, metadata vdr.DocumentMetadata) {
+	if previous != nil {
+		for name, values := range indexValues(previous.document, previous.metadata) {
+			for _, value := range values {
+				delete(m.indexes[name][value], id)
+				if len(m.indexes[name][value]) == 0 {
+					delete(m.indexes[name], value)
+				}
+			}
+		}
+	}
+	for name, values := range indexValues(*next, metadata) {
+		index, ok := m.indexes[name]
+		if !ok {
+			index = map[string]map[string]struct{}{}
+			m.indexes[name] = index
+		}
+		for _, value := range values {
+			if index[value] == nil {
+				index[value] = map[string]struct{}{}
+			}
+			index[value][id] = struct{}{}
+		}
+	}
+}
+
+// IterateIndex loops over the latest versions of the DID Documents that have the given value in the given index and applies fn.
+func (m *memory) IterateIndex(name string, value string, fn vdr.DocIterator) error {
+	if !isKnownIndex(name) {
+		return vdr.ErrUnknownIndex
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for id := range m.indexes[name][value] {
+		entry, err := m.store[id].last()
+		if err != nil {
+			return err
+		}
+
+		if err = fn(entry.document, entry.metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -360,3 +360,53 @@ func TestMemory_Iterate(t *testing.T) {
 		assert.Equal(t, types.ErrNotFound, err)
 	})
 }
+
+func TestMemory_IterateIndex(t *testing.T) {
+	store := NewMemoryStore()
+	did1, _ := did.ParseDID("did:nuts:1")
+	did2, _ := did.ParseDID("did:nuts:2")
+	controller, _ := did.ParseDID("did:nuts:controller")
+	firstHash := hash.SHA256Sum([]byte("1"))
+	doc1 := did.Document{ID: *did1, Controller: []did.DID{*controller}, Service: []did.Service{{Type: "NutsComm"}}}
+	_ = store.Write(doc1, types.DocumentMetadata{Hash: firstHash})
+	_ = store.Write(did.Document{ID: *did2}, types.DocumentMetadata{Hash: hash.SHA256Sum([]byte("2"))})
+	collect := func(name, value string) []string {
+		var result []string
+		err := store.IterateIndex(name, value, func(doc did.Document, _ types.DocumentMetadata) error {
+			result = append(result, doc.ID.String())
+			return nil
+		})
+		assert.NoError(t, err)
+		return result
+	}
+
+	t.Run("hit", func(t *testing.T) {
+		assert.Equal(t, []string{did1.String()}, collect(types.ServiceTypeIndex, "NutsComm"))
+		assert.Equal(t, []string{did1.String()}, collect(types.ControllerIndex, controller.String()))
+		assert.Len(t, collect(types.DeactivatedIndex, "false"), 2)
+	})
+
+	t.Run("no hits", func(t *testing.T) {
+		assert.Empty(t, collect(types.ServiceTypeIndex, "Other"))
+	})
+
+	t.Run("unknown index", func(t *testing.T) {
+		err := store.IterateIndex("foo", "bar", func(_ did.Document, _ types.DocumentMetadata) error {
+			return nil
+		})
+
+		assert.Equal(t, types.ErrUnknownIndex, err)
+	})
+
+	t.Run("entries of previous version are removed on update", func(t *testing.T) {
+		next := did.Document{ID: *did1, Controller: []did.DID{*controller}, Service: []did.Service{{Type: "Other"}}}
+		err := store.Update(*did1, firstHash, next, &types.DocumentMetadata{Hash: hash.SHA256Sum([]byte("3"))})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, collect(types.ServiceTypeIndex, "NutsComm"))
+		assert.Equal(t, []string{did1.String()}, collect(types.ServiceTypeIndex, "Other"))
+		assert.Equal(t, []string{did1.String()}, collect(types.ControllerIndex, controller.String()))
+	})
+}
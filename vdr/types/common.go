@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/nuts-foundation/go-did/did"
+	"github.com/nuts-foundation/nuts-node/crypto"
 	"github.com/nuts-foundation/nuts-node/crypto/hash"
 )
 
@@ -63,6 +64,21 @@ var ErrServiceReferenceToDeep = errors.New("service references are nested to dee
 // ErrInvalidServiceQuery is returned when a compound service contains an invalid service reference.
 var ErrInvalidServiceQuery = errors.New("service query is invalid")
 
+// ErrUnknownIndex is returned when a store is queried using an index it doesn't maintain.
+var ErrUnknownIndex = errors.New("unknown index")
+
+// ServiceTypeIndex is the name of the index on the types of the services of a DID Document.
+const ServiceTypeIndex = "serviceType"
+
+// ControllerIndex is the name of the index on the controllers of a DID Document.
+const ControllerIndex = "controller"
+
+// KeyThumbprintIndex is the name of the index on the JWK thumbprints of the verification methods of a DID Document.
+const KeyThumbprintIndex = "keyThumbprint"
+
+// DeactivatedIndex is the name of the index on the deactivation state of a DID Document. Its values are "true" and "false".
+const DeactivatedIndex = "deactivated"
+
 type deactivatedError struct {
 	msg string
 }
@@ -89,6 +105,9 @@ type DocumentMetadata struct {
 	SourceTransactions []hash.SHA256Hash `json:"txs"`
 	// Deactivated indicates if the document is deactivated
 	Deactivated bool `json:"deactivated"`
+	// SignedBy is the DID whose key signed the transaction that created the current version of this DID Document:
+	// the DID itself for a new DID Document, or the controller that updated it.
+	SignedBy *did.DID `json:"signedBy,omitempty"`
 }
 
 // Copy creates a deep copy of DocumentMetadata
@@ -103,6 +122,10 @@ func (m DocumentMetadata) Copy() DocumentMetadata {
 		m.PreviousHash = &prevHash
 	}
 	m.SourceTransactions = append(m.SourceTransactions[:0:0], m.SourceTransactions...)
+	if m.SignedBy != nil {
+		signedBy := *m.SignedBy
+		m.SignedBy = &signedBy
+	}
 
 	return m
 }
@@ -154,4 +177,73 @@ type DIDCreationOptions struct {
 	// SelfControl indicates wether the generated DID Document can be altered with its own capabilityInvocation key.
 	// Defaults to true when not given.
 	SelfControl bool
+
+	// KeyType specifies the type of the generated key pair. Defaults to crypto.DefaultKeyType when empty.
+	// Ed25519 and RSA keys can't be used for key agreement.
+	KeyType crypto.KeyType
+}
+
+// ProvisioningStatus describes the outcome of provisioning a single DID Document.
+type ProvisioningStatus string
+
+const (
+	// ProvisioningCreated indicates the DID Document was created and published.
+	ProvisioningCreated ProvisioningStatus = "created"
+	// ProvisioningExisting indicates a DID Document was already provisioned for the external reference, so nothing was created.
+	ProvisioningExisting ProvisioningStatus = "existing"
+	// ProvisioningFailed indicates the DID Document could not be provisioned.
+	ProvisioningFailed ProvisioningStatus = "failed"
+)
+
+// ProvisioningItem describes a single DID Document to be created through bulk provisioning.
+type ProvisioningItem struct {
+	// ExternalReference identifies the subject (e.g. a care organisation) in the requester's system.
+	// A DID Document is created only once for every external reference, which makes provisioning idempotent.
+	ExternalReference string
+	// Services are added to the DID Document before it's published. Their IDs are generated.
+	Services []did.Service
+}
+
+// ProvisioningResult contains the outcome of provisioning a single ProvisioningItem.
+type ProvisioningResult struct {
+	// ExternalReference is copied from the ProvisioningItem.
+	ExternalReference string
+	// DID contains the DID of the created or previously provisioned DID Document. It's nil when no DID Document was created.
+	DID *did.DID
+	// Status indicates whether the DID Document was created, already existed or failed.
+	Status ProvisioningStatus
+	// Error contains the reason provisioning failed.
+	Error error
+}
+
+// DocumentViolation describes a single reason why a DID Document can't be published.
+type DocumentViolation struct {
+	// Check identifies the check that failed, e.g. "verificationMethod", "service", "controller" or "managedKey".
+	Check string `json:"check"`
+	// Message describes the violation.
+	Message string `json:"message"`
+}
+
+// DocumentDiff describes the changes of a next version of a DID Document compared to its current version.
+type DocumentDiff struct {
+	AddedVerificationMethods   []string `json:"addedVerificationMethods"`
+	RemovedVerificationMethods []string `json:"removedVerificationMethods"`
+	AddedServices              []string `json:"addedServices"`
+	RemovedServices            []string `json:"removedServices"`
+	ChangedServices            []string `json:"changedServices"`
+	AddedControllers           []string `json:"addedControllers"`
+	RemovedControllers         []string `json:"removedControllers"`
+}
+
+// DocumentValidationReport is the result of validating a next version of a DID Document without publishing it.
+type DocumentValidationReport struct {
+	// Violations lists all reasons why the DID Document can't be published. It is empty when the DID Document is valid.
+	Violations []DocumentViolation `json:"violations"`
+	// Diff describes the changes compared to the current version of the DID Document.
+	Diff DocumentDiff `json:"diff"`
+}
+
+// Valid returns true if the report contains no violations.
+func (r DocumentValidationReport) Valid() bool {
+	return len(r.Violations) == 0
 }
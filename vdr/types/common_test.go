@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nuts-foundation/go-did/did"
 	"github.com/nuts-foundation/nuts-node/crypto/hash"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,6 +34,7 @@ func TestCopy(t *testing.T) {
 	timeNow := time.Now()
 	timeLater := time.Now().Add(time.Hour * +24)
 	h, _ := hash.ParseHex("452d9e89d5bd5d9225fb6daecd579e7388a166c7661ca04e47fd3cd8446e4620")
+	signer := did.MustParseDID("did:nuts:signer")
 
 	meta := DocumentMetadata{
 		Created:            timeBefore,
@@ -41,8 +43,9 @@ func TestCopy(t *testing.T) {
 		PreviousHash:       &h,
 		Deactivated:        false,
 		SourceTransactions: []hash.SHA256Hash{h},
+		SignedBy:           &signer,
 	}
-	numFields := 6
+	numFields := 7
 
 	t.Run("returns error if metadata can be manipulated", func(t *testing.T) {
 		var metaCopy DocumentMetadata
@@ -68,6 +71,10 @@ func TestCopy(t *testing.T) {
 		metaCopy.SourceTransactions[0] = hash.SHA256Hash{20}
 		assert.NotEqual(t, metaCopy.SourceTransactions, meta.SourceTransactions, "SourceTransactions is not deep-copied")
 
+		// SignedBy
+		*metaCopy.SignedBy = did.MustParseDID("did:nuts:other")
+		assert.NotEqual(t, metaCopy.SignedBy, meta.SignedBy, "SignedBy is not deep-copied")
+
 		// if this test fails, please make sure the Copy() method is updated as well!
 		assert.Equal(t, numFields, reflect.TypeOf(DocumentMetadata{}).NumField())
 	})
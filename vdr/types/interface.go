@@ -47,6 +47,13 @@ type Predicate interface {
 	Match(did.Document, DocumentMetadata) bool
 }
 
+// IndexedPredicate is a Predicate that can be answered using a secondary index of an IndexedStore.
+type IndexedPredicate interface {
+	Predicate
+	// Index returns the name of the index and the value that must be looked up in it.
+	Index() (name string, value string)
+}
+
 // DocFinder is the interface that groups all methods for finding DID documents based on search conditions
 type DocFinder interface {
 	Find(...Predicate) ([]did.Document, error)
@@ -125,6 +132,15 @@ type Store interface {
 	DocUpdater
 }
 
+// IndexedStore is a Store that keeps secondary indexes on the latest versions of the stored DID Documents.
+type IndexedStore interface {
+	Store
+	// IterateIndex loops over the latest versions of the DID Documents that have the given value in the given index and applies fn.
+	// It returns ErrUnknownIndex if the store doesn't maintain the given index.
+	// Calling any of the Store's functions from the given fn might cause a deadlock.
+	IterateIndex(index string, value string, fn DocIterator) error
+}
+
 // VDR defines the public end facing methods for the Verifiable Data Registry.
 type VDR interface {
 	DocCreator
@@ -132,6 +148,18 @@ type VDR interface {
 
 	// ConflictedDocuments returns the DID Document and metadata of all documents with a conflict.
 	ConflictedDocuments() ([]did.Document, []DocumentMetadata, error)
+
+	// Validate performs all checks Update would perform on the next version of a DID Document, without publishing it.
+	// Unlike Update, it doesn't stop at the first failing check but reports all violations,
+	// together with the differences between the current and next version.
+	// It returns ErrNotFound if the current version of the DID Document can't be found.
+	Validate(id did.DID, current hash.SHA256Hash, next did.Document) (*DocumentValidationReport, error)
+
+	// Provision creates a DID Document using the given options for every item that hasn't been provisioned before,
+	// which is determined by its external reference. Every DID Document is published in a single transaction including its services.
+	// It returns a result for every item, in the same order. A failing item does not stop the others from being provisioned,
+	// so a failed batch can be resubmitted as a whole.
+	Provision(options DIDCreationOptions, items []ProvisioningItem) ([]ProvisioningResult, error)
 }
 
 // DocManipulator groups several higher level methods to alter the state of a DID document.
@@ -142,7 +170,9 @@ type DocManipulator interface {
 	// If the DID Document is not found ErrNotFound is returned
 	// If the DID Document is not managed by this node, ErrDIDNotManagedByThisNode is returned
 	// If the DID Document is already deactivated ErrDeactivated is returned
-	Deactivate(id did.DID) error
+	// If retireKeys is true, the private keys of the DID document are retired, so they can't be used for signing anymore
+	// and are purged after the configured retention period.
+	Deactivate(id did.DID, retireKeys bool) error
 
 	// RemoveVerificationMethod removes a VerificationMethod from a DID document.
 	// It accepts the id DID as identifier for the DID document.
@@ -151,12 +181,14 @@ type DocManipulator interface {
 	// It returns an ErrNotFound when there is no VerificationMethod with the provided kid in the document.
 	// It returns an ErrDeactivated when the DID document has the deactivated state.
 	// It returns an ErrDIDNotManagedByThisNode if the DID document is not managed by this node.
-	RemoveVerificationMethod(id, keyID did.DID) error
+	// If retireKey is true, the private key of the VerificationMethod is retired.
+	RemoveVerificationMethod(id, keyID did.DID, retireKey bool) error
 
-	// AddVerificationMethod generates a new key and adds it, wrapped as a VerificationMethod, to a DID document.
-	// It accepts a DID as identifier for the DID document.
+	// AddVerificationMethod generates a new key of the given type and adds it, wrapped as a VerificationMethod, to a DID document.
+	// It accepts a DID as identifier for the DID document. An empty key type yields a key of crypto.DefaultKeyType.
 	// It returns an ErrNotFound when the DID document could not be found.
 	// It returns an ErrDeactivated when the DID document has the deactivated state.
 	// It returns an ErrDIDNotManagedByThisNode if the DID document is not managed by this node.
-	AddVerificationMethod(id did.DID) (*did.VerificationMethod, error)
+	// It returns a crypto.ErrUnsupportedKeyType if the key type is unknown.
+	AddVerificationMethod(id did.DID, keyType crypto2.KeyType) (*did.VerificationMethod, error)
 }
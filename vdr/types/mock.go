@@ -107,6 +107,58 @@ func (mr *MockPredicateMockRecorder) Match(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockPredicate)(nil).Match), arg0, arg1)
 }
 
+// MockIndexedPredicate is a mock of IndexedPredicate interface.
+type MockIndexedPredicate struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexedPredicateMockRecorder
+}
+
+// MockIndexedPredicateMockRecorder is the mock recorder for MockIndexedPredicate.
+type MockIndexedPredicateMockRecorder struct {
+	mock *MockIndexedPredicate
+}
+
+// NewMockIndexedPredicate creates a new mock instance.
+func NewMockIndexedPredicate(ctrl *gomock.Controller) *MockIndexedPredicate {
+	mock := &MockIndexedPredicate{ctrl: ctrl}
+	mock.recorder = &MockIndexedPredicateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndexedPredicate) EXPECT() *MockIndexedPredicateMockRecorder {
+	return m.recorder
+}
+
+// Index mocks base method.
+func (m *MockIndexedPredicate) Index() (string, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Index")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// Index indicates an expected call of Index.
+func (mr *MockIndexedPredicateMockRecorder) Index() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Index", reflect.TypeOf((*MockIndexedPredicate)(nil).Index))
+}
+
+// Match mocks base method.
+func (m *MockIndexedPredicate) Match(arg0 did.Document, arg1 DocumentMetadata) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Match", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Match indicates an expected call of Match.
+func (mr *MockIndexedPredicateMockRecorder) Match(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockIndexedPredicate)(nil).Match), arg0, arg1)
+}
+
 // MockDocFinder is a mock of DocFinder interface.
 type MockDocFinder struct {
 	ctrl     *gomock.Controller
@@ -456,6 +508,101 @@ func (mr *MockStoreMockRecorder) Write(document, metadata interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockStore)(nil).Write), document, metadata)
 }
 
+// MockIndexedStore is a mock of IndexedStore interface.
+type MockIndexedStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexedStoreMockRecorder
+}
+
+// MockIndexedStoreMockRecorder is the mock recorder for MockIndexedStore.
+type MockIndexedStoreMockRecorder struct {
+	mock *MockIndexedStore
+}
+
+// NewMockIndexedStore creates a new mock instance.
+func NewMockIndexedStore(ctrl *gomock.Controller) *MockIndexedStore {
+	mock := &MockIndexedStore{ctrl: ctrl}
+	mock.recorder = &MockIndexedStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndexedStore) EXPECT() *MockIndexedStoreMockRecorder {
+	return m.recorder
+}
+
+// Iterate mocks base method.
+func (m *MockIndexedStore) Iterate(fn DocIterator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockIndexedStoreMockRecorder) Iterate(fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockIndexedStore)(nil).Iterate), fn)
+}
+
+// IterateIndex mocks base method.
+func (m *MockIndexedStore) IterateIndex(index, value string, fn DocIterator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateIndex", index, value, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateIndex indicates an expected call of IterateIndex.
+func (mr *MockIndexedStoreMockRecorder) IterateIndex(index, value, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateIndex", reflect.TypeOf((*MockIndexedStore)(nil).IterateIndex), index, value, fn)
+}
+
+// Resolve mocks base method.
+func (m *MockIndexedStore) Resolve(id did.DID, metadata *ResolveMetadata) (*did.Document, *DocumentMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", id, metadata)
+	ret0, _ := ret[0].(*did.Document)
+	ret1, _ := ret[1].(*DocumentMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockIndexedStoreMockRecorder) Resolve(id, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockIndexedStore)(nil).Resolve), id, metadata)
+}
+
+// Update mocks base method.
+func (m *MockIndexedStore) Update(id did.DID, current hash.SHA256Hash, next did.Document, metadata *DocumentMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", id, current, next, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIndexedStoreMockRecorder) Update(id, current, next, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIndexedStore)(nil).Update), id, current, next, metadata)
+}
+
+// Write mocks base method.
+func (m *MockIndexedStore) Write(document did.Document, metadata DocumentMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", document, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockIndexedStoreMockRecorder) Write(document, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockIndexedStore)(nil).Write), document, metadata)
+}
+
 // MockVDR is a mock of VDR interface.
 type MockVDR struct {
 	ctrl     *gomock.Controller
@@ -511,6 +658,21 @@ func (mr *MockVDRMockRecorder) Create(options interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVDR)(nil).Create), options)
 }
 
+// Provision mocks base method.
+func (m *MockVDR) Provision(options DIDCreationOptions, items []ProvisioningItem) ([]ProvisioningResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Provision", options, items)
+	ret0, _ := ret[0].([]ProvisioningResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Provision indicates an expected call of Provision.
+func (mr *MockVDRMockRecorder) Provision(options, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Provision", reflect.TypeOf((*MockVDR)(nil).Provision), options, items)
+}
+
 // Update mocks base method.
 func (m *MockVDR) Update(id did.DID, current hash.SHA256Hash, next did.Document, metadata *DocumentMetadata) error {
 	m.ctrl.T.Helper()
@@ -525,6 +687,21 @@ func (mr *MockVDRMockRecorder) Update(id, current, next, metadata interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVDR)(nil).Update), id, current, next, metadata)
 }
 
+// Validate mocks base method.
+func (m *MockVDR) Validate(id did.DID, current hash.SHA256Hash, next did.Document) (*DocumentValidationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", id, current, next)
+	ret0, _ := ret[0].(*DocumentValidationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockVDRMockRecorder) Validate(id, current, next interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockVDR)(nil).Validate), id, current, next)
+}
+
 // MockDocManipulator is a mock of DocManipulator interface.
 type MockDocManipulator struct {
 	ctrl     *gomock.Controller
@@ -549,44 +726,44 @@ func (m *MockDocManipulator) EXPECT() *MockDocManipulatorMockRecorder {
 }
 
 // AddVerificationMethod mocks base method.
-func (m *MockDocManipulator) AddVerificationMethod(id did.DID) (*did.VerificationMethod, error) {
+func (m *MockDocManipulator) AddVerificationMethod(id did.DID, keyType crypto0.KeyType) (*did.VerificationMethod, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddVerificationMethod", id)
+	ret := m.ctrl.Call(m, "AddVerificationMethod", id, keyType)
 	ret0, _ := ret[0].(*did.VerificationMethod)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddVerificationMethod indicates an expected call of AddVerificationMethod.
-func (mr *MockDocManipulatorMockRecorder) AddVerificationMethod(id interface{}) *gomock.Call {
+func (mr *MockDocManipulatorMockRecorder) AddVerificationMethod(id, keyType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVerificationMethod", reflect.TypeOf((*MockDocManipulator)(nil).AddVerificationMethod), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVerificationMethod", reflect.TypeOf((*MockDocManipulator)(nil).AddVerificationMethod), id, keyType)
 }
 
 // Deactivate mocks base method.
-func (m *MockDocManipulator) Deactivate(id did.DID) error {
+func (m *MockDocManipulator) Deactivate(id did.DID, retireKeys bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Deactivate", id)
+	ret := m.ctrl.Call(m, "Deactivate", id, retireKeys)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Deactivate indicates an expected call of Deactivate.
-func (mr *MockDocManipulatorMockRecorder) Deactivate(id interface{}) *gomock.Call {
+func (mr *MockDocManipulatorMockRecorder) Deactivate(id, retireKeys interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockDocManipulator)(nil).Deactivate), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockDocManipulator)(nil).Deactivate), id, retireKeys)
 }
 
 // RemoveVerificationMethod mocks base method.
-func (m *MockDocManipulator) RemoveVerificationMethod(id, keyID did.DID) error {
+func (m *MockDocManipulator) RemoveVerificationMethod(id, keyID did.DID, retireKey bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveVerificationMethod", id, keyID)
+	ret := m.ctrl.Call(m, "RemoveVerificationMethod", id, keyID, retireKey)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveVerificationMethod indicates an expected call of RemoveVerificationMethod.
-func (mr *MockDocManipulatorMockRecorder) RemoveVerificationMethod(id, keyID interface{}) *gomock.Call {
+func (mr *MockDocManipulatorMockRecorder) RemoveVerificationMethod(id, keyID, retireKey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVerificationMethod", reflect.TypeOf((*MockDocManipulator)(nil).RemoveVerificationMethod), id, keyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVerificationMethod", reflect.TypeOf((*MockDocManipulator)(nil).RemoveVerificationMethod), id, keyID, retireKey)
 }
@@ -21,6 +21,7 @@ package vdr
 import (
 	"errors"
 	"fmt"
+	"github.com/lestrrat-go/jwx/jwa"
 	"github.com/lestrrat-go/jwx/jwk"
 	ssi "github.com/nuts-foundation/go-did"
 	"github.com/nuts-foundation/go-did/did"
@@ -30,11 +31,11 @@ import (
 // CreateDocumentValidator creates a DID Document validator that checks for inconsistencies in the the DID Document:
 // - validate it according to the W3C DID Core Data Model specification
 // - validate is according to the Nuts DID Method specification:
-//  - it checks validationMethods for the following conditions:
+//   - it checks validationMethods for the following conditions:
 //   - every validationMethod id must have a fragment
 //   - every validationMethod id should have the DID prefix
 //   - every validationMethod id must be unique
-//  - it checks services for the following conditions:
+//   - it checks services for the following conditions:
 //   - every service id must have a fragment
 //   - every service id should have the DID prefix
 //   - every service id must be unique
@@ -46,19 +47,71 @@ func CreateDocumentValidator() did.Validator {
 	}}
 }
 
+// createManagedDocumentValidator creates a DID Document validator for DID Documents created or updated by this node.
+// Next to the checks of CreateDocumentValidator it requires every keyAgreement to refer to an EC key.
+// This check isn't part of CreateDocumentValidator, since documents received through the network
+// may have been published by nodes that don't enforce it.
+func createManagedDocumentValidator() did.Validator {
+	return &did.MultiValidator{Validators: []did.Validator{
+		CreateDocumentValidator(),
+		keyAgreementValidator{},
+	}}
+}
+
 // verificationMethodValidator validates the Verification Methods of a Nuts DID Document.
 type verificationMethodValidator struct{}
 
 func (v verificationMethodValidator) Validate(document did.Document) error {
+	return firstError(v.validateAll(document))
+}
+
+// validateAll validates all Verification Methods and returns an error for every invalid one.
+func (v verificationMethodValidator) validateAll(document did.Document) []error {
+	var errs []error
 	knownKeyIds := make(map[string]bool, 0)
 	for _, method := range document.VerificationMethod {
 		if err := verifyDocumentEntryID(document.ID, method.ID.URI(), knownKeyIds); err != nil {
-			return fmt.Errorf("invalid verificationMethod: %w", err)
+			errs = append(errs, fmt.Errorf("invalid verificationMethod: %w", err))
+			continue
 		}
 		if err := v.verifyThumbprint(method); err != nil {
-			return fmt.Errorf("invalid verificationMethod: %w", err)
+			errs = append(errs, fmt.Errorf("invalid verificationMethod: %w", err))
+		}
+	}
+	return errs
+}
+
+// keyAgreementValidator validates the keyAgreement relationships of a DID Document.
+type keyAgreementValidator struct{}
+
+func (v keyAgreementValidator) Validate(document did.Document) error {
+	return firstError(v.validateAll(document))
+}
+
+// validateAll validates all keyAgreement relationships and returns an error for every invalid one.
+func (v keyAgreementValidator) validateAll(document did.Document) []error {
+	var errs []error
+	for _, keyAgreement := range document.KeyAgreement {
+		if err := v.verifyKeyAgreement(keyAgreement.VerificationMethod); err != nil {
+			errs = append(errs, fmt.Errorf("invalid keyAgreement: %w", err))
 		}
 	}
+	return errs
+}
+
+// verifyKeyAgreement checks whether the key can be used for key agreement (ECDH), which rules out Ed25519 and RSA keys.
+func (v keyAgreementValidator) verifyKeyAgreement(method *did.VerificationMethod) error {
+	if method == nil {
+		return nil
+	}
+	keyAsJWK, err := method.JWK()
+	if err != nil || keyAsJWK == nil {
+		// invalid keys are reported by verifyThumbprint
+		return nil
+	}
+	if keyAsJWK.KeyType() != jwa.EC {
+		return errors.New("key type does not support key agreement")
+	}
 	return nil
 }
 
@@ -67,6 +120,9 @@ func (v verificationMethodValidator) verifyThumbprint(method *did.VerificationMe
 	if err != nil {
 		return fmt.Errorf("unable to get JWK: %w", err)
 	}
+	if keyAsJWK == nil {
+		return errors.New("no public key")
+	}
 	_ = jwk.AssignKeyID(keyAsJWK)
 	if keyAsJWK.KeyID() != method.ID.Fragment {
 		return errors.New("key thumbprint does not match ID")
@@ -78,6 +134,12 @@ func (v verificationMethodValidator) verifyThumbprint(method *did.VerificationMe
 type serviceValidator struct{}
 
 func (s serviceValidator) Validate(document did.Document) error {
+	return firstError(s.validateAll(document))
+}
+
+// validateAll validates all Services and returns an error for every invalid one.
+func (s serviceValidator) validateAll(document did.Document) []error {
+	var errs []error
 	knownServiceIDs := make(map[string]bool, 0)
 	knownServiceTypes := make(map[string]bool, 0)
 	for _, method := range document.Service {
@@ -88,11 +150,18 @@ func (s serviceValidator) Validate(document did.Document) error {
 			}
 		}
 		if err != nil {
-			return fmt.Errorf("invalid service: %w", err)
+			errs = append(errs, fmt.Errorf("invalid service: %w", err))
 		}
 		knownServiceTypes[method.Type] = true
 	}
-	return nil
+	return errs
+}
+
+func firstError(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs[0]
 }
 
 func verifyDocumentEntryID(owner did.DID, entryID ssi.URI, knownIDs map[string]bool) error {
@@ -20,11 +20,14 @@ package vdr
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"errors"
+	"github.com/lestrrat-go/jwx/jwk"
 	ssi "github.com/nuts-foundation/go-did"
 	"github.com/nuts-foundation/go-did/did"
+	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
@@ -66,6 +69,11 @@ func Test_verificationMethodValidator(t *testing.T) {
 			didDoc.VerificationMethod = append(didDoc.VerificationMethod, vm)
 			a.doc = didDoc
 		}, errors.New("invalid verificationMethod: key thumbprint does not match ID")},
+		{"nok - verificationMethod without public key", func(t *testing.T, a *args) {
+			didDoc, _, _ := newDidDoc()
+			didDoc.VerificationMethod[0].PublicKeyJwk = nil
+			a.doc = didDoc
+		}, errors.New("invalid verificationMethod: no public key")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -92,6 +100,38 @@ func Test_verificationMethodValidator(t *testing.T) {
 	}
 }
 
+func Test_keyAgreementValidator(t *testing.T) {
+	newDocWithEd25519KeyAgreement := func() did.Document {
+		didDoc, _, _ := newDidDoc()
+		pub, _, _ := ed25519.GenerateKey(rand.Reader)
+		keyAsJWK, _ := jwk.New(pub)
+		_ = jwk.AssignKeyID(keyAsJWK)
+		keyID := didDoc.VerificationMethod[0].ID
+		keyID.Fragment = keyAsJWK.KeyID()
+		vm, _ := did.NewVerificationMethod(keyID, ssi.JsonWebKey2020, didDoc.ID, pub)
+		didDoc.AddKeyAgreement(vm)
+		return didDoc
+	}
+
+	t.Run("ok - EC key", func(t *testing.T) {
+		didDoc, _, _ := newDidDoc()
+		didDoc.AddKeyAgreement(didDoc.VerificationMethod[0])
+
+		assert.NoError(t, keyAgreementValidator{}.Validate(didDoc))
+	})
+	t.Run("nok - Ed25519 key", func(t *testing.T) {
+		err := keyAgreementValidator{}.Validate(newDocWithEd25519KeyAgreement())
+
+		assert.EqualError(t, err, "invalid keyAgreement: key type does not support key agreement")
+	})
+	t.Run("not enforced on documents received through the network", func(t *testing.T) {
+		didDoc := newDocWithEd25519KeyAgreement()
+
+		assert.NoError(t, CreateDocumentValidator().Validate(didDoc))
+		assert.Error(t, createManagedDocumentValidator().Validate(didDoc))
+	})
+}
+
 func Test_serviceValidator(t *testing.T) {
 	type args struct {
 		doc did.Document
@@ -43,6 +43,16 @@ import (
 	"github.com/nuts-foundation/nuts-node/crypto/hash"
 )
 
+// Names of the checks reported in a DocumentValidationReport
+const (
+	versionCheck            = "version"
+	documentCheck           = "document"
+	verificationMethodCheck = "verificationMethod"
+	serviceCheck            = "service"
+	controllerCheck         = "controller"
+	managedKeyCheck         = "managedKey"
+)
+
 // VDR stands for the Nuts Verifiable Data Registry. It is the public entrypoint to work with W3C DID documents.
 // It connects the Resolve, Create and Update DID methods to the network, and receives events back from the network which are processed in the store.
 // It is also a Runnable, Diagnosable and Configurable Nuts Engine.
@@ -56,6 +66,7 @@ type VDR struct {
 	didDocCreator     types.DocCreator
 	didDocResolver    types.DocResolver
 	keyStore          crypto.KeyStore
+	provisioning      *provisioningStore
 }
 
 // NewVDR creates a new VDR with provided params
@@ -81,9 +92,27 @@ func (r *VDR) Config() interface{} {
 }
 
 // Configure configures the VDR engine.
-func (r *VDR) Configure(_ core.ServerConfig) error {
+func (r *VDR) Configure(config core.ServerConfig) error {
 	// Initiate the routines for auto-updating the data.
 	r.networkAmbassador.Configure()
+
+	var err error
+	if r.provisioning, err = newProvisioningStore(config.Datadir); err != nil {
+		return fmt.Errorf("unable to setup DID provisioning store: %w", err)
+	}
+	return nil
+}
+
+// Start starts the VDR engine.
+func (r *VDR) Start() error {
+	return nil
+}
+
+// Shutdown closes the DID provisioning store.
+func (r *VDR) Shutdown() error {
+	if r.provisioning != nil {
+		return r.provisioning.close()
+	}
 	return nil
 }
 
@@ -128,20 +157,28 @@ func (r VDR) Create(options types.DIDCreationOptions) (*did.Document, crypto.Key
 		return nil, nil, fmt.Errorf("could not create DID document: %w", err)
 	}
 
+	if err = r.publish(*doc, key); err != nil {
+		return nil, nil, err
+	}
+
+	log.Logger().Infof("New DID Document created (DID=%s)", doc.ID)
+
+	return doc, key, nil
+}
+
+// publish publishes a new DID Document on the network, signed and attached with the given key.
+func (r VDR) publish(doc did.Document, key crypto.Key) error {
 	payload, err := json.Marshal(doc)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 
 	tx := network.TransactionTemplate(didDocumentType, payload, key).WithAttachKey()
 	_, err = r.network.CreateTransaction(tx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not store DID document in network: %w", err)
+		return fmt.Errorf("could not store DID document in network: %w", err)
 	}
-
-	log.Logger().Infof("New DID Document created (DID=%s)", doc.ID)
-
-	return doc, key, nil
+	return nil
 }
 
 // Update updates a DID Document based on the DID and current hash
@@ -160,7 +197,7 @@ func (r VDR) Update(id did.DID, current hash.SHA256Hash, next did.Document, _ *t
 		return types.ErrDeactivated
 	}
 
-	if err = CreateDocumentValidator().Validate(next); err != nil {
+	if err = createManagedDocumentValidator().Validate(next); err != nil {
 		return err
 	}
 
@@ -204,6 +241,89 @@ func (r VDR) Update(id did.DID, current hash.SHA256Hash, next did.Document, _ *t
 	return err
 }
 
+// Validate performs all checks Update would perform on the next version of a DID Document, without publishing it.
+func (r VDR) Validate(id did.DID, current hash.SHA256Hash, next did.Document) (*types.DocumentValidationReport, error) {
+	latestDIDDocument, latestMeta, err := r.store.Resolve(id, &types.ResolveMetadata{AllowDeactivated: true})
+	if err != nil {
+		return nil, err
+	}
+	currentDIDDocument := latestDIDDocument
+	if !latestMeta.Hash.Equals(current) {
+		if currentDIDDocument, _, err = r.store.Resolve(id, &types.ResolveMetadata{Hash: &current, AllowDeactivated: true}); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &types.DocumentValidationReport{
+		Violations: []types.DocumentViolation{},
+		Diff:       documentDiff(*currentDIDDocument, next),
+	}
+	addViolation := func(check string, err error) {
+		report.Violations = append(report.Violations, types.DocumentViolation{Check: check, Message: err.Error()})
+	}
+
+	if !latestMeta.Hash.Equals(current) {
+		addViolation(versionCheck, types.ErrUpdateOnOutdatedData)
+	}
+	if store.IsDeactivated(*currentDIDDocument) {
+		addViolation(documentCheck, types.ErrDeactivated)
+	}
+	if !next.ID.Equals(id) {
+		addViolation(documentCheck, fmt.Errorf("document ID (%s) does not match DID (%s)", next.ID, id))
+	}
+	// the same validators as for updating the document, so the validation doesn't pass documents the update rejects
+	report.Violations = append(report.Violations, documentViolations(createManagedDocumentValidator(), next)...)
+	for _, controller := range next.Controller {
+		if controller.Equals(id) {
+			continue
+		}
+		if _, _, err = r.didDocResolver.Resolve(controller, nil); err != nil {
+			addViolation(controllerCheck, fmt.Errorf("unable to resolve controller (%s): %w", controller, err))
+		}
+	}
+	if !store.IsDeactivated(*currentDIDDocument) {
+		if _, _, err = r.resolveControllerWithKey(*currentDIDDocument); err != nil {
+			if errors.Is(err, types.ErrDIDNotManagedByThisNode) {
+				addViolation(managedKeyCheck, err)
+			} else {
+				addViolation(controllerCheck, err)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// documentViolations returns the violations of the document reported by the validator. Validators that can report
+// all their violations at once do so, the others report their first violation.
+func documentViolations(validator did.Validator, document did.Document) []types.DocumentViolation {
+	if multiValidator, ok := validator.(*did.MultiValidator); ok {
+		var result []types.DocumentViolation
+		for _, curr := range multiValidator.Validators {
+			result = append(result, documentViolations(curr, document)...)
+		}
+		return result
+	}
+	check := documentCheck
+	switch validator.(type) {
+	case verificationMethodValidator, keyAgreementValidator:
+		check = verificationMethodCheck
+	case serviceValidator:
+		check = serviceCheck
+	}
+	var errs []error
+	if allValidator, ok := validator.(interface{ validateAll(did.Document) []error }); ok {
+		errs = allValidator.validateAll(document)
+	} else if err := validator.Validate(document); err != nil {
+		errs = []error{err}
+	}
+	result := make([]types.DocumentViolation, len(errs))
+	for i, err := range errs {
+		result[i] = types.DocumentViolation{Check: check, Message: err.Error()}
+	}
+	return result
+}
+
 func (r VDR) resolveControllerWithKey(doc did.Document) (did.Document, crypto.Key, error) {
 	controllers, err := r.didDocResolver.ResolveControllers(doc, nil)
 	if err != nil {
@@ -19,6 +19,8 @@
 package vdr
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"github.com/nuts-foundation/nuts-node/network/dag"
@@ -32,12 +34,14 @@ import (
 	"github.com/nuts-foundation/nuts-node/crypto"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/nuts-foundation/go-did/did"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/nuts-foundation/nuts-node/network"
 
 	"github.com/nuts-foundation/nuts-node/crypto/hash"
+	"github.com/nuts-foundation/nuts-node/test/io"
 	"github.com/nuts-foundation/nuts-node/vdr/types"
 )
 
@@ -195,6 +199,144 @@ func TestVDR_Update(t *testing.T) {
 			"expected ErrDIDNotManagedByThisNode error when the document is not managed by this node")
 	})
 }
+func TestVDR_Validate(t *testing.T) {
+	id, _ := did.ParseDID("did:nuts:123")
+	keyID, _ := did.ParseDIDURL("did:nuts:123#key-1")
+	currentHash := hash.SHA256Sum([]byte("currentHash"))
+	latestMetadata := &types.DocumentMetadata{Hash: currentHash}
+	latestResolveMetadata := &types.ResolveMetadata{AllowDeactivated: true}
+
+	newCurrentDocument := func() *did.Document {
+		currentDIDDocument := &did.Document{ID: *id, Controller: []did.DID{*id}}
+		currentDIDDocument.AddCapabilityInvocation(&did.VerificationMethod{ID: *keyID})
+		return currentDIDDocument
+	}
+	newNextDocument := func() did.Document {
+		nextDIDDocument := doc.CreateDocument()
+		nextDIDDocument.ID = *id
+		nextDIDDocument.Controller = []did.DID{*id}
+		return nextDIDDocument
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := newVDRTestCtx(t)
+		currentDIDDocument := newCurrentDocument()
+		nextDIDDocument := newNextDocument()
+		serviceID := ssi.MustParseURI(id.String() + "#service")
+		nextDIDDocument.Service = []did.Service{{ID: serviceID, Type: "type", ServiceEndpoint: "https://example.com"}}
+		ctx.mockStore.EXPECT().Resolve(*id, latestResolveMetadata).Return(currentDIDDocument, latestMetadata, nil)
+		ctx.mockKeyStore.EXPECT().Resolve(keyID.String()).Return(crypto.NewTestKey(keyID.String()), nil)
+
+		report, err := ctx.vdr.Validate(*id, currentHash, nextDIDDocument)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, report.Valid())
+		assert.Equal(t, []string{serviceID.String()}, report.Diff.AddedServices)
+		assert.Equal(t, []string{keyID.String()}, report.Diff.RemovedVerificationMethods)
+	})
+
+	t.Run("reports all violations", func(t *testing.T) {
+		ctx := newVDRTestCtx(t)
+		otherController, _ := did.ParseDID("did:nuts:other")
+		currentDIDDocument := newCurrentDocument()
+		nextDIDDocument := newNextDocument()
+		nextDIDDocument.Controller = append(nextDIDDocument.Controller, *otherController)
+		nextDIDDocument.VerificationMethod.Add(&did.VerificationMethod{ID: *keyID})
+		nextDIDDocument.Service = []did.Service{
+			{ID: ssi.MustParseURI(id.String() + "#service-1"), Type: "type", ServiceEndpoint: "https://example.com"},
+			{ID: ssi.MustParseURI(id.String() + "#service-2"), Type: "type", ServiceEndpoint: "https://example.com"},
+		}
+		ctx.mockStore.EXPECT().Resolve(*id, latestResolveMetadata).Return(currentDIDDocument, latestMetadata, nil)
+		ctx.mockStore.EXPECT().Resolve(*otherController, nil).Return(nil, nil, types.ErrNotFound)
+		ctx.mockKeyStore.EXPECT().Resolve(keyID.String()).Return(nil, crypto.ErrKeyNotFound)
+
+		report, err := ctx.vdr.Validate(*id, currentHash, nextDIDDocument)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.Len(t, report.Violations, 5) {
+			return
+		}
+		assert.Equal(t, documentCheck, report.Violations[0].Check)
+		assert.Equal(t, verificationMethodCheck, report.Violations[1].Check)
+		assert.Equal(t, serviceCheck, report.Violations[2].Check)
+		assert.Equal(t, controllerCheck, report.Violations[3].Check)
+		assert.Equal(t, managedKeyCheck, report.Violations[4].Check)
+		assert.Equal(t, []string{otherController.String()}, report.Diff.AddedControllers)
+	})
+
+	t.Run("outdated version", func(t *testing.T) {
+		ctx := newVDRTestCtx(t)
+		currentDIDDocument := newCurrentDocument()
+		oldHash := hash.SHA256Sum([]byte("oldHash"))
+		ctx.mockStore.EXPECT().Resolve(*id, latestResolveMetadata).Return(currentDIDDocument, latestMetadata, nil)
+		ctx.mockStore.EXPECT().Resolve(*id, &types.ResolveMetadata{Hash: &oldHash, AllowDeactivated: true}).Return(currentDIDDocument, &types.DocumentMetadata{Hash: oldHash}, nil)
+		ctx.mockKeyStore.EXPECT().Resolve(keyID.String()).Return(crypto.NewTestKey(keyID.String()), nil)
+
+		report, err := ctx.vdr.Validate(*id, oldHash, newNextDocument())
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.Len(t, report.Violations, 1) {
+			return
+		}
+		assert.Equal(t, versionCheck, report.Violations[0].Check)
+	})
+
+	t.Run("deactivated", func(t *testing.T) {
+		ctx := newVDRTestCtx(t)
+		ctx.mockStore.EXPECT().Resolve(*id, latestResolveMetadata).Return(&did.Document{ID: *id}, latestMetadata, nil)
+
+		report, err := ctx.vdr.Validate(*id, currentHash, newNextDocument())
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.Len(t, report.Violations, 1) {
+			return
+		}
+		assert.Equal(t, documentCheck, report.Violations[0].Check)
+		assert.Equal(t, types.ErrDeactivated.Error(), report.Violations[0].Message)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		ctx := newVDRTestCtx(t)
+		ctx.mockStore.EXPECT().Resolve(*id, latestResolveMetadata).Return(nil, nil, types.ErrNotFound)
+
+		_, err := ctx.vdr.Validate(*id, currentHash, newNextDocument())
+
+		assert.ErrorIs(t, err, types.ErrNotFound)
+	})
+}
+
+func Test_documentViolations(t *testing.T) {
+	didDoc, _, _ := newDidDoc()
+	pub, _, _ := ed25519.GenerateKey(rand.Reader)
+	keyAsJWK, _ := jwk.New(pub)
+	_ = jwk.AssignKeyID(keyAsJWK)
+	keyID := didDoc.VerificationMethod[0].ID
+	keyID.Fragment = keyAsJWK.KeyID()
+	vm, _ := did.NewVerificationMethod(keyID, ssi.JsonWebKey2020, didDoc.ID, pub)
+	didDoc.AddKeyAgreement(vm)
+	serviceID := ssi.MustParseURI(didDoc.ID.String() + "#service")
+	didDoc.Service = []did.Service{
+		{ID: serviceID, Type: "type", ServiceEndpoint: "https://example.com"},
+		{ID: serviceID, Type: "type", ServiceEndpoint: "https://example.com"},
+	}
+
+	violations := documentViolations(createManagedDocumentValidator(), didDoc)
+
+	// the keyAgreement is only rejected for documents managed by this node
+	assert.Equal(t, []types.DocumentViolation{
+		{Check: serviceCheck, Message: "invalid service: ID must be unique"},
+		{Check: verificationMethodCheck, Message: "invalid keyAgreement: key type does not support key agreement"},
+	}, violations)
+}
+
 func TestVDR_Create(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		ctx := newVDRTestCtx(t)
@@ -212,7 +354,7 @@ func TestVDR_Create(t *testing.T) {
 		nextDIDDocument.AddKeyAgreement(vm)
 		expectedPayload, _ := json.Marshal(nextDIDDocument)
 
-		ctx.mockKeyStore.EXPECT().New(gomock.Any()).Return(key, nil)
+		ctx.mockKeyStore.EXPECT().New(gomock.Any(), gomock.Any()).Return(key, nil)
 		ctx.mockNetwork.EXPECT().CreateTransaction(network.TransactionTemplate(expectedPayloadType, expectedPayload, key).WithAttachKey())
 
 		didDoc, key, err := ctx.vdr.Create(doc.DefaultCreationOptions())
@@ -224,7 +366,7 @@ func TestVDR_Create(t *testing.T) {
 
 	t.Run("error - doc creation", func(t *testing.T) {
 		ctx := newVDRTestCtx(t)
-		ctx.mockKeyStore.EXPECT().New(gomock.Any()).Return(nil, errors.New("b00m!"))
+		ctx.mockKeyStore.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil, errors.New("b00m!"))
 
 		_, _, err := ctx.vdr.Create(doc.DefaultCreationOptions())
 
@@ -234,7 +376,7 @@ func TestVDR_Create(t *testing.T) {
 	t.Run("error - transaction failed", func(t *testing.T) {
 		ctx := newVDRTestCtx(t)
 		key := crypto.NewTestKey("did:nuts:123#key-1")
-		ctx.mockKeyStore.EXPECT().New(gomock.Any()).Return(key, nil)
+		ctx.mockKeyStore.EXPECT().New(gomock.Any(), gomock.Any()).Return(key, nil)
 		ctx.mockNetwork.EXPECT().CreateTransaction(gomock.Any()).Return(nil, errors.New("b00m!"))
 
 		_, _, err := ctx.vdr.Create(doc.DefaultCreationOptions())
@@ -257,8 +399,10 @@ func TestVDR_Configure(t *testing.T) {
 	tx.EXPECT().Subscribe(dag.TransactionPayloadAddedEvent, gomock.Any(), gomock.Any())
 	cfg := Config{}
 	vdr := NewVDR(cfg, nil, tx, nil)
-	err := vdr.Configure(core.ServerConfig{})
+	err := vdr.Configure(core.ServerConfig{Datadir: io.TestDirectory(t)})
 	assert.NoError(t, err)
+	assert.NotNil(t, vdr.provisioning)
+	assert.NoError(t, vdr.Shutdown())
 }
 
 func TestVDR_ConflictingDocuments(t *testing.T) {